@@ -0,0 +1,154 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	pb "github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// error for message authentication
+var (
+	ErrUnknownReplica   = errors.New("ErrUnknownReplica")
+	ErrUnsignedRequest  = errors.New("ErrUnsignedRequest")
+	ErrInvalidSignature = errors.New("ErrInvalidSignature")
+	ErrReplicaMismatch  = errors.New("ErrReplicaMismatch")
+	ErrMessageType      = errors.New("ErrMessageType")
+)
+
+// message type carried by SignedRequest
+const (
+	MsgRequest int32 = iota
+	MsgStateFetch
+	MsgStateReply
+	MsgMembership
+)
+
+// KeyRegistry the public keys of all replicas, indexed by replica id
+type KeyRegistry map[uint32]crypto.PubKey
+
+// NewKeyRegistry create registry from hex public keys, the i-th key belongs to the replica whose nodeID is i+1
+func NewKeyRegistry(pubKeys []string) (KeyRegistry, error) {
+	cr, err := crypto.New(pb.GetSignName("", pb.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	keys := make(KeyRegistry)
+	for i, key := range pubKeys {
+		bkey, err := common.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := cr.PubKeyFromBytes(bkey)
+		if err != nil {
+			return nil, err
+		}
+		keys[uint32(i+1)] = pub
+	}
+	return keys, nil
+}
+
+// LoadPrivKey load the replica private key from hex string
+func LoadPrivKey(key string) (crypto.PrivKey, error) {
+	cr, err := crypto.New(pb.GetSignName("", pb.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(bkey)
+}
+
+func signBytes(ty int32, replica uint32, payload []byte) []byte {
+	var head [8]byte
+	binary.BigEndian.PutUint32(head[:4], uint32(ty))
+	binary.BigEndian.PutUint32(head[4:], replica)
+	return common.Sha256(append(head[:], payload...))
+}
+
+// SignMessage sign the message of type ty with the private key of replica
+func SignMessage(ty int32, replica uint32, priv crypto.PrivKey, msg proto.Message) (*SignedRequest, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	sig := priv.Sign(signBytes(ty, replica, payload))
+	return &SignedRequest{Replica: replica, Payload: payload, Signature: sig.Bytes(), Ty: ty}, nil
+}
+
+// SignRequest sign the request with the private key of replica
+func SignRequest(replica uint32, priv crypto.PrivKey, req *pb.Request) (*SignedRequest, error) {
+	return SignMessage(MsgRequest, replica, priv, req)
+}
+
+// Check check the signature of the message against the registry
+func (keys KeyRegistry) Check(signed *SignedRequest) error {
+	pub, ok := keys[signed.Replica]
+	if !ok {
+		return ErrUnknownReplica
+	}
+	if len(signed.Signature) == 0 {
+		return ErrUnsignedRequest
+	}
+	cr, err := crypto.New(pb.GetSignName("", pb.SECP256K1))
+	if err != nil {
+		return err
+	}
+	sig, err := cr.SignatureFromBytes(signed.Signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !pub.VerifyBytes(signBytes(signed.Ty, signed.Replica, signed.Payload), sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Verify check the signature against the registry and return the decoded request
+func (keys KeyRegistry) Verify(signed *SignedRequest) (*pb.Request, error) {
+	if signed.Ty != MsgRequest {
+		return nil, ErrMessageType
+	}
+	if err := keys.Check(signed); err != nil {
+		return nil, err
+	}
+	req := &pb.Request{}
+	if err := proto.Unmarshal(signed.Payload, req); err != nil {
+		return nil, err
+	}
+	if replica, ok := requestReplica(req); ok && replica != signed.Replica {
+		return nil, ErrReplicaMismatch
+	}
+	return req, nil
+}
+
+// requestReplica return the replica id carried inside the request, client requests carry none
+func requestReplica(req *pb.Request) (uint32, bool) {
+	switch req.Value.(type) {
+	case *pb.Request_Preprepare:
+		return req.GetPreprepare().Replica, true
+	case *pb.Request_Prepare:
+		return req.GetPrepare().Replica, true
+	case *pb.Request_Commit:
+		return req.GetCommit().Replica, true
+	case *pb.Request_Checkpoint:
+		return req.GetCheckpoint().Replica, true
+	case *pb.Request_Viewchange:
+		return req.GetViewchange().Replica, true
+	case *pb.Request_Ack:
+		return req.GetAck().Replica, true
+	case *pb.Request_Newview:
+		return req.GetNewview().Replica, true
+	default:
+		return 0, false
+	}
+}
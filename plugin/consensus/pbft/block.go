@@ -25,6 +25,7 @@ type Client struct {
 	replyChan   chan *types.ClientReply
 	requestChan chan *types.Request
 	isPrimary   bool
+	replica     *Replica
 }
 
 // NewBlockstore create Pbft Client
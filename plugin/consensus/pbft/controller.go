@@ -19,12 +19,17 @@ var (
 	clientAddr       string
 )
 
+const defaultDataDir = "datadir/pbft"
+
 type subConfig struct {
-	Genesis          string `json:"genesis"`
-	GenesisBlockTime int64  `json:"genesisBlockTime"`
-	NodeID           int64  `json:"nodeID"`
-	PeersURL         string `json:"peersURL"`
-	ClientAddr       string `json:"clientAddr"`
+	Genesis          string   `json:"genesis"`
+	GenesisBlockTime int64    `json:"genesisBlockTime"`
+	NodeID           int64    `json:"nodeID"`
+	PeersURL         string   `json:"peersURL"`
+	ClientAddr       string   `json:"clientAddr"`
+	PrivateKey       string   `json:"privateKey"`
+	ReplicaPubKeys   []string `json:"replicaPubKeys"`
+	DataDir          string   `json:"dataDir"`
 }
 
 // NewPbft create pbft cluster
@@ -47,8 +52,28 @@ func NewPbft(cfg *pb.Consensus, sub []byte) queue.Module {
 	}
 	clientAddr = subcfg.ClientAddr
 
+	privKey, err := LoadPrivKey(subcfg.PrivateKey)
+	if err != nil {
+		plog.Error("The privateKey is invalid", "err", err)
+		return nil
+	}
+	keys, err := NewKeyRegistry(subcfg.ReplicaPubKeys)
+	if err != nil {
+		plog.Error("The replicaPubKeys is invalid", "err", err)
+		return nil
+	}
+	if pub, ok := keys[uint32(subcfg.NodeID)]; !ok || !pub.Equals(privKey.PubKey()) {
+		plog.Error("The privateKey does not match the replicaPubKeys of nodeID", "nodeID", subcfg.NodeID)
+		return nil
+	}
+
+	if subcfg.DataDir == "" {
+		subcfg.DataDir = defaultDataDir
+	}
+
 	var c *Client
-	replyChan, requestChan, isPrimary := NewReplica(uint32(subcfg.NodeID), subcfg.PeersURL, subcfg.ClientAddr)
-	c = NewBlockstore(cfg, replyChan, requestChan, isPrimary)
+	replica := NewReplica(uint32(subcfg.NodeID), subcfg.PeersURL, subcfg.ClientAddr, subcfg.DataDir, privKey, keys)
+	c = NewBlockstore(cfg, replica.replyChan, replica.requestChan, replica.isPrimary(replica.ID))
+	c.replica = replica
 	return c
 }
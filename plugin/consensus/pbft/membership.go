@@ -0,0 +1,414 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	pb "github.com/33cn/chain33/types"
+)
+
+// membership change operation
+const (
+	MembershipAdd int32 = iota + 1
+	MembershipRemove
+)
+
+// error for membership change
+var (
+	ErrMembershipOp      = errors.New("ErrMembershipOp")
+	ErrReplicaExist      = errors.New("ErrReplicaExist")
+	ErrReplicaNotExist   = errors.New("ErrReplicaNotExist")
+	ErrTooFewReplicas    = errors.New("ErrTooFewReplicas")
+	ErrReplicaNotStarted = errors.New("ErrReplicaNotStarted")
+	// ErrMembershipSequence the change must take effect at a checkpoint boundary
+	ErrMembershipSequence = errors.New("ErrMembershipSequence")
+	// ErrMembershipEndorsement the committed change lacks the endorsements of more than 2/3 of the replicas
+	ErrMembershipEndorsement = errors.New("ErrMembershipEndorsement")
+)
+
+// membershipClient the client of the requests that carry a membership proposal
+const membershipClient = "pbft-membership"
+
+func sameMembershipChange(c1, c2 *MembershipChange) bool {
+	return bytes.Equal(pb.Encode(c1), pb.Encode(c2))
+}
+
+// SubmitMembership sign the membership change with the private key of replica and send it to the replica at addr.
+// The key is the one configured for the replica, so only its operator can endorse a change in its name.
+// Once more than 2/3 of the replicas endorsed the same change the primary orders it as a request,
+// every replica commits it at the same sequence and applies it when the checkpoint of the change is stable
+func SubmitMembership(addr string, replica uint32, priv crypto.PrivKey, change *MembershipChange) error {
+	signed, err := SignMessage(MsgMembership, replica, priv, change)
+	if err != nil {
+		return err
+	}
+	return WriteMessage(addr, signed)
+}
+
+// ToRequestMembership the client request that orders the proposal, the encoded proposal is carried in the timestamp
+func ToRequestMembership(proposal *MembershipProposal) *pb.Request {
+	return ToRequestClient(&pb.Operation{}, hex.EncodeToString(pb.Encode(proposal)), membershipClient)
+}
+
+func decodeProposal(timestamp string) (*MembershipProposal, error) {
+	data, err := hex.DecodeString(timestamp)
+	if err != nil {
+		return nil, err
+	}
+	proposal := &MembershipProposal{}
+	if err = pb.Decode(data, proposal); err != nil {
+		return nil, err
+	}
+	if proposal.Change == nil {
+		return nil, ErrMembershipOp
+	}
+	return proposal, nil
+}
+
+// checkChange check the change against the replica set of keys
+func checkChange(keys KeyRegistry, change *MembershipChange) error {
+	if change.Sequence%CheckPointPeriod != 0 {
+		return ErrMembershipSequence
+	}
+	switch change.Op {
+	case MembershipAdd:
+		if _, ok := keys[change.Replica]; ok || change.Replica == 0 {
+			return ErrReplicaExist
+		}
+		if change.Addr == "" {
+			return ErrMembershipOp
+		}
+		if _, err := parsePubKey(change.PubKey); err != nil {
+			return err
+		}
+	case MembershipRemove:
+		if _, ok := keys[change.Replica]; !ok {
+			return ErrReplicaNotExist
+		}
+		if len(keys) <= 1 {
+			return ErrTooFewReplicas
+		}
+	default:
+		return ErrMembershipOp
+	}
+	return nil
+}
+
+// endorsed the proposal carries the endorsements of more than 2/3 of the replicas of keys
+func endorsed(keys KeyRegistry, proposal *MembershipProposal) bool {
+	voters := make(map[uint32]bool)
+	for _, signed := range proposal.Endorsements {
+		change := &MembershipChange{}
+		if signed.Ty != MsgMembership || keys.Check(signed) != nil || pb.Decode(signed.Payload, change) != nil {
+			continue
+		}
+		if sameMembershipChange(change, proposal.Change) {
+			voters[signed.Replica] = true
+		}
+	}
+	return len(voters) > 2*(len(keys)-1)/3
+}
+
+// checkMembership check the change against the current replica set before the replica relays its own endorsement
+func (rep *Replica) checkMembership(change *MembershipChange) error {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	return checkChange(rep.keys, change)
+}
+
+// relayMembership forward the endorsement signed for this replica to the peers
+func (rep *Replica) relayMembership(signed *SignedRequest, change *MembershipChange) {
+	for _, replica := range rep.peers() {
+		if replica == rep.addr {
+			continue
+		}
+		if err := WriteMessage(replica, signed); err != nil {
+			plog.Error("send membership change error", "replica", replica, "err", err)
+		}
+	}
+	// a replica being added must learn the change too, so that it knows when to start
+	if change.Op == MembershipAdd {
+		if err := WriteMessage(change.Addr, signed); err != nil {
+			plog.Debug("send membership change to new replica error", "addr", change.Addr, "err", err)
+		}
+	}
+}
+
+// handleMembership collect the signed endorsement, a replica never endorses a change by itself,
+// the endorsement signed for this replica is checked and relayed to the peers.
+// The endorsements only authorize the change, the primary orders it as a request once they reach the quorum
+func (rep *Replica) handleMembership(signed *SignedRequest, change *MembershipChange) {
+	if change.Sequence <= rep.lowWaterMark() {
+		return
+	}
+	if signed.Replica == rep.ID {
+		if err := rep.checkMembership(change); err != nil {
+			plog.Error("reject membership change", "op", change.Op, "replica", change.Replica, "err", err)
+			return
+		}
+	}
+
+	rep.mu.Lock()
+	key := string(pb.Encode(change))
+	endorsements, ok := rep.endorsements[key]
+	if !ok {
+		endorsements = make(map[uint32]*SignedRequest)
+		rep.endorsements[key] = endorsements
+	}
+	_, known := rep.keys[signed.Replica]
+	counted := known && endorsements[signed.Replica] == nil
+	if counted {
+		endorsements[signed.Replica] = signed
+	}
+	var proposal *MembershipProposal
+	if counted && len(endorsements) > 2*(len(rep.keys)-1)/3 {
+		proposal = &MembershipProposal{Change: change}
+		for _, id := range sortedEndorsers(endorsements) {
+			proposal.Endorsements = append(proposal.Endorsements, endorsements[id])
+		}
+		delete(rep.endorsements, key)
+	}
+	rep.mu.Unlock()
+
+	if counted && signed.Replica == rep.ID {
+		rep.relayMembership(signed, change)
+	}
+	if proposal != nil && rep.isPrimary(rep.ID) {
+		plog.Info("membership change endorsed", "op", change.Op, "replica", change.Replica, "sequence", change.Sequence)
+		req := ToRequestMembership(proposal)
+		go func() {
+			rep.requestChan <- req
+		}()
+	}
+}
+
+func sortedEndorsers(endorsements map[uint32]*SignedRequest) []uint32 {
+	ids := make([]uint32, 0, len(endorsements))
+	for id := range endorsements {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// commitMembership log the proposal committed at sequence, it is applied when the checkpoint of the change is stable.
+// Changes whose checkpoint is not after the sequence can never take effect at the same point on every replica
+func (rep *Replica) commitMembership(sequence uint32, timestamp string) {
+	proposal, err := decodeProposal(timestamp)
+	if err != nil {
+		plog.Error("decode membership proposal error", "sequence", sequence, "err", err)
+		return
+	}
+	change := proposal.Change
+	if change.Sequence <= sequence {
+		plog.Error("membership change committed after its checkpoint", "replica", change.Replica, "sequence", sequence)
+		return
+	}
+	proposal.Committed = sequence
+
+	rep.mu.Lock()
+	var members []*MembershipProposal
+	for _, member := range rep.members {
+		if !sameMembershipChange(member.Change, change) {
+			members = append(members, member)
+			continue
+		}
+		// the same change committed twice, the replicas keep the earlier one whatever order they executed them in
+		if member.Committed <= sequence {
+			rep.mu.Unlock()
+			return
+		}
+	}
+	members = append(members, proposal)
+	sort.SliceStable(members, func(i, j int) bool { return members[i].Committed < members[j].Committed })
+	rep.members = members
+	// a lagging replica may see the checkpoint of the change stable before it executed the change,
+	// the changes are applied again in order up to the same checkpoint
+	stable := rep.applied
+	replay := change.Sequence <= stable
+	if replay {
+		rep.resetMemberSet()
+	}
+	rep.mu.Unlock()
+
+	rep.store.resetMembers(members)
+	plog.Info("membership change committed", "op", change.Op, "replica", change.Replica, "sequence", sequence)
+	if replay {
+		rep.applyMembership(stable)
+	}
+}
+
+// committedMembers the membership changes committed up to sequence
+func (rep *Replica) committedMembers(sequence uint32) []*MembershipProposal {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	var members []*MembershipProposal
+	for _, member := range rep.members {
+		if member.Committed <= sequence {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// membersDigest the checkpoint digest covers the state and the membership changes committed up to the checkpoint,
+// without any change it is the digest of the state
+func membersDigest(state *pb.ClientReply, members []*MembershipProposal) []byte {
+	if len(members) == 0 {
+		return RepDigest(state)
+	}
+	var buf bytes.Buffer
+	if state != nil {
+		buf.WriteString(state.String())
+	}
+	for _, member := range members {
+		buf.WriteString(member.String())
+	}
+	digest := md5.Sum(buf.Bytes())
+	return digest[:]
+}
+
+// adoptMembers take the membership changes committed up to the transferred checkpoint, the replica set
+// is rebuilt from the configured one when the checkpoint becomes stable
+func (rep *Replica) adoptMembers(sequence uint32, adopted []*MembershipProposal) {
+	rep.mu.Lock()
+	members := append([]*MembershipProposal{}, adopted...)
+	for _, member := range rep.members {
+		if member.Committed <= sequence {
+			continue
+		}
+		dup := false
+		for _, m := range adopted {
+			dup = dup || sameMembershipChange(m.Change, member.Change)
+		}
+		if !dup {
+			members = append(members, member)
+		}
+	}
+	rep.members = members
+	rep.resetMemberSet()
+	rep.mu.Unlock()
+	rep.store.resetMembers(members)
+}
+
+// resetMemberSet go back to the configured replica set, the caller holds the lock
+func (rep *Replica) resetMemberSet() {
+	rep.keys = make(KeyRegistry)
+	for id, key := range rep.initKeys {
+		rep.keys[id] = key
+	}
+	rep.replicas = make(map[uint32]string)
+	for id, addr := range rep.initReplicas {
+		rep.replicas[id] = addr
+	}
+	rep.applied = 0
+}
+
+// applyMembership apply the committed changes whose checkpoint became stable, ordered by their checkpoint
+// and then by the sequence they were committed at. Each change is checked again against the replica set
+// it applies to, so every replica ends with the same set whichever checkpoints it applied them at
+func (rep *Replica) applyMembership(sequence uint32) {
+	var due []*MembershipProposal
+	var applied []*MembershipChange
+	rep.mu.Lock()
+	for _, member := range rep.members {
+		if member.Change.Sequence > rep.applied && member.Change.Sequence <= sequence {
+			due = append(due, member)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].Change.Sequence < due[j].Change.Sequence })
+	for _, member := range due {
+		if err := rep.changeMember(member); err != nil {
+			plog.Error("apply membership change error", "replica", member.Change.Replica, "err", err)
+			continue
+		}
+		applied = append(applied, member.Change)
+	}
+	if sequence > rep.applied {
+		rep.applied = sequence
+	}
+	// endorsements that were not ordered before their checkpoint can never take effect
+	for key := range rep.endorsements {
+		change := &MembershipChange{}
+		if pb.Decode([]byte(key), change) == nil && change.Sequence <= sequence {
+			delete(rep.endorsements, key)
+		}
+	}
+	rep.mu.Unlock()
+
+	for _, change := range applied {
+		plog.Info("membership change applied", "op", change.Op, "replica", change.Replica, "size", rep.size())
+	}
+}
+
+// changeMember apply the proposal to the replica set, the caller holds the lock
+func (rep *Replica) changeMember(proposal *MembershipProposal) error {
+	change := proposal.Change
+	if err := checkChange(rep.keys, change); err != nil {
+		return err
+	}
+	if !endorsed(rep.keys, proposal) {
+		return ErrMembershipEndorsement
+	}
+	switch change.Op {
+	case MembershipAdd:
+		pub, err := parsePubKey(change.PubKey)
+		if err != nil {
+			return err
+		}
+		rep.keys[change.Replica] = pub
+		rep.replicas[change.Replica] = change.Addr
+	case MembershipRemove:
+		delete(rep.keys, change.Replica)
+		delete(rep.replicas, change.Replica)
+	}
+	return nil
+}
+
+func (rep *Replica) replicaSet() *ReplicaSet {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	set := &ReplicaSet{}
+	for _, member := range rep.members {
+		if member.Change.Sequence > rep.applied {
+			set.Pending = append(set.Pending, member.Change)
+		}
+	}
+	for _, id := range sortedIDs(rep.keys) {
+		set.Replicas = append(set.Replicas, &ReplicaInfo{
+			Replica: id,
+			Addr:    rep.replicas[id],
+			PubKey:  common.ToHex(rep.keys[id].Bytes()),
+		})
+	}
+	return set
+}
+
+func parsePubKey(key string) (crypto.PubKey, error) {
+	cr, err := crypto.New(pb.GetSignName("", pb.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	return cr.PubKeyFromBytes(bkey)
+}
+
+// Query_ReplicaSet query the current replica set and pending changes
+func (client *Client) Query_ReplicaSet(req *pb.ReqNil) (pb.Message, error) {
+	if client.replica == nil {
+		return nil, ErrReplicaNotStarted
+	}
+	return client.replica.replicaSet(), nil
+}
@@ -147,10 +147,10 @@ func RepDigest(reply fmt.Stringer) []byte {
 // WriteMessage write proto message
 func WriteMessage(addr string, msg proto.Message) error {
 	conn, err := net.Dial("tcp", addr)
-	defer conn.Close()
 	if err != nil {
 		return err
 	}
+	defer conn.Close()
 	bz, err := proto.Marshal(msg)
 	if err != nil {
 		return err
@@ -7,8 +7,11 @@ package pbft
 import (
 	"errors"
 	"net"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/33cn/chain33/common/crypto"
 	pb "github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -21,53 +24,115 @@ const (
 
 // Replica struct
 type Replica struct {
-	ID          uint32
-	replicas    map[uint32]string
-	activeView  bool
-	view        uint32
-	sequence    uint32
-	requestChan chan *pb.Request
-	replyChan   chan *pb.ClientReply
-	errChan     chan error
-	doneChan    chan string
-	requests    map[string][]*pb.Request
-	replies     map[string][]*pb.ClientReply
-	lastReply   *pb.ClientReply
-	pendingVC   []*pb.Request
-	executed    []uint32
-	checkpoints []*pb.Checkpoint
+	// mu guards keys, replicas, members, applied and endorsements, they are changed by the membership changes
+	// and read by the send routine and the queries. keys and replicas are both indexed by replica id
+	mu           sync.RWMutex
+	ID           uint32
+	replicas     map[uint32]string
+	activeView   bool
+	view         uint32
+	sequence     uint32
+	requestChan  chan *pb.Request
+	replyChan    chan *pb.ClientReply
+	errChan      chan error
+	doneChan     chan string
+	requests     map[string][]*pb.Request
+	replies      map[string][]*pb.ClientReply
+	lastReply    *pb.ClientReply
+	pendingVC    []*pb.Request
+	executed     []uint32
+	checkpoints  []*pb.Checkpoint
+	privKey      crypto.PrivKey
+	keys         KeyRegistry
+	addr         string
+	store        *replicaStore
+	proofs       map[uint32][]*SignedRequest
+	states       map[uint32]*pb.ClientReply
+	peerViews    map[uint32]uint32
+	endorsements map[string]map[uint32]*SignedRequest
+	// members the membership changes committed in the request log, in the order they were committed,
+	// the ones whose checkpoint is not after applied are applied on top of the configured replica set
+	members      []*MembershipProposal
+	applied      uint32
+	initKeys     KeyRegistry
+	initReplicas map[uint32]string
+	// fetching is set while the state fetch is resent, accessed atomically
+	fetching int32
 }
 
 // NewReplica create Replica instance
-func NewReplica(id uint32, PeersURL string, addr string) (chan *pb.ClientReply, chan *pb.Request, bool) {
+func NewReplica(id uint32, PeersURL string, addr string, dataDir string, privKey crypto.PrivKey, keys KeyRegistry) *Replica {
 	replyChan := make(chan *pb.ClientReply)
 	requestChan := make(chan *pb.Request)
 	pn := &Replica{
-		ID:          id,
-		replicas:    make(map[uint32]string),
-		activeView:  true,
-		view:        1,
-		sequence:    0,
-		requestChan: requestChan,
-		replyChan:   replyChan,
-		errChan:     make(chan error),
-		requests:    make(map[string][]*pb.Request),
-		replies:     make(map[string][]*pb.ClientReply),
-		doneChan:    make(chan string),
-		lastReply:   nil,
-		pendingVC:   make([]*pb.Request, 10),
-		executed:    make([]uint32, 10),
+		ID:           id,
+		replicas:     make(map[uint32]string),
+		activeView:   true,
+		view:         1,
+		sequence:     0,
+		requestChan:  requestChan,
+		replyChan:    replyChan,
+		errChan:      make(chan error),
+		requests:     make(map[string][]*pb.Request),
+		replies:      make(map[string][]*pb.ClientReply),
+		doneChan:     make(chan string),
+		lastReply:    nil,
+		pendingVC:    make([]*pb.Request, 10),
+		executed:     make([]uint32, 10),
+		privKey:      privKey,
+		keys:         keys,
+		addr:         addr,
+		store:        newReplicaStore(dataDir),
+		proofs:       make(map[uint32][]*SignedRequest),
+		states:       make(map[uint32]*pb.ClientReply),
+		peerViews:    make(map[uint32]uint32),
+		endorsements: make(map[string]map[uint32]*SignedRequest),
+		initKeys:     keys,
+		initReplicas: make(map[uint32]string),
 	}
 	peers := strings.Split(PeersURL, ",")
 	for num, peer := range peers {
-		pn.replicas[uint32(num)] = peer
+		pn.initReplicas[uint32(num+1)] = peer
 	}
+	pn.resetMemberSet()
 	pn.checkpoints = []*pb.Checkpoint{ToCheckpoint(0, []byte(""))}
+	pn.restore()
 	pn.Startnode(addr)
-	return replyChan, requestChan, pn.isPrimary(pn.ID)
+	pn.fetchState()
+	return pn
 
 }
 
+// restore replay the view, stable checkpoints and request log saved before the replica stopped
+func (rep *Replica) restore() {
+	if view := rep.store.loadView(); view > rep.view {
+		rep.view = view
+	}
+	for _, checkpoint := range rep.store.loadCheckpoints() {
+		rep.addCheckpoint(checkpoint)
+	}
+	if state := rep.store.loadState(rep.lowWaterMark()); state != nil {
+		rep.lastReply = state
+		rep.logReply(state.Client, state)
+	}
+	rep.members = rep.store.loadMembers()
+	rep.applyMembership(rep.lowWaterMark())
+	rep.requests = rep.store.loadRequests()
+	rep.sequence = rep.lowWaterMark()
+	for _, req := range rep.requests["pre-prepare"] {
+		if s := req.GetPreprepare().Sequence; s > rep.sequence {
+			rep.sequence = s
+		}
+	}
+	rep.executed = append(rep.executed, rep.lowWaterMark())
+	plog.Info("replica restored", "view", rep.view, "sequence", rep.sequence, "lastStable", rep.lowWaterMark())
+}
+
+func (rep *Replica) setView(view uint32) {
+	rep.view = view
+	rep.store.saveView(view)
+}
+
 // Startnode method
 func (rep *Replica) Startnode(addr string) {
 	rep.acceptConnections(addr)
@@ -75,12 +140,55 @@ func (rep *Replica) Startnode(addr string) {
 
 // Basic operations
 
+func sortedIDs(keys KeyRegistry) []uint32 {
+	ids := make([]uint32, 0, len(keys))
+	for id := range keys {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// size the number of the active replicas, the replicas whose keys are in the agreed set
+func (rep *Replica) size() int {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	return len(rep.keys)
+}
+
+// peers the addresses of the active replicas
+func (rep *Replica) peers() []string {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	peers := make([]string, 0, len(rep.keys))
+	for _, id := range sortedIDs(rep.keys) {
+		if addr := rep.replicas[id]; addr != "" {
+			peers = append(peers, addr)
+		}
+	}
+	return peers
+}
+
+func (rep *Replica) replicaAddr(ID uint32) string {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	return rep.replicas[ID]
+}
+
 func (rep *Replica) primary() uint32 {
-	return rep.view % uint32(len(rep.replicas)+1)
+	return rep.newPrimary(rep.view)
 }
 
+// newPrimary the primary rotates over the active replicas in ID order, view 1 starts from the smallest ID
 func (rep *Replica) newPrimary(view uint32) uint32 {
-	return view % uint32(len(rep.replicas)+1)
+	rep.mu.RLock()
+	ids := sortedIDs(rep.keys)
+	rep.mu.RUnlock()
+	if len(ids) == 0 {
+		return 0
+	}
+	n := uint32(len(ids))
+	return ids[(view+n-1)%n]
 }
 
 func (rep *Replica) isPrimary(ID uint32) bool {
@@ -88,19 +196,19 @@ func (rep *Replica) isPrimary(ID uint32) bool {
 }
 
 func (rep *Replica) oneThird(count int) bool {
-	return count >= (len(rep.replicas)+1)/3
+	return count >= (rep.size()+1)/3
 }
 
 func (rep *Replica) overOneThird(count int) bool {
-	return count > (len(rep.replicas)+1)/3
+	return count > (rep.size()+1)/3
 }
 
 func (rep *Replica) twoThirds(count int) bool {
-	return count >= 2*(len(rep.replicas)-1)/3
+	return count >= 2*(rep.size()-1)/3
 }
 
 func (rep *Replica) overTwoThirds(count int) bool {
-	return count > 2*(len(rep.replicas)-1)/3
+	return count > 2*(rep.size()-1)/3
 }
 
 func (rep *Replica) lowWaterMark() uint32 {
@@ -127,7 +235,7 @@ func (rep *Replica) theLastReply() *pb.ClientReply {
 	lastReply := rep.lastReply
 	for _, replies := range rep.replies {
 		reply := replies[len(replies)-1]
-		if reply.Timestamp > lastReply.Timestamp {
+		if lastReply == nil || reply.Timestamp > lastReply.Timestamp {
 			lastReply = reply
 		}
 	}
@@ -141,16 +249,56 @@ func (rep *Replica) lastReplyToClient(client string) *pb.ClientReply {
 	return nil
 }
 
-func (rep *Replica) stateDigest() []byte {
-	return RepDigest(rep.theLastReply())
+// checkpointDigest the digest of the state and the membership changes committed up to the checkpoint
+func (rep *Replica) checkpointDigest(sequence uint32) []byte {
+	return membersDigest(rep.theLastReply(), rep.committedMembers(sequence))
 }
 
 func (rep *Replica) isCheckpoint(sequence uint32) bool {
 	return sequence%CheckPointPeriod == 0
 }
 
+// vote count the replica once in voters, votes from unknown replicas are ignored
+func (rep *Replica) vote(voters map[uint32]bool, replica uint32) bool {
+	rep.mu.RLock()
+	_, ok := rep.keys[replica]
+	rep.mu.RUnlock()
+	if !ok || voters[replica] {
+		return false
+	}
+	voters[replica] = true
+	return true
+}
+
 func (rep *Replica) addCheckpoint(checkpoint *pb.Checkpoint) {
 	rep.checkpoints = append(rep.checkpoints, checkpoint)
+	if len(rep.checkpoints) > keepCheckpoints {
+		rep.checkpoints = rep.checkpoints[len(rep.checkpoints)-keepCheckpoints:]
+	}
+}
+
+// stableCheckpoint garbage collect the log up to the checkpoint and persist it
+func (rep *Replica) stableCheckpoint(checkpoint *pb.Checkpoint) {
+	rep.stateFetched()
+	rep.clearRequestsBySeq(checkpoint.Sequence)
+	rep.addCheckpoint(checkpoint)
+	state := rep.states[checkpoint.Sequence]
+	if state != nil && !EQ(membersDigest(state, rep.committedMembers(checkpoint.Sequence)), checkpoint.Digest) {
+		state = nil
+	}
+	rep.store.saveCheckpoint(checkpoint, rep.proofs[checkpoint.Sequence], state)
+	rep.store.resetRequests(rep.requests)
+	rep.applyMembership(checkpoint.Sequence)
+	for sequence := range rep.proofs {
+		if sequence < checkpoint.Sequence {
+			delete(rep.proofs, sequence)
+		}
+	}
+	for sequence := range rep.states {
+		if sequence <= checkpoint.Sequence {
+			delete(rep.states, sequence)
+		}
+	}
 }
 
 func (rep *Replica) acceptConnections(addr string) {
@@ -164,22 +312,79 @@ func (rep *Replica) acceptConnections(addr string) {
 			conn, err := ln.Accept()
 			if err != nil {
 				plog.Error("Accept error")
+				continue
 			}
-			req := &pb.Request{}
-			err = ReadMessage(conn, req)
+			signed := &SignedRequest{}
+			err = ReadMessage(conn, signed)
+			conn.Close()
 			if err != nil {
 				plog.Error("readmessage error", "err", err)
+				continue
 			}
-			rep.handleRequest(req)
+			rep.handleMessage(signed)
 		}
 	}()
 }
 
+func (rep *Replica) checkSigned(signed *SignedRequest) error {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	return rep.keys.Check(signed)
+}
+
+func (rep *Replica) verifySigned(signed *SignedRequest) (*pb.Request, error) {
+	rep.mu.RLock()
+	defer rep.mu.RUnlock()
+	return rep.keys.Verify(signed)
+}
+
+func (rep *Replica) handleMessage(signed *SignedRequest) {
+	switch signed.Ty {
+	case MsgRequest:
+		req, err := rep.verifySigned(signed)
+		if err != nil {
+			plog.Error("reject request", "replica", signed.Replica, "err", err)
+			return
+		}
+		if cp := req.GetCheckpoint(); cp != nil {
+			rep.proofs[cp.Sequence] = append(rep.proofs[cp.Sequence], signed)
+		}
+		rep.handleRequest(req)
+	case MsgStateFetch:
+		fetch := &StateFetch{}
+		if err := rep.checkSigned(signed); err != nil || proto.Unmarshal(signed.Payload, fetch) != nil {
+			plog.Error("reject state fetch", "replica", signed.Replica, "err", err)
+			return
+		}
+		rep.handleStateFetch(signed.Replica, fetch)
+	case MsgStateReply:
+		reply := &StateReply{}
+		if err := rep.checkSigned(signed); err != nil || proto.Unmarshal(signed.Payload, reply) != nil {
+			plog.Error("reject state reply", "replica", signed.Replica, "err", err)
+			return
+		}
+		rep.handleStateReply(signed.Replica, reply)
+	case MsgMembership:
+		change := &MembershipChange{}
+		if err := rep.checkSigned(signed); err != nil || proto.Unmarshal(signed.Payload, change) != nil {
+			plog.Error("reject membership change", "replica", signed.Replica, "err", err)
+			return
+		}
+		rep.handleMembership(signed, change)
+	default:
+		plog.Error("unknown message type", "replica", signed.Replica, "ty", signed.Ty)
+	}
+}
+
 // Sends
 
-func (rep *Replica) multicast(REQ proto.Message) error {
-	for _, replica := range rep.replicas {
-		err := WriteMessage(replica, REQ)
+func (rep *Replica) multicast(REQ *pb.Request) error {
+	signed, err := SignRequest(rep.ID, rep.privKey, REQ)
+	if err != nil {
+		return err
+	}
+	for _, replica := range rep.peers() {
+		err := WriteMessage(replica, signed)
 		if err != nil {
 			return err
 		}
@@ -193,12 +398,15 @@ func (rep *Replica) sendRoutine() {
 		case *pb.Request_Ack:
 			view := REQ.GetAck().View
 			primaryID := rep.newPrimary(view)
-			primary := rep.replicas[primaryID]
+			primary := rep.replicaAddr(primaryID)
 			if primary == "" {
 				plog.Error("primary not exeist")
 				continue
 			}
-			err := WriteMessage(primary, REQ)
+			signed, err := SignRequest(rep.ID, rep.privKey, REQ)
+			if err == nil {
+				err = WriteMessage(primary, signed)
+			}
 			if err != nil {
 				go func() {
 					rep.errChan <- err
@@ -228,26 +436,30 @@ func (rep *Replica) allRequests() []*pb.Request {
 // !hasRequest before append?
 
 func (rep *Replica) logRequest(REQ *pb.Request) {
+	var kind string
 	switch REQ.Value.(type) {
 	case *pb.Request_Client:
-		rep.requests["client"] = append(rep.requests["client"], REQ)
+		kind = "client"
 	case *pb.Request_Preprepare:
-		rep.requests["pre-prepare"] = append(rep.requests["pre-prepare"], REQ)
+		kind = "pre-prepare"
 	case *pb.Request_Prepare:
-		rep.requests["prepare"] = append(rep.requests["prepare"], REQ)
+		kind = "prepare"
 	case *pb.Request_Commit:
-		rep.requests["commit"] = append(rep.requests["commit"], REQ)
+		kind = "commit"
 	case *pb.Request_Checkpoint:
-		rep.requests["checkpoint"] = append(rep.requests["checkpoint"], REQ)
+		kind = "checkpoint"
 	case *pb.Request_Viewchange:
-		rep.requests["view-change"] = append(rep.requests["view-change"], REQ)
+		kind = "view-change"
 	case *pb.Request_Ack:
-		rep.requests["ack"] = append(rep.requests["ack"], REQ)
+		kind = "ack"
 	case *pb.Request_Newview:
-		rep.requests["new-view"] = append(rep.requests["new-view"], REQ)
+		kind = "new-view"
 	default:
 		plog.Info("Replica %d tried logging unrecognized request type\n", rep.ID)
+		return
 	}
+	rep.requests[kind] = append(rep.requests[kind], REQ)
+	rep.store.saveRequest(kind, REQ)
 }
 
 func (rep *Replica) logPendingVC(REQ *pb.Request) error {
@@ -335,6 +547,21 @@ func (rep *Replica) hasRequestCommit(REQ *pb.Request) bool {
 	return false
 }
 
+func (rep *Replica) hasRequestCheckpoint(REQ *pb.Request) bool {
+	sequence := REQ.GetCheckpoint().Sequence
+	digest := REQ.GetCheckpoint().Digest
+	replica := REQ.GetCheckpoint().Replica
+	for _, req := range rep.requests["checkpoint"] {
+		s := req.GetCheckpoint().Sequence
+		d := req.GetCheckpoint().Digest
+		r := req.GetCheckpoint().Replica
+		if s == sequence && EQ(d, digest) && r == replica {
+			return true
+		}
+	}
+	return false
+}
+
 func (rep *Replica) hasRequestViewChange(REQ *pb.Request) bool {
 	view := REQ.GetViewchange().View
 	replica := REQ.GetViewchange().Replica
@@ -385,66 +612,54 @@ func (rep *Replica) clearRequestsBySeq(sequence uint32) {
 }
 
 func (rep *Replica) clearRequestClients() {
-	clientReqs := rep.requests["client"]
-	lastTimestamp := rep.theLastReply().Timestamp
-	for idx, req := range rep.requests["client"] {
-		timestamp := req.GetClient().Timestamp
-		if lastTimestamp >= timestamp && idx < (len(clientReqs)-1) {
-			clientReqs = append(clientReqs[:idx], clientReqs[idx+1:]...)
-		} else {
-			clientReqs = clientReqs[:idx-1]
+	lastReply := rep.theLastReply()
+	if lastReply == nil {
+		return
+	}
+	var clientReqs []*pb.Request
+	for _, req := range rep.requests["client"] {
+		if req.GetClient().Timestamp > lastReply.Timestamp {
+			clientReqs = append(clientReqs, req)
 		}
 	}
 	rep.requests["client"] = clientReqs
 }
 
 func (rep *Replica) clearRequestPrepreparesBySeq(sequence uint32) {
-	prePrepares := rep.requests["pre-prepare"]
-	for idx, req := range rep.requests["pre-prepare"] {
-		s := req.GetPreprepare().Sequence
-		if s <= sequence && idx < (len(prePrepares)-1) {
-			prePrepares = append(prePrepares[:idx], prePrepares[idx+1:]...)
-		} else {
-			prePrepares = prePrepares[:idx-1]
+	var prePrepares []*pb.Request
+	for _, req := range rep.requests["pre-prepare"] {
+		if req.GetPreprepare().Sequence > sequence {
+			prePrepares = append(prePrepares, req)
 		}
 	}
 	rep.requests["pre-prepare"] = prePrepares
 }
 
 func (rep *Replica) clearRequestPreparesBySeq(sequence uint32) {
-	prepares := rep.requests["prepare"]
-	for idx, req := range rep.requests["prepare"] {
-		s := req.GetPrepare().Sequence
-		if s <= sequence && idx < (len(prepares)-1) {
-			prepares = append(prepares[:idx], prepares[idx+1:]...)
-		} else {
-			prepares = prepares[:idx-1]
+	var prepares []*pb.Request
+	for _, req := range rep.requests["prepare"] {
+		if req.GetPrepare().Sequence > sequence {
+			prepares = append(prepares, req)
 		}
 	}
 	rep.requests["prepare"] = prepares
 }
 
 func (rep *Replica) clearRequestCommitsBySeq(sequence uint32) {
-	commits := rep.requests["commit"]
-	for idx, req := range rep.requests["commit"] {
-		s := req.GetCommit().Sequence
-		if s <= sequence && idx < (len(commits)-1) {
-			commits = append(commits[:idx], commits[idx+1:]...)
-		} else {
-			commits = commits[:idx-1]
+	var commits []*pb.Request
+	for _, req := range rep.requests["commit"] {
+		if req.GetCommit().Sequence > sequence {
+			commits = append(commits, req)
 		}
 	}
 	rep.requests["commit"] = commits
 }
 
 func (rep *Replica) clearRequestCheckpointsBySeq(sequence uint32) {
-	checkpoints := rep.requests["checkpoint"]
-	for idx, req := range rep.requests["checkpoint"] {
-		s := req.GetCheckpoint().Sequence
-		if s <= sequence && idx < (len(checkpoints)-1) {
-			checkpoints = append(checkpoints[:idx], checkpoints[idx+1:]...)
-		} else {
-			checkpoints = checkpoints[:idx-1]
+	var checkpoints []*pb.Request
+	for _, req := range rep.requests["checkpoint"] {
+		if req.GetCheckpoint().Sequence > sequence {
+			checkpoints = append(checkpoints, req)
 		}
 	}
 	rep.requests["checkpoint"] = checkpoints
@@ -596,8 +811,6 @@ func (rep *Replica) handleRequestPreprepare(REQ *pb.Request) {
 
 func (rep *Replica) handleRequestPrepare(REQ *pb.Request) {
 
-	replica := REQ.GetPrepare().Replica
-
 	//if rep.isPrimary(replica) {
 	//return
 	//}
@@ -626,7 +839,7 @@ func (rep *Replica) handleRequestPrepare(REQ *pb.Request) {
 	//}()
 
 	go func() {
-		count := 0
+		voters := make(map[uint32]bool)
 		for _, req := range rep.requests["prepare"] {
 			v := req.GetPrepare().View
 			s := req.GetPrepare().Sequence
@@ -635,17 +848,16 @@ func (rep *Replica) handleRequestPrepare(REQ *pb.Request) {
 			if v != view || s != sequence || !EQ(d, digest) {
 				continue
 			}
-			if r == replica {
-				plog.Debug("multiple prepare requests", "Replica ", replica, " sent multiple prepare requests")
-				//continue
+			if !rep.vote(voters, r) {
+				plog.Debug("multiple prepare requests", "Replica ", r, " sent multiple prepare requests")
+				continue
 			}
-			count++
-			if rep.overTwoThirds(count) {
+			if rep.overTwoThirds(len(voters)) {
 				twoThirds <- true
 				return
 			}
-			twoThirds <- false
 		}
+		twoThirds <- false
 	}()
 
 	if !<-twoThirds {
@@ -678,12 +890,10 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 		return
 	}
 
-	replica := REQ.GetCommit().Replica
-
 	rep.logRequest(REQ)
 	twoThirds := make(chan bool, 1)
 	go func() {
-		count := 0
+		voters := make(map[uint32]bool)
 		for _, req := range rep.requests["commit"] {
 			v := req.GetCommit().View
 			s := req.GetCommit().Sequence
@@ -691,12 +901,11 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 			if v != view || s != sequence {
 				continue
 			}
-			if rr == replica {
-				plog.Debug("multiple commit requests", "Replica ", replica, " sent multiple commit requests")
-				//continue
+			if !rep.vote(voters, rr) {
+				plog.Debug("multiple commit requests", "Replica ", rr, " sent multiple commit requests")
+				continue
 			}
-			count++
-			if rep.overTwoThirds(count) {
+			if rep.overTwoThirds(len(voters)) {
 				twoThirds <- true
 				return
 			}
@@ -738,23 +947,30 @@ func (rep *Replica) handleRequestCommit(REQ *pb.Request) {
 		op := req.GetClient().Op
 		timestamp := req.GetClient().Timestamp
 		client := req.GetClient().Client
-		result := &pb.Result{Value: op.Value}
 
 		rep.executed = append(rep.executed, sequence)
-		reply := ToReply(view, timestamp, client, rep.ID, result)
+		if client == membershipClient {
+			// a membership change has no reply, it only changes the replica set
+			rep.commitMembership(sequence, timestamp)
+		} else {
+			result := &pb.Result{Value: op.Value}
+			reply := ToReply(view, timestamp, client, rep.ID, result)
 
-		rep.logReply(client, reply)
-		plog.Info("commit done")
-		rep.lastReply = reply
+			rep.logReply(client, reply)
+			plog.Info("commit done")
+			rep.lastReply = reply
 
-		go func() {
-			rep.replyChan <- reply
-		}()
+			go func() {
+				rep.replyChan <- reply
+			}()
+		}
 
 		if !rep.isCheckpoint(sequence) {
 			return
 		}
-		stateDigest := rep.stateDigest()
+		// keep the state the checkpoint certifies, it is persisted once the checkpoint is stable
+		rep.states[sequence] = rep.theLastReply()
+		stateDigest := rep.checkpointDigest(sequence)
 		req := ToRequestCheckpoint(sequence, stateDigest, rep.ID)
 		rep.logRequest(req)
 
@@ -775,9 +991,12 @@ func (rep *Replica) handleRequestCheckpoint(REQ *pb.Request) {
 	}
 
 	digest := REQ.GetCheckpoint().Digest
-	replica := REQ.GetCheckpoint().Replica
 
-	count := 0
+	if !rep.hasRequestCheckpoint(REQ) {
+		rep.logRequest(REQ)
+	}
+
+	voters := make(map[uint32]bool)
 	for _, req := range rep.requests["checkpoint"] {
 		s := req.GetCheckpoint().Sequence
 		d := req.GetCheckpoint().Digest
@@ -785,18 +1004,16 @@ func (rep *Replica) handleRequestCheckpoint(REQ *pb.Request) {
 		if s != sequence || !EQ(d, digest) {
 			continue
 		}
-		if r == replica {
-			plog.Info("Replica %d sent multiple checkpoint requests\n", replica)
-			//continue
+		if !rep.vote(voters, r) {
+			plog.Info("multiple checkpoint requests", "Replica", r)
+			continue
 		}
-		count++
-		if !rep.overTwoThirds(count) {
+		if !rep.overTwoThirds(len(voters)) {
 			continue
 		}
 		// rep.clearEntries(sequence)
-		rep.clearRequestsBySeq(sequence)
 		checkpoint := ToCheckpoint(sequence, digest)
-		rep.addCheckpoint(checkpoint)
+		rep.stableCheckpoint(checkpoint)
 		plog.Info("checkpoint and clear request done")
 		return
 	}
@@ -889,7 +1106,7 @@ func (rep *Replica) handleRequestAck(REQ *pb.Request) {
 	}()
 
 	go func() {
-		count := 0
+		voters := make(map[uint32]bool)
 		for _, req := range rep.requests["ack"] {
 			v := req.GetAck().View
 			r := req.GetAck().Replica
@@ -898,12 +1115,11 @@ func (rep *Replica) handleRequestAck(REQ *pb.Request) {
 			if v != view || vc != viewchanger || !EQ(d, digest) {
 				continue
 			}
-			if r == replica {
-				plog.Info("Replica %d sent multiple ack requests\n", replica)
+			if r == replica || !rep.vote(voters, r) {
+				plog.Info("multiple ack requests", "Replica", r)
 				continue
 			}
-			count++
-			if rep.twoThirds(count) {
+			if rep.twoThirds(len(voters)) {
 				twoThirds <- true
 				return
 			}
@@ -1425,7 +1641,7 @@ func (rep *Replica) requestViewChange(view uint32) {
 	if view != rep.view+1 {
 		return
 	}
-	rep.view = view
+	rep.setView(view)
 	rep.activeView = false
 
 	var prePreps []*pb.Entry
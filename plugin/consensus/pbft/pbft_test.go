@@ -7,9 +7,12 @@ package pbft
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"net"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -62,6 +65,100 @@ func TestPbft(t *testing.T) {
 	clearTestData()
 }
 
+func TestSignedRequest(t *testing.T) {
+	priv := getprivkey("0xfd2820c5ed067ba56c06ce26e8ef6551798f4cdd4b7f0cf6b29b0e522e6d660c")
+	other := getprivkey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	keys, err := NewKeyRegistry([]string{common.ToHex(priv.PubKey().Bytes())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := ToRequestPrepare(1, 1, []byte("digest"), 1)
+	signed, err := SignRequest(1, priv, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := keys.Verify(signed)
+	if err != nil || got.GetPrepare().Sequence != 1 {
+		t.Fatal("verify signed request failed", err)
+	}
+
+	forged, _ := SignRequest(1, other, req)
+	if _, err = keys.Verify(forged); err != ErrInvalidSignature {
+		t.Fatal("expect ErrInvalidSignature, got", err)
+	}
+	unsigned := &SignedRequest{Replica: 1, Payload: signed.Payload}
+	if _, err = keys.Verify(unsigned); err != ErrUnsignedRequest {
+		t.Fatal("expect ErrUnsignedRequest, got", err)
+	}
+	unknown, _ := SignRequest(2, priv, ToRequestPrepare(1, 1, []byte("digest"), 2))
+	if _, err = keys.Verify(unknown); err != ErrUnknownReplica {
+		t.Fatal("expect ErrUnknownReplica, got", err)
+	}
+	mismatch, _ := SignRequest(1, priv, ToRequestPrepare(1, 1, []byte("digest"), 2))
+	if _, err = keys.Verify(mismatch); err != ErrReplicaMismatch {
+		t.Fatal("expect ErrReplicaMismatch, got", err)
+	}
+}
+
+func TestReplicaStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := newReplicaStore(dir)
+	store.saveView(3)
+	store.saveRequest("pre-prepare", ToRequestPreprepare(3, 7, []byte("d7"), 1))
+	store.saveRequest("prepare", ToRequestPrepare(3, 7, []byte("d7"), 2))
+	store.saveRequest("pre-prepare", ToRequestPreprepare(3, 8, []byte("d8"), 1))
+	proof := []*SignedRequest{{Replica: 1, Payload: []byte("p1")}, {Replica: 2, Payload: []byte("p2")}}
+	state := ToReply(3, "t1", "client", 1, &types.Result{})
+	store.saveCheckpoint(ToCheckpoint(128, []byte("cp")), proof, state)
+	store.close()
+
+	store = newReplicaStore(dir)
+	defer store.close()
+	if store.loadView() != 3 {
+		t.Fatal("load view failed")
+	}
+	requests := store.loadRequests()
+	if len(requests["pre-prepare"]) != 2 || requests["pre-prepare"][1].GetPreprepare().Sequence != 8 {
+		t.Fatal("load pre-prepare failed", requests)
+	}
+	if len(requests["prepare"]) != 1 {
+		t.Fatal("load prepare failed", requests)
+	}
+	checkpoints := store.loadCheckpoints()
+	if len(checkpoints) != 1 || checkpoints[0].Sequence != 128 {
+		t.Fatal("load checkpoint failed", checkpoints)
+	}
+	if len(store.loadProof(128)) != 2 {
+		t.Fatal("load proof failed")
+	}
+	if got := store.loadState(128); got == nil || got.Timestamp != "t1" {
+		t.Fatal("load state failed", got)
+	}
+
+	// only the latest keepCheckpoints checkpoints are kept
+	store.saveCheckpoint(ToCheckpoint(256, []byte("cp")), proof, nil)
+	store.saveCheckpoint(ToCheckpoint(384, []byte("cp")), proof, nil)
+	checkpoints = store.loadCheckpoints()
+	if len(checkpoints) != keepCheckpoints || checkpoints[0].Sequence != 256 || checkpoints[1].Sequence != 384 {
+		t.Fatal("prune checkpoint failed", checkpoints)
+	}
+	if len(store.loadProof(128)) != 0 || store.loadState(128) != nil {
+		t.Fatal("prune proof and state failed")
+	}
+
+	delete(requests, "prepare")
+	store.resetRequests(requests)
+	requests = store.loadRequests()
+	if len(requests["prepare"]) != 0 || len(requests["pre-prepare"]) != 2 {
+		t.Fatal("reset requests failed", requests)
+	}
+}
+
 func initEnvPbft() (queue.Queue, *blockchain.BlockChain, *p2p.P2p, queue.Module, queue.Module, *executor.Executor, queue.Module, queue.Module) {
 	var q = queue.New("channel")
 	flag.Parse()
@@ -150,3 +247,342 @@ func clearTestData() {
 	}
 	fmt.Println("test data clear successfully!")
 }
+
+func newMembershipReplica(t *testing.T, dir string, privs []crypto.PrivKey, pubs []string) *Replica {
+	keys, err := NewKeyRegistry(pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replicas := make(map[uint32]string)
+	for i := range pubs {
+		replicas[uint32(i+1)] = fmt.Sprintf("127.0.0.1:%d", i+1)
+	}
+	rep := &Replica{
+		ID:           1,
+		view:         1,
+		privKey:      privs[0],
+		initKeys:     keys,
+		initReplicas: replicas,
+		store:        newReplicaStore(dir),
+		checkpoints:  []*types.Checkpoint{ToCheckpoint(0, nil)},
+		requestChan:  make(chan *types.Request, 1),
+		requests:     make(map[string][]*types.Request),
+		proofs:       make(map[uint32][]*SignedRequest),
+		endorsements: make(map[string]map[uint32]*SignedRequest),
+	}
+	rep.resetMemberSet()
+	return rep
+}
+
+func endorseMembership(rep *Replica, replica uint32, priv crypto.PrivKey, change *MembershipChange) {
+	signed, _ := SignMessage(MsgMembership, replica, priv, change)
+	rep.handleMessage(signed)
+}
+
+// proposedMembership the request the primary sent to order the endorsed change
+func proposedMembership(t *testing.T, rep *Replica) *types.RequestClient {
+	select {
+	case req := <-rep.requestChan:
+		if req.GetClient() == nil || req.GetClient().Client != membershipClient {
+			t.Fatal("not a membership request", req)
+		}
+		return req.GetClient()
+	case <-time.After(time.Second):
+		t.Fatal("membership change not proposed")
+	}
+	return nil
+}
+
+func TestMembershipChange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var privs []crypto.PrivKey
+	var pubs []string
+	for i := 0; i < 5; i++ {
+		cr, _ := crypto.New(types.GetSignName("", types.SECP256K1))
+		priv, _ := cr.GenKey()
+		privs = append(privs, priv)
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	rep := newMembershipReplica(t, dir, privs, pubs[:4])
+	defer rep.store.close()
+
+	// the replica checks the endorsement signed in its own name
+	add := &MembershipChange{Op: MembershipAdd, Replica: 4, Addr: "127.0.0.1:5", PubKey: pubs[4], Sequence: CheckPointPeriod}
+	if err := rep.checkMembership(add); err != ErrReplicaExist {
+		t.Fatal("expect ErrReplicaExist, got", err)
+	}
+	endorseMembership(rep, 1, privs[0], add)
+	if len(rep.endorsements) != 0 {
+		t.Fatal("invalid change endorsed")
+	}
+	odd := &MembershipChange{Op: MembershipRemove, Replica: 4, Sequence: CheckPointPeriod + 1}
+	if err := rep.checkMembership(odd); err != ErrMembershipSequence {
+		t.Fatal("expect ErrMembershipSequence, got", err)
+	}
+
+	change := &MembershipChange{Op: MembershipRemove, Replica: 4, Sequence: CheckPointPeriod}
+	endorseMembership(rep, 1, privs[0], change)
+	endorseMembership(rep, 2, privs[1], change)
+	endorseMembership(rep, 2, privs[1], change)
+	// endorsements not signed by the replica they claim are rejected
+	endorseMembership(rep, 3, privs[4], change)
+	if len(rep.requestChan) != 0 {
+		t.Fatal("change proposed without quorum")
+	}
+	// the endorsements only authorize the change, the primary orders it as a request
+	endorseMembership(rep, 3, privs[2], change)
+	proposal := proposedMembership(t, rep)
+	if len(rep.members) != 0 || len(rep.keys) != 4 {
+		t.Fatal("change applied before it was ordered")
+	}
+
+	// a change committed at or after its checkpoint can not take effect at the same point on every replica
+	rep.commitMembership(CheckPointPeriod, proposal.Timestamp)
+	if len(rep.members) != 0 {
+		t.Fatal("stale change committed")
+	}
+	rep.commitMembership(10, proposal.Timestamp)
+	rep.applyMembership(CheckPointPeriod - 1)
+	if len(rep.keys) != 4 || len(rep.replicaSet().Pending) != 1 {
+		t.Fatal("change applied before its checkpoint")
+	}
+	rep.applyMembership(CheckPointPeriod)
+	if len(rep.keys) != 3 || len(rep.replicas) != 3 || rep.size() != 3 || len(rep.replicaSet().Pending) != 0 {
+		t.Fatal("change not applied")
+	}
+	if rep.overTwoThirds(1) || !rep.overTwoThirds(2) {
+		t.Fatal("quorum not recalculated")
+	}
+	if members := rep.store.loadMembers(); len(members) != 1 || members[0].Committed != 10 || members[0].Change.Replica != 4 {
+		t.Fatal("membership not persisted", members)
+	}
+
+	// a committed change is checked again when it is applied, a forged request without the quorum is ignored
+	forged := &MembershipChange{Op: MembershipRemove, Replica: 3, Sequence: 2 * CheckPointPeriod}
+	signed, _ := SignMessage(MsgMembership, 1, privs[0], forged)
+	rep.commitMembership(20, ToRequestMembership(&MembershipProposal{Change: forged, Endorsements: []*SignedRequest{signed}}).GetClient().Timestamp)
+	rep.applyMembership(2 * CheckPointPeriod)
+	if len(rep.keys) != 3 {
+		t.Fatal("change applied without quorum")
+	}
+
+	// a lagging replica that executes the change after its checkpoint became stable ends with the same set,
+	// and the same change committed twice is kept at the earlier sequence
+	lagging := newMembershipReplica(t, dir+"/lagging", privs, pubs[:4])
+	defer lagging.store.close()
+	lagging.applyMembership(CheckPointPeriod)
+	lagging.commitMembership(10, proposal.Timestamp)
+	lagging.commitMembership(5, proposal.Timestamp)
+	if len(lagging.keys) != 3 || lagging.replicaAddr(4) != "" {
+		t.Fatal("late change not applied")
+	}
+	if len(lagging.members) != 1 || lagging.members[0].Committed != 5 {
+		t.Fatal("duplicate change kept", lagging.members)
+	}
+}
+
+func TestRemoveMiddleReplica(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var privs []crypto.PrivKey
+	var pubs []string
+	for i := 0; i < 4; i++ {
+		cr, _ := crypto.New(types.GetSignName("", types.SECP256K1))
+		priv, _ := cr.GenKey()
+		privs = append(privs, priv)
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	rep := newMembershipReplica(t, dir, privs, pubs)
+	defer rep.store.close()
+	for view, primary := range []uint32{4, 1, 2, 3, 4, 1} {
+		if got := rep.newPrimary(uint32(view)); got != primary {
+			t.Fatal("wrong primary", view, got)
+		}
+	}
+
+	change := &MembershipChange{Op: MembershipRemove, Replica: 2, Sequence: CheckPointPeriod}
+	for i := 0; i < 3; i++ {
+		endorseMembership(rep, uint32(i+1), privs[i], change)
+	}
+	rep.commitMembership(1, proposedMembership(t, rep).Timestamp)
+	rep.applyMembership(CheckPointPeriod)
+	if _, ok := rep.keys[2]; ok || len(rep.keys) != 3 {
+		t.Fatal("replica 2 not removed")
+	}
+
+	// the primary rotates over the remaining replicas and never picks the removed one
+	for view, primary := range []uint32{4, 1, 3, 4, 1, 3} {
+		if got := rep.newPrimary(uint32(view)); got != primary {
+			t.Fatal("wrong primary after removal", view, got)
+		}
+	}
+	// keys and addresses are indexed the same way
+	if rep.replicaAddr(1) != "127.0.0.1:1" || rep.replicaAddr(2) != "" || rep.replicaAddr(3) != "127.0.0.1:3" || rep.replicaAddr(4) != "127.0.0.1:4" {
+		t.Fatal("replica address lost")
+	}
+	if peers := rep.peers(); len(peers) != 3 || peers[1] != "127.0.0.1:3" {
+		t.Fatal("wrong peers", peers)
+	}
+	if !rep.isPrimary(1) {
+		t.Fatal("replica 1 should be the primary of view 1")
+	}
+}
+
+func TestStateTransfer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var privs []crypto.PrivKey
+	var pubs []string
+	for i := 0; i < 4; i++ {
+		cr, _ := crypto.New(types.GetSignName("", types.SECP256K1))
+		priv, _ := cr.GenKey()
+		privs = append(privs, priv)
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	keys, err := NewKeyRegistry(pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep := &Replica{
+		ID:           4,
+		view:         1,
+		initReplicas: map[uint32]string{1: "127.0.0.1:1", 2: "127.0.0.1:2", 3: "127.0.0.1:3", 4: "127.0.0.1:4"},
+		privKey:      privs[3],
+		initKeys:     keys,
+		store:        newReplicaStore(dir),
+		checkpoints:  []*types.Checkpoint{ToCheckpoint(0, nil)},
+		executed:     []uint32{0},
+		requests:     make(map[string][]*types.Request),
+		replies:      make(map[string][]*types.ClientReply),
+		proofs:       make(map[uint32][]*SignedRequest),
+		states:       make(map[uint32]*types.ClientReply),
+		peerViews:    make(map[uint32]uint32),
+	}
+	rep.resetMemberSet()
+	defer rep.store.close()
+
+	// the replica missed the commit of a membership change, it comes with the state
+	change := &MembershipChange{Op: MembershipRemove, Replica: 2, Sequence: CheckPointPeriod}
+	proposal := &MembershipProposal{Change: change, Committed: 10}
+	for i := 0; i < 3; i++ {
+		signed, _ := SignMessage(MsgMembership, uint32(i+1), privs[i], change)
+		proposal.Endorsements = append(proposal.Endorsements, signed)
+	}
+	members := []*MembershipProposal{proposal}
+	state := ToReply(1, "t1", "client", 1, &types.Result{})
+	checkpoint := ToCheckpoint(CheckPointPeriod, membersDigest(state, members))
+	var proof []*SignedRequest
+	for i := 0; i < 3; i++ {
+		signed, _ := SignRequest(uint32(i+1), privs[i], ToRequestCheckpoint(checkpoint.Sequence, checkpoint.Digest, uint32(i+1)))
+		proof = append(proof, signed)
+	}
+
+	// the state and the membership changes must match the certified digest
+	other := ToReply(1, "t2", "client", 1, &types.Result{})
+	rep.handleStateReply(1, &StateReply{View: 1, Checkpoint: checkpoint, Proof: proof, State: other, Members: members})
+	if rep.lowWaterMark() != 0 {
+		t.Fatal("adopted checkpoint with wrong state")
+	}
+	rep.handleStateReply(1, &StateReply{View: 1, Checkpoint: checkpoint, Proof: proof, State: state})
+	if rep.lowWaterMark() != 0 {
+		t.Fatal("adopted checkpoint without the membership changes")
+	}
+	rep.handleStateReply(1, &StateReply{View: 1, Checkpoint: checkpoint, Proof: proof[:2], State: state, Members: members})
+	if rep.lowWaterMark() != 0 {
+		t.Fatal("adopted checkpoint without quorum")
+	}
+	rep.handleStateReply(1, &StateReply{View: 1, Checkpoint: checkpoint, Proof: proof, State: state, Members: members})
+	if rep.lowWaterMark() != CheckPointPeriod || rep.sequence != CheckPointPeriod {
+		t.Fatal("checkpoint not adopted")
+	}
+	if !EQ(rep.checkpointDigest(CheckPointPeriod), checkpoint.Digest) {
+		t.Fatal("state not adopted")
+	}
+	if got := rep.store.loadState(CheckPointPeriod); got == nil || got.Timestamp != "t1" {
+		t.Fatal("state not persisted", got)
+	}
+	if rep.size() != 3 || rep.replicaAddr(2) != "" || len(rep.store.loadMembers()) != 1 {
+		t.Fatal("membership change not adopted")
+	}
+}
+
+func TestStateFetchRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pbft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cr, _ := crypto.New(types.GetSignName("", types.SECP256K1))
+	priv, _ := cr.GenKey()
+	keys, err := NewKeyRegistry([]string{common.ToHex(priv.PubKey().Bytes())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	var fetches int32
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			signed := &SignedRequest{}
+			if ReadMessage(conn, signed) == nil && signed.Ty == MsgStateFetch {
+				atomic.AddInt32(&fetches, 1)
+			}
+			conn.Close()
+		}
+	}()
+
+	retry := stateFetchRetry
+	stateFetchRetry = 20 * time.Millisecond
+	defer func() { stateFetchRetry = retry }()
+	rep := &Replica{
+		ID:          1,
+		view:        1,
+		addr:        "127.0.0.1:0",
+		replicas:    map[uint32]string{1: ln.Addr().String()},
+		privKey:     priv,
+		keys:        keys,
+		store:       newReplicaStore(dir),
+		checkpoints: []*types.Checkpoint{ToCheckpoint(0, nil)},
+		requests:    make(map[string][]*types.Request),
+		proofs:      make(map[uint32][]*SignedRequest),
+		states:      make(map[uint32]*types.ClientReply),
+	}
+	defer rep.store.close()
+
+	// the lost fetch is resent until a newer checkpoint is stable
+	rep.fetchState()
+	for i := 0; atomic.LoadInt32(&fetches) < 3; i++ {
+		if i > 100 {
+			t.Fatal("state fetch not retried", atomic.LoadInt32(&fetches))
+		}
+		time.Sleep(stateFetchRetry)
+	}
+	rep.stableCheckpoint(ToCheckpoint(CheckPointPeriod, nil))
+	time.Sleep(3 * stateFetchRetry)
+	sent := atomic.LoadInt32(&fetches)
+	time.Sleep(5 * stateFetchRetry)
+	if atomic.LoadInt32(&fetches) != sent {
+		t.Fatal("state fetch retried after the checkpoint moved")
+	}
+}
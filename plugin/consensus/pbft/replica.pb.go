@@ -0,0 +1,495 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: replica.proto
+
+package pbft
+
+import (
+	fmt "fmt"
+	math "math"
+
+	types "github.com/33cn/chain33/types"
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// SignedRequest the envelope of a message sent between replicas, payload is the encoded message of type ty
+type SignedRequest struct {
+	Replica              uint32   `protobuf:"varint,1,opt,name=replica,proto3" json:"replica,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Ty                   int32    `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedRequest) Reset()         { *m = SignedRequest{} }
+func (m *SignedRequest) String() string { return proto.CompactTextString(m) }
+func (*SignedRequest) ProtoMessage()    {}
+func (*SignedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{0}
+}
+
+func (m *SignedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedRequest.Unmarshal(m, b)
+}
+func (m *SignedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedRequest.Marshal(b, m, deterministic)
+}
+func (m *SignedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedRequest.Merge(m, src)
+}
+func (m *SignedRequest) XXX_Size() int {
+	return xxx_messageInfo_SignedRequest.Size(m)
+}
+func (m *SignedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedRequest proto.InternalMessageInfo
+
+func (m *SignedRequest) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
+func (m *SignedRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *SignedRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignedRequest) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// StateFetch sent by a restarted replica to ask peers for their last stable checkpoint and view
+type StateFetch struct {
+	Sequence             uint32   `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	View                 uint32   `protobuf:"varint,3,opt,name=view,proto3" json:"view,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateFetch) Reset()         { *m = StateFetch{} }
+func (m *StateFetch) String() string { return proto.CompactTextString(m) }
+func (*StateFetch) ProtoMessage()    {}
+func (*StateFetch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{1}
+}
+
+func (m *StateFetch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateFetch.Unmarshal(m, b)
+}
+func (m *StateFetch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateFetch.Marshal(b, m, deterministic)
+}
+func (m *StateFetch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateFetch.Merge(m, src)
+}
+func (m *StateFetch) XXX_Size() int {
+	return xxx_messageInfo_StateFetch.Size(m)
+}
+func (m *StateFetch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateFetch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateFetch proto.InternalMessageInfo
+
+func (m *StateFetch) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *StateFetch) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *StateFetch) GetView() uint32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+// StateReply the last stable checkpoint of a replica, proof holds the signed checkpoint messages of the quorum,
+// state the last reply and members the membership changes committed up to the checkpoint, the checkpoint digest
+// was computed from both
+type StateReply struct {
+	View                 uint32                `protobuf:"varint,1,opt,name=view,proto3" json:"view,omitempty"`
+	Checkpoint           *types.Checkpoint     `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Proof                []*SignedRequest      `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	State                *types.ClientReply    `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Members              []*MembershipProposal `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StateReply) Reset()         { *m = StateReply{} }
+func (m *StateReply) String() string { return proto.CompactTextString(m) }
+func (*StateReply) ProtoMessage()    {}
+func (*StateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{2}
+}
+
+func (m *StateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateReply.Unmarshal(m, b)
+}
+func (m *StateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateReply.Marshal(b, m, deterministic)
+}
+func (m *StateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateReply.Merge(m, src)
+}
+func (m *StateReply) XXX_Size() int {
+	return xxx_messageInfo_StateReply.Size(m)
+}
+func (m *StateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateReply proto.InternalMessageInfo
+
+func (m *StateReply) GetView() uint32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *StateReply) GetCheckpoint() *types.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *StateReply) GetProof() []*SignedRequest {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *StateReply) GetState() *types.ClientReply {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *StateReply) GetMembers() []*MembershipProposal {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// MembershipChange add or remove a replica, it takes effect at the stable checkpoint of sequence
+type MembershipChange struct {
+	Op                   int32    `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	Replica              uint32   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	PubKey               string   `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Sequence             uint32   `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MembershipChange) Reset()         { *m = MembershipChange{} }
+func (m *MembershipChange) String() string { return proto.CompactTextString(m) }
+func (*MembershipChange) ProtoMessage()    {}
+func (*MembershipChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{3}
+}
+
+func (m *MembershipChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MembershipChange.Unmarshal(m, b)
+}
+func (m *MembershipChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MembershipChange.Marshal(b, m, deterministic)
+}
+func (m *MembershipChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipChange.Merge(m, src)
+}
+func (m *MembershipChange) XXX_Size() int {
+	return xxx_messageInfo_MembershipChange.Size(m)
+}
+func (m *MembershipChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipChange proto.InternalMessageInfo
+
+func (m *MembershipChange) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *MembershipChange) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
+func (m *MembershipChange) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MembershipChange) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *MembershipChange) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// MembershipProposal a change endorsed by more than 2/3 of the replicas, the primary orders it as a client request,
+// committed is the sequence it was committed at
+type MembershipProposal struct {
+	Change               *MembershipChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	Endorsements         []*SignedRequest  `protobuf:"bytes,2,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	Committed            uint32            `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MembershipProposal) Reset()         { *m = MembershipProposal{} }
+func (m *MembershipProposal) String() string { return proto.CompactTextString(m) }
+func (*MembershipProposal) ProtoMessage()    {}
+func (*MembershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{4}
+}
+
+func (m *MembershipProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MembershipProposal.Unmarshal(m, b)
+}
+func (m *MembershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MembershipProposal.Marshal(b, m, deterministic)
+}
+func (m *MembershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipProposal.Merge(m, src)
+}
+func (m *MembershipProposal) XXX_Size() int {
+	return xxx_messageInfo_MembershipProposal.Size(m)
+}
+func (m *MembershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipProposal proto.InternalMessageInfo
+
+func (m *MembershipProposal) GetChange() *MembershipChange {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
+func (m *MembershipProposal) GetEndorsements() []*SignedRequest {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+func (m *MembershipProposal) GetCommitted() uint32 {
+	if m != nil {
+		return m.Committed
+	}
+	return 0
+}
+
+// ReplicaInfo a member of the replica set
+type ReplicaInfo struct {
+	Replica              uint32   `protobuf:"varint,1,opt,name=replica,proto3" json:"replica,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	PubKey               string   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{5}
+}
+
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
+}
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
+}
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
+}
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
+}
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
+
+func (m *ReplicaInfo) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReplicaInfo) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+// ReplicaSet the current replica set and the committed changes waiting for their checkpoint
+type ReplicaSet struct {
+	Replicas             []*ReplicaInfo      `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	Pending              []*MembershipChange `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReplicaSet) Reset()         { *m = ReplicaSet{} }
+func (m *ReplicaSet) String() string { return proto.CompactTextString(m) }
+func (*ReplicaSet) ProtoMessage()    {}
+func (*ReplicaSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e84aa831fb48ea1, []int{6}
+}
+
+func (m *ReplicaSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaSet.Unmarshal(m, b)
+}
+func (m *ReplicaSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaSet.Marshal(b, m, deterministic)
+}
+func (m *ReplicaSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaSet.Merge(m, src)
+}
+func (m *ReplicaSet) XXX_Size() int {
+	return xxx_messageInfo_ReplicaSet.Size(m)
+}
+func (m *ReplicaSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaSet proto.InternalMessageInfo
+
+func (m *ReplicaSet) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *ReplicaSet) GetPending() []*MembershipChange {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignedRequest)(nil), "pbft.SignedRequest")
+	proto.RegisterType((*StateFetch)(nil), "pbft.StateFetch")
+	proto.RegisterType((*StateReply)(nil), "pbft.StateReply")
+	proto.RegisterType((*MembershipChange)(nil), "pbft.MembershipChange")
+	proto.RegisterType((*MembershipProposal)(nil), "pbft.MembershipProposal")
+	proto.RegisterType((*ReplicaInfo)(nil), "pbft.ReplicaInfo")
+	proto.RegisterType((*ReplicaSet)(nil), "pbft.ReplicaSet")
+}
+
+func init() { proto.RegisterFile("replica.proto", fileDescriptor_1e84aa831fb48ea1) }
+
+var fileDescriptor_1e84aa831fb48ea1 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x55, 0x92, 0xa6, 0xdd, 0x4e, 0xb6, 0x88, 0x35, 0xd2, 0xca, 0x5a, 0x71, 0xa8, 0x72, 0x0a,
+	0x07, 0x22, 0x08, 0x07, 0x3e, 0x60, 0x25, 0x24, 0x84, 0x90, 0x56, 0xee, 0x17, 0xa4, 0xc9, 0xb4,
+	0x8d, 0x48, 0x6c, 0x6f, 0xec, 0x82, 0x72, 0xe7, 0x2f, 0xf8, 0x35, 0x3e, 0x06, 0xc5, 0x76, 0x9a,
+	0x04, 0xd8, 0xbd, 0x54, 0x9e, 0x99, 0xd7, 0x37, 0x6f, 0xde, 0x4c, 0x60, 0xd3, 0xa2, 0xac, 0xab,
+	0x22, 0x4f, 0x65, 0x2b, 0xb4, 0x20, 0x0b, 0xb9, 0x3f, 0xe8, 0x3b, 0xe8, 0x7f, 0x6d, 0x26, 0x7e,
+	0x84, 0xcd, 0xae, 0x3a, 0x72, 0x2c, 0x19, 0x3e, 0x9e, 0x51, 0x69, 0x42, 0x61, 0xe5, 0xfe, 0x43,
+	0xbd, 0xad, 0x97, 0x6c, 0xd8, 0x10, 0xf6, 0x15, 0x99, 0x77, 0xb5, 0xc8, 0x4b, 0xea, 0x6f, 0xbd,
+	0xe4, 0x9a, 0x0d, 0x21, 0x79, 0x0d, 0x6b, 0x55, 0x1d, 0x79, 0xae, 0xcf, 0x2d, 0xd2, 0xc0, 0xd4,
+	0xc6, 0x04, 0x79, 0x01, 0xbe, 0xee, 0xe8, 0x62, 0xeb, 0x25, 0x21, 0xf3, 0x75, 0x17, 0x3f, 0x00,
+	0xec, 0x74, 0xae, 0xf1, 0x13, 0xea, 0xe2, 0x44, 0xee, 0xe0, 0x4a, 0xf5, 0xad, 0x79, 0x81, 0xae,
+	0xe1, 0x25, 0x26, 0x04, 0x16, 0x79, 0x59, 0xb6, 0xa6, 0xdd, 0x9a, 0x99, 0x77, 0x9f, 0xfb, 0x5e,
+	0xe1, 0x0f, 0xd3, 0x66, 0xc3, 0xcc, 0x3b, 0xfe, 0xed, 0x39, 0x4a, 0x86, 0xb2, 0xee, 0x2e, 0x10,
+	0x6f, 0x84, 0x90, 0xf7, 0x00, 0xc5, 0x09, 0x8b, 0x6f, 0x52, 0x54, 0x5c, 0x1b, 0xc2, 0x28, 0xbb,
+	0x49, 0x75, 0x27, 0x51, 0xa5, 0xf7, 0x97, 0x02, 0x9b, 0x80, 0xc8, 0x1b, 0x08, 0x65, 0x2b, 0xc4,
+	0x81, 0x06, 0xdb, 0x20, 0x89, 0xb2, 0x57, 0xa9, 0xb1, 0x6d, 0xe6, 0x16, 0xb3, 0x08, 0x92, 0x40,
+	0xa8, 0xfa, 0xfe, 0x66, 0xca, 0x28, 0x23, 0x03, 0x71, 0x5d, 0x21, 0xd7, 0x46, 0x14, 0xb3, 0x00,
+	0x92, 0xc1, 0xaa, 0xc1, 0x66, 0x8f, 0xad, 0xa2, 0xa1, 0xa1, 0xa5, 0x96, 0xf6, 0xab, 0x4d, 0x9e,
+	0x2a, 0xf9, 0xd0, 0x0a, 0x29, 0x54, 0x5e, 0xb3, 0x01, 0x18, 0xff, 0xf4, 0xe0, 0xe5, 0x58, 0xbf,
+	0x3f, 0xe5, 0xfc, 0x68, 0x5c, 0x15, 0xd2, 0x8c, 0x18, 0x32, 0x5f, 0xc8, 0xe9, 0xde, 0xfc, 0xf9,
+	0xde, 0x06, 0x17, 0x83, 0x89, 0x8b, 0xb7, 0xb0, 0x94, 0xe7, 0xfd, 0x17, 0xb4, 0x7b, 0x59, 0x33,
+	0x17, 0xcd, 0xb6, 0x11, 0xce, 0xb7, 0x11, 0xff, 0xf2, 0x80, 0xfc, 0x2b, 0x93, 0xa4, 0xb0, 0x2c,
+	0x8c, 0x24, 0x23, 0x26, 0xca, 0x6e, 0xff, 0x1e, 0xc8, 0x0a, 0x66, 0x0e, 0x45, 0x3e, 0xc2, 0x35,
+	0xf2, 0x52, 0xb4, 0x0a, 0x1b, 0xe4, 0x5a, 0x51, 0xff, 0x69, 0x77, 0x67, 0xc0, 0xfe, 0xca, 0x0a,
+	0xd1, 0x34, 0x95, 0xd6, 0x58, 0xba, 0xf5, 0x8f, 0x89, 0x78, 0x07, 0x11, 0xb3, 0x03, 0x7f, 0xe6,
+	0x07, 0xf1, 0xcc, 0x19, 0xff, 0xef, 0xa8, 0x46, 0x3b, 0x82, 0xa9, 0x1d, 0x71, 0x03, 0xe0, 0x48,
+	0x77, 0xa8, 0xc9, 0x5b, 0xb8, 0x72, 0x24, 0x8a, 0x7a, 0x46, 0xf5, 0x8d, 0x55, 0x3d, 0x69, 0xcc,
+	0x2e, 0x10, 0xf2, 0x0e, 0x56, 0x12, 0x79, 0x59, 0xf1, 0xa3, 0x9b, 0xf1, 0x29, 0x67, 0x06, 0xd8,
+	0x7e, 0x69, 0xbe, 0xc9, 0x0f, 0x7f, 0x06, 0x00, 0x9a, 0xc0, 0xce, 0x98, 0xb6, 0x03, 0x00, 0x00,
+}
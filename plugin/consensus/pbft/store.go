@@ -0,0 +1,262 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	pb "github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	viewKey          = []byte("pbft-view")
+	requestPrefix    = []byte("pbft-request-")
+	checkpointPrefix = []byte("pbft-checkpoint-")
+	proofPrefix      = []byte("pbft-proof-")
+	memberPrefix     = []byte("pbft-membership-")
+	statePrefix      = []byte("pbft-state-")
+)
+
+// keepCheckpoints number of the latest stable checkpoints kept in memory and in the db
+const keepCheckpoints = 2
+
+// replicaStore persist the replica log, stable checkpoints and view into a local db
+type replicaStore struct {
+	db    dbm.DB
+	index uint64
+}
+
+func newReplicaStore(dir string) *replicaStore {
+	return &replicaStore{db: dbm.NewDB("pbft", "leveldb", dir, 16)}
+}
+
+func calcRequestKey(kind string, index uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%020d", requestPrefix, kind, index))
+}
+
+func calcCheckpointKey(sequence uint32) []byte {
+	return []byte(fmt.Sprintf("%s%010d", checkpointPrefix, sequence))
+}
+
+func calcProofKey(sequence, replica uint32) []byte {
+	return []byte(fmt.Sprintf("%s%010d-%010d", proofPrefix, sequence, replica))
+}
+
+func calcStateKey(sequence uint32) []byte {
+	return []byte(fmt.Sprintf("%s%010d", statePrefix, sequence))
+}
+
+func (s *replicaStore) saveView(view uint32) {
+	var value [4]byte
+	binary.BigEndian.PutUint32(value[:], view)
+	if err := s.db.SetSync(viewKey, value[:]); err != nil {
+		plog.Error("save view error", "err", err)
+	}
+}
+
+func (s *replicaStore) loadView() uint32 {
+	value, err := s.db.Get(viewKey)
+	if err != nil || len(value) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(value)
+}
+
+func (s *replicaStore) saveRequest(kind string, req *pb.Request) {
+	s.index++
+	if err := s.db.Set(calcRequestKey(kind, s.index), pb.Encode(req)); err != nil {
+		plog.Error("save request error", "err", err)
+	}
+}
+
+// loadRequests returns the request log grouped by kind, in the order they were logged
+func (s *replicaStore) loadRequests() map[string][]*pb.Request {
+	requests := make(map[string][]*pb.Request)
+	it := s.db.Iterator(requestPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		// kind itself may contain '-', the index is always the last 20 digits
+		key := string(it.Key()[len(requestPrefix):])
+		if len(key) < 21 {
+			continue
+		}
+		kind := key[:len(key)-21]
+		var index uint64
+		if _, err := fmt.Sscanf(key[len(key)-20:], "%d", &index); err != nil {
+			continue
+		}
+		req := &pb.Request{}
+		if err := pb.Decode(it.Value(), req); err != nil {
+			plog.Error("decode request error", "err", err)
+			continue
+		}
+		requests[kind] = append(requests[kind], req)
+		if index > s.index {
+			s.index = index
+		}
+	}
+	return requests
+}
+
+// resetRequests rewrite the whole request log, used after the log is garbage collected at a checkpoint
+func (s *replicaStore) resetRequests(requests map[string][]*pb.Request) {
+	batch := s.db.NewBatch(true)
+	it := s.db.Iterator(requestPrefix, nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+	}
+	it.Close()
+	s.index = 0
+	for kind, reqs := range requests {
+		for _, req := range reqs {
+			s.index++
+			batch.Set(calcRequestKey(kind, s.index), pb.Encode(req))
+		}
+	}
+	if err := batch.Write(); err != nil {
+		plog.Error("reset requests error", "err", err)
+	}
+}
+
+// saveCheckpoint persist the stable checkpoint with its proof and the state it certifies,
+// checkpoints older than the latest keepCheckpoints are pruned together with their proof and state
+func (s *replicaStore) saveCheckpoint(checkpoint *pb.Checkpoint, proof []*SignedRequest, state *pb.ClientReply) {
+	batch := s.db.NewBatch(true)
+	batch.Set(calcCheckpointKey(checkpoint.Sequence), pb.Encode(checkpoint))
+	for _, signed := range proof {
+		value, err := proto.Marshal(signed)
+		if err != nil {
+			continue
+		}
+		batch.Set(calcProofKey(checkpoint.Sequence, signed.Replica), value)
+	}
+	if state != nil {
+		batch.Set(calcStateKey(checkpoint.Sequence), pb.Encode(state))
+	}
+
+	var older []uint32
+	it := s.db.Iterator(checkpointPrefix, nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		var sequence uint32
+		if _, err := fmt.Sscanf(string(it.Key()[len(checkpointPrefix):]), "%d", &sequence); err != nil {
+			continue
+		}
+		if sequence < checkpoint.Sequence {
+			older = append(older, sequence)
+		}
+	}
+	it.Close()
+	for i := 0; i+keepCheckpoints-1 < len(older); i++ {
+		s.pruneCheckpoint(batch, older[i])
+	}
+	if err := batch.Write(); err != nil {
+		plog.Error("save checkpoint error", "err", err)
+	}
+}
+
+func (s *replicaStore) pruneCheckpoint(batch dbm.Batch, sequence uint32) {
+	batch.Delete(calcCheckpointKey(sequence))
+	batch.Delete(calcStateKey(sequence))
+	it := s.db.Iterator([]byte(fmt.Sprintf("%s%010d-", proofPrefix, sequence)), nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+	}
+}
+
+// loadCheckpoints returns at most the latest keepCheckpoints stable checkpoints in ascending sequence order
+func (s *replicaStore) loadCheckpoints() []*pb.Checkpoint {
+	var checkpoints []*pb.Checkpoint
+	it := s.db.Iterator(checkpointPrefix, nil, true)
+	defer it.Close()
+	for it.Rewind(); it.Valid() && len(checkpoints) < keepCheckpoints; it.Next() {
+		checkpoint := &pb.Checkpoint{}
+		if err := pb.Decode(it.Value(), checkpoint); err != nil {
+			plog.Error("decode checkpoint error", "err", err)
+			continue
+		}
+		checkpoints = append([]*pb.Checkpoint{checkpoint}, checkpoints...)
+	}
+	return checkpoints
+}
+
+// loadState returns the last reply certified by the stable checkpoint
+func (s *replicaStore) loadState(sequence uint32) *pb.ClientReply {
+	value, err := s.db.Get(calcStateKey(sequence))
+	if err != nil {
+		return nil
+	}
+	state := &pb.ClientReply{}
+	if err := pb.Decode(value, state); err != nil {
+		plog.Error("decode state error", "err", err)
+		return nil
+	}
+	return state
+}
+
+// loadProof returns the signed checkpoint messages that made the checkpoint stable
+func (s *replicaStore) loadProof(sequence uint32) []*SignedRequest {
+	var proof []*SignedRequest
+	prefix := []byte(fmt.Sprintf("%s%010d-", proofPrefix, sequence))
+	it := s.db.Iterator(prefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		signed := &SignedRequest{}
+		if err := proto.Unmarshal(it.Value(), signed); err != nil {
+			continue
+		}
+		proof = append(proof, signed)
+	}
+	return proof
+}
+
+func calcMemberKey(committed uint32) []byte {
+	return []byte(fmt.Sprintf("%s%010d", memberPrefix, committed))
+}
+
+// saveMember record the membership change committed at the sequence of the proposal
+func (s *replicaStore) saveMember(proposal *MembershipProposal) {
+	if err := s.db.SetSync(calcMemberKey(proposal.Committed), pb.Encode(proposal)); err != nil {
+		plog.Error("save member error", "err", err)
+	}
+}
+
+// resetMembers replace the committed membership changes, used when they are adopted from a state transfer
+func (s *replicaStore) resetMembers(proposals []*MembershipProposal) {
+	batch := s.db.NewBatch(true)
+	it := s.db.Iterator(memberPrefix, nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+	}
+	it.Close()
+	for _, proposal := range proposals {
+		batch.Set(calcMemberKey(proposal.Committed), pb.Encode(proposal))
+	}
+	if err := batch.Write(); err != nil {
+		plog.Error("reset members error", "err", err)
+	}
+}
+
+// loadMembers returns the membership changes committed before the replica stopped, in the order they were committed
+func (s *replicaStore) loadMembers() []*MembershipProposal {
+	var proposals []*MembershipProposal
+	it := s.db.Iterator(memberPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		proposal := &MembershipProposal{}
+		if err := proto.Unmarshal(it.Value(), proposal); err != nil || proposal.Change == nil {
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+func (s *replicaStore) close() {
+	s.db.Close()
+}
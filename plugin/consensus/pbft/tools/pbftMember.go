@@ -0,0 +1,68 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/33cn/plugin/plugin/consensus/pbft"
+)
+
+func main() {
+	if len(os.Args) == 1 || os.Args[1] == "-h" {
+		LoadHelp()
+		return
+	}
+	args := os.Args[1:]
+	var change *pbft.MembershipChange
+	switch {
+	case args[0] == "add" && len(args) == 8:
+		change = &pbft.MembershipChange{Op: pbft.MembershipAdd, Addr: args[6], PubKey: args[7]}
+	case args[0] == "remove" && len(args) == 6:
+		change = &pbft.MembershipChange{Op: pbft.MembershipRemove}
+	default:
+		fmt.Fprintln(os.Stderr, errors.New("参数错误").Error())
+		os.Exit(1)
+	}
+	if err := submit(args[1], args[2], args[3], args[4], args[5], change); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println("OK")
+}
+
+// LoadHelp show available commands
+func LoadHelp() {
+	fmt.Println("Available Commands:")
+	fmt.Println("add [replicaAddr, nodeID, privkey, replica, sequence, addr, pubkey] : 以nodeID节点的名义背书增加节点")
+	fmt.Println("remove [replicaAddr, nodeID, privkey, replica, sequence]          : 以nodeID节点的名义背书删除节点")
+	fmt.Println("超过2/3的节点背书同一变更后, 由主节点作为请求排序, 各节点在同一个sequence提交, 在sequence所在的checkpoint稳定时生效, sequence必须是128的整数倍")
+}
+
+// submit sign the change with the private key of nodeID and send it to the replica of nodeID
+func submit(replicaAddr, nodeID, privkey, replica, sequence string, change *pbft.MembershipChange) error {
+	id, err := strconv.ParseUint(nodeID, 10, 32)
+	if err != nil {
+		return err
+	}
+	target, err := strconv.ParseUint(replica, 10, 32)
+	if err != nil {
+		return err
+	}
+	seq, err := strconv.ParseUint(sequence, 10, 32)
+	if err != nil {
+		return err
+	}
+	priv, err := pbft.LoadPrivKey(privkey)
+	if err != nil {
+		return err
+	}
+	change.Replica = uint32(target)
+	change.Sequence = uint32(seq)
+	return pbft.SubmitMembership(replicaAddr, uint32(id), priv, change)
+}
@@ -0,0 +1,152 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pbft
+
+import (
+	"sync/atomic"
+	"time"
+
+	pb "github.com/33cn/chain33/types"
+)
+
+// stateFetchRetry the interval to resend the state fetch until a newer checkpoint is stable
+var stateFetchRetry = 10 * time.Second
+
+// fetchState ask all peers for a newer stable checkpoint than the local one, the request is
+// resent on a timer until the stable checkpoint moves past it, by a state reply or by the local log
+func (rep *Replica) fetchState() {
+	fetch := &StateFetch{Sequence: rep.lowWaterMark(), Addr: rep.addr, View: rep.view}
+	signed, err := SignMessage(MsgStateFetch, rep.ID, rep.privKey, fetch)
+	if err != nil {
+		plog.Error("sign state fetch error", "err", err)
+		return
+	}
+	atomic.StoreInt32(&rep.fetching, 1)
+	rep.sendStateFetch(signed)
+	go func() {
+		ticker := time.NewTicker(stateFetchRetry)
+		defer ticker.Stop()
+		for range ticker.C {
+			if atomic.LoadInt32(&rep.fetching) == 0 {
+				return
+			}
+			plog.Info("retry state fetch", "sequence", fetch.Sequence)
+			rep.sendStateFetch(signed)
+		}
+	}()
+}
+
+func (rep *Replica) sendStateFetch(signed *SignedRequest) {
+	for _, replica := range rep.peers() {
+		if replica == rep.addr {
+			continue
+		}
+		if err := WriteMessage(replica, signed); err != nil {
+			plog.Debug("send state fetch error", "replica", replica, "err", err)
+		}
+	}
+}
+
+// stateFetched stop resending the state fetch
+func (rep *Replica) stateFetched() {
+	atomic.StoreInt32(&rep.fetching, 0)
+}
+
+func (rep *Replica) handleStateFetch(replica uint32, fetch *StateFetch) {
+	lastStable := rep.lastStable()
+	// the reply is also how a restarted replica learns the current view, so answer
+	// when either the checkpoint or the view of the replica is behind
+	if lastStable.Sequence <= fetch.Sequence && rep.view <= fetch.View {
+		return
+	}
+	reply := &StateReply{
+		View:       rep.view,
+		Checkpoint: lastStable,
+		Proof:      rep.store.loadProof(lastStable.Sequence),
+		State:      rep.store.loadState(lastStable.Sequence),
+		Members:    rep.committedMembers(lastStable.Sequence),
+	}
+	signed, err := SignMessage(MsgStateReply, rep.ID, rep.privKey, reply)
+	if err != nil {
+		plog.Error("sign state reply error", "err", err)
+		return
+	}
+	if err := WriteMessage(fetch.Addr, signed); err != nil {
+		plog.Error("send state reply error", "replica", replica, "err", err)
+	}
+}
+
+func (rep *Replica) handleStateReply(replica uint32, reply *StateReply) {
+	rep.peerViews[replica] = reply.View
+	rep.adoptView()
+
+	checkpoint := reply.Checkpoint
+	if checkpoint == nil || checkpoint.Sequence <= rep.lowWaterMark() {
+		return
+	}
+	if !rep.verifyCheckpointProof(checkpoint, reply.Proof) {
+		plog.Error("invalid checkpoint proof", "replica", replica, "sequence", checkpoint.Sequence)
+		return
+	}
+	// the state and the membership changes must be the ones the quorum certified,
+	// otherwise the next checkpoint of the replica would not match
+	state := reply.State
+	if state == nil || !EQ(membersDigest(state, reply.Members), checkpoint.Digest) {
+		plog.Error("state does not match checkpoint", "replica", replica, "sequence", checkpoint.Sequence)
+		return
+	}
+	for _, member := range reply.Members {
+		if member.Change == nil || member.Committed > checkpoint.Sequence {
+			plog.Error("invalid membership change in state", "replica", replica, "sequence", checkpoint.Sequence)
+			return
+		}
+	}
+	rep.adoptMembers(checkpoint.Sequence, reply.Members)
+	rep.lastReply = state
+	rep.logReply(state.Client, state)
+	rep.states[checkpoint.Sequence] = state
+	rep.proofs[checkpoint.Sequence] = reply.Proof
+	rep.stableCheckpoint(checkpoint)
+	if rep.sequence < checkpoint.Sequence {
+		rep.sequence = checkpoint.Sequence
+	}
+	rep.executed = append(rep.executed, checkpoint.Sequence)
+	plog.Info("state transfer done", "replica", replica, "sequence", checkpoint.Sequence)
+}
+
+// verifyCheckpointProof the proof must carry matching checkpoint messages signed by more than 2/3 of the replicas
+func (rep *Replica) verifyCheckpointProof(checkpoint *pb.Checkpoint, proof []*SignedRequest) bool {
+	voters := make(map[uint32]bool)
+	for _, signed := range proof {
+		req, err := rep.verifySigned(signed)
+		if err != nil {
+			continue
+		}
+		cp := req.GetCheckpoint()
+		if cp == nil || cp.Sequence != checkpoint.Sequence || !EQ(cp.Digest, checkpoint.Digest) {
+			continue
+		}
+		rep.vote(voters, signed.Replica)
+	}
+	return rep.overTwoThirds(len(voters))
+}
+
+// adoptView move to the highest view that more than 1/3 of the replicas have reached
+func (rep *Replica) adoptView() {
+	for _, view := range rep.peerViews {
+		if view <= rep.view {
+			continue
+		}
+		count := 0
+		for _, v := range rep.peerViews {
+			if v >= view {
+				count++
+			}
+		}
+		if rep.overOneThird(count) {
+			rep.setView(view)
+		}
+	}
+}
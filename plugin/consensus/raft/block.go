@@ -224,7 +224,7 @@ func (client *Client) readCommits(commitC <-chan *types.Block, errorC <-chan err
 	}
 }
 
-//轮询任务，去检测本机器是否为validator节点，如果是，则执行打包任务
+// 轮询任务，去检测本机器是否为validator节点，如果是，则执行打包任务
 func (client *Client) pollingTask(c queue.Client) {
 	ticker := time.NewTicker(100 * time.Millisecond)
 	defer ticker.Stop()
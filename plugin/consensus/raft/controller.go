@@ -6,6 +6,7 @@ package raft
 
 import (
 	"strings"
+	"time"
 
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
@@ -14,15 +15,16 @@ import (
 )
 
 var (
-	rlog                    = log.New("module", "raft")
-	genesis                 string
-	genesisBlockTime        int64
-	defaultSnapCount        uint64 = 1000
-	snapshotCatchUpEntriesN uint64 = 1000
-	writeBlockSeconds       int64  = 1
-	heartbeatTick                  = 1
-	isLeader                       = false
-	confChangeC             chan raftpb.ConfChange
+	rlog                     = log.New("module", "raft")
+	genesis                  string
+	genesisBlockTime         int64
+	defaultSnapCount         uint64 = 1000
+	snapshotCatchUpEntriesN  uint64 = 1000
+	writeBlockSeconds        int64  = 1
+	heartbeatTick                   = 1
+	isLeader                        = false
+	confChangeC              chan raftpb.ConfChange
+	defaultConfChangeTimeout = 10 * time.Second
 )
 
 type subConfig struct {
@@ -37,6 +39,13 @@ type subConfig struct {
 	DefaultSnapCount  int64  `json:"defaultSnapCount"`
 	WriteBlockSeconds int64  `json:"writeBlockSeconds"`
 	HeartbeatTick     int32  `json:"heartbeatTick"`
+	// raft http api 的认证配置，可以使用共享token或者客户端证书
+	RaftAPIToken        string `json:"raftAPIToken"`
+	RaftAPICertFile     string `json:"raftAPICertFile"`
+	RaftAPIKeyFile      string `json:"raftAPIKeyFile"`
+	RaftAPIClientCAFile string `json:"raftAPIClientCAFile"`
+	// 等待节点变更提交的超时时间，单位秒
+	ConfChangeTimeout int64 `json:"confChangeTimeout"`
 }
 
 // NewRaftCluster create raft cluster
@@ -90,9 +99,20 @@ func NewRaftCluster(cfg *types.Consensus, sub []byte) queue.Module {
 	if len(addPeers) == 1 && addPeers[0] == "" {
 		addPeers = []string{}
 	}
-	commitC, errorC, snapshotterReady, validatorC, stopC := NewRaftNode(int(subcfg.NodeID), subcfg.IsNewJoinNode, peers, readOnlyPeers, addPeers, getSnapshot, proposeC, confChangeC)
-	//启动raft删除节点操作监听
-	go serveHTTPRaftAPI(int(subcfg.RaftAPIPort), confChangeC, errorC)
+	node, commitC, errorC, snapshotterReady, validatorC, stopC := NewRaftNode(int(subcfg.NodeID), subcfg.IsNewJoinNode, peers, readOnlyPeers, addPeers, getSnapshot, proposeC, confChangeC)
+	apiCfg := &raftAPIConfig{
+		port:              int(subcfg.RaftAPIPort),
+		token:             subcfg.RaftAPIToken,
+		certFile:          subcfg.RaftAPICertFile,
+		keyFile:           subcfg.RaftAPIKeyFile,
+		clientCAFile:      subcfg.RaftAPIClientCAFile,
+		confChangeTimeout: defaultConfChangeTimeout,
+	}
+	if subcfg.ConfChangeTimeout > 0 {
+		apiCfg.confChangeTimeout = time.Duration(subcfg.ConfChangeTimeout) * time.Second
+	}
+	//启动raft节点变更和状态查询的http api
+	go serveHTTPRaftAPI(apiCfg, node, confChangeC, errorC)
 	// 监听commit channel,取block
 	b = NewBlockstore(cfg, <-snapshotterReady, proposeC, commitC, errorC, validatorC, stopC)
 	return b
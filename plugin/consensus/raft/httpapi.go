@@ -5,76 +5,353 @@
 package raft
 
 import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
-	"fmt"
-
+	"github.com/coreos/etcd/raft"
 	"github.com/coreos/etcd/raft/raftpb"
 )
 
+// raftAPIConfig the listen and authentication config of the raft http api
+type raftAPIConfig struct {
+	port              int
+	token             string
+	certFile          string
+	keyFile           string
+	clientCAFile      string
+	confChangeTimeout time.Duration
+}
+
+// raftStatus the json reply of the status query and conf change
+type raftStatus struct {
+	ID             uint64          `json:"id"`
+	Leader         uint64          `json:"leader"`
+	Term           uint64          `json:"term"`
+	Commit         uint64          `json:"commit"`
+	Applied        uint64          `json:"applied"`
+	RaftState      string          `json:"raftState"`
+	LeadTransferee uint64          `json:"leadTransferee"`
+	Progress       []*peerProgress `json:"progress,omitempty"`
+}
+
+// peerProgress the replication progress of a peer, only known by the leader
+type peerProgress struct {
+	ID        uint64 `json:"id"`
+	Match     uint64 `json:"match"`
+	Next      uint64 `json:"next"`
+	State     string `json:"state"`
+	IsLearner bool   `json:"isLearner"`
+	Paused    bool   `json:"paused"`
+}
+
+type confChangeReply struct {
+	Type      string      `json:"type"`
+	NodeID    uint64      `json:"nodeID"`
+	Committed bool        `json:"committed"`
+	Status    *raftStatus `json:"status"`
+}
+
+type errorReply struct {
+	Error string `json:"error"`
+}
+
+func toRaftStatus(status raft.Status) *raftStatus {
+	s := &raftStatus{
+		ID:             status.ID,
+		Leader:         status.Lead,
+		Term:           status.Term,
+		Commit:         status.Commit,
+		Applied:        status.Applied,
+		RaftState:      status.RaftState.String(),
+		LeadTransferee: status.LeadTransferee,
+	}
+	for id, pr := range status.Progress {
+		s.Progress = append(s.Progress, &peerProgress{
+			ID:        id,
+			Match:     pr.Match,
+			Next:      pr.Next,
+			State:     pr.State.String(),
+			IsLearner: pr.IsLearner,
+			Paused:    pr.Paused,
+		})
+	}
+	return s
+}
+
+// confChangeWait notify the api handler when its conf change is applied
+type confChangeWait struct {
+	mu      sync.Mutex
+	waiters map[uint64]chan raftpb.ConfChange
+}
+
+func newConfChangeWait() *confChangeWait {
+	return &confChangeWait{waiters: make(map[uint64]chan raftpb.ConfChange)}
+}
+
+func (w *confChangeWait) register(id uint64) <-chan raftpb.ConfChange {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ch := make(chan raftpb.ConfChange, 1)
+	w.waiters[id] = ch
+	return ch
+}
+
+func (w *confChangeWait) cancel(id uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.waiters, id)
+}
+
+func (w *confChangeWait) trigger(cc raftpb.ConfChange) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if ch, ok := w.waiters[cc.ID]; ok {
+		ch <- cc
+		delete(w.waiters, cc.ID)
+	}
+}
+
 // Handler for a http based httpRaftAPI backed by raft
 type httpRaftAPI struct {
 	confChangeC chan<- raftpb.ConfChange
+	node        *raftNode
+	cfg         *raftAPIConfig
+}
+
+func (h *httpRaftAPI) authorized(r *http.Request) bool {
+	if h.cfg.token == "" {
+		return true
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	token := strings.TrimPrefix(auth, "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.cfg.token)) == 1
 }
 
 func (h *httpRaftAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	key := r.RequestURI
+	if !h.authorized(r) {
+		writeJSON(w, http.StatusUnauthorized, &errorReply{Error: "unauthorized"})
+		return
+	}
+	if !h.node.started() {
+		writeJSON(w, http.StatusServiceUnavailable, &errorReply{Error: "raft node is not started"})
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/")
 	switch {
+	case r.Method == "GET" && key == "status":
+		writeJSON(w, http.StatusOK, toRaftStatus(h.node.Status()))
+	case r.Method == "POST" && strings.HasPrefix(key, "learner/"):
+		h.addNode(w, r, strings.TrimPrefix(key, "learner/"), raftpb.ConfChangeAddLearnerNode)
+	case r.Method == "POST" && strings.HasPrefix(key, "promote/"):
+		h.promoteNode(w, strings.TrimPrefix(key, "promote/"))
+	case r.Method == "POST" && strings.HasPrefix(key, "transfer/"):
+		h.transferLeader(w, strings.TrimPrefix(key, "transfer/"))
 	case r.Method == "POST":
-		url, err := ioutil.ReadAll(r.Body)
-		if err != nil {
-			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
-			http.Error(w, "Failed on POST", http.StatusBadRequest)
-			return
-		}
-
-		nodeID, err := strconv.ParseUint(key[1:], 0, 64)
-		if err != nil {
-			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
-			http.Error(w, "Failed on POST", http.StatusBadRequest)
-			return
-		}
-
-		cc := raftpb.ConfChange{
-			Type:    raftpb.ConfChangeAddNode,
-			NodeID:  nodeID,
-			Context: url,
-		}
-		h.confChangeC <- cc
-		// As above, optimistic that raft will apply the conf change
-		w.WriteHeader(http.StatusCreated)
+		h.addNode(w, r, key, raftpb.ConfChangeAddNode)
 	case r.Method == "DELETE":
-		nodeID, err := strconv.ParseUint(key[1:], 0, 64)
+		nodeID, err := strconv.ParseUint(key, 0, 64)
 		if err != nil {
 			rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
-			http.Error(w, "Failed on DELETE", http.StatusBadRequest)
+			writeJSON(w, http.StatusBadRequest, &errorReply{Error: "Failed on DELETE"})
 			return
 		}
-		cc := raftpb.ConfChange{
+		h.proposeConfChange(w, raftpb.ConfChange{
 			Type:   raftpb.ConfChangeRemoveNode,
 			NodeID: nodeID,
-		}
-		h.confChangeC <- cc
-		// As above, optimistic that raft will apply the conf change
-		w.WriteHeader(http.StatusAccepted)
+		})
 	default:
+		w.Header().Add("Allow", "GET")
 		w.Header().Add("Allow", "POST")
 		w.Header().Add("Allow", "DELETE")
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		writeJSON(w, http.StatusMethodNotAllowed, &errorReply{Error: "Method not allowed"})
 	}
 }
 
-func serveHTTPRaftAPI(port int, confChangeC chan<- raftpb.ConfChange, errorC <-chan error) {
+func (h *httpRaftAPI) addNode(w http.ResponseWriter, r *http.Request, key string, ty raftpb.ConfChangeType) {
+	url, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		rlog.Error(fmt.Sprintf("Failed to read url for conf change (%v)", err.Error()))
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: "Failed on POST"})
+		return
+	}
+	nodeID, err := strconv.ParseUint(key, 0, 64)
+	if err != nil {
+		rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: "Failed on POST"})
+		return
+	}
+	h.proposeConfChange(w, raftpb.ConfChange{
+		Type:    ty,
+		NodeID:  nodeID,
+		Context: url,
+	})
+}
+
+// promoteNode turn a learner into a voter, raft treats AddNode of an existing learner as a promotion
+func (h *httpRaftAPI) promoteNode(w http.ResponseWriter, key string) {
+	nodeID, err := strconv.ParseUint(key, 0, 64)
+	if err != nil {
+		rlog.Error(fmt.Sprintf("Failed to convert ID for conf change (%v)", err.Error()))
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: "Failed on POST"})
+		return
+	}
+	status := h.node.Status()
+	if status.RaftState == raft.StateLeader {
+		pr, ok := status.Progress[nodeID]
+		if !ok || !pr.IsLearner {
+			writeJSON(w, http.StatusBadRequest, &errorReply{Error: fmt.Sprintf("node %d is not a learner", nodeID)})
+			return
+		}
+	}
+	h.proposeConfChange(w, raftpb.ConfChange{
+		Type:   raftpb.ConfChangeAddNode,
+		NodeID: nodeID,
+	})
+}
+
+// transferLeader hand the leadership to a caught-up voter and wait until it becomes the leader
+func (h *httpRaftAPI) transferLeader(w http.ResponseWriter, key string) {
+	transferee, err := strconv.ParseUint(key, 0, 64)
+	if err != nil {
+		rlog.Error(fmt.Sprintf("Failed to convert ID for leader transfer (%v)", err.Error()))
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: "Failed on POST"})
+		return
+	}
+	status := h.node.Status()
+	if status.Lead == transferee {
+		writeJSON(w, http.StatusOK, toRaftStatus(status))
+		return
+	}
+	if status.RaftState != raft.StateLeader {
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: fmt.Sprintf("not the leader, send the request to leader %d", status.Lead)})
+		return
+	}
+	pr, ok := status.Progress[transferee]
+	if !ok || pr.IsLearner {
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: fmt.Sprintf("node %d is not a voter", transferee)})
+		return
+	}
+	if pr.Match < status.Commit {
+		writeJSON(w, http.StatusConflict, &errorReply{Error: fmt.Sprintf("node %d has not caught up, match %d commit %d", transferee, pr.Match, status.Commit)})
+		return
+	}
+	h.node.TransferLeadership(transferee)
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(h.cfg.confChangeTimeout)
+	for {
+		select {
+		case <-ticker.C:
+			status = h.node.Status()
+			if status.Lead == transferee {
+				writeJSON(w, http.StatusOK, toRaftStatus(status))
+				return
+			}
+		case <-timeout:
+			writeJSON(w, http.StatusGatewayTimeout, toRaftStatus(h.node.Status()))
+			return
+		}
+	}
+}
+
+// proposeConfChange propose the conf change and wait until it is applied or timeout
+func (h *httpRaftAPI) proposeConfChange(w http.ResponseWriter, cc raftpb.ConfChange) {
+	cc.ID = uint64(time.Now().UnixNano())
+	applied := h.node.confWait.register(cc.ID)
+	h.confChangeC <- cc
+	reply := &confChangeReply{Type: cc.Type.String(), NodeID: cc.NodeID}
+	select {
+	case <-applied:
+		reply.Committed = true
+		reply.Status = toRaftStatus(h.node.Status())
+		writeJSON(w, http.StatusOK, reply)
+	case <-time.After(h.cfg.confChangeTimeout):
+		h.node.confWait.cancel(cc.ID)
+		reply.Status = toRaftStatus(h.node.Status())
+		writeJSON(w, http.StatusGatewayTimeout, reply)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// authenticated whether the api requires a token or a client certificate
+func (cfg *raftAPIConfig) authenticated() bool {
+	return cfg.token != "" || cfg.clientCAFile != ""
+}
+
+// useTLS whether the api is served over https
+func (cfg *raftAPIConfig) useTLS() bool {
+	return cfg.certFile != "" && cfg.keyFile != ""
+}
+
+// listenAddr the api only listens on the loopback interface unless authentication and tls are configured,
+// otherwise the token would be sent in cleartext
+func (cfg *raftAPIConfig) listenAddr() string {
+	if cfg.authenticated() && cfg.useTLS() {
+		return ":" + strconv.Itoa(cfg.port)
+	}
+	return "localhost:" + strconv.Itoa(cfg.port)
+}
+
+func serveHTTPRaftAPI(cfg *raftAPIConfig, node *raftNode, confChangeC chan<- raftpb.ConfChange, errorC <-chan error) {
 	srv := http.Server{
-		Addr: "localhost:" + strconv.Itoa(port),
+		Addr: cfg.listenAddr(),
 		Handler: &httpRaftAPI{
 			confChangeC: confChangeC,
+			node:        node,
+			cfg:         cfg,
 		},
 	}
+	if !cfg.authenticated() || !cfg.useTLS() {
+		rlog.Warn("raft http api only accepts local connections, set raftAPIToken or raftAPIClientCAFile together with raftAPICertFile and raftAPIKeyFile to serve remote clients")
+	}
+	if cfg.clientCAFile != "" {
+		if cfg.certFile == "" || cfg.keyFile == "" {
+			rlog.Error("raftAPIClientCAFile needs raftAPICertFile and raftAPIKeyFile")
+			return
+		}
+		ca, err := ioutil.ReadFile(cfg.clientCAFile)
+		if err != nil {
+			rlog.Error(fmt.Sprintf("Failed to read raft api client ca (%v)", err.Error()))
+			return
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			rlog.Error("Failed to parse raft api client ca")
+			return
+		}
+		srv.TLSConfig = &tls.Config{ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert}
+	}
 	go func() {
-		if err := srv.ListenAndServe(); err != nil {
+		var err error
+		if cfg.useTLS() {
+			err = srv.ListenAndServeTLS(cfg.certFile, cfg.keyFile)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil {
 			rlog.Error(fmt.Sprintf("ListenAndServe have a err: (%v)", err.Error()))
 		}
 	}()
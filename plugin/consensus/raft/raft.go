@@ -60,13 +60,15 @@ type raftNode struct {
 	httpstopc        chan struct{}
 	httpdonec        chan struct{}
 	validatorC       chan bool
+	confWait         *confChangeWait
+	leaderC          chan struct{}
 	//用于判断该节点是否重启过
 	restartC chan struct{}
 }
 
 // NewRaftNode create raft node
 func NewRaftNode(id int, join bool, peers []string, readOnlyPeers []string, addPeers []string, getSnapshot func() ([]byte, error), proposeC <-chan *types.Block,
-	confChangeC <-chan raftpb.ConfChange) (<-chan *types.Block, <-chan error, <-chan *snap.Snapshotter, <-chan bool, chan<- struct{}) {
+	confChangeC <-chan raftpb.ConfChange) (*raftNode, <-chan *types.Block, <-chan error, <-chan *snap.Snapshotter, <-chan bool, chan<- struct{}) {
 
 	rlog.Info("Enter consensus raft")
 	// commit channel
@@ -90,15 +92,17 @@ func NewRaftNode(id int, join bool, peers []string, readOnlyPeers []string, addP
 		httpstopc:        make(chan struct{}),
 		httpdonec:        make(chan struct{}),
 		validatorC:       make(chan bool),
+		confWait:         newConfChangeWait(),
+		leaderC:          make(chan struct{}, 1),
 		snapshotterReady: make(chan *snap.Snapshotter, 1),
 		restartC:         make(chan struct{}, 1),
 	}
 	go rc.startRaft()
 
-	return commitC, errorC, rc.snapshotterReady, rc.validatorC, rc.stopc
+	return rc, commitC, errorC, rc.snapshotterReady, rc.validatorC, rc.stopc
 }
 
-//  启动raft节点
+// 启动raft节点
 func (rc *raftNode) startRaft() {
 	// 有snapshot就打开，没有则创建
 	if !fileutil.Exist(rc.snapdir) {
@@ -132,16 +136,21 @@ func (rc *raftNode) startRaft() {
 	if len(rc.readOnlyPeers) > 0 && rc.id > len(rc.bootstrapPeers) {
 		rc.join = true
 	}
+	var node raft.Node
 	if oldwal {
 		rc.restartC <- struct{}{}
-		rc.node = raft.RestartNode(c)
+		node = raft.RestartNode(c)
 	} else {
 		startPeers := rpeers
 		if rc.join {
 			startPeers = nil
 		}
-		rc.node = raft.StartNode(c, startPeers)
+		node = raft.StartNode(c, startPeers)
 	}
+	// http api 可能在节点启动前收到请求，node的赋值需要加锁
+	rc.stopMu.Lock()
+	rc.node = node
+	rc.stopMu.Unlock()
 
 	rc.transport = &rafthttp.Transport{
 		ID:          typec.ID(rc.id),
@@ -239,8 +248,11 @@ func (rc *raftNode) serveChannels() {
 				if !ok {
 					rc.confChangeC = nil
 				} else {
-					confChangeCount++
-					cc.ID = confChangeCount
+					// the conf changes from raft api carry their own id to wait for the result
+					if cc.ID == 0 {
+						confChangeCount++
+						cc.ID = confChangeCount
+					}
 					err = rc.node.ProposeConfChange(context.TODO(), cc)
 					if err != nil {
 						rlog.Error(fmt.Sprintf("rc.node.ProposeConfChange:%v", err.Error()))
@@ -256,6 +268,9 @@ func (rc *raftNode) serveChannels() {
 		case <-ticker.C:
 			rc.node.Tick()
 		case rd := <-rc.node.Ready():
+			if rd.SoftState != nil {
+				rc.notifyLeaderChange()
+			}
 			rc.wal.Save(rd.HardState, rd.Entries)
 			if !raft.IsEmptySnap(rd.Snapshot) {
 				rc.saveSnap(rd.Snapshot)
@@ -298,14 +313,18 @@ func (rc *raftNode) updateValidator() {
 		ticker.Stop()
 	}
 	for {
-		time.Sleep(time.Second)
+		// leader变化时立即更新validator，避免新leader等待轮询间隔才开始打包
+		select {
+		case <-time.After(time.Second):
+		case <-rc.leaderC:
+		}
 		status := rc.Status()
 		if status.Lead == raft.None {
 			rlog.Debug(fmt.Sprintf("==============This is %s node!==============", status.RaftState.String()))
 			continue
 		} else {
-			// 获取到leader ID,选主成功
-			if rc.id == int(status.Lead) {
+			// 获取到leader ID,选主成功; leader转移期间停止打包，防止区块提议被丢弃
+			if rc.id == int(status.Lead) && status.LeadTransferee == raft.None {
 				//leader选举出来之后即可添加addReadOnlyPeers
 				if !flag && !isRestart {
 					go rc.addReadOnlyPeers()
@@ -318,9 +337,38 @@ func (rc *raftNode) updateValidator() {
 		}
 	}
 }
+func (rc *raftNode) notifyLeaderChange() {
+	select {
+	case rc.leaderC <- struct{}{}:
+	default:
+	}
+}
+
+// started raft节点是否已经启动
+func (rc *raftNode) started() bool {
+	rc.stopMu.RLock()
+	defer rc.stopMu.RUnlock()
+	return rc.node != nil
+}
+
+// TransferLeadership hand the leadership of this leader node to transferee
+func (rc *raftNode) TransferLeadership(transferee uint64) {
+	rc.stopMu.RLock()
+	defer rc.stopMu.RUnlock()
+	if rc.node == nil {
+		return
+	}
+	rc.node.TransferLeadership(context.TODO(), uint64(rc.id), transferee)
+	rc.notifyLeaderChange()
+}
+
+// Status 节点未启动时返回空的状态
 func (rc *raftNode) Status() raft.Status {
 	rc.stopMu.RLock()
 	defer rc.stopMu.RUnlock()
+	if rc.node == nil {
+		return raft.Status{}
+	}
 	return rc.node.Status()
 }
 
@@ -504,6 +552,8 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 			case raftpb.ConfChangeRemoveNode:
 				if cc.NodeID == uint64(rc.id) {
 					rlog.Info("I've been removed from the cluster! Shutting down.")
+					// 通知等待自身删除的api请求，节点随后停止
+					rc.confWait.trigger(cc)
 					return false
 				}
 				rc.transport.RemovePeer(typec.ID(cc.NodeID))
@@ -513,6 +563,7 @@ func (rc *raftNode) publishEntries(ents []raftpb.Entry) bool {
 				}
 				isReady = true
 			}
+			rc.confWait.trigger(cc)
 
 		}
 
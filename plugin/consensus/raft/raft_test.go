@@ -5,10 +5,14 @@
 package raft
 
 import (
+	"context"
 	"encoding/binary"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -30,6 +34,8 @@ import (
 	_ "github.com/33cn/plugin/plugin/dapp/init"
 	pty "github.com/33cn/plugin/plugin/dapp/norm/types"
 	_ "github.com/33cn/plugin/plugin/store/init"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
 )
 
 var (
@@ -177,3 +183,226 @@ func clearTestData() {
 	}
 	fmt.Println("test data clear successfully!")
 }
+
+// startTestNode 启动单节点的raft集群，并等待本节点成为leader
+func startTestNode(t *testing.T) (*raftNode, func()) {
+	storage := raft.NewMemoryStorage()
+	c := &raft.Config{
+		ID:              1,
+		ElectionTick:    10,
+		HeartbeatTick:   1,
+		Storage:         storage,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+	}
+	rc := &raftNode{id: 1, confWait: newConfChangeWait(), leaderC: make(chan struct{}, 1)}
+	rc.node = raft.StartNode(c, []raft.Peer{{ID: 1}})
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				rc.node.Tick()
+			case rd := <-rc.node.Ready():
+				if !raft.IsEmptyHardState(rd.HardState) {
+					storage.SetHardState(rd.HardState)
+				}
+				storage.Append(rd.Entries)
+				if !rc.publishEntries(rd.CommittedEntries) {
+					// 本节点被删除后停止
+					rc.node.Stop()
+					return
+				}
+				rc.node.Advance()
+			case <-stop:
+				rc.node.Stop()
+				return
+			}
+		}
+	}()
+	rc.node.Campaign(context.TODO())
+	for i := 0; i < 100 && rc.Status().RaftState != raft.StateLeader; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if rc.Status().RaftState != raft.StateLeader {
+		t.Fatal("node is not the leader")
+	}
+	return rc, func() {
+		close(stop)
+		<-done
+	}
+}
+
+func serveTestAPI(h *httpRaftAPI, method string, path string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestRaftAPIAuth(t *testing.T) {
+	// 节点启动前的请求不会访问空的node
+	h := &httpRaftAPI{node: &raftNode{}, cfg: &raftAPIConfig{token: "secret"}}
+	if w := serveTestAPI(h, "GET", "/status", "secret"); w.Code != http.StatusServiceUnavailable {
+		t.Fatal("expect 503 before the node is started, got", w.Code)
+	}
+
+	rc, stop := startTestNode(t)
+	defer stop()
+	h.node = rc
+	if w := serveTestAPI(h, "GET", "/status", ""); w.Code != http.StatusUnauthorized {
+		t.Fatal("expect 401 without token, got", w.Code)
+	}
+	if w := serveTestAPI(h, "GET", "/status", "wrong"); w.Code != http.StatusUnauthorized {
+		t.Fatal("expect 401 with wrong token, got", w.Code)
+	}
+	if w := serveTestAPI(h, "DELETE", "/2", "wrong"); w.Code != http.StatusUnauthorized {
+		t.Fatal("expect 401 for conf change with wrong token, got", w.Code)
+	}
+
+	w := serveTestAPI(h, "GET", "/status", "secret")
+	if w.Code != http.StatusOK {
+		t.Fatal("expect 200, got", w.Code)
+	}
+	var status raftStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	if status.ID != 1 || status.Leader != 1 || status.RaftState != raft.StateLeader.String() || len(status.Progress) != 1 {
+		t.Fatal("wrong status", w.Body.String())
+	}
+}
+
+func TestRaftAPIListenAddr(t *testing.T) {
+	// 没有认证配置时只接受本机连接
+	cfg := &raftAPIConfig{port: 9121}
+	if addr := cfg.listenAddr(); addr != "localhost:9121" {
+		t.Fatal("unauthenticated api should listen on localhost, got", addr)
+	}
+	// 没有TLS证书时token会明文传输，也只接受本机连接
+	cfg.token = "secret"
+	if addr := cfg.listenAddr(); addr != "localhost:9121" {
+		t.Fatal("api with token but without tls should listen on localhost, got", addr)
+	}
+	cfg.certFile = "cert.pem"
+	if addr := cfg.listenAddr(); addr != "localhost:9121" {
+		t.Fatal("api without tls key should listen on localhost, got", addr)
+	}
+	cfg.keyFile = "key.pem"
+	if addr := cfg.listenAddr(); addr != ":9121" {
+		t.Fatal("expect all interfaces with token and tls, got", addr)
+	}
+	cfg = &raftAPIConfig{port: 9121, clientCAFile: "ca.pem", certFile: "cert.pem", keyFile: "key.pem"}
+	if addr := cfg.listenAddr(); addr != ":9121" {
+		t.Fatal("expect all interfaces with client ca, got", addr)
+	}
+}
+
+// newTestAPI 节点变更请求直接提交给raft节点
+func newTestAPI(rc *raftNode) *httpRaftAPI {
+	confChangeC := make(chan raftpb.ConfChange)
+	go func() {
+		for cc := range confChangeC {
+			rc.node.ProposeConfChange(context.TODO(), cc)
+		}
+	}()
+	return &httpRaftAPI{node: rc, confChangeC: confChangeC, cfg: &raftAPIConfig{confChangeTimeout: 2 * time.Second}}
+}
+
+func TestRaftAPIPromoteLearner(t *testing.T) {
+	rc, stop := startTestNode(t)
+	defer stop()
+	h := newTestAPI(rc)
+	defer close(h.confChangeC)
+
+	if w := serveTestAPI(h, "POST", "/promote/2", ""); w.Code != http.StatusBadRequest {
+		t.Fatal("expect 400 for a node that is not a learner, got", w.Code)
+	}
+	w := serveTestAPI(h, "POST", "/learner/2", "")
+	if w.Code != http.StatusOK {
+		t.Fatal("add learner failed", w.Code, w.Body.String())
+	}
+	if pr, ok := rc.Status().Progress[2]; !ok || !pr.IsLearner {
+		t.Fatal("node 2 should be a learner")
+	}
+	w = serveTestAPI(h, "POST", "/promote/2", "")
+	if w.Code != http.StatusOK {
+		t.Fatal("promote learner failed", w.Code, w.Body.String())
+	}
+	var reply confChangeReply
+	if err := json.Unmarshal(w.Body.Bytes(), &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Committed || reply.Type != raftpb.ConfChangeAddNode.String() || reply.NodeID != 2 {
+		t.Fatal("wrong reply", w.Body.String())
+	}
+	if pr, ok := rc.Status().Progress[2]; !ok || pr.IsLearner {
+		t.Fatal("node 2 should be a voter")
+	}
+}
+
+func TestRaftAPIRemoveSelf(t *testing.T) {
+	rc, stop := startTestNode(t)
+	defer stop()
+	h := newTestAPI(rc)
+	defer close(h.confChangeC)
+
+	// 删除自身后节点停止，请求在提交后立即返回而不是等到超时
+	start := time.Now()
+	w := serveTestAPI(h, "DELETE", "/1", "")
+	if w.Code != http.StatusOK {
+		t.Fatal("remove self failed", w.Code, w.Body.String())
+	}
+	var reply confChangeReply
+	if err := json.Unmarshal(w.Body.Bytes(), &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Committed || reply.NodeID != 1 {
+		t.Fatal("wrong reply", w.Body.String())
+	}
+	if time.Since(start) >= h.cfg.confChangeTimeout {
+		t.Fatal("remove self waited until timeout")
+	}
+}
+
+func TestRaftAPITransferLeader(t *testing.T) {
+	rc, stop := startTestNode(t)
+	defer stop()
+	h := &httpRaftAPI{node: rc, cfg: &raftAPIConfig{confChangeTimeout: time.Second}}
+
+	if w := serveTestAPI(h, "POST", "/transfer/1", ""); w.Code != http.StatusOK {
+		t.Fatal("transfer to the current leader should succeed, got", w.Code)
+	}
+	if w := serveTestAPI(h, "POST", "/transfer/abc", ""); w.Code != http.StatusBadRequest {
+		t.Fatal("expect 400 for invalid node id, got", w.Code)
+	}
+	if w := serveTestAPI(h, "POST", "/transfer/3", ""); w.Code != http.StatusBadRequest {
+		t.Fatal("expect 400 for unknown node, got", w.Code)
+	}
+
+	// 新加入的节点还没有同步日志，不能成为leader
+	err := rc.node.ProposeConfChange(context.TODO(), raftpb.ConfChange{Type: raftpb.ConfChangeAddNode, NodeID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if _, ok := rc.Status().Progress[2]; ok {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	w := serveTestAPI(h, "POST", "/transfer/2", "")
+	if w.Code != http.StatusConflict {
+		t.Fatal("expect 409 for a node not caught up, got", w.Code, w.Body.String())
+	}
+	if rc.Status().Lead != 1 {
+		t.Fatal("leadership should not move")
+	}
+}
@@ -7,11 +7,15 @@ package main
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -94,6 +98,14 @@ func main() {
 			return
 		}
 		NormReadPerf(argsWithoutProg[1], argsWithoutProg[2], argsWithoutProg[3])
+	case "transferleader":
+		if len(argsWithoutProg) < 3 || len(argsWithoutProg) > 7 {
+			fmt.Print(errors.New("参数错误").Error())
+			return
+		}
+		opts := make([]string, 4)
+		copy(opts, argsWithoutProg[3:])
+		TransferLeader(os.Args[1], argsWithoutProg[1], argsWithoutProg[2], opts[0], opts[1], opts[2], opts[3])
 	}
 }
 
@@ -106,6 +118,77 @@ func LoadHelp() {
 	fmt.Println("[ip] normput [privkey, key, value]                               : 常规写数据")
 	fmt.Println("[ip] normget [key]                                               : 常规读数据")
 	fmt.Println("[ip] normreadperf [num, interval, duration]                      : 常规读数据性能测试")
+	fmt.Println("[ip] transferleader [api, nodeID, token, caFile, certFile, keyFile] : 将raft leader转移给指定节点")
+	fmt.Println("     api为raft api端口或完整地址(如https://127.0.0.1:9121), 节点配置了raftAPICertFile时使用https")
+}
+
+// raftAPIURL build the raft api url, api is either the port on ip or a full address with the scheme the node is configured with
+func raftAPIURL(ip string, api string, path string) string {
+	if strings.Contains(api, "://") {
+		return strings.TrimSuffix(api, "/") + path
+	}
+	return fmt.Sprintf("http://%s:%s%s", ip, api, path)
+}
+
+// raftAPIClient the http client of the raft api, caFile verifies the server certificate
+// and certFile, keyFile are the client certificate when the node requires one
+func raftAPIClient(caFile, certFile, keyFile string) (*http.Client, error) {
+	if caFile == "" && certFile == "" {
+		return http.DefaultClient, nil
+	}
+	tlsConfig := &tls.Config{}
+	if caFile != "" {
+		ca, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("invalid ca file")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}, nil
+}
+
+// TransferLeader ask the raft leader at ip to hand the leadership to nodeID
+func TransferLeader(ip string, api string, nodeID string, token string, caFile string, certFile string, keyFile string) {
+	client, err := raftAPIClient(caFile, certFile, keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	req, err := http.NewRequest("POST", raftAPIURL(ip, api, "/transfer/"+nodeID), nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintln(os.Stderr, resp.Status, string(body))
+		return
+	}
+	fmt.Println(string(body))
 }
 
 // TransferPerf run transfer performance
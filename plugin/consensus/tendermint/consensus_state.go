@@ -1095,21 +1095,14 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 	}
 
 	//check whether need update validator nodes
+	var updates []*tmtypes.ValNode
 	valNodes, err := cs.client.QueryValidatorsByHeight(block.Header.Height)
 	if err == nil && valNodes != nil {
-		if len(valNodes.Nodes) > 0 {
-			tendermintlog.Info("finalizeCommit validators of statecopy update", "update-valnodes", valNodes)
-			prevValSet := stateCopy.LastValidators.Copy()
-			nextValSet := prevValSet.Copy()
-			err := updateValidators(nextValSet, valNodes.Nodes)
-			if err != nil {
-				tendermintlog.Error("Error changing validator set", "error", err)
-			}
-			// change results from this height but only applies to the next height
-			stateCopy.LastHeightValidatorsChanged = block.Header.Height + 1
-			nextValSet.IncrementAccum(1)
-			stateCopy.Validators = nextValSet
-		}
+		tendermintlog.Info("finalizeCommit validators of statecopy update", "update-valnodes", valNodes)
+		updates = valNodes.Nodes
+	}
+	if err := updateStateValidators(&stateCopy, block.Header.Height, updates); err != nil {
+		tendermintlog.Error("Error changing validator set", "error", err)
 	}
 	tendermintlog.Debug("finalizeCommit validators of statecopy", "validators", stateCopy.Validators)
 	// NewHeightStep!
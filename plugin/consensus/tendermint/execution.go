@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/33cn/chain33/types"
 	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
 	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
@@ -88,7 +89,7 @@ func updateState(s State, blockID ttypes.BlockID, block *ttypes.TendermintBlock)
 
 	// NOTE: the AppHash has not been populated.
 	// It will be filled on state.Save.
-	return State{
+	next := State{
 		ChainID:                          s.ChainID,
 		LastBlockHeight:                  block.Header.Height,
 		LastBlockTotalTx:                 s.LastBlockTotalTx + block.Header.NumTxs,
@@ -101,21 +102,254 @@ func updateState(s State, blockID ttypes.BlockID, block *ttypes.TendermintBlock)
 		LastHeightConsensusParamsChanged: lastHeightParamsChanged,
 		LastResultsHash:                  nil,
 		AppHash:                          nil,
-	}, nil
+		PendingValidators:                s.PendingValidators,
+		JailedValidators:                 s.JailedValidators,
+	}
+	if types.IsDappFork(block.Header.Height, tmtypes.ValNodeX, tmtypes.ForkValidatorJail) {
+		jailValidators(&next, block)
+	}
+	return next, nil
+}
+
+// jailValidators jail the validators whose byzantine behaviour is proven by the evidence committed in block.
+// The offender loses all its voting power at the next height, without waiting for the unbonding delay
+// and whatever its share of the total power, and later updates of a jailed validator are ignored.
+func jailValidators(s *State, block *ttypes.TendermintBlock) {
+	if block.Evidence == nil {
+		return
+	}
+	height := block.Header.Height
+	for _, ev := range block.Evidence.Evidence {
+		evidence := ttypes.EvidenceEnvelope2Evidence(ev)
+		if evidence == nil {
+			continue
+		}
+		address := evidence.Address()
+		if s.isJailed(address) {
+			continue
+		}
+		jailed := &tmtypes.JailedValidator{
+			Address:        address,
+			Height:         height,
+			EvidenceHeight: evidence.Height(),
+			EvidenceHash:   evidence.Hash(),
+		}
+		if _, val := s.Validators.GetByAddress(address); val != nil {
+			jailed.PubKey = val.PubKey
+			jailed.Power = val.VotingPower
+		}
+		// drop the pending changes of the offender, a pending join never takes effect
+		pending := make([]*tmtypes.PendingValidator, 0, len(s.PendingValidators)+1)
+		for _, p := range s.PendingValidators {
+			pubkey, err := ttypes.ConsensusCrypto.PubKeyFromBytes(p.PubKey)
+			if err == nil && bytes.Equal(ttypes.GenAddressByPubKey(pubkey), address) {
+				continue
+			}
+			pending = append(pending, p)
+		}
+		if jailed.Power > 0 {
+			pending = append(pending, &tmtypes.PendingValidator{
+				PubKey:          jailed.PubKey,
+				Power:           0,
+				Height:          height,
+				EffectiveHeight: height + 1,
+			})
+		}
+		s.PendingValidators = pending
+		s.JailedValidators = append(s.JailedValidators, jailed)
+		tendermintlog.Info("jail validator", "address", fmt.Sprintf("%X", address), "power", jailed.Power,
+			"height", height, "evidence-height", jailed.EvidenceHeight)
+	}
 }
 
-func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode) error {
+// scheduleValidators turn the validator updates committed in block height into pending changes.
+// A join or power increase takes effect validatorUpdateDelay blocks later, a leave or power decrease
+// only after unbondingDelay blocks, so the leaving power stays accountable for its recent votes.
+func scheduleValidators(s *State, height int64, updates []*tmtypes.ValNode) {
+	for _, v := range updates {
+		pubkey, err := ttypes.ConsensusCrypto.PubKeyFromBytes(v.PubKey)
+		if err != nil {
+			tendermintlog.Error("scheduleValidators invalid pubkey", "pubkey", fmt.Sprintf("%X", v.PubKey), "error", err)
+			continue
+		}
+		if v.Power < 0 {
+			tendermintlog.Error("scheduleValidators negative power", "pubkey", fmt.Sprintf("%X", v.PubKey), "power", v.Power)
+			continue
+		}
+		if s.isJailed(ttypes.GenAddressByPubKey(pubkey)) {
+			tendermintlog.Info("scheduleValidators ignore jailed validator", "pubkey", fmt.Sprintf("%X", v.PubKey), "power", v.Power)
+			continue
+		}
+		// a later change of the same validator replaces the one still pending
+		pending := make([]*tmtypes.PendingValidator, 0, len(s.PendingValidators)+1)
+		for _, p := range s.PendingValidators {
+			if !bytes.Equal(p.PubKey, v.PubKey) {
+				pending = append(pending, p)
+			}
+		}
+		s.PendingValidators = pending
+
+		delay := validatorUpdateDelay
+		_, val := s.Validators.GetByAddress(ttypes.GenAddressByPubKey(pubkey))
+		if val == nil && v.Power == 0 {
+			// leaving before joining just cancels the join
+			continue
+		}
+		if val != nil && v.Power < val.VotingPower {
+			delay = unbondingDelay
+		}
+		s.PendingValidators = append(s.PendingValidators, &tmtypes.PendingValidator{
+			PubKey:          v.PubKey,
+			Power:           v.Power,
+			Height:          height,
+			EffectiveHeight: height + 1 + delay,
+		})
+	}
+}
+
+// updateStateValidators schedule the validator updates of block height and apply
+// the pending changes that take effect at height+1 to the next validator set.
+// Before ForkValidatorDelay the updates of block height take effect at height+1 at once,
+// before ForkValidatorStep the due changes take effect at once instead of in limited steps.
+func updateStateValidators(s *State, height int64, updates []*tmtypes.ValNode) error {
+	if !types.IsDappFork(height, tmtypes.ValNodeX, tmtypes.ForkValidatorDelay) {
+		if len(updates) == 0 {
+			return nil
+		}
+		nextValSet := s.LastValidators.Copy()
+		err := updateValidators(nextValSet, updates, nextValSet.TotalVotingPower()/3-1)
+		s.LastHeightValidatorsChanged = height + 1
+		nextValSet.IncrementAccum(1)
+		s.Validators = nextValSet
+		return err
+	}
+	scheduleValidators(s, height, updates)
+
+	jailed, due, pending := dueValidatorChanges(s.LastValidators, height, s.PendingValidators, s.isJailed)
+	nextValSet := s.LastValidators.Copy()
+	// jailed validators leave at once, only the other changes are held below 1/3 of the remaining power
+	err := applyValidatorUpdates(nextValSet, jailed)
+	var changes []*tmtypes.ValNode
+	limit := nextValSet.TotalVotingPower()/3 - 1
+	if types.IsDappFork(height, tmtypes.ValNodeX, tmtypes.ForkValidatorStep) {
+		limit = maxValidatorChange(nextValSet.TotalVotingPower())
+		var left []*tmtypes.PendingValidator
+		changes, left = limitValidatorChanges(nextValSet, limit, due)
+		pending = append(pending, left...)
+	} else {
+		for _, p := range due {
+			changes = append(changes, &tmtypes.ValNode{PubKey: p.PubKey, Power: p.Power})
+		}
+	}
+	s.PendingValidators = pending
+	if len(jailed) == 0 && len(changes) == 0 {
+		return nil
+	}
+	tendermintlog.Info("validator changes take effect", "height", height+1, "jailed", len(jailed), "changes", len(changes))
+	if err == nil {
+		err = updateValidators(nextValSet, changes, limit)
+	}
+	// change results from this height but only applies to the next height
+	s.LastHeightValidatorsChanged = height + 1
+	nextValSet.IncrementAccum(1)
+	s.Validators = nextValSet
+	return err
+}
+
+// dueValidatorChanges pick the pending changes that take effect at height+1. The removals of jailed
+// validators are returned apart, they always take effect at once.
+func dueValidatorChanges(currentSet *ttypes.ValidatorSet, height int64, pendings []*tmtypes.PendingValidator,
+	isJailed func(address []byte) bool) (jailed []*tmtypes.ValNode, due, pending []*tmtypes.PendingValidator) {
+	for _, p := range pendings {
+		if p.EffectiveHeight > height+1 {
+			pending = append(pending, p)
+			continue
+		}
+		pubkey, err := ttypes.ConsensusCrypto.PubKeyFromBytes(p.PubKey)
+		if err != nil {
+			continue
+		}
+		address := ttypes.GenAddressByPubKey(pubkey)
+		if p.Power == 0 && isJailed(address) {
+			if currentSet.HasAddress(address) {
+				jailed = append(jailed, &tmtypes.ValNode{PubKey: p.PubKey, Power: 0})
+			}
+			continue
+		}
+		due = append(due, p)
+	}
+	return jailed, due, pending
+}
+
+// minValidatorStep the voting power a small validator set changes by per height. When the total power
+// is below 6, total/3-1 is less than 1 and such a set could never change at all.
+// A light client may not be able to follow these steps.
+const minValidatorStep = 1
+
+// maxValidatorChange the voting power allowed to change in one height, strictly less than 1/3 of total
+func maxValidatorChange(total int64) int64 {
+	if limit := total/3 - 1; limit > minValidatorStep {
+		return limit
+	}
+	return minValidatorStep
+}
+
+// limitValidatorChanges hold the voting power changed by the due changes within limit, otherwise a light
+// client can't follow the transition (see ./lite/doc.go). A change beyond the limit is applied in steps:
+// the part that fits takes effect now and the rest stays pending for the next heights.
+func limitValidatorChanges(currentSet *ttypes.ValidatorSet, limit int64, due []*tmtypes.PendingValidator) ([]*tmtypes.ValNode, []*tmtypes.PendingValidator) {
+	var changes []*tmtypes.ValNode
+	var pending []*tmtypes.PendingValidator
+	budget := limit
+	for _, p := range due {
+		pubkey, err := ttypes.ConsensusCrypto.PubKeyFromBytes(p.PubKey)
+		if err != nil {
+			continue
+		}
+		var power int64
+		if _, val := currentSet.GetByAddress(ttypes.GenAddressByPubKey(pubkey)); val != nil {
+			power = val.VotingPower
+		}
+		delta := p.Power - power
+		if delta < 0 {
+			delta = -delta
+		}
+		switch {
+		case delta <= budget:
+			changes = append(changes, &tmtypes.ValNode{PubKey: p.PubKey, Power: p.Power})
+			budget -= delta
+		case budget > 0:
+			step := power + budget
+			if p.Power < power {
+				step = power - budget
+			}
+			changes = append(changes, &tmtypes.ValNode{PubKey: p.PubKey, Power: step})
+			budget = 0
+			pending = append(pending, p)
+		default:
+			pending = append(pending, p)
+		}
+	}
+	return changes, pending
+}
+
+// updateValidators apply the updates if the voting power they change is no more than limit
+func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode, limit int64) error {
 	// If more or equal than 1/3 of total voting power changed in one block, then
 	// a light client could never prove the transition externally. See
 	// ./lite/doc.go for details on how a light client tracks validators.
-	vp23, err := changeInVotingPowerMoreOrEqualToOneThird(currentSet, updates)
+	vp23, err := changeInVotingPowerMoreThan(currentSet, updates, limit)
 	if err != nil {
 		return err
 	}
 	if vp23 {
 		return errors.New("the change in voting power must be strictly less than 1/3")
 	}
+	return applyValidatorUpdates(currentSet, updates)
+}
 
+// applyValidatorUpdates add, update or remove the validators without limiting the change in voting power
+func applyValidatorUpdates(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode) error {
 	for _, v := range updates {
 		pubkey, err := ttypes.ConsensusCrypto.PubKeyFromBytes(v.PubKey) // NOTE: expects go-wire encoded pubkey
 		if err != nil {
@@ -154,8 +388,7 @@ func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNod
 	return nil
 }
 
-func changeInVotingPowerMoreOrEqualToOneThird(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode) (bool, error) {
-	threshold := currentSet.TotalVotingPower() * 1 / 3
+func changeInVotingPowerMoreThan(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode, limit int64) (bool, error) {
 	acc := int64(0)
 
 	for _, v := range updates {
@@ -182,7 +415,7 @@ func changeInVotingPowerMoreOrEqualToOneThird(currentSet *ttypes.ValidatorSet, u
 			acc += np
 		}
 
-		if acc >= threshold {
+		if acc > limit {
 			return true, nil
 		}
 	}
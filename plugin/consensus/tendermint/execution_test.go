@@ -0,0 +1,298 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system/crypto/ed25519"
+)
+
+func TestValidatorLifecycle(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+	validatorUpdateDelay, unbondingDelay = 2, 5
+	defer func() { validatorUpdateDelay, unbondingDelay = 0, 0 }()
+
+	var pubs [][]byte
+	var vals []*ttypes.Validator
+	for i := 0; i < 5; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes())
+		if i < 4 {
+			vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+		}
+	}
+	s := State{Validators: ttypes.NewValidatorSet(vals), LastValidators: ttypes.NewValidatorSet(vals)}
+	base := validatorForkHeight()
+	commit := func(height int64, updates ...*tmtypes.ValNode) {
+		s.LastValidators = s.Validators.Copy()
+		assert.Nil(t, updateStateValidators(&s, base+height, updates))
+	}
+
+	// join takes effect validatorUpdateDelay blocks later, leave after the unbonding delay
+	commit(10, &tmtypes.ValNode{PubKey: pubs[4], Power: 5}, &tmtypes.ValNode{PubKey: pubs[0], Power: 0})
+	assert.Equal(t, 2, len(s.PendingValidators))
+	assert.Equal(t, base+13, s.PendingValidators[0].EffectiveHeight)
+	assert.Equal(t, base+16, s.PendingValidators[1].EffectiveHeight)
+	commit(11)
+	assert.Equal(t, 4, s.Validators.Size())
+	commit(12)
+	assert.Equal(t, 5, s.Validators.Size())
+	assert.Equal(t, base+13, s.LastHeightValidatorsChanged)
+	assert.Equal(t, 1, len(s.PendingValidators))
+
+	// state survives the round trip through the block info
+	s = LoadState(SaveState(s))
+	assert.Equal(t, base+16, s.PendingValidators[0].EffectiveHeight)
+	for h := int64(13); h < 15; h++ {
+		commit(h)
+		assert.True(t, s.Validators.HasAddress(ttypes.GenAddressByPubKey(mustPubKey(t, pubs[0]))))
+	}
+	commit(15)
+	assert.False(t, s.Validators.HasAddress(ttypes.GenAddressByPubKey(mustPubKey(t, pubs[0]))))
+	assert.Equal(t, 4, s.Validators.Size())
+	assert.Equal(t, 0, len(s.PendingValidators))
+
+	// a later change replaces the pending one, leaving before joining cancels the join
+	commit(20, &tmtypes.ValNode{PubKey: pubs[0], Power: 10})
+	commit(21, &tmtypes.ValNode{PubKey: pubs[0], Power: 0})
+	assert.Equal(t, 0, len(s.PendingValidators))
+}
+
+func TestValidatorUpdatesBeforeFork(t *testing.T) {
+	base := validatorForkHeight()
+	if base == 0 {
+		t.Skip("validator forks are enabled from genesis")
+	}
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+	validatorUpdateDelay, unbondingDelay = 2, 5
+	defer func() { validatorUpdateDelay, unbondingDelay = 0, 0 }()
+
+	var vals []*ttypes.Validator
+	for i := 0; i < 4; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+	}
+	priv, err := cr.GenKey()
+	assert.Nil(t, err)
+	s := State{Validators: ttypes.NewValidatorSet(vals), LastValidators: ttypes.NewValidatorSet(vals)}
+
+	// the update takes effect at the next height without any delay
+	assert.Nil(t, updateStateValidators(&s, base-10, []*tmtypes.ValNode{{PubKey: priv.PubKey().Bytes(), Power: 5}}))
+	assert.Equal(t, 5, s.Validators.Size())
+	assert.Equal(t, base-9, s.LastHeightValidatorsChanged)
+	assert.Equal(t, 0, len(s.PendingValidators))
+}
+
+func TestJailValidators(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+	NewEvidenceStore(nil)
+
+	var pubs [][]byte
+	var vals []*ttypes.Validator
+	for i := 0; i < 4; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes())
+		vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+	}
+	base := validatorForkHeight()
+	s := State{Validators: ttypes.NewValidatorSet(vals), LastValidators: ttypes.NewValidatorSet(vals), LastBlockHeight: base + 9}
+	address := ttypes.GenAddressByPubKey(mustPubKey(t, pubs[1]))
+	evidence := &ttypes.DuplicateVoteEvidence{DuplicateVoteEvidence: &tmtypes.DuplicateVoteEvidence{
+		PubKey: hex.EncodeToString(pubs[1]),
+		VoteA:  &tmtypes.Vote{Height: base + 8, ValidatorAddress: address, BlockID: &tmtypes.BlockID{Hash: []byte("a")}},
+		VoteB:  &tmtypes.Vote{Height: base + 8, ValidatorAddress: address, BlockID: &tmtypes.BlockID{Hash: []byte("b")}},
+	}}
+	block := ttypes.MakeBlock(base+10, 0, nil, &tmtypes.TendermintCommit{})
+	block.AddEvidence([]ttypes.Evidence{evidence})
+
+	// the offender is jailed and removed at the next height, whatever the unbonding delay
+	unbondingDelay = 5
+	defer func() { unbondingDelay = 0 }()
+	s, err = updateState(s, ttypes.BlockID{}, block)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(s.JailedValidators))
+	assert.Equal(t, address, s.JailedValidators[0].Address)
+	assert.Equal(t, int64(10), s.JailedValidators[0].Power)
+	assert.Equal(t, base+8, s.JailedValidators[0].EvidenceHeight)
+	assert.Nil(t, updateStateValidators(&s, base+10, nil))
+	assert.False(t, s.Validators.HasAddress(address))
+	assert.Equal(t, 3, s.Validators.Size())
+	assert.Equal(t, 0, len(s.PendingValidators))
+
+	// the same evidence committed again does not jail twice, a jailed validator can't come back
+	s = LoadState(SaveState(s))
+	assert.True(t, s.isJailed(address))
+	jailValidators(&s, block)
+	assert.Equal(t, 1, len(s.JailedValidators))
+	s.LastValidators = s.Validators.Copy()
+	assert.Nil(t, updateStateValidators(&s, base+11, []*tmtypes.ValNode{{PubKey: pubs[1], Power: 10}}))
+	assert.Equal(t, 0, len(s.PendingValidators))
+	assert.False(t, s.Validators.HasAddress(address))
+}
+
+func TestJailValidatorAboveOneThird(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+	NewEvidenceStore(nil)
+
+	var pubs [][]byte
+	var vals []*ttypes.Validator
+	for i := 0; i < 5; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes())
+		if i < 4 {
+			vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+		}
+	}
+	vals[0].VotingPower = 30
+	base := validatorForkHeight()
+	s := State{Validators: ttypes.NewValidatorSet(vals), LastValidators: ttypes.NewValidatorSet(vals), LastBlockHeight: base + 9}
+	address := ttypes.GenAddressByPubKey(mustPubKey(t, pubs[0]))
+	block := ttypes.MakeBlock(base+10, 0, nil, &tmtypes.TendermintCommit{})
+	block.AddEvidence([]ttypes.Evidence{&ttypes.DuplicateVoteEvidence{DuplicateVoteEvidence: &tmtypes.DuplicateVoteEvidence{
+		PubKey: hex.EncodeToString(pubs[0]),
+		VoteA:  &tmtypes.Vote{Height: base + 8, ValidatorAddress: address, BlockID: &tmtypes.BlockID{Hash: []byte("a")}},
+		VoteB:  &tmtypes.Vote{Height: base + 8, ValidatorAddress: address, BlockID: &tmtypes.BlockID{Hash: []byte("b")}},
+	}}})
+	s, err = updateState(s, ttypes.BlockID{}, block)
+	assert.Nil(t, err)
+
+	// the offender holds half of the power and still leaves at the next height,
+	// a join due at the same height is limited by the power left
+	s.PendingValidators = append(s.PendingValidators, &tmtypes.PendingValidator{PubKey: pubs[4], Power: 10, EffectiveHeight: base + 11})
+	assert.Nil(t, updateStateValidators(&s, base+10, nil))
+	assert.False(t, s.Validators.HasAddress(address))
+	assert.Equal(t, int64(39), s.Validators.TotalVotingPower())
+	assert.Equal(t, 1, len(s.PendingValidators))
+}
+
+func TestLimitValidatorChanges(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+
+	var pubs [][]byte
+	var vals []*ttypes.Validator
+	for i := 0; i < 5; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes())
+		if i < 4 {
+			vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+		}
+	}
+	s := State{Validators: ttypes.NewValidatorSet(vals), LastValidators: ttypes.NewValidatorSet(vals)}
+	base := validatorForkHeight()
+	commit := func(height int64, updates ...*tmtypes.ValNode) {
+		s.LastValidators = s.Validators.Copy()
+		assert.Nil(t, updateStateValidators(&s, base+height, updates))
+	}
+	powerOf := func(pub []byte) int64 {
+		_, val := s.Validators.GetByAddress(ttypes.GenAddressByPubKey(mustPubKey(t, pub)))
+		if val == nil {
+			return 0
+		}
+		return val.VotingPower
+	}
+
+	// joining with 3/7 of the power takes effect in steps of less than 1/3 of the total
+	commit(10, &tmtypes.ValNode{PubKey: pubs[4], Power: 30})
+	assert.Equal(t, int64(12), powerOf(pubs[4]))
+	assert.Equal(t, 1, len(s.PendingValidators))
+	commit(11)
+	assert.Equal(t, int64(28), powerOf(pubs[4]))
+	commit(12)
+	assert.Equal(t, int64(30), powerOf(pubs[4]))
+	assert.Equal(t, 0, len(s.PendingValidators))
+
+	// removing 3 validators at once is spread over several heights
+	var total int64 = 70
+	commit(20, &tmtypes.ValNode{PubKey: pubs[0], Power: 0}, &tmtypes.ValNode{PubKey: pubs[1], Power: 0}, &tmtypes.ValNode{PubKey: pubs[2], Power: 0})
+	for h := int64(21); len(s.PendingValidators) > 0; h++ {
+		assert.True(t, total-s.Validators.TotalVotingPower() < total/3)
+		total = s.Validators.TotalVotingPower()
+		commit(h)
+	}
+	assert.Equal(t, 2, s.Validators.Size())
+	assert.Equal(t, int64(40), s.Validators.TotalVotingPower())
+}
+
+// validatorForkHeight the height the scheduled validator changes, jailing and steps are enabled at
+func validatorForkHeight() int64 {
+	height := types.GetDappFork(tmtypes.ValNodeX, tmtypes.ForkValidatorDelay)
+	for _, fork := range []string{tmtypes.ForkValidatorJail, tmtypes.ForkValidatorStep} {
+		if h := types.GetDappFork(tmtypes.ValNodeX, fork); h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+func TestLimitSmallValidatorSet(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	ttypes.ConsensusCrypto = cr
+
+	var pubs [][]byte
+	for i := 0; i < 4; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes())
+	}
+	s := State{Validators: ttypes.NewValidatorSet([]*ttypes.Validator{ttypes.NewValidator(mustPubKey(t, pubs[0]), 1)})}
+	base := validatorForkHeight()
+	commit := func(height int64, updates ...*tmtypes.ValNode) {
+		s.LastValidators = s.Validators.Copy()
+		assert.Nil(t, updateStateValidators(&s, base+height, updates))
+	}
+
+	// a single validator of power 1 can't change by less than 1/3, the set grows by 1 per height
+	commit(10, &tmtypes.ValNode{PubKey: pubs[1], Power: 2}, &tmtypes.ValNode{PubKey: pubs[2], Power: 1})
+	for h := int64(11); len(s.PendingValidators) > 0; h++ {
+		assert.True(t, h < 20)
+		commit(h)
+	}
+	assert.Equal(t, 3, s.Validators.Size())
+	assert.Equal(t, int64(4), s.Validators.TotalVotingPower())
+
+	// and shrinks by 1 per height
+	commit(30, &tmtypes.ValNode{PubKey: pubs[1], Power: 0}, &tmtypes.ValNode{PubKey: pubs[2], Power: 0})
+	for h := int64(31); len(s.PendingValidators) > 0; h++ {
+		assert.True(t, h < 40)
+		total := s.Validators.TotalVotingPower()
+		commit(h)
+		assert.True(t, total-s.Validators.TotalVotingPower() <= minValidatorStep)
+	}
+	assert.Equal(t, 1, s.Validators.Size())
+	assert.Equal(t, int64(1), s.Validators.TotalVotingPower())
+	assert.Equal(t, int64(1), maxValidatorChange(1))
+	assert.Equal(t, int64(1), maxValidatorChange(5))
+	assert.Equal(t, int64(9), maxValidatorChange(30))
+}
+
+func mustPubKey(t *testing.T, pub []byte) crypto.PubKey {
+	key, err := ttypes.ConsensusCrypto.PubKeyFromBytes(pub)
+	assert.Nil(t, err)
+	return key
+}
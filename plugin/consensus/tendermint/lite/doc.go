@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package lite verifies tendermint block headers without replaying blocks.
+
+A light block is a header together with the commit that proves it and the
+validator set that signed it. A light block is valid by itself when the
+validator set hashes to the header's ValidatorsHash and more than 2/3 of its
+voting power precommitted the header hash.
+
+Starting from a trusted light block, a later header is trusted when the
+validator set didn't change in between, or when the client is handed the
+light block of every height where the validator set changed. At each such
+transition more than 2/3 of the previously trusted set must have signed for
+the new header as well. The consensus applies validator changes in steps that
+change strictly less than 1/3 of the total voting power per height: a larger
+change stays pending and takes effect over several heights. So an honest
+chain always provides such a path. A set whose total power is below 6 changes
+by 1 per height, which can be 1/3 of its power or more, so the client may not
+be able to follow it. The other exception is a jailed validator: it
+leaves at once whatever its power, and when it held a large share of the power
+the client may have to be given a new trusted light block after that height.
+*/
+package lite
@@ -0,0 +1,160 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lite
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+
+	_ "github.com/33cn/chain33/system/crypto/ed25519" // register ed25519
+)
+
+// error define
+var (
+	ErrInvalidLightBlock     = errors.New("Invalid light block")
+	ErrChainIDMismatch       = errors.New("Chain id mismatch")
+	ErrHeightNotIncreasing   = errors.New("Height not increasing")
+	ErrValidatorsHash        = errors.New("Validators do not hash to the header")
+	ErrCommitBlockIDMismatch = errors.New("Commit is not for the header")
+)
+
+var cryptoOnce sync.Once
+
+// initCrypto make sure the consensus crypto is available even when the node doesn't run tendermint
+func initCrypto() {
+	cryptoOnce.Do(func() {
+		if ttypes.ConsensusCrypto != nil {
+			return
+		}
+		cr, err := crypto.New(types.GetSignName("", types.ED25519))
+		if err != nil {
+			panic(fmt.Sprintf("lite init consensus crypto failed:%v", err))
+		}
+		ttypes.ConsensusCrypto = cr
+	})
+}
+
+// keep the validators in the order they signed the commit
+func validatorSet(set *tmtypes.ValidatorSet) *ttypes.ValidatorSet {
+	vals := make([]*ttypes.Validator, 0, len(set.GetValidators()))
+	for _, v := range set.GetValidators() {
+		vals = append(vals, &ttypes.Validator{
+			Address:     v.Address,
+			PubKey:      v.PubKey,
+			VotingPower: v.VotingPower,
+			Accum:       v.Accum,
+		})
+	}
+	return &ttypes.ValidatorSet{Validators: vals}
+}
+
+func headerBlockID(lb *tmtypes.LightBlock) (ttypes.BlockID, error) {
+	header := &ttypes.Header{TendermintBlockHeader: lb.Header}
+	hash := header.Hash()
+	if len(hash) == 0 || !bytes.Equal(hash, lb.Commit.GetBlockID().GetHash()) {
+		return ttypes.BlockID{}, ErrCommitBlockIDMismatch
+	}
+	return ttypes.BlockID{BlockID: tmtypes.BlockID{Hash: hash}}, nil
+}
+
+// Verify checks the light block by itself: its validators hash to the header
+// and more than 2/3 of their voting power signed the commit for the header.
+func Verify(lb *tmtypes.LightBlock) error {
+	initCrypto()
+	if lb == nil || lb.Header == nil || lb.Commit == nil || len(lb.GetValidators().GetValidators()) == 0 {
+		return ErrInvalidLightBlock
+	}
+	valSet := validatorSet(lb.Validators)
+	if !bytes.Equal(valSet.Hash(), lb.Header.ValidatorsHash) {
+		return ErrValidatorsHash
+	}
+	blockID, err := headerBlockID(lb)
+	if err != nil {
+		return err
+	}
+	commit := &ttypes.Commit{TendermintCommit: lb.Commit}
+	if commit.FirstPrecommit() == nil {
+		return ErrInvalidLightBlock
+	}
+	return valSet.VerifyCommit(lb.Header.ChainID, blockID, lb.Header.Height, commit)
+}
+
+// VerifyNext checks the light block next against the already trusted one.
+// When the validator set changed, more than 2/3 of the trusted validators must have signed next as well.
+func VerifyNext(trusted, next *tmtypes.LightBlock) error {
+	if err := Verify(next); err != nil {
+		return err
+	}
+	if next.Header.ChainID != trusted.Header.ChainID {
+		return ErrChainIDMismatch
+	}
+	if next.Header.Height <= trusted.Header.Height {
+		return ErrHeightNotIncreasing
+	}
+	if bytes.Equal(next.Header.ValidatorsHash, trusted.Header.ValidatorsHash) {
+		return nil
+	}
+	blockID, err := headerBlockID(next)
+	if err != nil {
+		return err
+	}
+	return validatorSet(trusted.Validators).VerifyCommitAny(validatorSet(next.Validators), next.Header.ChainID,
+		blockID, next.Header.Height, &ttypes.Commit{TendermintCommit: next.Commit})
+}
+
+// VerifyHeader checks the target light block from the trusted one, following the light blocks
+// of the heights where the validator set changed in between, in ascending height order.
+func VerifyHeader(trusted *tmtypes.LightBlock, transitions []*tmtypes.LightBlock, target *tmtypes.LightBlock) error {
+	if err := Verify(trusted); err != nil {
+		return err
+	}
+	last := trusted
+	for _, lb := range transitions {
+		if err := VerifyNext(last, lb); err != nil {
+			return fmt.Errorf("transition at height %d: %v", lb.GetHeader().GetHeight(), err)
+		}
+		last = lb
+	}
+	return VerifyNext(last, target)
+}
+
+// Verifier keeps the latest verified light block and moves it forward
+type Verifier struct {
+	mtx     sync.Mutex
+	trusted *tmtypes.LightBlock
+}
+
+// NewVerifier returns a verifier trusting the given light block
+func NewVerifier(trusted *tmtypes.LightBlock) (*Verifier, error) {
+	if err := Verify(trusted); err != nil {
+		return nil, err
+	}
+	return &Verifier{trusted: trusted}, nil
+}
+
+// Trusted returns the latest verified light block
+func (v *Verifier) Trusted() *tmtypes.LightBlock {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	return v.trusted
+}
+
+// Update verifies the target and trusts it from now on
+func (v *Verifier) Update(transitions []*tmtypes.LightBlock, target *tmtypes.LightBlock) error {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if err := VerifyHeader(v.trusted, transitions, target); err != nil {
+		return err
+	}
+	v.trusted = target
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lite
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const chainID = "test-chain"
+
+type signer struct {
+	privs []crypto.PrivKey
+}
+
+func newSigner(t *testing.T, n int) *signer {
+	initCrypto()
+	s := &signer{}
+	for i := 0; i < n; i++ {
+		priv, err := ttypes.ConsensusCrypto.GenKey()
+		assert.Nil(t, err)
+		s.privs = append(s.privs, priv)
+	}
+	return s
+}
+
+// lightBlock makes a light block at height signed by the validators with the given indexes
+func (s *signer) lightBlock(height int64, validators ...int) *tmtypes.LightBlock {
+	var vals []*ttypes.Validator
+	for _, i := range validators {
+		vals = append(vals, ttypes.NewValidator(s.privs[i].PubKey(), 10))
+	}
+	valSet := ttypes.NewValidatorSet(vals)
+	header := &tmtypes.TendermintBlockHeader{ChainID: chainID, Height: height, ValidatorsHash: valSet.Hash()}
+	hash := (&ttypes.Header{TendermintBlockHeader: header}).Hash()
+
+	set := &tmtypes.ValidatorSet{}
+	commit := &tmtypes.TendermintCommit{BlockID: &tmtypes.BlockID{Hash: hash}}
+	for i, val := range valSet.Validators {
+		set.Validators = append(set.Validators, &tmtypes.Validator{Address: val.Address, PubKey: val.PubKey, VotingPower: val.VotingPower})
+		vote := &tmtypes.Vote{
+			ValidatorAddress: val.Address,
+			ValidatorIndex:   int32(i),
+			Height:           height,
+			Type:             uint32(ttypes.VoteTypePrecommit),
+			BlockID:          &tmtypes.BlockID{Hash: hash},
+		}
+		for _, priv := range s.privs {
+			if bytes.Equal(priv.PubKey().Bytes(), val.PubKey) {
+				vote.Signature = priv.Sign(ttypes.SignBytes(chainID, &ttypes.Vote{Vote: vote})).Bytes()
+			}
+		}
+		commit.Precommits = append(commit.Precommits, vote)
+	}
+	return &tmtypes.LightBlock{Header: header, Commit: commit, Validators: set}
+}
+
+func TestVerify(t *testing.T) {
+	s := newSigner(t, 4)
+	lb := s.lightBlock(3, 0, 1, 2, 3)
+	assert.Nil(t, Verify(lb))
+
+	// a header not signed by the commit
+	lb.Header.AppHash = []byte("app")
+	assert.Equal(t, ErrCommitBlockIDMismatch, Verify(lb))
+
+	// validators not matching the header
+	lb = s.lightBlock(3, 0, 1, 2, 3)
+	lb.Validators.Validators[0].VotingPower = 100
+	assert.Equal(t, ErrValidatorsHash, Verify(lb))
+
+	// not enough signatures
+	lb = s.lightBlock(3, 0, 1, 2, 3)
+	lb.Commit.Precommits[0].Signature = nil
+	lb.Commit.Precommits[1].Signature = nil
+	assert.NotNil(t, Verify(lb))
+	for _, vote := range lb.Commit.Precommits {
+		vote.Signature = nil
+	}
+	assert.Equal(t, ErrInvalidLightBlock, Verify(lb))
+	assert.Equal(t, ErrInvalidLightBlock, Verify(nil))
+}
+
+func TestVerifyHeader(t *testing.T) {
+	s := newSigner(t, 8)
+	trusted := s.lightBlock(1, 0, 1, 2, 3)
+
+	// unchanged validator set
+	assert.Nil(t, VerifyHeader(trusted, nil, s.lightBlock(100, 0, 1, 2, 3)))
+	assert.Equal(t, ErrHeightNotIncreasing, VerifyHeader(trusted, nil, s.lightBlock(1, 0, 1, 2, 3)))
+
+	// validators replaced one at a time, the target can't be reached without the transitions
+	transitions := []*tmtypes.LightBlock{
+		s.lightBlock(5, 0, 1, 2, 3, 4),
+		s.lightBlock(8, 1, 2, 3, 4),
+		s.lightBlock(11, 1, 2, 3, 4, 5),
+		s.lightBlock(14, 2, 3, 4, 5),
+		s.lightBlock(17, 2, 3, 4, 5, 6),
+		s.lightBlock(20, 3, 4, 5, 6),
+	}
+	target := s.lightBlock(30, 3, 4, 5, 6)
+	assert.Nil(t, VerifyHeader(trusted, transitions, target))
+	assert.NotNil(t, VerifyHeader(trusted, nil, target))
+	assert.NotNil(t, VerifyHeader(trusted, transitions[2:], target))
+
+	// a validator set taken over all at once
+	assert.NotNil(t, VerifyHeader(trusted, nil, s.lightBlock(30, 4, 5, 6, 7)))
+
+	v, err := NewVerifier(trusted)
+	assert.Nil(t, err)
+	assert.Nil(t, v.Update(transitions, target))
+	assert.Equal(t, target, v.Trusted())
+	assert.Nil(t, v.Update(nil, s.lightBlock(31, 3, 4, 5, 6)))
+	assert.Equal(t, int64(31), v.Trusted().Header.Height)
+}
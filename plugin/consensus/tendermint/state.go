@@ -64,6 +64,12 @@ type State struct {
 
 	// The latest AppHash we've received from calling abci.Commit()
 	AppHash []byte
+
+	// Validator changes waiting for their effective height
+	PendingValidators []*tmtypes.PendingValidator
+
+	// Validators jailed by committed evidence
+	JailedValidators []*tmtypes.JailedValidator
 }
 
 // Copy makes a copy of the State for mutating.
@@ -86,7 +92,20 @@ func (s State) Copy() State {
 		AppHash: s.AppHash,
 
 		LastResultsHash: s.LastResultsHash,
+
+		PendingValidators: append([]*tmtypes.PendingValidator(nil), s.PendingValidators...),
+		JailedValidators:  append([]*tmtypes.JailedValidator(nil), s.JailedValidators...),
+	}
+}
+
+// isJailed returns true if the validator with the address has been jailed.
+func (s State) isJailed(address []byte) bool {
+	for _, j := range s.JailedValidators {
+		if bytes.Equal(j.Address, address) {
+			return true
+		}
 	}
+	return false
 }
 
 // Equals returns true if the States are identical.
@@ -234,6 +253,8 @@ func LoadState(state *tmtypes.State) State {
 		LastHeightConsensusParamsChanged: state.LastHeightConsensusParamsChanged,
 		LastResultsHash:                  state.LastResultsHash,
 		AppHash:                          state.AppHash,
+		PendingValidators:                state.GetPendingValidators(),
+		JailedValidators:                 state.GetJailedValidators(),
 	}
 	if validators := state.GetValidators(); validators != nil {
 		if array := validators.GetValidators(); array != nil {
@@ -382,6 +403,8 @@ func SaveState(state State) *tmtypes.State {
 		LastHeightConsensusParamsChanged: state.LastHeightConsensusParamsChanged,
 		LastResultsHash:                  state.LastResultsHash,
 		AppHash:                          state.AppHash,
+		PendingValidators:                state.PendingValidators,
+		JailedValidators:                 state.JailedValidators,
 	}
 	if state.Validators != nil {
 		newState.Validators.Validators = saveValidators(newState.Validators.Validators, state.Validators.Validators)
@@ -43,6 +43,8 @@ var (
 	peerGossipSleepDuration     int32 = 100
 	peerQueryMaj23SleepDuration int32 = 2000
 	zeroHash                    [32]byte
+	validatorUpdateDelay        int64 // block
+	unbondingDelay              int64 // block
 )
 
 func init() {
@@ -81,6 +83,8 @@ type subConfig struct {
 	CreateEmptyBlocks         bool     `json:"createEmptyBlocks"`
 	CreateEmptyBlocksInterval int32    `json:"createEmptyBlocksInterval"`
 	ValidatorNodes            []string `json:"validatorNodes"`
+	ValidatorUpdateDelay      int64    `json:"validatorUpdateDelay"`
+	UnbondingDelay            int64    `json:"unbondingDelay"`
 }
 
 func (client *Client) applyConfig(sub []byte) {
@@ -126,6 +130,12 @@ func (client *Client) applyConfig(sub []byte) {
 	if len(subcfg.ValidatorNodes) > 0 {
 		validatorNodes = subcfg.ValidatorNodes
 	}
+	if subcfg.ValidatorUpdateDelay > 0 {
+		validatorUpdateDelay = subcfg.ValidatorUpdateDelay
+	}
+	if subcfg.UnbondingDelay > 0 {
+		unbondingDelay = subcfg.UnbondingDelay
+	}
 }
 
 // DefaultDBProvider returns a database using the DBBackend and DBDir
@@ -283,20 +293,15 @@ OuterLoop:
 	}
 
 	tendermintlog.Debug("Load state finish", "state", state)
+	var updates []*tmtypes.ValNode
 	valNodes, err := client.QueryValidatorsByHeight(curHeight)
 	if err == nil && valNodes != nil {
-		if len(valNodes.Nodes) > 0 {
-			tendermintlog.Info("StartConsensus validators update", "update-valnodes", valNodes)
-			prevValSet := state.LastValidators.Copy()
-			nextValSet := prevValSet.Copy()
-			err := updateValidators(nextValSet, valNodes.Nodes)
-			if err != nil {
-				tendermintlog.Error("Error changing validator set", "error", err)
-			}
-			// change results from this height but only applies to the next height
-			state.LastHeightValidatorsChanged = curHeight + 1
-			nextValSet.IncrementAccum(1)
-			state.Validators = nextValSet
+		tendermintlog.Info("StartConsensus validators update", "update-valnodes", valNodes)
+		updates = valNodes.Nodes
+	}
+	if curHeight > 0 {
+		if err := updateStateValidators(&state, curHeight, updates); err != nil {
+			tendermintlog.Error("Error changing validator set", "error", err)
 		}
 	}
 	tendermintlog.Info("StartConsensus", "validators", state.Validators)
@@ -586,6 +591,24 @@ func (client *Client) Query_IsHealthy(req *types.ReqNil) (types.Message, error)
 	return &tmtypes.IsHealthy{IsHealthy: isHealthy}, nil
 }
 
+// Query_PendingValidators query the validator changes waiting for their effective height
+func (client *Client) Query_PendingValidators(req *types.ReqNil) (types.Message, error) {
+	if client == nil || client.csState == nil {
+		return nil, fmt.Errorf("%s", "client not bind message queue.")
+	}
+	pending := client.csState.GetState().PendingValidators
+	return &tmtypes.PendingValidators{Pending: pending}, nil
+}
+
+// Query_JailedValidators query the validators jailed by committed evidence
+func (client *Client) Query_JailedValidators(req *types.ReqNil) (types.Message, error) {
+	if client == nil || client.csState == nil {
+		return nil, fmt.Errorf("%s", "client not bind message queue.")
+	}
+	jailed := client.csState.GetState().JailedValidators
+	return &tmtypes.JailedValidators{Jailed: jailed}, nil
+}
+
 // Query_NodeInfo query validator node info
 func (client *Client) Query_NodeInfo(req *types.ReqNil) (types.Message, error) {
 	if client == nil {
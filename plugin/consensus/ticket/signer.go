@@ -0,0 +1,383 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ticket
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/types"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+var (
+	// ErrSignerNoKey 签名进程中没有挖矿地址的私钥
+	ErrSignerNoKey = errors.New("ErrSignerNoKey")
+	// ErrSignerRefused 签名进程拒绝签名不是挖矿的交易
+	ErrSignerRefused = errors.New("ErrSignerRefused")
+	// ErrSignerAddr 远程签名地址格式错误, 支持unix://path和tcp://host:port
+	ErrSignerAddr = errors.New("ErrSignerAddr")
+	// ErrSignerTimeout 远程签名超时
+	ErrSignerTimeout = errors.New("ErrSignerTimeout")
+	// ErrSignerTLS tcp方式的远程签名必须配置双向认证的tls证书
+	ErrSignerTLS = errors.New("ErrSignerTLS")
+)
+
+const (
+	signerService     = "TicketSigner"
+	signerDialTimeout = 5 * time.Second
+	signerCallTimeout = 10 * time.Second
+)
+
+// Signer 挖矿中需要私钥的操作, 私钥可以在本地钱包中, 也可以在远程的签名进程中
+type Signer interface {
+	// MinerAddrs 可以签名的挖矿地址
+	MinerAddrs() ([]string, error)
+	// PrivHashes 计算挖矿地址下每个ticket的privHash
+	PrivHashes(addr string, ticketIDs []string) ([][]byte, error)
+	// VrfEvaluate 用挖矿地址的私钥计算vrf hash和proof
+	VrfEvaluate(addr string, input []byte) ([]byte, []byte, error)
+	// SignMinerTx 用挖矿地址的私钥签名, 只签名ticket的miner交易
+	SignMinerTx(addr string, tx *types.Transaction) (*types.Transaction, error)
+}
+
+type keySigner struct {
+	privs map[string]crypto.PrivKey
+}
+
+// NewKeySigner 使用私钥在本地签名
+func NewKeySigner(privs []crypto.PrivKey) Signer {
+	s := &keySigner{privs: make(map[string]crypto.PrivKey)}
+	for _, priv := range privs {
+		addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+		s.privs[addr] = priv
+	}
+	return s
+}
+
+func (s *keySigner) getPriv(addr string) (crypto.PrivKey, error) {
+	priv, ok := s.privs[addr]
+	if !ok {
+		return nil, ErrSignerNoKey
+	}
+	return priv, nil
+}
+
+func (s *keySigner) MinerAddrs() ([]string, error) {
+	addrs := make([]string, 0, len(s.privs))
+	for addr := range s.privs {
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func (s *keySigner) PrivHashes(addr string, ticketIDs []string) ([][]byte, error) {
+	priv, err := s.getPriv(addr)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([][]byte, len(ticketIDs))
+	for i, tid := range ticketIDs {
+		hashes[i], err = genPrivHash(priv, tid)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+func (s *keySigner) VrfEvaluate(addr string, input []byte) ([]byte, []byte, error) {
+	priv, err := s.getPriv(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), priv.Bytes())
+	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
+	vrfHash, vrfProof := vrfPriv.Evaluate(input)
+	return vrfHash[:], vrfProof, nil
+}
+
+func (s *keySigner) SignMinerTx(addr string, tx *types.Transaction) (*types.Transaction, error) {
+	priv, err := s.getPriv(addr)
+	if err != nil {
+		return nil, err
+	}
+	// 签名进程可能和挖矿节点不在同一个信任域, 只签名本地址ticket的挖矿交易
+	if string(tx.Execer) != ty.TicketX {
+		return nil, ErrSignerRefused
+	}
+	var action ty.TicketAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return nil, ErrSignerRefused
+	}
+	miner := action.GetMiner()
+	if action.Ty != ty.TicketActionMiner || miner == nil {
+		return nil, ErrSignerRefused
+	}
+	privHash, err := genPrivHash(priv, miner.TicketId)
+	if err != nil || !bytes.Equal(privHash, miner.PrivHash) {
+		return nil, ErrSignerRefused
+	}
+	tx.Sign(types.SECP256K1, priv)
+	return tx, nil
+}
+
+// SignerArgs 远程签名的请求参数
+type SignerArgs struct {
+	Addr      string
+	TicketIDs []string
+	Input     []byte
+	Tx        []byte
+}
+
+// SignerReply 远程签名的返回
+type SignerReply struct {
+	Addrs  []string
+	Hashes [][]byte
+	Hash   []byte
+	Proof  []byte
+	Tx     []byte
+}
+
+// SignerService 签名进程对外提供的rpc服务
+type SignerService struct {
+	signer Signer
+}
+
+// MinerAddrs 可以签名的挖矿地址
+func (s *SignerService) MinerAddrs(args *SignerArgs, reply *SignerReply) (err error) {
+	reply.Addrs, err = s.signer.MinerAddrs()
+	return err
+}
+
+// PrivHashes 计算ticket的privHash
+func (s *SignerService) PrivHashes(args *SignerArgs, reply *SignerReply) (err error) {
+	reply.Hashes, err = s.signer.PrivHashes(args.Addr, args.TicketIDs)
+	return err
+}
+
+// VrfEvaluate 计算vrf
+func (s *SignerService) VrfEvaluate(args *SignerArgs, reply *SignerReply) (err error) {
+	reply.Hash, reply.Proof, err = s.signer.VrfEvaluate(args.Addr, args.Input)
+	return err
+}
+
+// SignMinerTx 签名挖矿交易
+func (s *SignerService) SignMinerTx(args *SignerArgs, reply *SignerReply) error {
+	var tx types.Transaction
+	if err := types.Decode(args.Tx, &tx); err != nil {
+		return err
+	}
+	signed, err := s.signer.SignMinerTx(args.Addr, &tx)
+	if err != nil {
+		tlog.Error("SignerService SignMinerTx", "addr", args.Addr, "err", err)
+		return err
+	}
+	reply.Tx = types.Encode(signed)
+	return nil
+}
+
+// ServeSigner 在listener上提供签名服务, 直到listener关闭
+func ServeSigner(l net.Listener, signer Signer) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(signerService, &SignerService{signer: signer}); err != nil {
+		return err
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// ParseSignerAddr 解析签名进程的地址, 返回network和address
+func ParseSignerAddr(target string) (string, string, error) {
+	for _, network := range []string{"unix", "tcp"} {
+		prefix := network + "://"
+		if strings.HasPrefix(target, prefix) && len(target) > len(prefix) {
+			return network, target[len(prefix):], nil
+		}
+	}
+	return "", "", ErrSignerAddr
+}
+
+// NewSignerTLS 加载tcp方式远程签名的tls配置, 签名进程和挖矿节点用同一个CA签发的证书互相认证
+// server为true时是签名进程的配置, 要求并校验挖矿节点的证书
+func NewSignerTLS(certFile, keyFile, caFile string, server bool) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return nil, ErrSignerTLS
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, ErrSignerTLS
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if server {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = pool
+	} else {
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+// ListenSigner 签名进程监听的地址, tcp方式必须提供tls配置
+func ListenSigner(target string, config *tls.Config) (net.Listener, error) {
+	network, addr, err := ParseSignerAddr(target)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		return net.Listen(network, addr)
+	}
+	if config == nil {
+		return nil, ErrSignerTLS
+	}
+	return tls.Listen(network, addr, config)
+}
+
+type remoteSigner struct {
+	network string
+	addr    string
+	tls     *tls.Config
+	mu      sync.Mutex
+	client  *rpc.Client
+}
+
+// NewRemoteSigner 连接远程的签名进程, 连接断开后下一次调用时重连
+// unix socket只能本机连接, tcp方式必须提供tls配置和签名进程双向认证
+func NewRemoteSigner(target string, config *tls.Config) (Signer, error) {
+	network, addr, err := ParseSignerAddr(target)
+	if err != nil {
+		return nil, err
+	}
+	s := &remoteSigner{network: network, addr: addr}
+	if network == "tcp" {
+		if config == nil {
+			return nil, ErrSignerTLS
+		}
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, ErrSignerAddr
+		}
+		s.tls = config.Clone()
+		if s.tls.ServerName == "" {
+			s.tls.ServerName = host
+		}
+	}
+	return s, nil
+}
+
+func (s *remoteSigner) dial() (net.Conn, error) {
+	if s.tls != nil {
+		return tls.DialWithDialer(&net.Dialer{Timeout: signerDialTimeout}, s.network, s.addr, s.tls)
+	}
+	return net.DialTimeout(s.network, s.addr, signerDialTimeout)
+}
+
+func (s *remoteSigner) call(method string, args *SignerArgs) (*SignerReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client == nil {
+		conn, err := s.dial()
+		if err != nil {
+			tlog.Error("remoteSigner dial", "addr", s.addr, "err", err)
+			return nil, err
+		}
+		s.client = jsonrpc.NewClient(conn)
+	}
+	reply := &SignerReply{}
+	call := s.client.Go(signerService+"."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+	case <-time.After(signerCallTimeout):
+		s.client.Close()
+		s.client = nil
+		return nil, ErrSignerTimeout
+	}
+	if call.Error == nil {
+		return reply, nil
+	}
+	if serr, ok := call.Error.(rpc.ServerError); ok {
+		for _, known := range []error{ErrSignerNoKey, ErrSignerRefused} {
+			if string(serr) == known.Error() {
+				return nil, known
+			}
+		}
+		return nil, call.Error
+	}
+	// 连接错误, 下次调用重新连接
+	s.client.Close()
+	s.client = nil
+	return nil, call.Error
+}
+
+func (s *remoteSigner) MinerAddrs() ([]string, error) {
+	reply, err := s.call("MinerAddrs", &SignerArgs{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.Addrs, nil
+}
+
+func (s *remoteSigner) PrivHashes(addr string, ticketIDs []string) ([][]byte, error) {
+	reply, err := s.call("PrivHashes", &SignerArgs{Addr: addr, TicketIDs: ticketIDs})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Hashes) != len(ticketIDs) {
+		return nil, types.ErrInvalidParam
+	}
+	return reply.Hashes, nil
+}
+
+func (s *remoteSigner) VrfEvaluate(addr string, input []byte) ([]byte, []byte, error) {
+	reply, err := s.call("VrfEvaluate", &SignerArgs{Addr: addr, Input: input})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reply.Hash, reply.Proof, nil
+}
+
+func (s *remoteSigner) SignMinerTx(addr string, tx *types.Transaction) (*types.Transaction, error) {
+	reply, err := s.call("SignMinerTx", &SignerArgs{Addr: addr, Tx: types.Encode(tx)})
+	if err != nil {
+		return nil, err
+	}
+	var signed types.Transaction
+	if err := types.Decode(reply.Tx, &signed); err != nil {
+		return nil, err
+	}
+	// 签名进程只能修改签名, 并且必须是挖矿地址的签名
+	if !bytes.Equal(signed.Payload, tx.Payload) || string(signed.Execer) != string(tx.Execer) ||
+		!signed.CheckSign() || signed.From() != addr {
+		return nil, types.ErrSign
+	}
+	return &signed, nil
+}
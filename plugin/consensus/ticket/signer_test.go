@@ -0,0 +1,211 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ticket
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTicketID = "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv:0x1234:1"
+
+func newMinerTx(t *testing.T, tid string, privHash []byte) *types.Transaction {
+	action := &ty.TicketAction{
+		Ty:    ty.TicketActionMiner,
+		Value: &ty.TicketAction_Miner{Miner: &ty.TicketMiner{TicketId: tid, PrivHash: privHash}},
+	}
+	tx, err := types.CreateFormatTx("ticket", types.Encode(action))
+	require.Nil(t, err)
+	return tx
+}
+
+func testSigner(t *testing.T, signer Signer, priv crypto.PrivKey) {
+	addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+	addrs, err := signer.MinerAddrs()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{addr}, addrs)
+
+	hashes, err := signer.PrivHashes(addr, []string{testTicketID, "1111"})
+	assert.Nil(t, err)
+	privHash, _ := genPrivHash(priv, testTicketID)
+	assert.Equal(t, privHash, hashes[0])
+	assert.Equal(t, 0, len(hashes[1]))
+	_, err = signer.PrivHashes("1PUiGcbsccfxW3zuvHXZBJfznziph5miAo", []string{testTicketID})
+	assert.Equal(t, ErrSignerNoKey, err)
+
+	hash, proof, err := signer.VrfEvaluate(addr, []byte("input"))
+	assert.Nil(t, err)
+	assert.Nil(t, vrfVerify(priv.PubKey().Bytes(), []byte("input"), proof, hash))
+
+	tx, err := signer.SignMinerTx(addr, newMinerTx(t, testTicketID, privHash))
+	assert.Nil(t, err)
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, addr, tx.From())
+
+	//只签名本地址ticket的挖矿交易
+	_, err = signer.SignMinerTx(addr, newMinerTx(t, testTicketID, []byte("other")))
+	assert.Equal(t, ErrSignerRefused, err)
+	open, err := types.CreateFormatTx("ticket", types.Encode(&ty.TicketAction{Ty: ty.TicketActionOpen, Value: &ty.TicketAction_Topen{Topen: &ty.TicketOpen{}}}))
+	require.Nil(t, err)
+	_, err = signer.SignMinerTx(addr, open)
+	assert.Equal(t, ErrSignerRefused, err)
+	coins, err := types.CreateFormatTx("coins", nil)
+	require.Nil(t, err)
+	_, err = signer.SignMinerTx(addr, coins)
+	assert.Equal(t, ErrSignerRefused, err)
+}
+
+func newTestPriv(t *testing.T) crypto.PrivKey {
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	require.Nil(t, err)
+	priv, err := cr.GenKey()
+	require.Nil(t, err)
+	return priv
+}
+
+func TestKeySigner(t *testing.T) {
+	priv := newTestPriv(t)
+	testSigner(t, NewKeySigner([]crypto.PrivKey{priv}), priv)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "signer.sock")
+	priv := newTestPriv(t)
+	local := NewKeySigner([]crypto.PrivKey{priv})
+
+	l, err := net.Listen("unix", path)
+	require.Nil(t, err)
+	go ServeSigner(l, local)
+	remote, err := NewRemoteSigner("unix://"+path, nil)
+	require.Nil(t, err)
+	testSigner(t, remote, priv)
+
+	//签名进程重启之后重新连接
+	l.Close()
+	remote.(*remoteSigner).client.Close()
+	_, err = remote.MinerAddrs()
+	assert.NotNil(t, err)
+	l, err = net.Listen("unix", path)
+	require.Nil(t, err)
+	defer l.Close()
+	go ServeSigner(l, local)
+	addrs, err := remote.MinerAddrs()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(addrs))
+}
+
+// 生成CA和由CA签发的证书, 写入dir/name.pem和dir/name.key
+func genTestCert(t *testing.T, dir, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if ca == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		ca, caKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert, key
+}
+
+func TestRemoteSignerTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := func(name string) string { return filepath.Join(dir, name) }
+	ca, caKey := genTestCert(t, dir, "ca", nil, nil)
+	genTestCert(t, dir, "server", ca, caKey)
+	genTestCert(t, dir, "client", ca, caKey)
+	other, otherKey := genTestCert(t, dir, "other-ca", nil, nil)
+	genTestCert(t, dir, "other", other, otherKey)
+
+	//tcp方式必须配置证书
+	_, err = ListenSigner("tcp://127.0.0.1:0", nil)
+	assert.Equal(t, ErrSignerTLS, err)
+	_, err = NewRemoteSigner("tcp://127.0.0.1:8805", nil)
+	assert.Equal(t, ErrSignerTLS, err)
+	_, err = NewSignerTLS(file("client.pem"), file("client.key"), "", false)
+	assert.Equal(t, ErrSignerTLS, err)
+
+	serverTLS, err := NewSignerTLS(file("server.pem"), file("server.key"), file("ca.pem"), true)
+	require.Nil(t, err)
+	l, err := ListenSigner("tcp://127.0.0.1:0", serverTLS)
+	require.Nil(t, err)
+	defer l.Close()
+	priv := newTestPriv(t)
+	go ServeSigner(l, NewKeySigner([]crypto.PrivKey{priv}))
+	target := "tcp://" + l.Addr().String()
+
+	clientTLS, err := NewSignerTLS(file("client.pem"), file("client.key"), file("ca.pem"), false)
+	require.Nil(t, err)
+	remote, err := NewRemoteSigner(target, clientTLS)
+	require.Nil(t, err)
+	testSigner(t, remote, priv)
+
+	//不是同一个CA签发的证书不能连接
+	otherTLS, err := NewSignerTLS(file("other.pem"), file("other.key"), file("ca.pem"), false)
+	require.Nil(t, err)
+	remote, err = NewRemoteSigner(target, otherTLS)
+	require.Nil(t, err)
+	_, err = remote.MinerAddrs()
+	assert.NotNil(t, err)
+	otherTLS, err = NewSignerTLS(file("client.pem"), file("client.key"), file("other-ca.pem"), false)
+	require.Nil(t, err)
+	remote, err = NewRemoteSigner(target, otherTLS)
+	require.Nil(t, err)
+	_, err = remote.MinerAddrs()
+	assert.NotNil(t, err)
+}
+
+func TestParseSignerAddr(t *testing.T) {
+	network, addr, err := ParseSignerAddr("unix:///tmp/signer.sock")
+	assert.Nil(t, err)
+	assert.Equal(t, "unix", network)
+	assert.Equal(t, "/tmp/signer.sock", addr)
+	network, addr, err = ParseSignerAddr("tcp://127.0.0.1:8805")
+	assert.Nil(t, err)
+	assert.Equal(t, "tcp", network)
+	assert.Equal(t, "127.0.0.1:8805", addr)
+	for _, target := range []string{"", "unix://", "http://127.0.0.1:8805", "/tmp/signer.sock"} {
+		_, _, err = ParseSignerAddr(target)
+		assert.Equal(t, ErrSignerAddr, err)
+	}
+}
@@ -7,6 +7,7 @@ package ticket
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"crypto/tls"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -17,7 +18,6 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common"
-	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/difficulty"
 	"github.com/33cn/chain33/common/log/log15"
@@ -47,10 +47,15 @@ type Client struct {
 	*drivers.BaseClient
 	//ticket map for miner
 	ticketsMap map[string]*ty.Ticket
-	privmap    map[string]crypto.PrivKey
-	ticketmu   sync.Mutex
-	done       chan struct{}
-	subcfg     *subConfig
+	//ticket对应的privHash, 挖矿时不需要私钥
+	privHashes map[string][]byte
+	signer     Signer
+	//配置了远程签名时私钥只在签名进程中, 不从钱包读取
+	remote    Signer
+	lastFlush time.Time
+	ticketmu  sync.Mutex
+	done      chan struct{}
+	subcfg    *subConfig
 }
 
 type genesisTicket struct {
@@ -62,8 +67,17 @@ type genesisTicket struct {
 type subConfig struct {
 	GenesisBlockTime int64            `json:"genesisBlockTime"`
 	Genesis          []*genesisTicket `json:"genesis"`
+	// 远程签名进程的地址, unix:///path 或者 tcp://host:port
+	RemoteSigner string `json:"remoteSigner"`
+	// tcp方式远程签名的双向认证证书
+	RemoteSignerCert string `json:"remoteSignerCert"`
+	RemoteSignerKey  string `json:"remoteSignerKey"`
+	RemoteSignerCA   string `json:"remoteSignerCA"`
 }
 
+// 使用远程签名时重新读取ticket的间隔
+const remoteFlushInterval = time.Minute
+
 // New  ticket's init env
 func New(cfg *types.Consensus, sub []byte) queue.Module {
 	c := drivers.NewBaseClient(cfg)
@@ -77,15 +91,35 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 	t := &Client{
 		BaseClient: c,
 		ticketsMap: make(map[string]*ty.Ticket),
-		privmap:    nil,
+		privHashes: nil,
 		ticketmu:   sync.Mutex{},
 		done:       make(chan struct{}),
 		subcfg:     &subcfg}
+	if subcfg.RemoteSigner != "" {
+		t.remote = mustRemoteSigner(&subcfg)
+	}
 	c.SetChild(t)
 	go t.flushTicketBackend()
 	return t
 }
 
+// 连接配置的远程签名进程, 配置错误时panic
+func mustRemoteSigner(subcfg *subConfig) Signer {
+	var config *tls.Config
+	if subcfg.RemoteSignerCert != "" {
+		var err error
+		config, err = NewSignerTLS(subcfg.RemoteSignerCert, subcfg.RemoteSignerKey, subcfg.RemoteSignerCA, false)
+		if err != nil {
+			panic(err)
+		}
+	}
+	remote, err := NewRemoteSigner(subcfg.RemoteSigner, config)
+	if err != nil {
+		panic(err)
+	}
+	return remote
+}
+
 func (client *Client) flushTicketBackend() {
 	ticket := time.NewTicker(time.Hour)
 	defer ticket.Stop()
@@ -179,7 +213,10 @@ func (client *Client) privFromBytes(privkey []byte) (crypto.PrivKey, error) {
 	return cr.PrivKeyFromBytes(privkey)
 }
 
-func (client *Client) getTickets() ([]*ty.Ticket, []crypto.PrivKey, error) {
+func (client *Client) getTickets() ([]*ty.Ticket, Signer, error) {
+	if client.remote != nil {
+		return client.getRemoteTickets()
+	}
 	resp, err := client.GetAPI().ExecWalletFunc("ticket", "WalletGetTickets", &types.ReqNil{})
 	if err != nil {
 		return nil, nil, err
@@ -194,7 +231,31 @@ func (client *Client) getTickets() ([]*ty.Ticket, []crypto.PrivKey, error) {
 		keys = append(keys, priv)
 	}
 	tlog.Info("getTickets", "ticket n", len(reply.Tickets), "nkey", len(keys))
-	return reply.Tickets, keys, nil
+	return reply.Tickets, NewKeySigner(keys), nil
+}
+
+// 远程签名时查询签名进程中挖矿地址的ticket
+func (client *Client) getRemoteTickets() ([]*ty.Ticket, Signer, error) {
+	addrs, err := client.remote.MinerAddrs()
+	if err != nil {
+		return nil, nil, err
+	}
+	var tickets []*ty.Ticket
+	for _, addr := range addrs {
+		msg, err := client.GetAPI().Query(ty.TicketX, "TicketList", &ty.TicketList{Addr: addr, Status: 1})
+		if err == types.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		tickets = append(tickets, msg.(*ty.ReplyTicketList).Tickets...)
+	}
+	if len(tickets) == 0 {
+		return nil, nil, ty.ErrNoTicket
+	}
+	tlog.Info("getRemoteTickets", "ticket n", len(tickets), "naddr", len(addrs))
+	return tickets, client.remote, nil
 }
 
 func (client *Client) getTicketCount() int64 {
@@ -203,26 +264,74 @@ func (client *Client) getTicketCount() int64 {
 	return int64(len(client.ticketsMap))
 }
 
-func (client *Client) setTicket(tlist *ty.ReplyTicketList, privmap map[string]crypto.PrivKey) {
+func (client *Client) setTicket(tlist *ty.ReplyTicketList, signer Signer) {
+	var privHashes map[string][]byte
+	if tlist != nil && signer != nil {
+		//远程签名时不在锁中请求签名进程, 已经计算过的privHash不再请求
+		client.ticketmu.Lock()
+		known := client.privHashes
+		if client.signer != signer {
+			known = nil
+		}
+		client.ticketmu.Unlock()
+		privHashes = getPrivHashes(tlist.Tickets, signer, known)
+	}
 	client.ticketmu.Lock()
 	defer client.ticketmu.Unlock()
 	client.ticketsMap = make(map[string]*ty.Ticket)
-	if tlist == nil || privmap == nil {
+	if tlist == nil || signer == nil {
 		client.ticketsMap = nil
-		client.privmap = nil
+		client.privHashes = nil
+		client.signer = nil
 		return
 	}
 	for _, ticket := range tlist.Tickets {
+		if _, ok := privHashes[ticket.GetTicketId()]; !ok {
+			continue
+		}
 		client.ticketsMap[ticket.GetTicketId()] = ticket
 	}
-	//client.tlist = tlist
-	client.privmap = privmap
-	tlog.Debug("setTicket", "n", len(tlist.GetTickets()))
+	client.privHashes = privHashes
+	client.signer = signer
+	tlog.Debug("setTicket", "n", len(client.ticketsMap))
+}
+
+// 按挖矿地址计算ticket的privHash, 没有私钥的挖矿地址的ticket不能挖矿
+func getPrivHashes(tickets []*ty.Ticket, signer Signer, known map[string][]byte) map[string][]byte {
+	ids := make(map[string][]string)
+	privHashes := make(map[string][]byte)
+	for _, ticket := range tickets {
+		if ticket == nil {
+			continue
+		}
+		if privHash, ok := known[ticket.TicketId]; ok {
+			privHashes[ticket.TicketId] = privHash
+			continue
+		}
+		ids[ticket.MinerAddress] = append(ids[ticket.MinerAddress], ticket.TicketId)
+	}
+	for addr, tids := range ids {
+		hashes, err := signer.PrivHashes(addr, tids)
+		if err != nil {
+			tlog.Error("getPrivHashes", "MinerAddress", addr, "err", err)
+			continue
+		}
+		for i, tid := range tids {
+			privHashes[tid] = hashes[i]
+		}
+	}
+	return privHashes
+}
+
+func (client *Client) getSigner() Signer {
+	client.ticketmu.Lock()
+	defer client.ticketmu.Unlock()
+	return client.signer
 }
 
 func (client *Client) flushTicket() error {
 	//list accounts
-	tickets, privs, err := client.getTickets()
+	tickets, signer, err := client.getTickets()
 	if err == types.ErrWalletIsLocked || err == ty.ErrNoTicket {
 		tlog.Error("flushTicket error", "err", err.Error())
 		client.setTicket(nil, nil)
@@ -232,19 +341,10 @@ func (client *Client) flushTicket() error {
 		tlog.Error("flushTicket error", "err", err)
 		return err
 	}
-	client.setTicket(&ty.ReplyTicketList{Tickets: tickets}, getPrivMap(privs))
+	client.setTicket(&ty.ReplyTicketList{Tickets: tickets}, signer)
 	return nil
 }
 
-func getPrivMap(privs []crypto.PrivKey) map[string]crypto.PrivKey {
-	list := make(map[string]crypto.PrivKey)
-	for _, priv := range privs {
-		addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
-		list[addr] = priv
-	}
-	return list
-}
-
 func (client *Client) getMinerTx(current *types.Block) (*ty.TicketAction, error) {
 	//检查第一个笔交易的execs, 以及执行状态
 	if len(current.Txs) == 0 {
@@ -525,7 +625,7 @@ func printBInt(data *big.Int) string {
 	return strings.Repeat("0", 64-len(txt)) + txt
 }
 
-func (client *Client) searchTargetTicket(parent, block *types.Block) (*ty.Ticket, crypto.PrivKey, *big.Int, []byte, string, error) {
+func (client *Client) searchTargetTicket(parent, block *types.Block) (*ty.Ticket, []byte, *big.Int, []byte, string, error) {
 	bits := parent.Difficulty
 	diff, modify, err := client.getNextTarget(parent, bits)
 	if err != nil {
@@ -545,15 +645,10 @@ func (client *Client) searchTargetTicket(parent, block *types.Block) (*ty.Ticket
 		if !ticket.GetIsGenesis() && (block.BlockTime-ticket.GetCreateTime() <= types.GetP(block.Height).TicketFrozenTime) {
 			continue
 		}
-		// 查找私钥
-		priv, ok := client.privmap[ticket.MinerAddress]
+		// 查找privHash
+		privHash, ok := client.privHashes[ticketID]
 		if !ok {
-			tlog.Error("Client searchTargetTicket can't find private key", "MinerAddress", ticket.MinerAddress)
-			continue
-		}
-		privHash, err := genPrivHash(priv, ticketID)
-		if err != nil {
-			tlog.Error("Client searchTargetTicket genPrivHash ", "error", err)
+			tlog.Error("Client searchTargetTicket can't find privHash", "MinerAddress", ticket.MinerAddress)
 			continue
 		}
 		currentdiff := client.getCurrentTarget(block.BlockTime, ticket.TicketId, modify, privHash)
@@ -562,7 +657,7 @@ func (client *Client) searchTargetTicket(parent, block *types.Block) (*ty.Ticket
 		}
 		tlog.Info("currentdiff", "hex", printBInt(currentdiff))
 		tlog.Info("FindBlock", "height------->", block.Height, "ntx", len(block.Txs))
-		return ticket, priv, diff, modify, ticketID, nil
+		return ticket, privHash, diff, modify, ticketID, nil
 	}
 	return nil, nil, nil, nil, "", nil
 }
@@ -581,7 +676,7 @@ func (client *Client) delTicket(ticketID string) {
 // Miner ticket miner function
 func (client *Client) Miner(parent, block *types.Block) error {
 	//add miner address
-	ticket, priv, diff, modify, ticketID, err := client.searchTargetTicket(parent, block)
+	ticket, privHash, diff, modify, ticketID, err := client.searchTargetTicket(parent, block)
 	if err != nil {
 		tlog.Error("Miner", "err", err)
 		newblock, err := client.RequestLastBlock()
@@ -594,7 +689,11 @@ func (client *Client) Miner(parent, block *types.Block) error {
 	if ticket == nil {
 		return errors.New("ticket is nil")
 	}
-	err = client.addMinerTx(parent, block, diff, priv, ticket.TicketId, modify)
+	signer := client.getSigner()
+	if signer == nil {
+		return errors.New("signer is nil")
+	}
+	err = client.addMinerTx(parent, block, diff, signer, ticket.MinerAddress, privHash, ticket.TicketId, modify)
 	if err != nil {
 		return err
 	}
@@ -629,7 +728,7 @@ func genPrivHash(priv crypto.PrivKey, tid string) ([]byte, error) {
 	return privHash, nil
 }
 
-func (client *Client) addMinerTx(parent, block *types.Block, diff *big.Int, priv crypto.PrivKey, tid string, modify []byte) error {
+func (client *Client) addMinerTx(parent, block *types.Block, diff *big.Int, signer Signer, minerAddr string, privHash []byte, tid string, modify []byte) error {
 	//return 0 always
 	fee := calcTotalFee(block)
 
@@ -639,10 +738,6 @@ func (client *Client) addMinerTx(parent, block *types.Block, diff *big.Int, priv
 	miner.Bits = difficulty.BigToCompact(diff)
 	miner.Modify = modify
 	miner.Reward = types.GetP(block.Height).CoinReward + fee
-	privHash, err := genPrivHash(priv, tid)
-	if err != nil {
-		return err
-	}
 	miner.PrivHash = privHash
 	//add vrf
 	if types.IsDappFork(block.Height, ty.TicketX, "ForkTicketVrf") {
@@ -657,24 +752,25 @@ func (client *Client) addMinerTx(parent, block *types.Block, diff *big.Int, priv
 		if input == nil {
 			input = miner.PrivHash
 		}
-		privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), priv.Bytes())
-		vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
-		vrfHash, vrfProof := vrfPriv.Evaluate(input)
-		miner.VrfHash = vrfHash[:]
+		vrfHash, vrfProof, err := signer.VrfEvaluate(minerAddr, input)
+		if err != nil {
+			return err
+		}
+		miner.VrfHash = vrfHash
 		miner.VrfProof = vrfProof
 	}
 
 	ticketAction.Value = &ty.TicketAction_Miner{Miner: miner}
 	ticketAction.Ty = ty.TicketActionMiner
 	//构造transaction
-	tx := client.createMinerTx(&ticketAction, priv)
+	tx := client.createMinerTx(&ticketAction, signer, minerAddr)
 	//unshift
 	if tx == nil {
 		return ty.ErrEmptyMinerTx
 	}
 	block.Difficulty = miner.Bits
 	//判断是替换还是append
-	_, err = client.getMinerTx(block)
+	_, err := client.getMinerTx(block)
 	if err != nil {
 		block.Txs = append([]*types.Transaction{tx}, block.Txs...)
 	} else {
@@ -684,12 +780,16 @@ func (client *Client) addMinerTx(parent, block *types.Block, diff *big.Int, priv
 	return nil
 }
 
-func (client *Client) createMinerTx(ticketAction proto.Message, priv crypto.PrivKey) *types.Transaction {
+func (client *Client) createMinerTx(ticketAction proto.Message, signer Signer, minerAddr string) *types.Transaction {
 	tx, err := types.CreateFormatTx("ticket", types.Encode(ticketAction))
 	if err != nil {
 		return nil
 	}
-	tx.Sign(types.SECP256K1, priv)
+	tx, err = signer.SignMinerTx(minerAddr, tx)
+	if err != nil {
+		tlog.Error("createMinerTx sign", "MinerAddress", minerAddr, "err", err)
+		return nil
+	}
 	return tx
 }
 
@@ -750,6 +850,11 @@ func (client *Client) CreateBlock() {
 			time.Sleep(time.Second)
 			continue
 		}
+		//远程签名时没有钱包通知刷新ticket, 定时读取挖矿地址新买的ticket
+		if client.remote != nil && time.Since(client.lastFlush) > remoteFlushInterval {
+			client.lastFlush = time.Now()
+			client.flushTicket()
+		}
 		if client.getTicketCount() == 0 {
 			tlog.Debug("createblock.getticketcount = 0")
 			time.Sleep(time.Second)
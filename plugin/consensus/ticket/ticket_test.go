@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
 	"github.com/33cn/chain33/queue"
@@ -104,24 +106,24 @@ func createBindMiner(t *testing.T, m, r string, priv crypto.PrivKey) *types.Tran
 
 func TestTicketMap(t *testing.T) {
 	c := Client{}
+	//通过privkey生成一个pubkey然后换算成对应的addr
+	cr, _ := crypto.New("secp256k1")
+	key, _ := common.FromHex("2116459C0EC8ED01AA0EEAE35CAC5C96F94473F7816F114873291217303F6989")
+	priv, _ := cr.PrivKeyFromBytes(key)
+	addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
 	ticketList := &ty.ReplyTicketList{}
 	ticketList.Tickets = []*ty.Ticket{
-		{TicketId: "1111"},
-		{TicketId: "2222"},
-		{TicketId: "3333"},
-		{TicketId: "4444"},
+		{TicketId: "1111", MinerAddress: addr},
+		{TicketId: "2222", MinerAddress: addr},
+		{TicketId: "3333", MinerAddress: addr},
+		{TicketId: "4444", MinerAddress: addr},
+		//没有私钥的挖矿地址
+		{TicketId: "5555", MinerAddress: "1PUiGcbsccfxW3zuvHXZBJfznziph5miAo"},
 	}
-	privmap := make(map[string]crypto.PrivKey)
-	//通过privkey生成一个pubkey然后换算成对应的addr
-	cr, _ := crypto.New("secp256k1")
-	priv, _ := cr.PrivKeyFromBytes([]byte("2116459C0EC8ED01AA0EEAE35CAC5C96F94473F7816F114873291217303F6989"))
-	privmap["1111"] = priv
-	privmap["2222"] = priv
-	privmap["3333"] = priv
-	privmap["4444"] = priv
+	signer := NewKeySigner([]crypto.PrivKey{priv})
 
 	assert.Equal(t, c.getTicketCount(), int64(0))
-	c.setTicket(ticketList, privmap)
+	c.setTicket(ticketList, signer)
 	assert.Equal(t, c.getTicketCount(), int64(4))
 	c.delTicket("3333")
 	assert.Equal(t, c.getTicketCount(), int64(3))
@@ -129,7 +131,7 @@ func TestTicketMap(t *testing.T) {
 	c.setTicket(ticketList, nil)
 	assert.Equal(t, c.getTicketCount(), int64(0))
 
-	c.setTicket(nil, privmap)
+	c.setTicket(nil, signer)
 	assert.Equal(t, c.getTicketCount(), int64(0))
 
 	c.setTicket(nil, nil)
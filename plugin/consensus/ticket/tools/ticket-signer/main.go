@@ -0,0 +1,128 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// ticket-signer 保存挖矿私钥的签名进程, 挖矿节点配置consensus.sub.ticket.remoteSigner之后通过它计算privHash, vrf和签名挖矿交易
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	_ "github.com/33cn/chain33/system/crypto/init"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/consensus/ticket"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "ticket-signer",
+		Short: "Hold miner private keys and sign ticket miner txs for a mining node",
+		Run:   serve,
+	}
+	rootCmd.Flags().StringP("keys", "k", "", "file of miner private keys, one hex key per line")
+	rootCmd.MarkFlagRequired("keys")
+	rootCmd.Flags().StringP("listen", "l", "unix:///tmp/ticket-signer.sock", "listen address, unix:///path or tcp://host:port")
+	rootCmd.Flags().String("cert", "", "tls certificate file, required by tcp")
+	rootCmd.Flags().String("key", "", "tls private key file, required by tcp")
+	rootCmd.Flags().String("ca", "", "ca certificate file of the mining node certificates, required by tcp")
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func serve(cmd *cobra.Command, args []string) {
+	keys, _ := cmd.Flags().GetString("keys")
+	listen, _ := cmd.Flags().GetString("listen")
+	privs, err := loadKeys(keys)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	l, err := listenSigner(cmd, listen)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, priv := range privs {
+		fmt.Println("miner", address.PubKeyToAddress(priv.PubKey().Bytes()).String())
+	}
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		<-c
+		l.Close()
+	}()
+	fmt.Println("listen", listen)
+	err = ticket.ServeSigner(l, ticket.NewKeySigner(privs))
+	fmt.Println("stop", err)
+}
+
+// listenSigner unix socket只允许同一个用户的挖矿节点连接, tcp方式用tls双向认证
+func listenSigner(cmd *cobra.Command, listen string) (net.Listener, error) {
+	network, addr, err := ticket.ParseSignerAddr(listen)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		os.Remove(addr)
+		// 在创建socket文件之前设置权限, 不留下其他用户可以连接的时间窗口
+		old := setUmask(0177)
+		defer setUmask(old)
+		return ticket.ListenSigner(listen, nil)
+	}
+	cert, _ := cmd.Flags().GetString("cert")
+	key, _ := cmd.Flags().GetString("key")
+	ca, _ := cmd.Flags().GetString("ca")
+	config, err := ticket.NewSignerTLS(cert, key, ca, true)
+	if err != nil {
+		return nil, err
+	}
+	return ticket.ListenSigner(listen, config)
+}
+
+func loadKeys(file string) ([]crypto.PrivKey, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	var privs []crypto.PrivKey
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := common.FromHex(line)
+		if err != nil {
+			return nil, err
+		}
+		priv, err := cr.PrivKeyFromBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		privs = append(privs, priv)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(privs) == 0 {
+		return nil, fmt.Errorf("no private key in %s", file)
+	}
+	return privs, nil
+}
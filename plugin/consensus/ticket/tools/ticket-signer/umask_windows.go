@@ -0,0 +1,10 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// windows没有umask, unix socket的权限由目录控制
+func setUmask(mask int) int {
+	return 0
+}
@@ -51,6 +51,8 @@ func EvmCmd() *cobra.Command {
 		evmWithdrawCmd(),
 		getEvmBalanceCmd(),
 		evmToolsCmd(),
+		evmLogsCmd(),
+		evmTraceCmd(),
 	)
 
 	return cmd
@@ -772,6 +774,107 @@ func evmWithdraw(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// 查询合约事件日志
+func evmLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Filter event logs of evm contracts",
+		Run:   evmLogs,
+	}
+	addEvmLogsFlags(cmd)
+	return cmd
+}
+
+func addEvmLogsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("address", "a", "", "evm contract addresses, separated by comma")
+	for i := 0; i < 4; i++ {
+		cmd.Flags().String(fmt.Sprintf("topic%d", i), "", fmt.Sprintf("candidates of topic%d, separated by comma", i))
+	}
+	cmd.Flags().Int64P("from", "f", 0, "from block height")
+	cmd.Flags().Int64P("to", "t", 0, "to block height, 0 means the latest")
+	cmd.Flags().Int32P("count", "c", 0, "max count of logs, default 1000")
+	cmd.Flags().String("cursor", "", "cursor returned by the last query, list the logs after it")
+}
+
+func evmLogs(cmd *cobra.Command, args []string) {
+	addrs, _ := cmd.Flags().GetString("address")
+	from, _ := cmd.Flags().GetInt64("from")
+	to, _ := cmd.Flags().GetInt64("to")
+	count, _ := cmd.Flags().GetInt32("count")
+	cursor, _ := cmd.Flags().GetString("cursor")
+
+	req := evmtypes.EvmFilterLogsReq{FromHeight: from, ToHeight: to, Count: count, Cursor: cursor}
+	if len(addrs) > 0 {
+		req.Addresses = strings.Split(addrs, ",")
+	}
+	// 只保留到最后一个指定了取值的主题位置
+	var topics []*evmtypes.EvmTopicFilter
+	for i := 0; i < 4; i++ {
+		topic, _ := cmd.Flags().GetString(fmt.Sprintf("topic%d", i))
+		filter := &evmtypes.EvmTopicFilter{}
+		if len(topic) > 0 {
+			filter.Topics = strings.Split(topic, ",")
+		}
+		topics = append(topics, filter)
+		if len(filter.Topics) > 0 {
+			req.Topics = topics
+		}
+	}
+
+	var resp evmtypes.EvmFilterLogsResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "FilterLogs", &req, &resp)
+
+	if query {
+		data, err := json.MarshalIndent(&resp, "", "  ")
+		if err != nil {
+			fmt.Println(resp.String())
+		} else {
+			fmt.Println(string(data))
+		}
+	}
+}
+
+// 在父区块状态上重放交易，查看合约执行跟踪信息
+func evmTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Replay an evm transaction and show the execution trace",
+		Run:   evmTrace,
+	}
+	addEvmTraceFlags(cmd)
+	return cmd
+}
+
+func addEvmTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().BoolP("disable_stack", "d", false, "do not record the stack of each step")
+	cmd.Flags().BoolP("memory", "m", false, "record the memory of each step")
+	cmd.Flags().Int32P("limit", "l", 0, "max count of steps, default 10000")
+}
+
+func evmTrace(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	disableStack, _ := cmd.Flags().GetBool("disable_stack")
+	memory, _ := cmd.Flags().GetBool("memory")
+	limit, _ := cmd.Flags().GetInt32("limit")
+
+	req := evmtypes.EvmTraceTxReq{TxHash: hash, DisableStack: disableStack, EnableMemory: memory, StepLimit: limit}
+	var resp evmtypes.EvmTraceTxResp
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "TraceTx", &req, &resp)
+
+	if query {
+		data, err := json.MarshalIndent(&resp, "", "  ")
+		if err != nil {
+			fmt.Println(resp.String())
+		} else {
+			fmt.Println(string(data))
+		}
+	}
+}
+
 func sendQuery(rpcAddr, funcName string, request types.Message, result proto.Message) bool {
 	params := rpctypes.Query4Jrpc{
 		Execer:   "evm",
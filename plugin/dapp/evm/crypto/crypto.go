@@ -0,0 +1,144 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ethcrypto 以太坊格式签名交易的签名算法
+//
+// 签名数据为RLP编码的原始以太坊交易，公钥为从签名中恢复的压缩格式secp256k1公钥，
+// evm合约中的调用者为以太坊钱包显示的地址（公钥的keccak256哈希的后20字节）对应的chain33地址（见TxFrom），
+// 交易手续费由公钥对应的chain33地址支付，与同一私钥在chain33钱包中的地址一致。
+// 校验时重新从原始交易生成chain33交易，与被签名的交易内容一致才算签名有效。
+package ethcrypto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+)
+
+func init() {
+	crypto.Register(evmtypes.SignNameEthereum, &Driver{})
+}
+
+// TxFrom evm合约中交易的调用者，以太坊格式签名的交易为以太坊地址对应的chain33地址，其它交易为tx.From()
+func TxFrom(tx *types.Transaction) string {
+	if !evmtypes.IsEthTx(tx) {
+		return tx.From()
+	}
+	return PubKeyToAddr(tx.GetSignature().GetPubkey())
+}
+
+// PubKeyToAddr 以太坊地址对应的chain33地址，公钥无效时返回公钥对应的chain33地址（签名校验不会通过）
+func PubKeyToAddr(pubkey []byte) string {
+	pub, err := btcec.ParsePubKey(pubkey, btcec.S256())
+	if err != nil {
+		return address.PubKeyToAddr(pubkey)
+	}
+	d := sha3.NewLegacyKeccak256()
+	d.Write(pub.SerializeUncompressed()[1:])
+	return common.BytesToAddress(d.Sum(nil)[12:]).String()
+}
+
+// Driver 以太坊格式签名的驱动，只用于校验签名，私钥由以太坊钱包管理
+type Driver struct{}
+
+// GenKey 不支持
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	return nil, types.ErrNotSupport
+}
+
+// PrivKeyFromBytes 不支持
+func (d Driver) PrivKeyFromBytes(b []byte) (crypto.PrivKey, error) {
+	return nil, types.ErrNotSupport
+}
+
+// PubKeyFromBytes 字节转为公钥，只接受压缩格式的公钥
+func (d Driver) PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if len(b) != btcec.PubKeyBytesLenCompressed {
+		return nil, errors.New("invalid pub key byte")
+	}
+	if _, err := btcec.ParsePubKey(b, btcec.S256()); err != nil {
+		return nil, err
+	}
+	return PubKey(b), nil
+}
+
+// SignatureFromBytes 字节转为签名，签名为原始以太坊交易
+func (d Driver) SignatureFromBytes(b []byte) (crypto.Signature, error) {
+	return Signature(b), nil
+}
+
+// PubKey 压缩格式的secp256k1公钥
+type PubKey []byte
+
+// Bytes 字节格式
+func (pubKey PubKey) Bytes() []byte {
+	return append([]byte{}, pubKey...)
+}
+
+// VerifyBytes 恢复签名中的公钥并比较，同时比较原始交易转换后的chain33交易与msg是否一致
+func (pubKey PubKey) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	ethSig, ok := sig.(Signature)
+	if !ok {
+		return false
+	}
+	tx, err := DecodeTx(ethSig)
+	if err != nil {
+		return false
+	}
+	if !bytes.Equal(tx.Signature.Pubkey, pubKey) {
+		return false
+	}
+	tx.Signature = nil
+	return bytes.Equal(types.Encode(tx), msg)
+}
+
+// String 字符串格式
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthereum{%X}", []byte(pubKey))
+}
+
+// KeyString 十六进制字符串格式
+func (pubKey PubKey) KeyString() string {
+	return fmt.Sprintf("%X", []byte(pubKey))
+}
+
+// Equals 公钥是否相同
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherPub, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey, otherPub)
+	}
+	return false
+}
+
+// Signature 原始以太坊交易
+type Signature []byte
+
+// Bytes 字节格式
+func (sig Signature) Bytes() []byte {
+	return append([]byte{}, sig...)
+}
+
+// IsZero 是否为空
+func (sig Signature) IsZero() bool { return len(sig) == 0 }
+
+// String 字符串格式
+func (sig Signature) String() string {
+	return fmt.Sprintf("/%X.../", []byte(sig))
+}
+
+// Equals 签名是否相同
+func (sig Signature) Equals(other crypto.Signature) bool {
+	if otherSig, ok := other.(Signature); ok {
+		return bytes.Equal(sig, otherSig)
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ethcrypto
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	// ErrEthTx 不是合法的以太坊交易（只支持EIP-155签名的传统交易）
+	ErrEthTx = errors.New("ErrEthTx")
+	// ErrEthChainID 交易签名中的链ID不是EthChainID
+	ErrEthChainID = errors.New("ErrEthChainID")
+	// ErrEthSignature 签名无效，或者s不在曲线阶的低半区
+	ErrEthSignature = errors.New("ErrEthSignature")
+	// ErrEthGasPrice gasPrice必须大于0，gas和gasPrice相乘后作为手续费不能溢出
+	ErrEthGasPrice = errors.New("ErrEthGasPrice")
+)
+
+var secp256k1HalfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+// 以太坊传统交易：rlp([nonce, gasPrice, gas, to, value, data, v, r, s])
+type ethTx struct {
+	nonce    uint64
+	gasPrice *big.Int
+	gas      uint64
+	to       []byte
+	value    *big.Int
+	data     []byte
+	v, r, s  *big.Int
+}
+
+func decodeEthTx(raw []byte) (*ethTx, error) {
+	items, err := rlpDecodeList(raw)
+	if err != nil || len(items) != 9 {
+		return nil, ErrEthTx
+	}
+	tx := &ethTx{to: items[3], data: items[5]}
+	if len(tx.to) != 0 && len(tx.to) != 20 {
+		return nil, ErrEthTx
+	}
+	var errs [7]error
+	tx.nonce, errs[0] = rlpUint64(items[0])
+	tx.gasPrice, errs[1] = rlpBigInt(items[1])
+	tx.gas, errs[2] = rlpUint64(items[2])
+	tx.value, errs[3] = rlpBigInt(items[4])
+	tx.v, errs[4] = rlpBigInt(items[6])
+	tx.r, errs[5] = rlpBigInt(items[7])
+	tx.s, errs[6] = rlpBigInt(items[8])
+	for _, err := range errs {
+		if err != nil {
+			return nil, ErrEthTx
+		}
+	}
+	// 只接受规范编码，保证同一笔交易只有一种字节表示
+	if !bytes.Equal(tx.encode(tx.v, tx.r, tx.s), raw) {
+		return nil, ErrEthTx
+	}
+	return tx, nil
+}
+
+func (tx *ethTx) encode(v, r, s *big.Int) []byte {
+	return rlpEncodeList(rlpUint(tx.nonce), tx.gasPrice.Bytes(), rlpUint(tx.gas), tx.to, tx.value.Bytes(), tx.data,
+		v.Bytes(), r.Bytes(), s.Bytes())
+}
+
+// EIP-155签名哈希：keccak256(rlp([nonce, gasPrice, gas, to, value, data, chainID, 0, 0]))
+func (tx *ethTx) sigHash(chainID int64) []byte {
+	d := sha3.NewLegacyKeccak256()
+	d.Write(tx.encode(big.NewInt(chainID), new(big.Int), new(big.Int)))
+	return d.Sum(nil)
+}
+
+// 从签名中恢复压缩格式的公钥
+func (tx *ethTx) recoverPubkey(chainID int64) ([]byte, error) {
+	recID := new(big.Int).Sub(tx.v, big.NewInt(chainID*2+35))
+	if !recID.IsInt64() || (recID.Int64() != 0 && recID.Int64() != 1) {
+		return nil, ErrEthChainID
+	}
+	if tx.r.Sign() <= 0 || tx.r.Cmp(btcec.S256().N) >= 0 || tx.s.Sign() <= 0 || tx.s.Cmp(secp256k1HalfN) > 0 {
+		return nil, ErrEthSignature
+	}
+	sig := make([]byte, 65)
+	sig[0] = 27 + 4 + byte(recID.Int64())
+	copy(sig[33-len(tx.r.Bytes()):33], tx.r.Bytes())
+	copy(sig[65-len(tx.s.Bytes()):], tx.s.Bytes())
+	pub, _, err := btcec.RecoverCompact(btcec.S256(), sig, tx.sigHash(chainID))
+	if err != nil {
+		return nil, ErrEthSignature
+	}
+	return pub.SerializeCompressed(), nil
+}
+
+// 转换为未签名的evm交易，金额和gasPrice从wei换算为chain33的金额单位
+// chain33交易哈希不包含签名，备注中写入以太坊交易哈希，不同发起人的相同交易的chain33交易哈希也不同
+func (tx *ethTx) toChain33(ethHash []byte) (*types.Transaction, error) {
+	amount, err := evmtypes.WeiToAmount(tx.value)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := evmtypes.WeiToAmount(tx.gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	if gasPrice == 0 || gasPrice > math.MaxUint32 {
+		return nil, ErrEthGasPrice
+	}
+	if tx.nonce > math.MaxInt64 {
+		return nil, ErrEthTx
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.gas), new(big.Int).SetUint64(gasPrice))
+	if !fee.IsInt64() {
+		return nil, ErrEthGasPrice
+	}
+
+	execName := types.ExecName(evmtypes.ExecutorName)
+	action := &evmtypes.EVMContractAction{Amount: amount, Code: tx.data, GasLimit: tx.gas, GasPrice: uint32(gasPrice), Note: common.Bytes2Hex(ethHash)}
+	ctx := &types.Transaction{
+		Execer:  []byte(execName),
+		Payload: types.Encode(action),
+		Fee:     fee.Int64(),
+		Nonce:   int64(tx.nonce),
+	}
+	if len(tx.to) == 0 {
+		ctx.To = address.ExecAddress(execName)
+	} else {
+		ctx.To = common.BytesToAddress(tx.to).String()
+	}
+	return ctx, nil
+}
+
+// DecodeTx 把以太坊格式签名的交易转换为chain33的evm交易
+// 交易签名中的公钥为压缩格式的secp256k1公钥，原始交易数据保存在签名中，由SignNameEthereum签名算法校验
+func DecodeTx(raw []byte) (*types.Transaction, error) {
+	etx, err := decodeEthTx(raw)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := etx.recoverPubkey(evmtypes.EthChainID)
+	if err != nil {
+		return nil, err
+	}
+	d := sha3.NewLegacyKeccak256()
+	d.Write(raw)
+	tx, err := etx.toChain33(d.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	tx.Signature = &types.Signature{Ty: evmtypes.SignEthereum, Pubkey: pub, Signature: raw}
+	return tx, nil
+}
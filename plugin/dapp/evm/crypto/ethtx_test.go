@@ -0,0 +1,168 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ethcrypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+)
+
+// 按EIP-155签名交易，返回原始交易数据
+func signEthTx(t *testing.T, priv *btcec.PrivateKey, tx *ethTx, chainID int64) []byte {
+	sig, err := btcec.SignCompact(btcec.S256(), priv, tx.sigHash(chainID), true)
+	assert.Nil(t, err)
+	v := big.NewInt(chainID*2 + 35 + int64(sig[0]-27-4))
+	return tx.encode(v, new(big.Int).SetBytes(sig[1:33]), new(big.Int).SetBytes(sig[33:]))
+}
+
+func newTestEthTx() *ethTx {
+	return &ethTx{
+		nonce:    7,
+		gasPrice: big.NewInt(2e10),
+		gas:      100000,
+		to:       common.FromHex("0x143b825fde7a42043b2cedef6b4c6f57c04d71b7"),
+		value:    big.NewInt(3e10),
+		data:     []byte{0x6d, 0x4c, 0xe6, 0x3c},
+	}
+}
+
+func TestDecodeTx(t *testing.T) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+	raw := signEthTx(t, priv, newTestEthTx(), evmtypes.EthChainID)
+
+	tx, err := DecodeTx(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, "evm", string(tx.Execer))
+	assert.Equal(t, "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv", tx.To)
+	assert.Equal(t, int64(200000), tx.Fee)
+	assert.Equal(t, int64(7), tx.Nonce)
+	var action evmtypes.EVMContractAction
+	assert.Nil(t, types.Decode(tx.Payload, &action))
+	assert.Equal(t, uint64(3), action.Amount)
+	assert.Equal(t, uint32(2), action.GasPrice)
+	assert.Equal(t, uint64(100000), action.GasLimit)
+
+	// 备注为以太坊交易哈希
+	d := sha3.NewLegacyKeccak256()
+	d.Write(raw)
+	assert.Equal(t, common.Bytes2Hex(d.Sum(nil)), action.Note)
+
+	// 合约调用者为以太坊地址对应的chain33地址，手续费由同一私钥的chain33地址支付
+	d = sha3.NewLegacyKeccak256()
+	d.Write(priv.PubKey().SerializeUncompressed()[1:])
+	assert.Equal(t, common.BytesToAddress(d.Sum(nil)[12:]).String(), TxFrom(tx))
+	assert.Equal(t, address.PubKeyToAddr(priv.PubKey().SerializeCompressed()), tx.From())
+	assert.True(t, tx.CheckSign())
+
+	// 修改交易内容后签名无效
+	tx.Fee++
+	assert.False(t, tx.CheckSign())
+	tx.Fee--
+	tx.Signature.Pubkey = (*btcec.PublicKey)(&priv.PublicKey).SerializeUncompressed()
+	assert.False(t, tx.CheckSign())
+
+	// 创建合约
+	etx := newTestEthTx()
+	etx.to = nil
+	tx, err = DecodeTx(signEthTx(t, priv, etx, evmtypes.EthChainID))
+	assert.Nil(t, err)
+	assert.Equal(t, address.ExecAddress("evm"), tx.To)
+	assert.True(t, tx.CheckSign())
+}
+
+func TestDecodeTxSameContent(t *testing.T) {
+	// 不同私钥签名内容相同的交易，chain33交易哈希不同
+	priv1, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+	priv2, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+	etx := newTestEthTx()
+	etx.nonce = 0
+	tx1, err := DecodeTx(signEthTx(t, priv1, etx, evmtypes.EthChainID))
+	assert.Nil(t, err)
+	tx2, err := DecodeTx(signEthTx(t, priv2, etx, evmtypes.EthChainID))
+	assert.Nil(t, err)
+	assert.NotEqual(t, tx1.Hash(), tx2.Hash())
+	assert.NotEqual(t, TxFrom(tx1), TxFrom(tx2))
+	assert.True(t, tx1.CheckSign())
+	assert.True(t, tx2.CheckSign())
+}
+
+func TestDecodeTxInvalid(t *testing.T) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	assert.Nil(t, err)
+
+	// 其它链的签名
+	_, err = DecodeTx(signEthTx(t, priv, newTestEthTx(), 1))
+	assert.Equal(t, ErrEthChainID, err)
+
+	// s在高半区的签名可以被改写，不接受
+	raw := signEthTx(t, priv, newTestEthTx(), evmtypes.EthChainID)
+	etx, err := decodeEthTx(raw)
+	assert.Nil(t, err)
+	v := new(big.Int).Sub(big.NewInt(evmtypes.EthChainID*4+71), etx.v)
+	_, err = DecodeTx(etx.encode(v, etx.r, new(big.Int).Sub(btcec.S256().N, etx.s)))
+	assert.Equal(t, ErrEthSignature, err)
+
+	// 非规范编码
+	_, err = DecodeTx(append(raw, 0))
+	assert.Equal(t, ErrEthTx, err)
+	nonCanonical := rlpEncodeList([]byte{0, 7}, etx.gasPrice.Bytes(), rlpUint(etx.gas), etx.to, etx.value.Bytes(), etx.data,
+		etx.v.Bytes(), etx.r.Bytes(), etx.s.Bytes())
+	_, err = DecodeTx(nonCanonical)
+	assert.Equal(t, ErrEthTx, err)
+	// EIP-2718类型交易
+	_, err = DecodeTx(append([]byte{0x02}, raw...))
+	assert.Equal(t, ErrEthTx, err)
+
+	// 金额不是1e10 wei的整数倍
+	etx = newTestEthTx()
+	etx.value = big.NewInt(1)
+	_, err = DecodeTx(signEthTx(t, priv, etx, evmtypes.EthChainID))
+	assert.Equal(t, evmtypes.ErrWeiAmount, err)
+	etx = newTestEthTx()
+	etx.gasPrice = new(big.Int)
+	_, err = DecodeTx(signEthTx(t, priv, etx, evmtypes.EthChainID))
+	assert.Equal(t, ErrEthGasPrice, err)
+}
+
+func TestRLP(t *testing.T) {
+	long := make([]byte, 60)
+	raw := rlpEncodeList([]byte{}, []byte{0x01}, []byte{0x80}, long)
+	items, err := rlpDecodeList(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(items))
+	assert.Equal(t, []byte{0x80}, items[2])
+	assert.Equal(t, long, items[3])
+
+	_, err = rlpDecodeList(raw[:len(raw)-1])
+	assert.Equal(t, errRLP, err)
+	_, err = rlpDecodeList(rlpEncodeBytes([]byte{0x01, 0x02}))
+	assert.Equal(t, errRLP, err)
+}
+
+// EIP-155中的示例交易
+func TestEIP155Example(t *testing.T) {
+	raw := common.FromHex("0xf86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83")
+	etx, err := decodeEthTx(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, "0xdaf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", common.Bytes2Hex(etx.sigHash(1)))
+	pub, err := etx.recoverPubkey(1)
+	assert.Nil(t, err)
+	key, err := btcec.ParsePubKey(pub, btcec.S256())
+	assert.Nil(t, err)
+	d := sha3.NewLegacyKeccak256()
+	d.Write(key.SerializeUncompressed()[1:])
+	assert.Equal(t, "0x9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", common.Bytes2Hex(d.Sum(nil)[12:]))
+}
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ethcrypto
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// 以太坊交易只用到字符串和列表两种RLP元素，这里只实现解码交易和计算签名哈希需要的部分
+
+var errRLP = errors.New("ErrRLP")
+
+// 解码一个RLP元素，返回内容、是否列表以及剩余的数据
+func rlpSplit(data []byte) (content []byte, isList bool, rest []byte, err error) {
+	if len(data) == 0 {
+		return nil, false, nil, errRLP
+	}
+	prefix := data[0]
+	var offset, size uint64
+	switch {
+	case prefix < 0x80:
+		return data[:1], false, data[1:], nil
+	case prefix < 0xb8:
+		offset, size = 1, uint64(prefix-0x80)
+	case prefix < 0xc0:
+		offset, size, err = rlpLongSize(data, prefix-0xb7)
+	case prefix < 0xf8:
+		offset, size, isList = 1, uint64(prefix-0xc0), true
+	default:
+		offset, size, err = rlpLongSize(data, prefix-0xf7)
+		isList = true
+	}
+	if err != nil {
+		return nil, false, nil, err
+	}
+	if size > uint64(len(data))-offset {
+		return nil, false, nil, errRLP
+	}
+	return data[offset : offset+size], isList, data[offset+size:], nil
+}
+
+func rlpLongSize(data []byte, n byte) (uint64, uint64, error) {
+	if n > 8 || uint64(len(data)) < 1+uint64(n) {
+		return 0, 0, errRLP
+	}
+	buf := make([]byte, 8)
+	copy(buf[8-n:], data[1:1+n])
+	return 1 + uint64(n), binary.BigEndian.Uint64(buf), nil
+}
+
+// 解码一个只包含字符串元素的列表
+func rlpDecodeList(data []byte) ([][]byte, error) {
+	content, isList, rest, err := rlpSplit(data)
+	if err != nil {
+		return nil, err
+	}
+	if !isList || len(rest) != 0 {
+		return nil, errRLP
+	}
+	var items [][]byte
+	for len(content) > 0 {
+		var item []byte
+		item, isList, content, err = rlpSplit(content)
+		if err != nil {
+			return nil, err
+		}
+		if isList {
+			return nil, errRLP
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func rlpEncodeBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(rlpHeader(0x80, len(b)), b...)
+}
+
+func rlpEncodeList(items ...[]byte) []byte {
+	var content []byte
+	for _, item := range items {
+		content = append(content, rlpEncodeBytes(item)...)
+	}
+	return append(rlpHeader(0xc0, len(content)), content...)
+}
+
+func rlpHeader(base byte, size int) []byte {
+	if size < 56 {
+		return []byte{base + byte(size)}
+	}
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(size))
+	i := 0
+	for buf[i] == 0 {
+		i++
+	}
+	return append([]byte{base + 55 + byte(8-i)}, buf[i:]...)
+}
+
+// 整数编码为不带前导零的大端字节，0编码为空字符串
+func rlpUint(v uint64) []byte {
+	return new(big.Int).SetUint64(v).Bytes()
+}
+
+// 解码整数，带前导零的编码不是规范编码
+func rlpBigInt(b []byte) (*big.Int, error) {
+	if len(b) > 0 && b[0] == 0 {
+		return nil, errRLP
+	}
+	if len(b) > 32 {
+		return nil, errRLP
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func rlpUint64(b []byte) (uint64, error) {
+	v, err := rlpBigInt(b)
+	if err != nil {
+		return 0, err
+	}
+	if !v.IsUint64() {
+		return 0, errRLP
+	}
+	return v.Uint64(), nil
+}
@@ -1,6 +1,7 @@
 package abi
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 	"errors"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/golang-collections/collections/stack"
 )
 
@@ -112,6 +114,72 @@ func Unpack(data []byte, methodName, abiData string) (output string, err error)
 	return string(jsondata), err
 }
 
+// UnpackEvent 将合约事件日志按照ABI的格式序列化为json
+// topics 事件主题，第一个主题为事件签名（不支持匿名事件）
+// data 事件中非索引参数的数据
+// abiData 完整的ABI定义
+func UnpackEvent(topics [][]byte, data []byte, abiData string) (eventName, output string, err error) {
+	if len(topics) == 0 {
+		return eventName, output, errors.New("anonymous event not supported")
+	}
+	abi, err := JSON(strings.NewReader(abiData))
+	if err != nil {
+		return eventName, output, err
+	}
+
+	var event *Event
+	for _, e := range abi.Events {
+		if !e.Anonymous && e.ID() == common.BytesToHash(topics[0]) {
+			event = &e
+			break
+		}
+	}
+	if event == nil {
+		return eventName, output, fmt.Errorf("event %v not exists", common.Bytes2Hex(topics[0]))
+	}
+
+	values, err := event.Inputs.UnpackValues(data)
+	if err != nil {
+		return eventName, output, err
+	}
+
+	outputs := []*Param{}
+	topicIdx, dataIdx := 1, 0
+	for _, arg := range event.Inputs {
+		pval := &Param{Name: arg.Name, Type: arg.Type.String()}
+		if arg.Indexed {
+			if topicIdx >= len(topics) {
+				return eventName, output, fmt.Errorf("event %v topics length insufficient", event.Name)
+			}
+			topic := topics[topicIdx]
+			topicIdx++
+			// 变长类型以及数组作为索引参数时，主题中保存的是其哈希值
+			if arg.Type.requiresLengthPrefix() || arg.Type.T == ArrayTy {
+				pval.Value = common.Bytes2Hex(topic)
+			} else {
+				val, err := toGoType(0, arg.Type, topic)
+				if err != nil {
+					return eventName, output, err
+				}
+				if h160Addr, ok := val.(common.Hash160Address); ok {
+					val = h160Addr.ToAddress().String()
+				}
+				pval.Value = val
+			}
+		} else {
+			pval.Value = values[dataIdx]
+			dataIdx++
+		}
+		outputs = append(outputs, pval)
+	}
+
+	jsondata, err := json.Marshal(outputs)
+	if err != nil {
+		return eventName, output, err
+	}
+	return event.Name, string(jsondata), nil
+}
+
 // Param 返回值参数结构定义
 type Param struct {
 	// Name 参数名称
@@ -122,6 +190,25 @@ type Param struct {
 	Value interface{} `json:"value"`
 }
 
+// revertSelector 合约中 revert("reason") 返回数据的方法签名 Error(string)
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// UnpackRevert 解析合约执行revert时返回的数据，得到错误原因
+func UnpackRevert(data []byte) (reason string, err error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], revertSelector) {
+		return reason, errors.New("invalid revert data")
+	}
+	typ, err := NewType("string")
+	if err != nil {
+		return reason, err
+	}
+	values, err := Arguments{{Type: typ}}.UnpackValues(data[4:])
+	if err != nil {
+		return reason, err
+	}
+	return values[0].(string), nil
+}
+
 func convertUint(val uint64, kind reflect.Kind) interface{} {
 	switch kind {
 	case reflect.Uint:
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,6 +82,55 @@ func TestABI_Unpack(t *testing.T) {
 	}
 }
 
+func TestABI_UnpackEvent(t *testing.T) {
+	abiData := `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"name":"key","type":"string"},{"indexed":false,"name":"value","type":"string"}],"name":"Set","type":"event"}]`
+
+	from := common.FromHex("0x000000000000000000000000245afbf176934ccdd7ca291a8dddaa13c8184822")
+	to := common.FromHex("0x0000000000000000000000000f7b661757fe8471c0b853b09bf526b19a9b3e04")
+	topics := [][]byte{
+		common.FromHex("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		from,
+		to,
+	}
+	data := common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000064")
+	name, output, err := UnpackEvent(topics, data, abiData)
+	assert.NoError(t, err)
+	assert.Equal(t, "Transfer", name)
+	expect := fmt.Sprintf(`[{"name":"from","type":"address","value":"%v"},{"name":"to","type":"address","value":"%v"},{"name":"value","type":"uint256","value":100}]`,
+		common.BytesToHash160Address(from).ToAddress().String(), common.BytesToHash160Address(to).ToAddress().String())
+	assert.Equal(t, expect, output)
+
+	// 变长类型的索引参数只返回哈希值
+	setTopics := [][]byte{
+		crypto.Keccak256([]byte("Set(string,string)")),
+		crypto.Keccak256([]byte("k")),
+	}
+	data = common.FromHex("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000017600000000000000000000000000000000000000000000000000000000000000")
+	name, output, err = UnpackEvent(setTopics, data, abiData)
+	assert.NoError(t, err)
+	assert.Equal(t, "Set", name)
+	assert.Equal(t, fmt.Sprintf(`[{"name":"key","type":"string","value":"%v"},{"name":"value","type":"string","value":"v"}]`, common.Bytes2Hex(setTopics[1])), output)
+
+	// 未定义的事件
+	_, _, err = UnpackEvent([][]byte{crypto.Keccak256([]byte("Unknown()"))}, nil, abiData)
+	assert.Error(t, err)
+	_, _, err = UnpackEvent(nil, nil, abiData)
+	assert.Error(t, err)
+}
+
+func TestABI_UnpackRevert(t *testing.T) {
+	// revert("not owner")
+	data := common.FromHex("0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000096e6f74206f776e65720000000000000000000000000000000000000000000000")
+	reason, err := UnpackRevert(data)
+	assert.NoError(t, err)
+	assert.Equal(t, "not owner", reason)
+
+	_, err = UnpackRevert(nil)
+	assert.Error(t, err)
+	_, err = UnpackRevert(common.FromHex("0x4e487b710000000000000000000000000000000000000000000000000000000000000001"))
+	assert.Error(t, err)
+}
+
 func TestProcFuncCall(t *testing.T) {
 	for _, test := range []struct {
 		input    string
@@ -0,0 +1,46 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 以太坊交易哈希到chain33交易哈希的索引，以太坊工具按以太坊交易哈希查询回执
+const ethTxHashPrefix = "LODB-evm-ethtx:"
+
+func ethTxHashKey(hash []byte) []byte {
+	return []byte(ethTxHashPrefix + common.Bytes2Hex(hash))
+}
+
+func (evm *EVMExecutor) checkEthTx(tx *types.Transaction) error {
+	if evmtypes.IsEthTx(tx) && !types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEthTx) {
+		return evmtypes.ErrEthTxNotEnabled
+	}
+	return nil
+}
+
+// 以太坊格式签名的交易建立以太坊交易哈希的索引，isDel为true时删除索引
+func (evm *EVMExecutor) ethTxHashKVs(tx *types.Transaction, isDel bool) []*types.KeyValue {
+	if !evmtypes.IsEthTx(tx) || !types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEthTx) {
+		return nil
+	}
+	kv := &types.KeyValue{Key: ethTxHashKey(evmtypes.EthTxHash(tx))}
+	if !isDel {
+		kv.Value = tx.Hash()
+	}
+	return []*types.KeyValue{kv}
+}
+
+// Query_GetEthTxHash 查询以太坊交易哈希对应的chain33交易哈希
+func (evm *EVMExecutor) Query_GetEthTxHash(in *types.ReqHash) (types.Message, error) {
+	hash, err := evm.GetLocalDB().Get(ethTxHashKey(in.GetHash()))
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyHash{Hash: hash}, nil
+}
@@ -0,0 +1,286 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 事件日志索引中，按合约地址、主题和区块高度分别建立索引
+	eventLogAddrPrefix   = "LODB-evm-log-addr:"
+	eventLogTopicPrefix  = "LODB-evm-log-topic"
+	eventLogHeightPrefix = "LODB-evm-log-height:"
+
+	// 事件主题最多有4个（LOG4）
+	maxEventTopics = 4
+	// 单次查询返回的最大日志条数，超过时返回cursor分页查询
+	maxFilterLogs = 1000
+	// 每次从localdb中读取的索引条数
+	eventLogListBatch = 100
+)
+
+// 索引key的后缀，保证同一前缀下的日志按照区块高度、交易序号和日志序号排序
+func eventLogSuffix(record *evmtypes.EVMEventLogRecord) string {
+	return fmt.Sprintf("%016d:%06d:%04d", record.Height, record.TxIndex, record.Log.Index)
+}
+
+func eventLogAddrKeyPrefix(addr string) string {
+	return fmt.Sprintf("%s%s:", eventLogAddrPrefix, addr)
+}
+
+func eventLogTopicKeyPrefix(pos int, topic string) string {
+	return fmt.Sprintf("%s%d:%s:", eventLogTopicPrefix, pos, topic)
+}
+
+// 一条事件日志对应的所有索引key
+func eventLogKeys(record *evmtypes.EVMEventLogRecord) (keys [][]byte) {
+	suffix := eventLogSuffix(record)
+	keys = append(keys, []byte(eventLogAddrKeyPrefix(record.Log.ContractAddr)+suffix))
+	for i, topic := range record.Log.Topics {
+		if i >= maxEventTopics {
+			break
+		}
+		keys = append(keys, []byte(eventLogTopicKeyPrefix(i, common.Bytes2Hex(topic))+suffix))
+	}
+	keys = append(keys, []byte(eventLogHeightPrefix+suffix))
+	return keys
+}
+
+// 从交易回执中解析合约事件日志，生成对应的localdb索引数据
+// isDel 为true时生成删除索引的数据，用于区块回滚
+func (evm *EVMExecutor) eventLogKVs(tx *types.Transaction, receipt *types.ReceiptData, index int, isDel bool) (kvs []*types.KeyValue, err error) {
+	for _, logItem := range receipt.Logs {
+		if evmtypes.TyLogEVMEventData != logItem.Ty {
+			continue
+		}
+		var eventLog evmtypes.EVMContractEventLog
+		err = types.Decode(logItem.Log, &eventLog)
+		if err != nil {
+			return nil, err
+		}
+		record := &evmtypes.EVMEventLogRecord{Log: &eventLog, Height: evm.GetHeight(), TxIndex: int32(index), TxHash: tx.Hash()}
+		var value []byte
+		if !isDel {
+			value = types.Encode(record)
+		}
+		for _, key := range eventLogKeys(record) {
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+		}
+	}
+	return kvs, nil
+}
+
+// 定位到start之前的最后一条索引, 没有时返回nil, 从它之后开始顺序遍历
+// start可以是不存在的key, 直接从start开始遍历会跳过第一条日志
+func (evm *EVMExecutor) seekEventLog(prefix, start string) ([]byte, error) {
+	values, err := evm.GetLocalDB().List([]byte(prefix), []byte(prefix+start), 1, dbm.ListSeek)
+	if err == types.ErrNotFound || len(values) == 0 {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if string(values[0]) > prefix+start {
+		return nil, nil
+	}
+	return values[0], nil
+}
+
+// 按前缀顺序遍历事件日志索引，返回start之后、toHeight之内满足过滤条件的日志
+func (evm *EVMExecutor) listEventLogs(prefix, start string, toHeight int64, count int, match func(*evmtypes.EVMEventLogRecord) bool) (records []*evmtypes.EVMEventLogRecord, err error) {
+	primary, err := evm.seekEventLog(prefix, start)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		values, err := evm.GetLocalDB().List([]byte(prefix), primary, eventLogListBatch, dbm.ListASC)
+		if err == types.ErrNotFound {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			var record evmtypes.EVMEventLogRecord
+			err = types.Decode(value, &record)
+			if err != nil {
+				return nil, err
+			}
+			if toHeight > 0 && record.Height > toHeight {
+				return records, nil
+			}
+			if match(&record) {
+				records = append(records, &record)
+				if len(records) >= count {
+					return records, nil
+				}
+			}
+			primary = []byte(prefix + eventLogSuffix(&record))
+		}
+		if len(values) < eventLogListBatch {
+			return records, nil
+		}
+	}
+}
+
+// 检查日志是否满足过滤条件
+func matchEventLog(record *evmtypes.EVMEventLogRecord, addrs map[string]bool, topics [][]string) bool {
+	if len(addrs) > 0 && !addrs[record.Log.ContractAddr] {
+		return false
+	}
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if i >= len(record.Log.Topics) {
+			return false
+		}
+		topic := common.Bytes2Hex(record.Log.Topics[i])
+		matched := false
+		for _, alt := range alternatives {
+			if alt == topic {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterLogs 按合约地址、主题和区块高度查询合约事件日志
+func (evm *EVMExecutor) FilterLogs(in *evmtypes.EvmFilterLogsReq) (*evmtypes.EvmFilterLogsResp, error) {
+	if in.FromHeight < 0 || (in.ToHeight > 0 && in.ToHeight < in.FromHeight) || len(in.Topics) > maxEventTopics {
+		return nil, types.ErrInvalidParam
+	}
+	count := int(in.Count)
+	if count <= 0 || count > maxFilterLogs {
+		count = maxFilterLogs
+	}
+
+	addrs := make(map[string]bool)
+	for _, addr := range in.Addresses {
+		if common.StringToAddress(addr) == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		addrs[addr] = true
+	}
+	// 主题统一转换为带0x前缀的小写十六进制格式
+	topics := make([][]string, len(in.Topics))
+	for i, filter := range in.Topics {
+		for _, topic := range filter.GetTopics() {
+			data, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(topic), "0x"))
+			if err != nil || len(data) != common.HashLength {
+				return nil, types.ErrInvalidParam
+			}
+			topics[i] = append(topics[i], common.Bytes2Hex(data))
+		}
+	}
+
+	// 选择区分度最高的索引：优先合约地址，其次第一个指定了取值的主题，最后按区块高度
+	var prefixes []string
+	if len(in.Addresses) > 0 {
+		for addr := range addrs {
+			prefixes = append(prefixes, eventLogAddrKeyPrefix(addr))
+		}
+	} else {
+		for i, alternatives := range topics {
+			if len(alternatives) == 0 {
+				continue
+			}
+			for _, topic := range alternatives {
+				prefixes = append(prefixes, eventLogTopicKeyPrefix(i, topic))
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = append(prefixes, eventLogHeightPrefix)
+	}
+
+	// 从fromHeight开始, 指定了cursor时从cursor之后开始
+	start := fmt.Sprintf("%016d", in.FromHeight)
+	if in.Cursor > start {
+		start = in.Cursor
+	}
+	match := func(record *evmtypes.EVMEventLogRecord) bool {
+		return matchEventLog(record, addrs, topics)
+	}
+	var records []*evmtypes.EVMEventLogRecord
+	seen := make(map[string]bool)
+	for _, prefix := range prefixes {
+		// 多取一条用来判断是否还有更多的日志
+		list, err := evm.listEventLogs(prefix, start, in.ToHeight, count+1, match)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range list {
+			suffix := eventLogSuffix(record)
+			if seen[suffix] {
+				continue
+			}
+			seen[suffix] = true
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return eventLogSuffix(records[i]) < eventLogSuffix(records[j])
+	})
+	resp := &evmtypes.EvmFilterLogsResp{}
+	if len(records) > count {
+		records = records[:count]
+		resp.Cursor = eventLogSuffix(records[count-1])
+	}
+	abis := make(map[string]string)
+	for _, record := range records {
+		resp.Logs = append(resp.Logs, evm.fmtEventLog(record, abis))
+	}
+	return resp, nil
+}
+
+// 格式化事件日志，合约绑定了ABI时解析事件参数
+func (evm *EVMExecutor) fmtEventLog(record *evmtypes.EVMEventLogRecord, abis map[string]string) *evmtypes.EvmLogItem {
+	item := &evmtypes.EvmLogItem{
+		Address:  record.Log.ContractAddr,
+		Data:     common.Bytes2Hex(record.Log.Data),
+		Height:   record.Height,
+		TxIndex:  record.TxIndex,
+		TxHash:   common.Bytes2Hex(record.TxHash),
+		LogIndex: record.Log.Index,
+	}
+	for _, topic := range record.Log.Topics {
+		item.Topics = append(item.Topics, common.Bytes2Hex(topic))
+	}
+
+	abiData, ok := abis[record.Log.ContractAddr]
+	if !ok {
+		abiData = evm.GetMStateDB().GetAbi(record.Log.ContractAddr)
+		abis[record.Log.ContractAddr] = abiData
+	}
+	if len(abiData) > 0 {
+		eventName, jsonData, err := abi.UnpackEvent(record.Log.Topics, record.Log.Data, abiData)
+		if err != nil {
+			// 解析失败不影响查询结果，只返回原始数据
+			log.Debug("unpack evm event error", "contract", record.Log.ContractAddr, "error", err)
+		} else {
+			item.EventName = eventName
+			item.JsonData = jsonData
+		}
+	}
+	return item
+}
@@ -30,6 +30,13 @@ var (
 
 var driverName = evmtypes.ExecutorName
 
+type subConfig struct {
+	// 跟踪交易时最多重放的同一区块中之前的交易数，未配置时使用默认值
+	TraceMaxReplayTxs int `json:"traceMaxReplayTxs"`
+}
+
+var cfg subConfig
+
 func init() {
 	ety := types.LoadExecutorType(driverName)
 	ety.InitFuncList(types.ListMethod(&EVMExecutor{}))
@@ -38,6 +45,9 @@ func init() {
 // Init 初始化本合约对象
 func Init(name string, sub []byte) {
 	driverName = name
+	if sub != nil {
+		types.MustDecode(sub, &cfg)
+	}
 	drivers.Register(driverName, newEVMDriver, types.GetDappFork(driverName, evmtypes.EVMEnable))
 	EvmAddress = address.ExecAddress(types.ExecName(name))
 	// 初始化硬分叉数据
@@ -135,9 +145,9 @@ func (evm *EVMExecutor) getNewAddr(txHash []byte) common.Address {
 	return common.NewAddress(txHash)
 }
 
-// CheckTx 校验交易
+// CheckTx 校验交易，ForkEVMEthTx之前不接受以太坊格式签名的交易
 func (evm *EVMExecutor) CheckTx(tx *types.Transaction, index int) error {
-	return nil
+	return evm.checkEthTx(tx)
 }
 
 // GetActionName 获取运行状态名
@@ -11,6 +11,7 @@ import (
 
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
+	ethcrypto "github.com/33cn/plugin/plugin/dapp/evm/crypto"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
@@ -22,6 +23,9 @@ import (
 // Exec 本合约执行逻辑
 func (evm *EVMExecutor) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
 	evm.CheckInit()
+	if err := evm.checkEthTx(tx); err != nil {
+		return nil, err
+	}
 	// 先转换消息
 	msg, err := evm.GetMessage(tx)
 	if err != nil {
@@ -136,6 +140,10 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	}
 	logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogCallContract, Log: types.Encode(contractReceipt)})
 	logs = append(logs, evm.mStateDB.GetReceiptLogs(contractAddr.String())...)
+	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		// 合约生成的事件日志写入回执，在localdb中建立索引后可按条件查询
+		logs = append(logs, evm.mStateDB.GetEventLogs()...)
+	}
 
 	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMKVHash) {
 		// 将执行时生成的合约状态数据变更信息也计算哈希并保存
@@ -230,9 +238,9 @@ func getDataHashKey(addr common.Address) []byte {
 	return []byte(fmt.Sprintf("mavl-%v-data-hash:%v", evmtypes.ExecutorName, addr))
 }
 
-// 从交易信息中获取交易发起人地址
+// 从交易信息中获取交易发起人地址，以太坊格式签名的交易为以太坊地址对应的chain33地址
 func getCaller(tx *types.Transaction) common.Address {
-	return *common.StringToAddress(tx.From())
+	return *common.StringToAddress(ethcrypto.TxFrom(tx))
 }
 
 // 从交易信息中获取交易目标地址，在创建合约交易中，此地址为空
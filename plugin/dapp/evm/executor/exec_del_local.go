@@ -15,6 +15,7 @@ func (evm *EVMExecutor) ExecDelLocal(tx *types.Transaction, receipt *types.Recei
 	if err != nil {
 		return nil, err
 	}
+	set.KV = append(set.KV, evm.ethTxHashKVs(tx, true)...)
 	if receipt.GetTy() != types.ExecOk {
 		return set, nil
 	}
@@ -33,6 +34,14 @@ func (evm *EVMExecutor) ExecDelLocal(tx *types.Transaction, receipt *types.Recei
 			}
 		}
 	}
+	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		// 删除合约事件日志索引
+		kvs, err := evm.eventLogKVs(tx, receipt, index, true)
+		if err != nil {
+			return set, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
 
 	return set, err
 }
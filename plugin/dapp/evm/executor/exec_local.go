@@ -17,6 +17,8 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 	if err != nil {
 		return nil, err
 	}
+	// 执行失败的以太坊交易也可以按以太坊交易哈希查询回执
+	set.KV = append(set.KV, evm.ethTxHashKVs(tx, false)...)
 	if receipt.GetTy() != types.ExecOk {
 		return set, nil
 	}
@@ -42,6 +44,14 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 			}
 		}
 	}
+	if types.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		// 为合约事件日志建立按合约地址、主题和区块高度的索引
+		kvs, err := evm.eventLogKVs(tx, receipt, index, false)
+		if err != nil {
+			return set, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
 
 	return set, err
 }
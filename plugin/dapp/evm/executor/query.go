@@ -184,3 +184,68 @@ func (evm *EVMExecutor) Query_QueryABI(in *evmtypes.EvmQueryAbiReq) (types.Messa
 
 	return &evmtypes.EvmQueryAbiResp{Address: in.GetAddress(), Abi: abiData}, nil
 }
+
+// Query_RawCall 使用原始字节数据调用合约，返回未经ABI解析的调用结果，不修改原有执行器的状态数据
+func (evm *EVMExecutor) Query_RawCall(in *evmtypes.EvmRawCallReq) (types.Message, error) {
+	evm.CheckInit()
+
+	to := common.StringToAddress(in.To)
+	if to == nil {
+		return nil, model.ErrAddrNotExists
+	}
+
+	// 如果未指定调用地址，则直接使用一个虚拟的地址发起调用
+	caller := common.ExecAddress(types.ExecName(evmtypes.ExecutorName))
+	if len(in.Caller) > 0 {
+		callAddr := common.StringToAddress(in.Caller)
+		if callAddr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		caller = *callAddr
+	}
+
+	msg := common.NewMessage(caller, to, 0, in.Amount, evmtypes.MaxGasLimit, 1, in.Data, "rawCall", "")
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+
+	receipt, err := evm.innerExec(msg, txHash, 1, evmtypes.MaxGasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+	ret := &evmtypes.EvmRawCallResp{}
+	if receipt != nil && receipt.Ty == types.ExecOk {
+		callData := getCallReceipt(receipt.GetLogs())
+		if callData != nil {
+			ret.Ret = callData.Ret
+			ret.UsedGas = callData.UsedGas
+		}
+	}
+	return ret, nil
+}
+
+// Query_GetCode 查询合约代码
+func (evm *EVMExecutor) Query_GetCode(in *evmtypes.EvmGetCodeReq) (types.Message, error) {
+	evm.CheckInit()
+
+	addr := common.StringToAddress(in.GetAddress())
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	return &evmtypes.EvmGetCodeResp{Address: in.GetAddress(), Code: evm.mStateDB.GetCode(addr.String())}, nil
+}
+
+// Query_GetBalance 查询地址在合约中可用的余额，合约地址返回合约账户余额，外部地址返回其coins余额
+func (evm *EVMExecutor) Query_GetBalance(in *evmtypes.EvmGetBalanceReq) (types.Message, error) {
+	evm.CheckInit()
+
+	addr := common.StringToAddress(in.GetAddress())
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	return &evmtypes.EvmGetBalanceResp{Address: in.GetAddress(), Balance: evm.mStateDB.GetBalance(addr.String())}, nil
+}
+
+// Query_FilterLogs 按合约地址、主题和区块高度查询合约事件日志，合约绑定了ABI时返回解析后的事件参数
+func (evm *EVMExecutor) Query_FilterLogs(in *evmtypes.EvmFilterLogsReq) (types.Message, error) {
+	evm.CheckInit()
+	return evm.FilterLogs(in)
+}
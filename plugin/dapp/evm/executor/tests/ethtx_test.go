@@ -0,0 +1,57 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ethcrypto "github.com/33cn/plugin/plugin/dapp/evm/crypto"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthTx(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	// 链ID为33的EIP-155签名交易
+	raw := common.FromHex("0xf86e078504a817c800830186a094143b825fde7a42043b2cedef6b4c6f57c04d71b78506fc23ac00846d4ce63c65a09d4dfcd7bb5e29dd19c4017b058fa4ee41e35ce2da307e7b6364a2747d424284a0641a7aadea0dec1e045b78028ade7905174e98610c6242c364fa95cd67b9c3c6")
+	tx, err := ethcrypto.DecodeTx(raw)
+	assert.Nil(t, err)
+	ethHash := evmtypes.EthTxHash(tx)
+	assert.NotEqual(t, tx.Hash(), ethHash)
+
+	inst := evm.NewEVMExecutor()
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+
+	// 分叉之前不接受以太坊格式签名的交易
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEthTx)
+	inst.SetEnv(height-1, 0, 0)
+	assert.Equal(t, evmtypes.ErrEthTxNotEnabled, inst.CheckTx(tx, 0))
+	_, err = inst.Exec(tx, 0)
+	assert.Equal(t, evmtypes.ErrEthTxNotEnabled, err)
+	inst.SetEnv(height, 0, 0)
+	assert.Nil(t, inst.CheckTx(tx, 0))
+
+	// 执行失败的交易也可以按以太坊交易哈希查到chain33交易哈希
+	receipt := &types.ReceiptData{Ty: types.ExecPack}
+	set, err := inst.ExecLocal(tx, receipt, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	reply, err := inst.Query_GetEthTxHash(&types.ReqHash{Hash: ethHash})
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash(), reply.(*types.ReplyHash).Hash)
+
+	// 回滚后删除索引
+	set, err = inst.ExecDelLocal(tx, receipt, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(set.KV))
+	assert.Nil(t, set.KV[0].Value)
+}
@@ -0,0 +1,158 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	crypto2 "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const storedAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"id","type":"uint256"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Stored","type":"event"}]`
+
+// 构造在构造函数中执行 emit Stored(id, 42) 的合约部署代码
+func storedDeployCode(id int64) []byte {
+	topic0 := crypto2.Keccak256([]byte("Stored(uint256,uint256)"))
+	topic1 := common.BigToHash(big.NewInt(id)).Bytes()
+	code := "602a600052" + "7f" + hex.EncodeToString(topic1) + "7f" + hex.EncodeToString(topic0) + "60206000a200"
+	return getBin(code)
+}
+
+// 在指定高度部署合约，并将执行结果和localdb索引写入数据库
+func deployStored(t *testing.T, kvdb dbm.KVDB, privKey crypto.PrivKey, height int64, id int64) (*types.Transaction, *types.ReceiptData, string) {
+	action := evmtypes.EVMContractAction{Code: storedDeployCode(id), Abi: storedAbi}
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&action), Fee: 1000000, Nonce: id, To: address.ExecAddress("evm")}
+	tx.Sign(types.SECP256K1, privKey)
+
+	inst := evm.NewEVMExecutor()
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+	inst.SetEnv(height, 0, 0)
+	receipt, err := inst.Exec(tx, 0)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+
+	var contractAddr string
+	for _, l := range receipt.Logs {
+		if l.Ty == evmtypes.TyLogCallContract {
+			var cr evmtypes.ReceiptEVMContract
+			types.Decode(l.Log, &cr)
+			contractAddr = cr.ContractAddr
+		}
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := inst.ExecLocal(tx, receiptData, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	return tx, receiptData, contractAddr
+}
+
+func filterLogs(t *testing.T, kvdb dbm.KVDB, height int64, req *evmtypes.EvmFilterLogsReq) []*evmtypes.EvmLogItem {
+	inst := evm.NewEVMExecutor()
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+	inst.SetEnv(height, 0, 0)
+	resp, err := inst.Query_FilterLogs(req)
+	assert.Nil(t, err)
+	return resp.(*evmtypes.EvmFilterLogsResp).Logs
+}
+
+func TestEventLogFilter(t *testing.T) {
+	_, ldb, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	_, _, addr1 := deployStored(t, kvdb, privKey, height, 7)
+	tx2, receipt2, addr2 := deployStored(t, kvdb, privKey, height+1, 8)
+	assert.NotEqual(t, addr1, addr2)
+
+	// 按合约地址查询，并使用ABI解析事件
+	logs := filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Addresses: []string{addr1}})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, height, logs[0].Height)
+	assert.Equal(t, "Stored", logs[0].EventName)
+	assert.Equal(t, `[{"name":"id","type":"uint256","value":7},{"name":"value","type":"uint256","value":42}]`, logs[0].JsonData)
+
+	// 按事件签名查询
+	topic0 := common.Bytes2Hex(crypto2.Keccak256([]byte("Stored(uint256,uint256)")))
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Topics: []*evmtypes.EvmTopicFilter{{Topics: []string{topic0}}}})
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, addr1, logs[0].Address)
+	assert.Equal(t, addr2, logs[1].Address)
+
+	// 按第二个主题查询
+	topic1 := common.BigToHash(big.NewInt(8)).Hex()
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Topics: []*evmtypes.EvmTopicFilter{{}, {Topics: []string{topic1}}}})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, addr2, logs[0].Address)
+
+	// 地址和主题同时指定
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Addresses: []string{addr1}, Topics: []*evmtypes.EvmTopicFilter{{}, {Topics: []string{topic1}}}})
+	assert.Equal(t, 0, len(logs))
+
+	// 按区块高度查询
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{FromHeight: height + 1, ToHeight: height + 1})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, addr2, logs[0].Address)
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Addresses: []string{addr1, addr2}, FromHeight: height})
+	assert.Equal(t, 2, len(logs))
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Count: 1})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, addr1, logs[0].Address)
+
+	// 超过count时返回cursor, 从cursor之后继续查询
+	inst := evm.NewEVMExecutor()
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+	inst.SetEnv(height+1, 0, 0)
+	req := &evmtypes.EvmFilterLogsReq{Addresses: []string{addr1, addr2}, Count: 1}
+	resp, err := inst.Query_FilterLogs(req)
+	assert.Nil(t, err)
+	page := resp.(*evmtypes.EvmFilterLogsResp)
+	assert.Equal(t, 1, len(page.Logs))
+	assert.Equal(t, addr1, page.Logs[0].Address)
+	assert.NotEqual(t, "", page.Cursor)
+	req.Cursor = page.Cursor
+	resp, err = inst.Query_FilterLogs(req)
+	assert.Nil(t, err)
+	page = resp.(*evmtypes.EvmFilterLogsResp)
+	assert.Equal(t, 1, len(page.Logs))
+	assert.Equal(t, addr2, page.Logs[0].Address)
+	assert.Equal(t, "", page.Cursor)
+
+	// 区块回滚后删除索引
+	set, err := inst.ExecDelLocal(tx2, receipt2, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			ldb.Delete(kv.Key)
+		} else {
+			kvdb.Set(kv.Key, kv.Value)
+		}
+	}
+	logs = filterLogs(t, kvdb, height+1, &evmtypes.EvmFilterLogsReq{Topics: []*evmtypes.EvmTopicFilter{{Topics: []string{topic0}}}})
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, addr1, logs[0].Address)
+
+	// 非法的主题
+	inst.CheckInit()
+	_, err = inst.Query_FilterLogs(&evmtypes.EvmFilterLogsReq{Topics: []*evmtypes.EvmTopicFilter{{Topics: []string{"0x01"}}}})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
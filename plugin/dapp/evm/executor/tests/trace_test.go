@@ -0,0 +1,321 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	// 重放区块中之前的交易时按执行器名称加载执行器
+	drivers.Register(transferDriverName, newTransferDriver, 0)
+	// 限制重放的交易数，测试超过上限的情况
+	evm.Init("evm", []byte(`{"traceMaxReplayTxs":1}`))
+}
+
+const transferDriverName = "tracetransfer"
+
+// 测试用的非evm执行器：从交易发起人转账1个币到交易的目标地址
+type transferDriver struct {
+	drivers.DriverBase
+}
+
+func newTransferDriver() drivers.Driver {
+	d := &transferDriver{}
+	d.SetChild(d)
+	return d
+}
+
+func (d *transferDriver) GetDriverName() string {
+	return transferDriverName
+}
+
+func (d *transferDriver) CheckTx(tx *types.Transaction, index int) error {
+	return nil
+}
+
+func (d *transferDriver) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
+	return d.GetCoinsAccount().Transfer(tx.From(), tx.To, types.Coin)
+}
+
+// 部署代码：把紧跟在后面的运行时代码作为合约代码返回
+func deployCodeOf(runtimeCode string) []byte {
+	return getBin(fmt.Sprintf("60%02x80600b6000396000f3", len(runtimeCode)/2) + runtimeCode)
+}
+
+// 部署合约，执行结果直接写入状态数据库，作为重放时父区块的状态
+func deployContract(t *testing.T, kvdb dbm.KVDB, privKey crypto.PrivKey, height int64, nonce int64, runtimeCode string) string {
+	action := evmtypes.EVMContractAction{Code: deployCodeOf(runtimeCode)}
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&action), Fee: 1000000, Nonce: nonce, To: address.ExecAddress("evm")}
+	tx.Sign(types.SECP256K1, privKey)
+
+	inst := evm.NewEVMExecutor()
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+	inst.SetEnv(height, 0, 0)
+	receipt, err := inst.Exec(tx, 0)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	for _, l := range receipt.Logs {
+		if l.Ty == evmtypes.TyLogCallContract {
+			var cr evmtypes.ReceiptEVMContract
+			types.Decode(l.Log, &cr)
+			return cr.ContractAddr
+		}
+	}
+	return ""
+}
+
+func callContractTx(privKey crypto.PrivKey, contract string, nonce int64) *types.Transaction {
+	action := evmtypes.EVMContractAction{}
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&action), Fee: 1000000, Nonce: nonce, To: contract}
+	tx.Sign(types.SECP256K1, privKey)
+	return tx
+}
+
+// 扣除了手续费的交易回执
+func feeReceipt(ty int32) *types.ReceiptData {
+	return &types.ReceiptData{Ty: ty, Logs: []*types.ReceiptLog{{Ty: types.TyLogFee}}}
+}
+
+// 构造重放需要的区块和交易查询，所有交易的回执类型相同
+func mockTraceAPI(kvdb dbm.KVDB, height int64, txs []*types.Transaction, receiptTy int32) *mocks.QueueProtocolAPI {
+	var receipts []*types.ReceiptData
+	for range txs {
+		receipts = append(receipts, feeReceipt(receiptTy))
+	}
+	return mockTraceBlockAPI(kvdb, height, txs, receipts)
+}
+
+// 构造重放需要的区块和交易查询，状态数据从kvdb中读取
+func mockTraceBlockAPI(kvdb dbm.KVDB, height int64, txs []*types.Transaction, receipts []*types.ReceiptData) *mocks.QueueProtocolAPI {
+	api := new(mocks.QueueProtocolAPI)
+	block := &types.Block{Height: height, Txs: txs}
+	for i, tx := range txs {
+		detail := &types.TransactionDetail{Tx: tx, Height: height, Index: int64(i), Receipt: receipts[i]}
+		api.On("QueryTx", &types.ReqHash{Hash: tx.Hash()}).Return(detail, nil)
+	}
+	api.On("GetBlocks", &types.ReqBlocks{Start: height, End: height, IsDetail: true}).Return(&types.BlockDetails{Items: []*types.BlockDetail{{Block: block, Receipts: receipts}}}, nil)
+	api.On("GetHeaders", &types.ReqBlocks{Start: height - 1, End: height - 1}).Return(&types.Headers{Items: []*types.Header{{Height: height - 1, StateHash: []byte("parent")}}}, nil)
+	reply := &types.StoreReplyValue{}
+	api.On("StoreGet", mock.Anything).Return(reply, nil).Run(func(args mock.Arguments) {
+		req := args.Get(0).(*types.StoreGet)
+		reply.Values = nil
+		for _, key := range req.Keys {
+			value, _ := kvdb.Get(key)
+			reply.Values = append(reply.Values, value)
+		}
+	})
+	return api
+}
+
+// 给账户在coins合约中充值，用于扣除重放时的手续费
+func fundAccount(kvdb dbm.KVDB, addr string, amount int64) {
+	coins := account.NewCoinsAccount()
+	coins.SetDB(kvdb)
+	coins.SaveAccount(&types.Account{Addr: addr, Balance: amount})
+}
+
+func traceTx(t *testing.T, api *mocks.QueueProtocolAPI, kvdb dbm.KVDB, req *evmtypes.EvmTraceTxReq) (*evmtypes.EvmTraceTxResp, error) {
+	inst := evm.NewEVMExecutor()
+	inst.SetAPI(api)
+	inst.SetStateDB(kvdb)
+	inst.SetLocalDB(kvdb)
+	resp, err := inst.Query_TraceTx(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*evmtypes.EvmTraceTxResp), nil
+}
+
+func TestTraceTx(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 合约B：返回7
+	addrB := deployContract(t, kvdb, privKey, height, 1, "600760005260206000f3")
+	// 合约A：slot1的值加1，然后通过STATICCALL调用合约B
+	hexB := hex.EncodeToString(common.StringToAddress(addrB).Bytes())
+	addrA := deployContract(t, kvdb, privKey, height, 2, "6001546001016001556020600060006000"+"73"+hexB+"61fffffa5000")
+	fundAccount(kvdb, address.PubKeyToAddr(privKey.PubKey().Bytes()), types.Coin)
+
+	// 同一区块中先执行的交易会先重放，被跟踪的交易执行前slot1为1
+	txs := []*types.Transaction{callContractTx(privKey, addrA, 3), callContractTx(privKey, addrA, 4)}
+	api := mockTraceAPI(kvdb, height+1, txs, types.ExecOk)
+	resp, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	assert.False(t, resp.Failed)
+	assert.Equal(t, height+1, resp.Height)
+	assert.Equal(t, int32(1), resp.Index)
+
+	assert.Equal(t, 1, len(resp.StorageDiffs))
+	assert.Equal(t, addrA, resp.StorageDiffs[0].Address)
+	assert.Equal(t, common.BytesToHash([]byte{1}).Hex(), resp.StorageDiffs[0].Key)
+	assert.Equal(t, common.BytesToHash([]byte{1}).Hex(), resp.StorageDiffs[0].Original)
+	assert.Equal(t, common.BytesToHash([]byte{2}).Hex(), resp.StorageDiffs[0].Current)
+
+	tree := resp.CallTree
+	assert.Equal(t, "CALL", tree.Type)
+	assert.Equal(t, addrA, tree.To)
+	assert.Equal(t, resp.GasUsed, tree.GasUsed)
+	assert.Equal(t, 1, len(tree.Calls))
+	assert.Equal(t, "STATICCALL", tree.Calls[0].Type)
+	assert.Equal(t, addrA, tree.Calls[0].From)
+	assert.Equal(t, addrB, tree.Calls[0].To)
+	assert.Equal(t, uint64(0xffff), tree.Calls[0].Gas)
+	assert.Equal(t, common.BytesToHash([]byte{7}).Hex(), tree.Calls[0].Output)
+	assert.True(t, tree.Calls[0].GasUsed > 0)
+	assert.True(t, tree.GasUsed > tree.Calls[0].GasUsed)
+
+	assert.False(t, resp.Truncated)
+	assert.Equal(t, "PUSH1", resp.Steps[0].Op)
+	assert.Equal(t, int32(1), resp.Steps[0].Depth)
+	assert.Equal(t, 0, len(resp.Steps[0].Stack))
+	assert.Equal(t, []string{"1"}, resp.Steps[1].Stack)
+	depth2 := 0
+	for _, step := range resp.Steps {
+		if step.Depth == 2 {
+			depth2++
+		}
+	}
+	assert.Equal(t, 6, depth2)
+
+	// 限制指令条数，不记录栈数据
+	resp, err = traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash()), StepLimit: 3, DisableStack: true})
+	assert.Nil(t, err)
+	assert.True(t, resp.Truncated)
+	assert.Equal(t, 3, len(resp.Steps))
+	assert.Nil(t, resp.Steps[1].Stack)
+	assert.Equal(t, 1, len(resp.StorageDiffs))
+}
+
+func TestTraceTxRevert(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 合约R：修改slot2后执行 revert("not owner")
+	reason := "08c379a0" + common.BytesToHash([]byte{0x20}).Hex()[2:] + common.BytesToHash([]byte{9}).Hex()[2:] + hex.EncodeToString([]byte("not owner")) + strings.Repeat("0", 46)
+	addrR := deployContract(t, kvdb, privKey, height, 1, "6001600255"+"60646011600039"+"60646000fd"+reason)
+	fundAccount(kvdb, address.PubKeyToAddr(privKey.PubKey().Bytes()), types.Coin)
+
+	txs := []*types.Transaction{callContractTx(privKey, addrR, 2)}
+	api := mockTraceAPI(kvdb, height+1, txs, types.ExecPack)
+	resp, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[0].Hash())})
+	assert.Nil(t, err)
+	assert.True(t, resp.Failed)
+	assert.Equal(t, "not owner", resp.RevertReason)
+	assert.Equal(t, "0x"+reason, resp.ReturnValue)
+	assert.Equal(t, resp.Error, resp.CallTree.Error)
+	// 被回滚的修改不出现在存储数据变化中
+	assert.Equal(t, 0, len(resp.StorageDiffs))
+	assert.Equal(t, "REVERT", resp.Steps[len(resp.Steps)-1].Op)
+
+	// 非法参数和非evm交易
+	_, err = traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: "0x01"})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	coinsTx := &types.Transaction{Execer: []byte("coins"), To: addrR}
+	api.On("QueryTx", &types.ReqHash{Hash: coinsTx.Hash()}).Return(&types.TransactionDetail{Tx: coinsTx, Height: height + 1}, nil)
+	_, err = traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(coinsTx.Hash())})
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
+
+func TestTraceTxReplayBlock(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	from := address.PubKeyToAddr(privKey.PubKey().Bytes())
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 合约C：返回调用者在coins合约中的余额
+	addrC := deployContract(t, kvdb, privKey, height, 1, "3331"+"60005260206000f3")
+	fundAccount(kvdb, from, 10*types.Coin)
+
+	// 之前的非evm转账交易和每个交易的手续费都要重放
+	transferTx := &types.Transaction{Execer: []byte(transferDriverName), Fee: 100000, Nonce: 2, To: addrC}
+	transferTx.Sign(types.SECP256K1, privKey)
+	txs := []*types.Transaction{transferTx, callContractTx(privKey, addrC, 3)}
+	api := mockTraceAPI(kvdb, height+1, txs, types.ExecOk)
+	resp, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	assert.False(t, resp.Failed)
+	balance := 10*types.Coin - types.Coin - transferTx.Fee - txs[1].Fee
+	assert.Equal(t, common.BytesToHash(big.NewInt(balance).Bytes()).Hex(), resp.ReturnValue)
+
+	// 执行失败的交易只扣除手续费
+	api = mockTraceBlockAPI(kvdb, height+1, txs, []*types.ReceiptData{feeReceipt(types.ExecPack), feeReceipt(types.ExecOk)})
+	resp, err = traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	balance = 10*types.Coin - transferTx.Fee - txs[1].Fee
+	assert.Equal(t, common.BytesToHash(big.NewInt(balance).Bytes()).Hex(), resp.ReturnValue)
+}
+
+func TestTraceTxReplayNonEVMState(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	from := address.PubKeyToAddr(privKey.PubKey().Bytes())
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 合约D：调用者在coins合约中的余额小于9.5个币时revert
+	threshold := hex.EncodeToString(big.NewInt(95 * types.Coin / 10).Bytes())
+	addrD := deployContract(t, kvdb, privKey, height, 1, "3331"+"67"+strings.Repeat("0", 16-len(threshold))+threshold+"11"+"601057"+"00"+"5b600080fd")
+	fundAccount(kvdb, from, 10*types.Coin)
+
+	// 之前的非evm交易转出1个币，被跟踪的交易执行时余额不足而revert
+	transferTx := &types.Transaction{Execer: []byte(transferDriverName), Fee: 100000, Nonce: 2, To: addrD}
+	transferTx.Sign(types.SECP256K1, privKey)
+	txs := []*types.Transaction{transferTx, callContractTx(privKey, addrD, 3)}
+	api := mockTraceBlockAPI(kvdb, height+1, txs, []*types.ReceiptData{feeReceipt(types.ExecOk), feeReceipt(types.ExecPack)})
+	resp, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	assert.True(t, resp.Failed)
+	assert.Equal(t, "REVERT", resp.Steps[len(resp.Steps)-1].Op)
+
+	// 转账交易执行失败时只扣除了手续费，被跟踪的交易执行成功
+	api = mockTraceBlockAPI(kvdb, height+1, txs, []*types.ReceiptData{feeReceipt(types.ExecPack), feeReceipt(types.ExecOk)})
+	resp, err = traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	assert.False(t, resp.Failed)
+	assert.Equal(t, "STOP", resp.Steps[len(resp.Steps)-1].Op)
+}
+
+func TestTraceTxReplayLimit(t *testing.T) {
+	_, _, kvdb := util.CreateTestDB()
+	privKey := getPrivKey()
+	height := types.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEventLog)
+
+	// 之前的交易数超过traceMaxReplayTxs时不重放
+	addrB := deployContract(t, kvdb, privKey, height, 1, "600760005260206000f3")
+	fundAccount(kvdb, address.PubKeyToAddr(privKey.PubKey().Bytes()), types.Coin)
+	txs := []*types.Transaction{callContractTx(privKey, addrB, 2), callContractTx(privKey, addrB, 3), callContractTx(privKey, addrB, 4)}
+	api := mockTraceAPI(kvdb, height+1, txs, types.ExecOk)
+	_, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[2].Hash())})
+	assert.Equal(t, evmtypes.ErrTraceReplayTxs, err)
+	api.AssertNotCalled(t, "StoreGet", mock.Anything)
+
+	resp, err := traceTx(t, api, kvdb, &evmtypes.EvmTraceTxReq{TxHash: common.Bytes2Hex(txs[1].Hash())})
+	assert.Nil(t, err)
+	assert.False(t, resp.Failed)
+}
@@ -0,0 +1,291 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 交易重放时默认记录的指令条数
+	defaultTraceSteps = 10000
+	// 交易重放时最多记录的指令条数
+	maxTraceSteps = 100000
+	// 交易重放时默认最多重放的同一区块中之前的交易数
+	defaultTraceReplayTxs = 100
+)
+
+// 交易重放使用的状态数据库，从指定状态哈希读取数据，写入的数据只保存在内存中
+type traceStateDB struct {
+	api       client.QueueProtocolAPI
+	stateHash []byte
+	cache     map[string][]byte
+	// 当前交易写入的数据，交易执行失败时丢弃
+	txcache map[string][]byte
+}
+
+func newTraceStateDB(api client.QueueProtocolAPI, stateHash []byte) *traceStateDB {
+	return &traceStateDB{api: api, stateHash: stateHash, cache: make(map[string][]byte)}
+}
+
+// Get 优先读取重放过程中写入的数据
+func (db *traceStateDB) Get(key []byte) ([]byte, error) {
+	value, ok := db.txcache[string(key)]
+	if !ok {
+		value, ok = db.cache[string(key)]
+	}
+	if !ok {
+		reply, err := db.api.StoreGet(&types.StoreGet{StateHash: db.stateHash, Keys: [][]byte{key}})
+		if err != nil {
+			return nil, err
+		}
+		if len(reply.Values) > 0 {
+			value = reply.Values[0]
+		}
+		db.cache[string(key)] = value
+	}
+	if value == nil {
+		return nil, types.ErrNotFound
+	}
+	return value, nil
+}
+
+// Set 只写入内存
+func (db *traceStateDB) Set(key []byte, value []byte) error {
+	if db.txcache != nil {
+		db.txcache[string(key)] = value
+		return nil
+	}
+	db.cache[string(key)] = value
+	return nil
+}
+
+// Begin 开始重放一个交易
+func (db *traceStateDB) Begin() {
+	db.txcache = make(map[string][]byte)
+}
+
+// Commit 保留交易写入的数据
+func (db *traceStateDB) Commit() error {
+	for k, v := range db.txcache {
+		db.cache[k] = v
+	}
+	db.txcache = nil
+	return nil
+}
+
+// Rollback 丢弃交易写入的数据
+func (db *traceStateDB) Rollback() {
+	db.txcache = nil
+}
+
+// 判断交易是否由evm执行器执行
+func isEVMTx(tx *types.Transaction) bool {
+	exec := types.GetParaExec(tx.Execer)
+	return bytes.Equal(exec, evmtypes.ExecerEvm) || bytes.HasPrefix(exec, evmtypes.UserPrefix)
+}
+
+// 创建在指定区块上下文中重放交易的执行器
+func (evm *EVMExecutor) newReplayExecutor(block *types.Block, stateDB *traceStateDB, vmCfg *runtime.Config) *EVMExecutor {
+	replay := NewEVMExecutor()
+	if vmCfg != nil {
+		replay.vmCfg = vmCfg
+	}
+	replay.SetAPI(evm.GetAPI())
+	replay.SetStateDB(stateDB)
+	replay.SetLocalDB(evm.GetLocalDB())
+	replay.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	replay.SetBlockInfo(block.ParentHash, block.MainHash, block.MainHeight)
+	replay.SetTxs(block.Txs)
+	replay.CheckInit()
+	return replay
+}
+
+// 交易在区块中是否扣除了手续费，扣除手续费的交易回执中有手续费日志
+func hasFeeLog(receipt *types.ReceiptData) bool {
+	for _, l := range receipt.GetLogs() {
+		if l.Ty == types.TyLogFee {
+			return true
+		}
+	}
+	return false
+}
+
+// 重放手续费的扣除，交易组的手续费在第一个交易中一起扣除
+func replayFee(stateDB *traceStateDB, tx *types.Transaction) error {
+	coins := account.NewCoinsAccount()
+	coins.SetDB(stateDB)
+	acc := coins.LoadAccount(tx.From())
+	if acc.GetBalance() < tx.Fee {
+		return types.ErrNoBalance
+	}
+	acc.Balance -= tx.Fee
+	coins.SaveKVSet(coins.GetKVSet(acc))
+	return nil
+}
+
+// 跟踪交易时最多重放的之前的交易数，每个交易读取的状态数据都要单独查询，需要限制一次查询的重放开销
+func traceReplayTxs() int {
+	if cfg.TraceMaxReplayTxs > 0 {
+		return cfg.TraceMaxReplayTxs
+	}
+	return defaultTraceReplayTxs
+}
+
+// 由交易对应的执行器重新执行交易，把执行结果写入状态数据库，执行器在同一区块中复用，和区块执行时一致
+// 状态数据读取父区块的状态，localdb只能使用当前的localdb，而不是该区块高度的localdb，
+// 在Exec中读取localdb的执行器重放结果可能和区块执行时不同
+func (evm *EVMExecutor) replayTx(block *types.Block, stateDB *traceStateDB, execs map[string]drivers.Driver, tx *types.Transaction, index int) error {
+	exec, ok := execs[string(tx.Execer)]
+	if !ok {
+		exec = drivers.LoadDriverAllow(tx, index, block.Height)
+		exec.SetStateDB(stateDB)
+		exec.SetLocalDB(evm.GetLocalDB())
+		exec.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+		exec.SetBlockInfo(block.ParentHash, block.MainHash, block.MainHeight)
+		exec.SetAPI(evm.GetAPI())
+		exec.SetExecutorAPI(evm.GetAPI(), nil)
+		exec.SetTxs(block.Txs)
+		execs[string(tx.Execer)] = exec
+	}
+	stateDB.Begin()
+	err := exec.CheckTx(tx, index)
+	var receipt *types.Receipt
+	if err == nil {
+		receipt, err = exec.Exec(tx, index)
+	}
+	if err != nil {
+		stateDB.Rollback()
+		return err
+	}
+	for _, kv := range receipt.GetKV() {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	return stateDB.Commit()
+}
+
+// Query_TraceTx 在交易所在区块的父区块状态上重新执行交易，返回指令执行步骤、调用树、存储数据变化和revert原因
+// 同一区块中排在该交易之前的所有交易（包括其它执行器的交易）按区块中的回执依次重放：
+// 扣除了手续费的交易先扣除手续费，执行成功的交易再由对应的执行器重新执行，被跟踪的交易执行前同样先扣除手续费
+// 之前的交易数超过配置的traceMaxReplayTxs时返回ErrTraceReplayTxs；重放使用当前的localdb，见replayTx
+func (evm *EVMExecutor) Query_TraceTx(in *evmtypes.EvmTraceTxReq) (types.Message, error) {
+	txHash := common.FromHex(in.TxHash)
+	if len(txHash) != common.HashLength || in.StepLimit < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	api := evm.GetAPI()
+	if api == nil {
+		return nil, types.ErrNotSupport
+	}
+	detail, err := api.QueryTx(&types.ReqHash{Hash: txHash})
+	if err != nil {
+		return nil, err
+	}
+	if !isEVMTx(detail.Tx) {
+		return nil, types.ErrActionNotSupport
+	}
+	if detail.Height <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+
+	blocks, err := api.GetBlocks(&types.ReqBlocks{Start: detail.Height, End: detail.Height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.Items) == 0 || blocks.Items[0].Block == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	block := blocks.Items[0].Block
+	receipts := blocks.Items[0].Receipts
+	if detail.Index < 0 || int(detail.Index) >= len(block.Txs) || !bytes.Equal(block.Txs[detail.Index].Hash(), txHash) {
+		return nil, types.ErrTxNotExist
+	}
+	if len(receipts) != len(block.Txs) {
+		return nil, types.ErrBlockNotFound
+	}
+	if int(detail.Index) > traceReplayTxs() {
+		return nil, evmtypes.ErrTraceReplayTxs
+	}
+	headers, err := api.GetHeaders(&types.ReqBlocks{Start: detail.Height - 1, End: detail.Height - 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.Items) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	stateDB := newTraceStateDB(api, headers.Items[0].StateHash)
+
+	// 依次重放之前的交易，回执为ExecErr的交易没有写入任何数据，ExecPack的交易只扣除了手续费
+	execs := make(map[string]drivers.Driver)
+	for i := 0; i < int(detail.Index); i++ {
+		tx := block.Txs[i]
+		if hasFeeLog(receipts[i]) {
+			if err := replayFee(stateDB, tx); err != nil {
+				return nil, err
+			}
+		}
+		if receipts[i].GetTy() != types.ExecOk {
+			continue
+		}
+		if err := evm.replayTx(block, stateDB, execs, tx, i); err != nil {
+			log.Error("evm trace replay tx", "index", i, "execer", string(tx.Execer), "err", err)
+			return nil, err
+		}
+	}
+	if hasFeeLog(receipts[detail.Index]) {
+		if err := replayFee(stateDB, detail.Tx); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := int(in.StepLimit)
+	if limit == 0 {
+		limit = defaultTraceSteps
+	} else if limit > maxTraceSteps {
+		limit = maxTraceSteps
+	}
+	tracer := runtime.NewStructTracer(in.DisableStack, in.EnableMemory, limit)
+	replay := evm.newReplayExecutor(block, stateDB, &runtime.Config{Debug: true, Tracer: tracer})
+	resp := &evmtypes.EvmTraceTxResp{TxHash: common.Bytes2Hex(txHash), Height: detail.Height, Index: int32(detail.Index)}
+	msg, err := replay.GetMessage(detail.Tx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = replay.innerExec(msg, txHash, int(detail.Index), detail.Tx.Fee, false)
+	if err != nil {
+		resp.Failed = true
+		resp.Error = err.Error()
+	}
+	if (err == nil) != (detail.Receipt.GetTy() == types.ExecOk) {
+		log.Info("evm trace result differs from receipt", "txHash", resp.TxHash, "receiptTy", detail.Receipt.GetTy(), "err", err)
+	}
+
+	output, vmerr := tracer.Output()
+	resp.ReturnValue = common.Bytes2Hex(output)
+	if vmerr == model.ErrExecutionReverted {
+		reason, err := abi.UnpackRevert(output)
+		if err == nil {
+			resp.RevertReason = reason
+		}
+	}
+	resp.Steps, resp.Truncated = tracer.Steps()
+	resp.CallTree = tracer.CallTree()
+	if resp.CallTree != nil {
+		resp.GasUsed = resp.CallTree.GasUsed
+	}
+	resp.StorageDiffs = tracer.StorageDiffs()
+	return resp, nil
+}
@@ -0,0 +1,198 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// StructTracer 将合约执行过程记录为结构化的跟踪数据，包括指令执行步骤、调用树和存储数据变化
+// 只在交易重放等调试场景下使用，每次执行创建一个新的实例
+type StructTracer struct {
+	// DisableStack 不记录栈数据
+	DisableStack bool
+	// EnableMemory 记录内存数据
+	EnableMemory bool
+	// Limit 最多记录的指令条数，为0时不限制
+	Limit int
+
+	steps     []*evmtypes.EvmTraceStep
+	truncated bool
+
+	root   *evmtypes.EvmCallFrame
+	frames []*evmtypes.EvmCallFrame
+
+	env *EVM
+	// 按照首次写入的顺序记录存储数据的原始值
+	storageKeys []storageKey
+	original    map[storageKey]common.Hash
+
+	output []byte
+	err    error
+}
+
+type storageKey struct {
+	addr string
+	key  common.Hash
+}
+
+// NewStructTracer 创建新的结构化跟踪器
+func NewStructTracer(disableStack, enableMemory bool, limit int) *StructTracer {
+	return &StructTracer{
+		DisableStack: disableStack,
+		EnableMemory: enableMemory,
+		Limit:        limit,
+		original:     make(map[storageKey]common.Hash),
+	}
+}
+
+func newCallFrame(typ string, from common.Address, to common.Address, input []byte, gas uint64, value uint64) *evmtypes.EvmCallFrame {
+	return &evmtypes.EvmCallFrame{
+		Type:  typ,
+		From:  from.String(),
+		To:    to.String(),
+		Value: value,
+		Gas:   gas,
+		Input: common.Bytes2Hex(input),
+	}
+}
+
+func finishCallFrame(frame *evmtypes.EvmCallFrame, output []byte, gasUsed uint64, err error) {
+	frame.Output = common.Bytes2Hex(output)
+	frame.GasUsed = gasUsed
+	if err != nil {
+		frame.Error = err.Error()
+	}
+}
+
+// CaptureStart 记录外部调用（调用树的根节点）
+func (t *StructTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.root = newCallFrame(typ.String(), from, to, input, gas, value)
+	t.frames = []*evmtypes.EvmCallFrame{t.root}
+	return nil
+}
+
+// CaptureState 记录指令执行前的状态，SSTORE指令执行前记录存储数据的原始值
+func (t *StructTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	t.env = env
+	if op == SSTORE && stack.Len() >= 1 {
+		sk := storageKey{addr: contract.Address().String(), key: common.BigToHash(stack.Back(0))}
+		if _, ok := t.original[sk]; !ok {
+			t.original[sk] = env.StateDB.GetState(sk.addr, sk.key)
+			t.storageKeys = append(t.storageKeys, sk)
+		}
+	}
+
+	if t.Limit > 0 && len(t.steps) >= t.Limit {
+		t.truncated = true
+		return nil
+	}
+	step := &evmtypes.EvmTraceStep{
+		Pc:      pc,
+		Op:      op.String(),
+		Gas:     gas,
+		GasCost: cost,
+		Depth:   int32(depth),
+	}
+	if !t.DisableStack {
+		step.Stack = formatStack(stack.Data())
+	}
+	if t.EnableMemory {
+		step.Memory = formatMemory(memory.Data())
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	t.steps = append(t.steps, step)
+	return nil
+}
+
+// CaptureFault 指令执行出错时，在最后一条指令上记录错误信息
+func (t *StructTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, contract *Contract, depth int, err error) error {
+	if t.truncated || len(t.steps) == 0 || err == nil {
+		return nil
+	}
+	last := t.steps[len(t.steps)-1]
+	if last.Pc == pc && last.Depth == int32(depth) {
+		last.Error = err.Error()
+	}
+	return nil
+}
+
+// CaptureEnter 合约内部调用时在调用树中增加新的节点
+func (t *StructTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) error {
+	frame := newCallFrame(typ.String(), from, to, input, gas, value)
+	if len(t.frames) > 0 {
+		parent := t.frames[len(t.frames)-1]
+		parent.Calls = append(parent.Calls, frame)
+	}
+	t.frames = append(t.frames, frame)
+	return nil
+}
+
+// CaptureExit 合约内部调用结束，记录返回数据和消耗的Gas
+func (t *StructTracer) CaptureExit(output []byte, gasUsed uint64, err error) error {
+	if len(t.frames) <= 1 {
+		return nil
+	}
+	finishCallFrame(t.frames[len(t.frames)-1], output, gasUsed, err)
+	t.frames = t.frames[:len(t.frames)-1]
+	return nil
+}
+
+// CaptureEnd 外部调用结束
+func (t *StructTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	t.output = output
+	t.err = err
+	if t.root != nil {
+		finishCallFrame(t.root, output, gasUsed, err)
+	}
+	t.frames = nil
+	return nil
+}
+
+// Steps 返回记录的指令执行步骤，以及是否因为超过条数限制而被截断
+func (t *StructTracer) Steps() ([]*evmtypes.EvmTraceStep, bool) {
+	return t.steps, t.truncated
+}
+
+// CallTree 返回调用树
+func (t *StructTracer) CallTree() *evmtypes.EvmCallFrame {
+	return t.root
+}
+
+// Output 返回外部调用的返回数据和错误信息
+func (t *StructTracer) Output() ([]byte, error) {
+	return t.output, t.err
+}
+
+// StorageDiffs 返回执行前后发生变化的存储数据，需要在执行结束后调用，被回滚的修改不会出现在结果中
+func (t *StructTracer) StorageDiffs() (diffs []*evmtypes.EvmStorageDiff) {
+	if t.env == nil {
+		return nil
+	}
+	for _, sk := range t.storageKeys {
+		original := t.original[sk]
+		current := t.env.StateDB.GetState(sk.addr, sk.key)
+		if original == current {
+			continue
+		}
+		diffs = append(diffs, &evmtypes.EvmStorageDiff{
+			Address:  sk.addr,
+			Key:      sk.key.Hex(),
+			Original: original.Hex(),
+			Current:  current.Hex(),
+		})
+	}
+	return diffs
+}
@@ -693,6 +693,18 @@ func (mdb *MemoryStateDB) AddLog(log *model.ContractLog) {
 	mdb.logSize++
 }
 
+// GetEventLogs 获取当前交易中合约生成的事件日志，转换为回执日志
+func (mdb *MemoryStateDB) GetEventLogs() (logs []*types.ReceiptLog) {
+	for i, item := range mdb.logs[mdb.txHash] {
+		eventLog := &evmtypes.EVMContractEventLog{ContractAddr: item.Address.String(), Data: item.Data, Index: int32(i)}
+		for _, topic := range item.Topics {
+			eventLog.Topics = append(eventLog.Topics, topic.Bytes())
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(eventLog)})
+	}
+	return
+}
+
 // AddPreimage 存储sha3指令对应的数据
 func (mdb *MemoryStateDB) AddPreimage(hash common.Hash, data []byte) {
 	// 目前只用于打印日志
@@ -7,6 +7,7 @@ package evm
 import (
 	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/plugin/plugin/dapp/evm/commands"
+	_ "github.com/33cn/plugin/plugin/dapp/evm/crypto" // register crypto package
 	"github.com/33cn/plugin/plugin/dapp/evm/executor"
 	"github.com/33cn/plugin/plugin/dapp/evm/rpc"
 	"github.com/33cn/plugin/plugin/dapp/evm/types"
@@ -0,0 +1,256 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 以太坊兼容的jsonrpc服务，把标准的eth_*方法映射到evm执行器的查询和交易上
+//
+// 地址转换：以太坊地址为chain33地址中Hash160部分的十六进制表示（0x开头，20字节），
+// 输入时同时兼容chain33格式的地址，输出统一为以太坊格式的地址，
+// 以太坊格式签名交易在合约中的调用者就是以太坊钱包显示的地址按此规则对应的chain33地址，
+// 手续费由同一私钥在chain33钱包中的地址支付
+// 链ID：eth_chainId和net_version返回EthChainID，以太坊格式交易的签名中也必须包含该链ID
+// 金额：余额和调用金额以wei表示，1个chain33最小单位（1e-8）等于1e10 wei，输入的金额必须是1e10 wei的整数倍
+// 区块参数：只保存了最新的状态，状态查询的区块参数只能是最新区块，其它区块返回错误
+// 交易：eth_sendRawTransaction接受EIP-155签名的以太坊交易和已签名的chain33交易，
+// 以太坊交易的哈希为以太坊交易哈希，回执和日志中也使用以太坊交易哈希，其它交易为chain33交易哈希
+// 跨域：只有配置项ethRpcCorsDomain中的域名可以从浏览器访问，配置为"*"时允许所有域名
+
+var elog = log.New("module", "evm.ethrpc")
+
+var (
+	// 当前运行的以太坊jsonrpc服务
+	ethSrvMu sync.Mutex
+	ethSrv   *ethServer
+)
+
+const (
+	ethErrParse          = -32700
+	ethErrInvalidRequest = -32600
+	ethErrMethodNotFound = -32601
+	ethErrInvalidParams  = -32602
+	ethErrServer         = -32000
+
+	// 单个请求体的最大长度
+	maxEthRequestSize = 5 * 1024 * 1024
+)
+
+type ethRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type ethError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type ethResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *ethError       `json:"error,omitempty"`
+}
+
+// 参数错误，返回-32602
+type ethParamError struct {
+	msg string
+}
+
+func (e *ethParamError) Error() string {
+	return e.msg
+}
+
+func newParamError(msg string) error {
+	return &ethParamError{msg: msg}
+}
+
+type ethServer struct {
+	api     *ethAPI
+	methods map[string]func(json.RawMessage) (interface{}, error)
+	// 允许跨域访问的域名
+	corsDomains []string
+
+	mu     sync.Mutex
+	server *http.Server
+}
+
+func newEthServer(api *ethAPI, corsDomains []string) *ethServer {
+	s := &ethServer{api: api, corsDomains: corsDomains}
+	s.methods = map[string]func(json.RawMessage) (interface{}, error){
+		"eth_chainId":               api.getChainID,
+		"net_version":               api.netVersion,
+		"eth_blockNumber":           api.blockNumber,
+		"eth_getBalance":            api.getBalance,
+		"eth_getCode":               api.getCode,
+		"eth_call":                  api.call,
+		"eth_estimateGas":           api.estimateGas,
+		"eth_getTransactionReceipt": api.getTransactionReceipt,
+		"eth_getLogs":               api.getLogs,
+		"eth_sendRawTransaction":    api.sendRawTransaction,
+	}
+	return s
+}
+
+// 根据配置启动以太坊兼容的jsonrpc服务，未配置监听地址时不启动
+// rpc模块没有插件关闭的通知，服务随进程退出，同一进程中重新初始化rpc模块时先关闭之前启动的服务，
+// 嵌入chain33的程序需要单独关闭时调用CloseEthServer
+func startEthServer(name string, cli *channelClient) {
+	cfg := types.ConfSub(name)
+	bindAddr := cfg.GStr("ethRpcBindAddr")
+	if bindAddr == "" {
+		return
+	}
+	chainID := int64(evmtypes.EthChainID)
+	s := newEthServer(&ethAPI{cli: cli, execName: types.ExecName(evmtypes.ExecutorName), chainID: chainID}, cfg.GStrList("ethRpcCorsDomain"))
+	server := &http.Server{Addr: bindAddr, Handler: s}
+	s.server = server
+
+	ethSrvMu.Lock()
+	defer ethSrvMu.Unlock()
+	if ethSrv != nil {
+		ethSrv.close()
+	}
+	ethSrv = s
+	go func() {
+		elog.Info("start eth jsonrpc server", "addr", bindAddr, "chainID", chainID)
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			elog.Error("eth jsonrpc server stopped", "err", err)
+		}
+	}()
+}
+
+// CloseEthServer 关闭以太坊兼容的jsonrpc服务
+func CloseEthServer() {
+	ethSrvMu.Lock()
+	defer ethSrvMu.Unlock()
+	if ethSrv == nil {
+		return
+	}
+	ethSrv.close()
+	ethSrv = nil
+}
+
+func (s *ethServer) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.server == nil {
+		return
+	}
+	if err := s.server.Close(); err != nil {
+		elog.Error("close eth jsonrpc server", "err", err)
+	}
+	s.server = nil
+	elog.Info("eth jsonrpc server closed")
+}
+
+// 请求来源在允许的域名中时返回跨域响应头中的域名
+func (s *ethServer) allowOrigin(origin string) string {
+	if origin == "" {
+		return ""
+	}
+	for _, domain := range s.corsDomains {
+		if domain == "*" {
+			return "*"
+		}
+		if strings.EqualFold(domain, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// ServeHTTP 处理单个或批量的jsonrpc请求
+func (s *ethServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	allowed := s.allowOrigin(origin)
+	if allowed != "" {
+		w.Header().Set("Access-Control-Allow-Origin", allowed)
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Vary", "Origin")
+	}
+	if r.Method == http.MethodOptions {
+		if origin != "" && allowed == "" {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+		}
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxEthRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.handle(body))
+}
+
+func (s *ethServer) handle(body []byte) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return errorResponse(nil, ethErrParse, err.Error())
+		}
+		if len(reqs) == 0 {
+			return errorResponse(nil, ethErrInvalidRequest, "empty batch")
+		}
+		resps := make([]*ethResponse, 0, len(reqs))
+		for _, req := range reqs {
+			resps = append(resps, s.handleOne(req))
+		}
+		return resps
+	}
+	return s.handleOne(body)
+}
+
+func (s *ethServer) handleOne(body []byte) *ethResponse {
+	var req ethRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return errorResponse(nil, ethErrParse, err.Error())
+	}
+	if req.Method == "" {
+		return errorResponse(req.ID, ethErrInvalidRequest, "method not specified")
+	}
+	method, ok := s.methods[req.Method]
+	if !ok {
+		return errorResponse(req.ID, ethErrMethodNotFound, "the method "+req.Method+" does not exist/is not available")
+	}
+	result, err := method(req.Params)
+	if err != nil {
+		elog.Debug("eth jsonrpc call", "method", req.Method, "err", err)
+		if _, ok := err.(*ethParamError); ok {
+			return errorResponse(req.ID, ethErrInvalidParams, err.Error())
+		}
+		return errorResponse(req.ID, ethErrServer, err.Error())
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(req.ID, ethErrServer, err.Error())
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: req.ID, Result: data}
+}
+
+func errorResponse(id json.RawMessage, code int, msg string) *ethResponse {
+	return &ethResponse{JSONRPC: "2.0", ID: id, Error: &ethError{Code: code, Message: msg}}
+}
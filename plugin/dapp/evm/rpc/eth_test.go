@@ -0,0 +1,302 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	ethcrypto "github.com/33cn/plugin/plugin/dapp/evm/crypto"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	ethTestAddr    = "12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv"
+	ethTestHexAddr = "0x143b825fde7a42043b2cedef6b4c6f57c04d71b7"
+)
+
+func newTestEthServer() (*ethServer, *mocks.QueueProtocolAPI) {
+	api := new(mocks.QueueProtocolAPI)
+	cli := &channelClient{ChannelClient: rpctypes.ChannelClient{QueueProtocolAPI: api}}
+	return newEthServer(&ethAPI{cli: cli, execName: "evm", chainID: evmtypes.EthChainID}, []string{"http://localhost:8080"}), api
+}
+
+// 调用单个方法，返回结果或错误
+func ethCall(t *testing.T, s *ethServer, method string, params ...interface{}) (json.RawMessage, *ethError) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	assert.Nil(t, err)
+	resp := s.handle(body).(*ethResponse)
+	assert.Equal(t, "1", string(resp.ID))
+	return resp.Result, resp.Error
+}
+
+func TestEthAddressConvert(t *testing.T) {
+	hexAddr, err := toEthAddress(ethTestAddr)
+	assert.Nil(t, err)
+	addr, err := fromEthAddress(hexAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, ethTestAddr, addr)
+	assert.Equal(t, ethTestHexAddr, strings.ToLower(hexAddr))
+
+	addr, err = fromEthAddress(ethTestAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, ethTestAddr, addr)
+	_, err = fromEthAddress("0x1234")
+	assert.NotNil(t, err)
+}
+
+func TestEthServer(t *testing.T) {
+	s, api := newTestEthServer()
+
+	result, e := ethCall(t, s, "eth_chainId")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x21"`, string(result))
+	result, e = ethCall(t, s, "net_version")
+	assert.Nil(t, e)
+	assert.Equal(t, `"33"`, string(result))
+
+	_, e = ethCall(t, s, "eth_mining")
+	assert.Equal(t, ethErrMethodNotFound, e.Code)
+
+	api.On("GetLastHeader").Return(&types.Header{Height: 100}, nil)
+	result, e = ethCall(t, s, "eth_blockNumber")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x64"`, string(result))
+
+	// 以太坊格式的地址转换为chain33地址后查询
+	api.On("Query", "evm", "GetCode", &evmtypes.EvmGetCodeReq{Address: ethTestAddr}).Return(&evmtypes.EvmGetCodeResp{Code: []byte{0x60, 0x80}}, nil)
+	result, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, "latest")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x6080"`, string(result))
+	_, e = ethCall(t, s, "eth_getCode")
+	assert.Equal(t, ethErrInvalidParams, e.Code)
+
+	// 区块参数只能是最新区块
+	result, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, "0x64")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x6080"`, string(result))
+	_, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, map[string]string{"blockNumber": "0x64"})
+	assert.Nil(t, e)
+	_, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, "0x10")
+	assert.Equal(t, ErrEthBlockState.Error(), e.Message)
+	_, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, "earliest")
+	assert.Equal(t, ErrEthBlockState.Error(), e.Message)
+	_, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, "0xzz")
+	assert.Equal(t, ethErrInvalidParams, e.Code)
+	api.On("GetBlockOverview", &types.ReqHash{Hash: []byte{0xab}}).Return(&types.BlockOverview{Head: &types.Header{Height: 99}}, nil)
+	_, e = ethCall(t, s, "eth_getCode", ethTestHexAddr, map[string]string{"blockHash": "0xab"})
+	assert.Equal(t, ErrEthBlockState.Error(), e.Message)
+
+	api.On("Query", "evm", "GetBalance", &evmtypes.EvmGetBalanceReq{Address: ethTestAddr}).Return(&evmtypes.EvmGetBalanceResp{Balance: 1000}, nil)
+	// 余额以wei表示
+	result, e = ethCall(t, s, "eth_getBalance", ethTestHexAddr, "latest")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x9184e72a000"`, string(result))
+	_, e = ethCall(t, s, "eth_getBalance", ethTestHexAddr, "0x63")
+	assert.Equal(t, ErrEthBlockState.Error(), e.Message)
+
+	api.On("Query", "evm", "RawCall", &evmtypes.EvmRawCallReq{To: ethTestAddr, Data: []byte{0x6d, 0x4c, 0xe6, 0x3c}}).Return(&evmtypes.EvmRawCallResp{Ret: []byte{0x01}}, nil)
+	result, e = ethCall(t, s, "eth_call", map[string]string{"to": ethTestHexAddr, "data": "0x6d4ce63c"}, "latest")
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x01"`, string(result))
+	_, e = ethCall(t, s, "eth_call", map[string]string{"data": "0x6d4ce63c"})
+	assert.Equal(t, ethErrInvalidParams, e.Code)
+	_, e = ethCall(t, s, "eth_call", map[string]string{"to": ethTestHexAddr, "data": "0x6d4ce63c"}, "0x1")
+	assert.Equal(t, ErrEthBlockState.Error(), e.Message)
+
+	// 调用金额以wei表示，必须是1e10 wei的整数倍
+	api.On("Query", "evm", "RawCall", &evmtypes.EvmRawCallReq{To: ethTestAddr, Data: []byte{0x6d, 0x4c, 0xe6, 0x3c}, Amount: 2}).Return(&evmtypes.EvmRawCallResp{Ret: []byte{0x02}}, nil)
+	result, e = ethCall(t, s, "eth_call", map[string]string{"to": ethTestHexAddr, "data": "0x6d4ce63c", "value": "0x4a817c800"})
+	assert.Nil(t, e)
+	assert.Equal(t, `"0x02"`, string(result))
+	_, e = ethCall(t, s, "eth_call", map[string]string{"to": ethTestHexAddr, "data": "0x6d4ce63c", "value": "0x1"})
+	assert.Equal(t, ethErrInvalidParams, e.Code)
+
+	api.On("Query", "evm", "EstimateGas", &evmtypes.EstimateEVMGasReq{Code: []byte{0x60}, Caller: ethTestAddr}).Return(&evmtypes.EstimateEVMGasResp{Gas: 53000}, nil)
+	result, e = ethCall(t, s, "eth_estimateGas", map[string]string{"from": ethTestHexAddr, "data": "0x60"})
+	assert.Nil(t, e)
+	assert.Equal(t, `"0xcf08"`, string(result))
+
+	// 批量请求
+	resps := s.handle([]byte(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}]`)).([]*ethResponse)
+	assert.Equal(t, 2, len(resps))
+	assert.Equal(t, `"0x64"`, string(resps[1].Result))
+}
+
+func TestEthReceiptAndLogs(t *testing.T) {
+	s, api := newTestEthServer()
+
+	c, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	from := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+	contract := address.ExecAddress("user.evm.0x01")
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&evmtypes.EVMContractAction{}), Fee: 100000, To: address.ExecAddress("evm")}
+	tx.Sign(types.SECP256K1, priv)
+
+	topic := make([]byte, 32)
+	topic[31] = 1
+	eventLog := &evmtypes.EVMContractEventLog{ContractAddr: contract, Topics: [][]byte{topic}, Data: []byte{0x2a}}
+	receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+		{Ty: evmtypes.TyLogCallContract, Log: types.Encode(&evmtypes.ReceiptEVMContract{Caller: from, ContractName: "user.evm.0x01", ContractAddr: contract, UsedGas: 2100})},
+		{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(eventLog)},
+	}}
+	api.On("QueryTx", &types.ReqHash{Hash: tx.Hash()}).Return(&types.TransactionDetail{Tx: tx, Receipt: receipt, Height: 10, Index: 1, Fromaddr: from}, nil)
+	api.On("QueryTx", mock.Anything).Return(nil, types.ErrTxNotExist)
+	api.On("Query", "evm", "GetEthTxHash", mock.Anything).Return(nil, types.ErrNotFound)
+	api.On("GetBlockHash", &types.ReqInt{Height: 10}).Return(&types.ReplyHash{Hash: []byte{0xab}}, nil)
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(&types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}, nil)
+
+	result, e := ethCall(t, s, "eth_getTransactionReceipt", encodeHexData(tx.Hash()))
+	assert.Nil(t, e)
+	var r ethReceipt
+	assert.Nil(t, json.Unmarshal(result, &r))
+	contractHex, _ := toEthAddress(contract)
+	fromHex, _ := toEthAddress(from)
+	assert.Equal(t, "0x1", r.Status)
+	assert.Equal(t, "0xa", r.BlockNumber)
+	assert.Equal(t, "0xab", r.BlockHash)
+	assert.Equal(t, "0x834", r.GasUsed)
+	assert.Equal(t, fromHex, r.From)
+	assert.Nil(t, r.To)
+	assert.Equal(t, contractHex, *r.ContractAddress)
+	assert.Equal(t, 1, len(r.Logs))
+	assert.Equal(t, encodeHexData(topic), r.Logs[0].Topics[0])
+	assert.Equal(t, "0x2a", r.Logs[0].Data)
+
+	result, e = ethCall(t, s, "eth_getTransactionReceipt", "0x01")
+	assert.Nil(t, e)
+	assert.Equal(t, "null", string(result))
+
+	// 日志过滤参数转换
+	api.On("GetLastHeader").Return(&types.Header{Height: 20}, nil)
+	req := &evmtypes.EvmFilterLogsReq{Addresses: []string{contract}, Topics: []*evmtypes.EvmTopicFilter{{}, {Topics: []string{encodeHexData(topic)}}}, FromHeight: 5, ToHeight: 20}
+	api.On("Query", "evm", "FilterLogs", req).Return(&evmtypes.EvmFilterLogsResp{Logs: []*evmtypes.EvmLogItem{
+		{Address: contract, Topics: []string{encodeHexData(topic)}, Data: "0x2a", Height: 10, TxIndex: 1, TxHash: encodeHexData(tx.Hash())},
+	}}, nil)
+	filter := map[string]interface{}{"fromBlock": "0x5", "address": contractHex, "topics": []interface{}{nil, encodeHexData(topic)}}
+	result, e = ethCall(t, s, "eth_getLogs", filter)
+	assert.Nil(t, e)
+	var logs []*ethLog
+	assert.Nil(t, json.Unmarshal(result, &logs))
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, contractHex, logs[0].Address)
+	assert.Equal(t, "0xab", logs[0].BlockHash)
+	assert.Equal(t, "0x1", logs[0].TransactionIndex)
+	assert.Equal(t, encodeHexData(tx.Hash()), logs[0].TransactionHash)
+
+	// chain33签名交易
+	sameTx := mock.MatchedBy(func(in *types.Transaction) bool { return bytes.Equal(in.Hash(), tx.Hash()) })
+	api.On("SendTx", sameTx).Return(&types.Reply{IsOk: true, Msg: tx.Hash()}, nil)
+	result, e = ethCall(t, s, "eth_sendRawTransaction", encodeHexData(types.Encode(tx)))
+	assert.Nil(t, e)
+	assert.Equal(t, `"`+encodeHexData(tx.Hash())+`"`, string(result))
+	_, e = ethCall(t, s, "eth_sendRawTransaction", "0x0a03")
+	assert.Equal(t, ethErrServer, e.Code)
+	assert.Equal(t, ErrEthRawTx.Error(), e.Message)
+	_, e = ethCall(t, s, "eth_sendRawTransaction", "0xf86b80")
+	assert.Equal(t, ethcrypto.ErrEthTx.Error(), e.Message)
+}
+
+func TestEthSendRawTransaction(t *testing.T) {
+	s, api := newTestEthServer()
+
+	// 链ID为33，私钥4c0883a6...签名的以太坊交易
+	raw := "0xf86e078504a817c800830186a094143b825fde7a42043b2cedef6b4c6f57c04d71b78506fc23ac00846d4ce63c65a09d4dfcd7bb5e29dd19c4017b058fa4ee41e35ce2da307e7b6364a2747d424284a0641a7aadea0dec1e045b78028ade7905174e98610c6242c364fa95cd67b9c3c6"
+	d := sha3.NewLegacyKeccak256()
+	d.Write(common.FromHex(raw))
+	ethHash := encodeHexData(d.Sum(nil))
+	// 发起人为该私钥在以太坊钱包中的地址
+	from, err := fromEthAddress("0x2c7536e3605d9c16a7a3d7b1898e529396a65c23")
+	assert.Nil(t, err)
+	var sent *types.Transaction
+	ethTx := mock.MatchedBy(func(tx *types.Transaction) bool {
+		sent = tx
+		return tx.CheckSign() && ethcrypto.TxFrom(tx) == from &&
+			tx.To == ethTestAddr && tx.Fee == 200000
+	})
+	api.On("SendTx", ethTx).Return(&types.Reply{IsOk: true}, nil)
+	result, e := ethCall(t, s, "eth_sendRawTransaction", raw)
+	assert.Nil(t, e)
+	assert.Equal(t, `"`+ethHash+`"`, string(result))
+
+	// 按以太坊交易哈希查询回执
+	api.On("Query", "evm", "GetEthTxHash", &types.ReqHash{Hash: common.FromHex(ethHash)}).Return(&types.ReplyHash{Hash: sent.Hash()}, nil)
+	api.On("QueryTx", &types.ReqHash{Hash: sent.Hash()}).Return(&types.TransactionDetail{Tx: sent, Receipt: &types.ReceiptData{Ty: types.ExecPack}, Height: 10, Fromaddr: sent.From()}, nil)
+	api.On("GetBlockHash", &types.ReqInt{Height: 10}).Return(&types.ReplyHash{Hash: []byte{0xab}}, nil)
+	result, e = ethCall(t, s, "eth_getTransactionReceipt", ethHash)
+	assert.Nil(t, e)
+	var r ethReceipt
+	assert.Nil(t, json.Unmarshal(result, &r))
+	assert.Equal(t, ethHash, r.TransactionHash)
+	assert.Equal(t, "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23", strings.ToLower(r.From))
+	assert.Equal(t, "0x0", r.Status)
+}
+
+func TestEthGetLogsPaging(t *testing.T) {
+	s, api := newTestEthServer()
+	api.On("GetLastHeader").Return(&types.Header{Height: 20}, nil)
+	api.On("GetBlockHash", mock.Anything).Return(&types.ReplyHash{Hash: []byte{0xab}}, nil)
+	api.On("GetTransactionByHash", mock.Anything).Return(&types.TransactionDetails{}, nil)
+
+	contract := address.ExecAddress("user.evm.0x01")
+	item := &evmtypes.EvmLogItem{Address: contract, Height: 10}
+	api.On("Query", "evm", "FilterLogs", &evmtypes.EvmFilterLogsReq{ToHeight: 20}).Return(&evmtypes.EvmFilterLogsResp{Logs: []*evmtypes.EvmLogItem{item}, Cursor: "c1"}, nil)
+	api.On("Query", "evm", "FilterLogs", &evmtypes.EvmFilterLogsReq{ToHeight: 20, Cursor: "c1"}).Return(&evmtypes.EvmFilterLogsResp{Logs: []*evmtypes.EvmLogItem{item}}, nil)
+	result, e := ethCall(t, s, "eth_getLogs", map[string]interface{}{"fromBlock": "earliest"})
+	assert.Nil(t, e)
+	var logs []*ethLog
+	assert.Nil(t, json.Unmarshal(result, &logs))
+	assert.Equal(t, 2, len(logs))
+
+	// 超过上限时返回错误，不截断
+	many := make([]*evmtypes.EvmLogItem, maxEthLogs+1)
+	api.On("Query", "evm", "FilterLogs", &evmtypes.EvmFilterLogsReq{FromHeight: 1, ToHeight: 20}).Return(&evmtypes.EvmFilterLogsResp{Logs: many, Cursor: "c2"}, nil)
+	_, e = ethCall(t, s, "eth_getLogs", map[string]interface{}{"fromBlock": "0x1"})
+	assert.Equal(t, ErrEthTooManyLogs.Error(), e.Message)
+}
+
+func TestEthServerCors(t *testing.T) {
+	s, api := newTestEthServer()
+	api.On("GetLastHeader").Return(&types.Header{Height: 100}, nil)
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Origin", "http://localhost:8080")
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "http://localhost:8080", w.Header().Get("Access-Control-Allow-Origin"))
+
+	r = httptest.NewRequest(http.MethodOptions, "/", nil)
+	r.Header.Set("Origin", "http://evil.example")
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "", w.Header().Get("Access-Control-Allow-Origin"))
+
+	s.corsDomains = []string{"*"}
+	w = httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+}
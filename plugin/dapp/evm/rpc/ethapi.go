@@ -0,0 +1,667 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/33cn/chain33/types"
+	ethcrypto "github.com/33cn/plugin/plugin/dapp/evm/crypto"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+var (
+	// ErrEthRawTx 既不是以太坊格式签名的交易，也不是已签名的chain33交易
+	ErrEthRawTx = errors.New("ErrEthRawTx")
+	// ErrEthBlockState 只保存了最新的状态，不能查询历史区块的状态
+	ErrEthBlockState = errors.New("ErrEthBlockState")
+	// ErrEthTooManyLogs eth_getLogs查询到的日志超过maxEthLogs条，需要缩小查询范围
+	ErrEthTooManyLogs = errors.New("ErrEthTooManyLogs")
+)
+
+const (
+	// 以太坊日志布隆过滤器长度，目前不计算布隆过滤器，返回全零
+	ethBloomLength = 256
+	// eth_getLogs单次返回的最大日志条数
+	maxEthLogs = 10000
+)
+
+type ethAPI struct {
+	cli      *channelClient
+	execName string
+	chainID  int64
+}
+
+// 调用合约的参数
+type ethCallArgs struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Input string `json:"input"`
+}
+
+// EIP-1898格式的区块参数
+type ethBlockArgs struct {
+	BlockNumber string `json:"blockNumber"`
+	BlockHash   string `json:"blockHash"`
+}
+
+type ethFilterArgs struct {
+	FromBlock string            `json:"fromBlock"`
+	ToBlock   string            `json:"toBlock"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+	BlockHash string            `json:"blockHash"`
+}
+
+type ethLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+type ethReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	GasUsed           string    `json:"gasUsed"`
+	ContractAddress   *string   `json:"contractAddress"`
+	Logs              []*ethLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Status            string    `json:"status"`
+}
+
+// chain33地址转换为以太坊格式的地址
+func toEthAddress(addr string) (string, error) {
+	a := common.StringToAddress(addr)
+	if a == nil {
+		return "", types.ErrInvalidAddress
+	}
+	return a.ToHash160().Hex(), nil
+}
+
+// 以太坊格式的地址转换为chain33地址，同时兼容chain33格式的地址
+func fromEthAddress(addr string) (string, error) {
+	if strings.HasPrefix(addr, "0x") || strings.HasPrefix(addr, "0X") {
+		data, err := hex.DecodeString(addr[2:])
+		if err != nil || len(data) != common.Hash160Length {
+			return "", newParamError("invalid address " + addr)
+		}
+		return common.BytesToAddress(data).String(), nil
+	}
+	if common.StringToAddress(addr) == nil {
+		return "", newParamError("invalid address " + addr)
+	}
+	return addr, nil
+}
+
+func encodeQuantity(v uint64) string {
+	return fmt.Sprintf("0x%x", v)
+}
+
+func decodeQuantity(s string) (uint64, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return 0, newParamError("hex string without 0x prefix: " + s)
+	}
+	v, err := strconv.ParseUint(s[2:], 16, 64)
+	if err != nil {
+		return 0, newParamError("invalid hex quantity: " + s)
+	}
+	return v, nil
+}
+
+func encodeBig(v *big.Int) string {
+	return "0x" + v.Text(16)
+}
+
+func decodeBig(s string) (*big.Int, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, newParamError("hex string without 0x prefix: " + s)
+	}
+	v, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok || v.Sign() < 0 {
+		return nil, newParamError("invalid hex quantity: " + s)
+	}
+	return v, nil
+}
+
+func decodeHexData(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, newParamError("invalid hex data")
+	}
+	return data, nil
+}
+
+func encodeHexData(data []byte) string {
+	return "0x" + hex.EncodeToString(data)
+}
+
+func splitParams(raw json.RawMessage) ([]json.RawMessage, error) {
+	var params []json.RawMessage
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, newParamError("params must be an array")
+		}
+	}
+	return params, nil
+}
+
+// 把jsonrpc参数数组解析到对应的参数对象上，多余的参数被忽略
+func parseParams(raw json.RawMessage, args ...interface{}) error {
+	params, err := splitParams(raw)
+	if err != nil {
+		return err
+	}
+	if len(params) < len(args) {
+		return newParamError(fmt.Sprintf("missing value for required argument %d", len(params)))
+	}
+	for i, arg := range args {
+		if err := json.Unmarshal(params[i], arg); err != nil {
+			return newParamError(fmt.Sprintf("invalid argument %d: %v", i, err))
+		}
+	}
+	return nil
+}
+
+// 检查第index个参数（区块参数），未指定时为最新区块
+// 状态查询只能基于最新区块，区块参数指向其它区块时返回ErrEthBlockState
+func (api *ethAPI) checkBlockParam(raw json.RawMessage, index int) error {
+	params, err := splitParams(raw)
+	if err != nil {
+		return err
+	}
+	if len(params) <= index || string(params[index]) == "null" {
+		return nil
+	}
+	var tag string
+	var args ethBlockArgs
+	if err := json.Unmarshal(params[index], &tag); err != nil {
+		if err := json.Unmarshal(params[index], &args); err != nil {
+			return newParamError(fmt.Sprintf("invalid argument %d: block tag, number or object expected", index))
+		}
+		tag = args.BlockNumber
+	}
+	header, err := api.cli.GetLastHeader()
+	if err != nil {
+		return err
+	}
+	height := header.Height
+	if args.BlockHash != "" {
+		hash, err := decodeHexData(args.BlockHash)
+		if err != nil {
+			return err
+		}
+		overview, err := api.cli.GetBlockOverview(&types.ReqHash{Hash: hash})
+		if err != nil {
+			return err
+		}
+		height = overview.Head.Height
+	} else if height, err = parseBlockTag(tag, header.Height); err != nil {
+		return err
+	}
+	if height != header.Height {
+		return ErrEthBlockState
+	}
+	return nil
+}
+
+func (api *ethAPI) query(funcName string, param types.Message) (types.Message, error) {
+	return api.cli.Query(api.execName, funcName, param)
+}
+
+func (api *ethAPI) getChainID(params json.RawMessage) (interface{}, error) {
+	return encodeQuantity(uint64(api.chainID)), nil
+}
+
+func (api *ethAPI) netVersion(params json.RawMessage) (interface{}, error) {
+	return strconv.FormatInt(api.chainID, 10), nil
+}
+
+func (api *ethAPI) blockNumber(params json.RawMessage) (interface{}, error) {
+	header, err := api.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	return encodeQuantity(uint64(header.Height)), nil
+}
+
+// eth_getBalance [address, block]
+func (api *ethAPI) getBalance(params json.RawMessage) (interface{}, error) {
+	var addr string
+	if err := parseParams(params, &addr); err != nil {
+		return nil, err
+	}
+	addr, err := fromEthAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkBlockParam(params, 1); err != nil {
+		return nil, err
+	}
+	msg, err := api.query("GetBalance", &evmtypes.EvmGetBalanceReq{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+	return encodeBig(evmtypes.AmountToWei(msg.(*evmtypes.EvmGetBalanceResp).Balance)), nil
+}
+
+// eth_getCode [address, block]
+func (api *ethAPI) getCode(params json.RawMessage) (interface{}, error) {
+	var addr string
+	if err := parseParams(params, &addr); err != nil {
+		return nil, err
+	}
+	addr, err := fromEthAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkBlockParam(params, 1); err != nil {
+		return nil, err
+	}
+	msg, err := api.query("GetCode", &evmtypes.EvmGetCodeReq{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+	return encodeHexData(msg.(*evmtypes.EvmGetCodeResp).Code), nil
+}
+
+// 把调用参数转换为chain33格式的调用者、合约地址、调用数据和金额
+func (args *ethCallArgs) convert() (caller, to string, data []byte, amount uint64, err error) {
+	if args.From != "" {
+		if caller, err = fromEthAddress(args.From); err != nil {
+			return
+		}
+	}
+	if args.To != "" {
+		if to, err = fromEthAddress(args.To); err != nil {
+			return
+		}
+	}
+	input := args.Input
+	if input == "" {
+		input = args.Data
+	}
+	if data, err = decodeHexData(input); err != nil {
+		return
+	}
+	if args.Value != "" {
+		var wei *big.Int
+		if wei, err = decodeBig(args.Value); err != nil {
+			return
+		}
+		if amount, err = evmtypes.WeiToAmount(wei); err != nil {
+			err = newParamError("value must be a multiple of 1e10 wei: " + args.Value)
+		}
+	}
+	return
+}
+
+// eth_call [callArgs, block]
+func (api *ethAPI) call(params json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	if err := parseParams(params, &args); err != nil {
+		return nil, err
+	}
+	caller, to, data, amount, err := args.convert()
+	if err != nil {
+		return nil, err
+	}
+	if to == "" {
+		return nil, newParamError("contract address not specified")
+	}
+	if err := api.checkBlockParam(params, 1); err != nil {
+		return nil, err
+	}
+	msg, err := api.query("RawCall", &evmtypes.EvmRawCallReq{To: to, Data: data, Caller: caller, Amount: amount})
+	if err != nil {
+		return nil, err
+	}
+	return encodeHexData(msg.(*evmtypes.EvmRawCallResp).Ret), nil
+}
+
+// eth_estimateGas [callArgs, block]，未指定合约地址时估算创建合约消耗的Gas
+func (api *ethAPI) estimateGas(params json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	if err := parseParams(params, &args); err != nil {
+		return nil, err
+	}
+	caller, to, data, amount, err := args.convert()
+	if err != nil {
+		return nil, err
+	}
+	if err := api.checkBlockParam(params, 1); err != nil {
+		return nil, err
+	}
+	msg, err := api.query("EstimateGas", &evmtypes.EstimateEVMGasReq{To: to, Code: data, Caller: caller, Amount: amount})
+	if err != nil {
+		return nil, err
+	}
+	return encodeQuantity(msg.(*evmtypes.EstimateEVMGasResp).Gas), nil
+}
+
+// 获取区块哈希，同一请求中的相同高度只查询一次
+func (api *ethAPI) blockHash(height int64, cache map[int64]string) (string, error) {
+	if hash, ok := cache[height]; ok {
+		return hash, nil
+	}
+	reply, err := api.cli.GetBlockHash(&types.ReqInt{Height: height})
+	if err != nil {
+		return "", err
+	}
+	hash := encodeHexData(reply.Hash)
+	cache[height] = hash
+	return hash, nil
+}
+
+// eth_getTransactionReceipt [txHash]，txHash可以是以太坊交易哈希或chain33交易哈希，交易不存在时返回null
+func (api *ethAPI) getTransactionReceipt(params json.RawMessage) (interface{}, error) {
+	var hash string
+	if err := parseParams(params, &hash); err != nil {
+		return nil, err
+	}
+	hashBytes, err := decodeHexData(hash)
+	if err != nil {
+		return nil, err
+	}
+	// 以太坊格式签名的交易先转换为chain33交易哈希
+	msg, err := api.query("GetEthTxHash", &types.ReqHash{Hash: hashBytes})
+	if err == nil {
+		hashBytes = msg.(*types.ReplyHash).Hash
+	} else if err != types.ErrNotFound {
+		return nil, err
+	}
+	detail, err := api.cli.QueryTx(&types.ReqHash{Hash: hashBytes})
+	if err == types.ErrTxNotExist {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blockHash, err := api.blockHash(detail.Height, make(map[int64]string))
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := toEthAddress(ethcrypto.TxFrom(detail.Tx))
+	if err != nil {
+		return nil, err
+	}
+	receipt := &ethReceipt{
+		TransactionHash:  encodeHexData(evmtypes.EthTxHash(detail.Tx)),
+		TransactionIndex: encodeQuantity(uint64(detail.Index)),
+		BlockHash:        blockHash,
+		BlockNumber:      encodeQuantity(uint64(detail.Height)),
+		From:             from,
+		Logs:             []*ethLog{},
+		LogsBloom:        encodeHexData(make([]byte, ethBloomLength)),
+		Status:           encodeQuantity(0),
+	}
+	if detail.Receipt.GetTy() == types.ExecOk {
+		receipt.Status = encodeQuantity(1)
+	}
+	if to, err := toEthAddress(detail.Tx.To); err == nil {
+		receipt.To = &to
+	}
+
+	var usedGas uint64
+	for _, item := range detail.Receipt.GetLogs() {
+		switch item.Ty {
+		case evmtypes.TyLogCallContract:
+			var contract evmtypes.ReceiptEVMContract
+			if err := types.Decode(item.Log, &contract); err != nil {
+				return nil, err
+			}
+			usedGas = contract.UsedGas
+			// 创建合约的交易，合约名称不为空
+			if contract.ContractName != "" {
+				addr, err := toEthAddress(contract.ContractAddr)
+				if err != nil {
+					return nil, err
+				}
+				receipt.ContractAddress = &addr
+				receipt.To = nil
+			}
+		case evmtypes.TyLogEVMEventData:
+			var eventLog evmtypes.EVMContractEventLog
+			if err := types.Decode(item.Log, &eventLog); err != nil {
+				return nil, err
+			}
+			l, err := newEthLog(eventLog.ContractAddr, eventLog.Topics, eventLog.Data, eventLog.Index)
+			if err != nil {
+				return nil, err
+			}
+			l.BlockNumber = receipt.BlockNumber
+			l.BlockHash = receipt.BlockHash
+			l.TransactionHash = receipt.TransactionHash
+			l.TransactionIndex = receipt.TransactionIndex
+			receipt.Logs = append(receipt.Logs, l)
+		}
+	}
+	receipt.GasUsed = encodeQuantity(usedGas)
+	receipt.CumulativeGasUsed = receipt.GasUsed
+	return receipt, nil
+}
+
+func newEthLog(contractAddr string, topics [][]byte, data []byte, index int32) (*ethLog, error) {
+	addr, err := toEthAddress(contractAddr)
+	if err != nil {
+		return nil, err
+	}
+	l := &ethLog{Address: addr, Topics: []string{}, Data: encodeHexData(data), LogIndex: encodeQuantity(uint64(index))}
+	for _, topic := range topics {
+		l.Topics = append(l.Topics, encodeHexData(topic))
+	}
+	return l, nil
+}
+
+// 解析区块参数，latest、pending和空值都表示最新区块
+func parseBlockTag(tag string, latest int64) (int64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return latest, nil
+	case "earliest":
+		return 0, nil
+	}
+	height, err := decodeQuantity(tag)
+	if err != nil {
+		return 0, err
+	}
+	return int64(height), nil
+}
+
+// 地址和主题参数可以是单个字符串，也可以是字符串数组
+func parseStringOrArray(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, newParamError("expect string or string array")
+	}
+	return list, nil
+}
+
+// 把以太坊的日志过滤参数转换为evm执行器的日志查询请求
+func (api *ethAPI) convertFilter(args *ethFilterArgs) (*evmtypes.EvmFilterLogsReq, error) {
+	req := &evmtypes.EvmFilterLogsReq{}
+	if args.BlockHash != "" {
+		hash, err := decodeHexData(args.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		overview, err := api.cli.GetBlockOverview(&types.ReqHash{Hash: hash})
+		if err != nil {
+			return nil, err
+		}
+		req.FromHeight = overview.Head.Height
+		req.ToHeight = overview.Head.Height
+	} else {
+		header, err := api.cli.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		if req.FromHeight, err = parseBlockTag(args.FromBlock, header.Height); err != nil {
+			return nil, err
+		}
+		if req.ToHeight, err = parseBlockTag(args.ToBlock, header.Height); err != nil {
+			return nil, err
+		}
+	}
+
+	addrs, err := parseStringOrArray(args.Address)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range addrs {
+		addr, err = fromEthAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		req.Addresses = append(req.Addresses, addr)
+	}
+	for _, raw := range args.Topics {
+		topics, err := parseStringOrArray(raw)
+		if err != nil {
+			return nil, err
+		}
+		req.Topics = append(req.Topics, &evmtypes.EvmTopicFilter{Topics: topics})
+	}
+	return req, nil
+}
+
+// eth_getLogs [filter]，按游标分页查询所有匹配的日志，超过maxEthLogs条时返回错误而不是截断
+func (api *ethAPI) getLogs(params json.RawMessage) (interface{}, error) {
+	var args ethFilterArgs
+	if err := parseParams(params, &args); err != nil {
+		return nil, err
+	}
+	req, err := api.convertFilter(&args)
+	if err != nil {
+		return nil, err
+	}
+	var items []*evmtypes.EvmLogItem
+	for {
+		msg, err := api.query("FilterLogs", req)
+		if err != nil {
+			return nil, err
+		}
+		resp := msg.(*evmtypes.EvmFilterLogsResp)
+		items = append(items, resp.Logs...)
+		if len(items) > maxEthLogs {
+			return nil, ErrEthTooManyLogs
+		}
+		if resp.Cursor == "" {
+			break
+		}
+		req.Cursor = resp.Cursor
+	}
+
+	txHashes, err := api.ethTxHashes(items)
+	if err != nil {
+		return nil, err
+	}
+	logs := []*ethLog{}
+	hashes := make(map[int64]string)
+	for _, item := range items {
+		var topics [][]byte
+		for _, topic := range item.Topics {
+			topics = append(topics, common.FromHex(topic))
+		}
+		l, err := newEthLog(item.Address, topics, common.FromHex(item.Data), item.LogIndex)
+		if err != nil {
+			return nil, err
+		}
+		if l.BlockHash, err = api.blockHash(item.Height, hashes); err != nil {
+			return nil, err
+		}
+		l.BlockNumber = encodeQuantity(uint64(item.Height))
+		l.TransactionHash = txHashes[item.TxHash]
+		l.TransactionIndex = encodeQuantity(uint64(item.TxIndex))
+		logs = append(logs, l)
+	}
+	return logs, nil
+}
+
+// 日志中的chain33交易哈希对应的交易哈希，以太坊格式签名的交易为以太坊交易哈希
+func (api *ethAPI) ethTxHashes(items []*evmtypes.EvmLogItem) (map[string]string, error) {
+	txHashes := make(map[string]string)
+	var req types.ReqHashes
+	for _, item := range items {
+		if _, ok := txHashes[item.TxHash]; !ok {
+			txHashes[item.TxHash] = item.TxHash
+			req.Hashes = append(req.Hashes, common.FromHex(item.TxHash))
+		}
+	}
+	if len(req.Hashes) == 0 {
+		return txHashes, nil
+	}
+	details, err := api.cli.GetTransactionByHash(&req)
+	if err != nil {
+		return nil, err
+	}
+	for _, detail := range details.GetTxs() {
+		if detail.GetTx() != nil && evmtypes.IsEthTx(detail.Tx) {
+			txHashes[common.Bytes2Hex(detail.Tx.Hash())] = encodeHexData(evmtypes.EthTxHash(detail.Tx))
+		}
+	}
+	return txHashes, nil
+}
+
+// 以RLP列表开头的数据为以太坊交易，否则按chain33交易解码
+func decodeRawTx(data []byte) (*types.Transaction, error) {
+	if len(data) > 0 && data[0] >= 0xc0 {
+		return ethcrypto.DecodeTx(data)
+	}
+	var tx types.Transaction
+	if err := types.Decode(data, &tx); err != nil || tx.Signature == nil {
+		return nil, ErrEthRawTx
+	}
+	return &tx, nil
+}
+
+// eth_sendRawTransaction [data]，data为十六进制编码的以太坊交易或已签名的chain33交易，
+// 以太坊交易返回以太坊交易哈希keccak256(data)，chain33交易返回chain33交易哈希
+func (api *ethAPI) sendRawTransaction(params json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := parseParams(params, &raw); err != nil {
+		return nil, err
+	}
+	data, err := decodeHexData(raw)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := decodeRawTx(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := api.cli.SendTx(tx); err != nil {
+		return nil, err
+	}
+	return encodeHexData(evmtypes.EthTxHash(tx)), nil
+}
@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/33cn/chain33/types"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// SignNameEthereum 以太坊格式签名交易的签名算法名称
+	SignNameEthereum = "evm.eth_secp256k1"
+	// SignEthereum 以太坊格式签名交易的签名类型
+	SignEthereum = 6
+
+	// EthChainID 以太坊链ID，以太坊格式交易的签名中必须包含该链ID，所有节点一致，修改需要分叉
+	EthChainID = 33
+)
+
+var (
+	// ErrWeiAmount 以太坊金额必须是1e10 wei的整数倍，且不超过chain33金额的范围
+	ErrWeiAmount = errors.New("ErrWeiAmount")
+	// ErrEthTxNotEnabled ForkEVMEthTx之前不接受以太坊格式签名的交易
+	ErrEthTxNotEnabled = errors.New("ErrEthTxNotEnabled")
+
+	// 1个chain33最小金额单位（1e-8）等于1e10 wei
+	weiPerAmount = big.NewInt(1e10)
+	maxAmount    = new(big.Int).SetUint64(^uint64(0))
+
+	mapSignType2name = map[int]string{
+		SignEthereum: SignNameEthereum,
+	}
+
+	mapSignName2Type = map[string]int{
+		SignNameEthereum: SignEthereum,
+	}
+)
+
+// IsEthTx 是否为以太坊格式签名的交易
+func IsEthTx(tx *types.Transaction) bool {
+	return tx.GetSignature().GetTy() == SignEthereum
+}
+
+// EthTxHash 以太坊格式签名的交易返回以太坊交易哈希keccak256(原始交易)，其它交易返回chain33交易哈希
+func EthTxHash(tx *types.Transaction) []byte {
+	if !IsEthTx(tx) {
+		return tx.Hash()
+	}
+	d := sha3.NewLegacyKeccak256()
+	d.Write(tx.GetSignature().GetSignature())
+	return d.Sum(nil)
+}
+
+// AmountToWei chain33金额转换为以太坊的wei
+func AmountToWei(amount uint64) *big.Int {
+	wei := new(big.Int).SetUint64(amount)
+	return wei.Mul(wei, weiPerAmount)
+}
+
+// WeiToAmount 以太坊的wei转换为chain33金额，不能整除或者溢出时返回错误
+func WeiToAmount(wei *big.Int) (uint64, error) {
+	if wei.Sign() < 0 {
+		return 0, ErrWeiAmount
+	}
+	amount, rem := new(big.Int).QuoRem(wei, weiPerAmount, new(big.Int))
+	if rem.Sign() != 0 || amount.Cmp(maxAmount) > 0 {
+		return 0, ErrWeiAmount
+	}
+	return amount.Uint64(), nil
+}
@@ -24,6 +24,9 @@ var (
 		"EvmCreate": EvmCreateAction,
 		"EvmCall":   EvmCallAction,
 	}
+
+	// ErrTraceReplayTxs 跟踪交易时区块中排在该交易之前的交易数超过了配置的重放上限
+	ErrTraceReplayTxs = errors.New("ErrTraceReplayTxs")
 )
 
 func init() {
@@ -40,6 +43,10 @@ func init() {
 	types.RegisterDappFork(ExecutorName, ForkEVMABI, 1250000)
 	// EEVM合约用户金额冻结
 	types.RegisterDappFork(ExecutorName, ForkEVMFrozen, 1300000)
+	// EVM合约事件日志写入回执并在localdb中建立索引
+	types.RegisterDappFork(ExecutorName, ForkEVMEventLog, types.MaxHeight)
+	// EVM合约接受以太坊格式签名的交易
+	types.RegisterDappFork(ExecutorName, ForkEVMEthTx, types.MaxHeight)
 }
 
 // EvmType EVM类型定义
@@ -117,6 +124,22 @@ func (evm *EvmType) GetLogMap() map[int64]*types.LogInfo {
 	return logInfo
 }
 
+// GetCryptoDriver 获取执行器支持的签名算法，目前为以太坊格式的签名
+func (evm *EvmType) GetCryptoDriver(ty int) (string, error) {
+	if name, ok := mapSignType2name[ty]; ok {
+		return name, nil
+	}
+	return "", types.ErrNotSupport
+}
+
+// GetCryptoType 获取签名算法对应的签名类型
+func (evm *EvmType) GetCryptoType(name string) (int, error) {
+	if ty, ok := mapSignName2Type[name]; ok {
+		return ty, nil
+	}
+	return 0, types.ErrNotSupport
+}
+
 func createEvmTx(param *CreateCallTx) (*types.Transaction, error) {
 	if param == nil {
 		elog.Error("createEvmTx", "param", param)
@@ -463,6 +463,135 @@ func (m *EVMStateChangeItem) GetCurrentValue() []byte {
 	return nil
 }
 
+// 合约执行过程中通过LOG0~LOG4指令生成的事件日志
+type EVMContractEventLog struct {
+	ContractAddr string   `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contractAddr,omitempty"`
+	Topics       [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data         []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// 日志在本交易中的序号
+	Index                int32    `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMContractEventLog) Reset()         { *m = EVMContractEventLog{} }
+func (m *EVMContractEventLog) String() string { return proto.CompactTextString(m) }
+func (*EVMContractEventLog) ProtoMessage()    {}
+func (*EVMContractEventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{6}
+}
+
+func (m *EVMContractEventLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMContractEventLog.Unmarshal(m, b)
+}
+func (m *EVMContractEventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMContractEventLog.Marshal(b, m, deterministic)
+}
+func (m *EVMContractEventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractEventLog.Merge(m, src)
+}
+func (m *EVMContractEventLog) XXX_Size() int {
+	return xxx_messageInfo_EVMContractEventLog.Size(m)
+}
+func (m *EVMContractEventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMContractEventLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMContractEventLog proto.InternalMessageInfo
+
+func (m *EVMContractEventLog) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EVMContractEventLog) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EVMContractEventLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EVMContractEventLog) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// localdb中保存的合约事件日志索引数据
+type EVMEventLogRecord struct {
+	Log                  *EVMContractEventLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Height               int64                `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex              int32                `protobuf:"varint,3,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	TxHash               []byte               `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *EVMEventLogRecord) Reset()         { *m = EVMEventLogRecord{} }
+func (m *EVMEventLogRecord) String() string { return proto.CompactTextString(m) }
+func (*EVMEventLogRecord) ProtoMessage()    {}
+func (*EVMEventLogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{7}
+}
+
+func (m *EVMEventLogRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMEventLogRecord.Unmarshal(m, b)
+}
+func (m *EVMEventLogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMEventLogRecord.Marshal(b, m, deterministic)
+}
+func (m *EVMEventLogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMEventLogRecord.Merge(m, src)
+}
+func (m *EVMEventLogRecord) XXX_Size() int {
+	return xxx_messageInfo_EVMEventLogRecord.Size(m)
+}
+func (m *EVMEventLogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMEventLogRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMEventLogRecord proto.InternalMessageInfo
+
+func (m *EVMEventLogRecord) GetLog() *EVMContractEventLog {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *EVMEventLogRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EVMEventLogRecord) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EVMEventLogRecord) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
 // 存放合约固定数据
 type EVMContractDataCmd struct {
 	Creator              string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -480,7 +609,7 @@ func (m *EVMContractDataCmd) Reset()         { *m = EVMContractDataCmd{} }
 func (m *EVMContractDataCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractDataCmd) ProtoMessage()    {}
 func (*EVMContractDataCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{6}
+	return fileDescriptor_74353de561acd7c6, []int{8}
 }
 
 func (m *EVMContractDataCmd) XXX_Unmarshal(b []byte) error {
@@ -558,7 +687,7 @@ func (m *EVMContractStateCmd) Reset()         { *m = EVMContractStateCmd{} }
 func (m *EVMContractStateCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractStateCmd) ProtoMessage()    {}
 func (*EVMContractStateCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{7}
+	return fileDescriptor_74353de561acd7c6, []int{9}
 }
 
 func (m *EVMContractStateCmd) XXX_Unmarshal(b []byte) error {
@@ -625,7 +754,7 @@ func (m *ReceiptEVMContractCmd) Reset()         { *m = ReceiptEVMContractCmd{} }
 func (m *ReceiptEVMContractCmd) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContractCmd) ProtoMessage()    {}
 func (*ReceiptEVMContractCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{8}
+	return fileDescriptor_74353de561acd7c6, []int{10}
 }
 
 func (m *ReceiptEVMContractCmd) XXX_Unmarshal(b []byte) error {
@@ -692,7 +821,7 @@ func (m *CheckEVMAddrReq) Reset()         { *m = CheckEVMAddrReq{} }
 func (m *CheckEVMAddrReq) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrReq) ProtoMessage()    {}
 func (*CheckEVMAddrReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{9}
+	return fileDescriptor_74353de561acd7c6, []int{11}
 }
 
 func (m *CheckEVMAddrReq) XXX_Unmarshal(b []byte) error {
@@ -734,7 +863,7 @@ func (m *CheckEVMAddrResp) Reset()         { *m = CheckEVMAddrResp{} }
 func (m *CheckEVMAddrResp) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrResp) ProtoMessage()    {}
 func (*CheckEVMAddrResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{10}
+	return fileDescriptor_74353de561acd7c6, []int{12}
 }
 
 func (m *CheckEVMAddrResp) XXX_Unmarshal(b []byte) error {
@@ -798,7 +927,7 @@ func (m *EstimateEVMGasReq) Reset()         { *m = EstimateEVMGasReq{} }
 func (m *EstimateEVMGasReq) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasReq) ProtoMessage()    {}
 func (*EstimateEVMGasReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{11}
+	return fileDescriptor_74353de561acd7c6, []int{13}
 }
 
 func (m *EstimateEVMGasReq) XXX_Unmarshal(b []byte) error {
@@ -865,7 +994,7 @@ func (m *EstimateEVMGasResp) Reset()         { *m = EstimateEVMGasResp{} }
 func (m *EstimateEVMGasResp) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasResp) ProtoMessage()    {}
 func (*EstimateEVMGasResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{12}
+	return fileDescriptor_74353de561acd7c6, []int{14}
 }
 
 func (m *EstimateEVMGasResp) XXX_Unmarshal(b []byte) error {
@@ -905,7 +1034,7 @@ func (m *EvmDebugReq) Reset()         { *m = EvmDebugReq{} }
 func (m *EvmDebugReq) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugReq) ProtoMessage()    {}
 func (*EvmDebugReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{13}
+	return fileDescriptor_74353de561acd7c6, []int{15}
 }
 
 func (m *EvmDebugReq) XXX_Unmarshal(b []byte) error {
@@ -944,7 +1073,7 @@ func (m *EvmDebugResp) Reset()         { *m = EvmDebugResp{} }
 func (m *EvmDebugResp) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugResp) ProtoMessage()    {}
 func (*EvmDebugResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{14}
+	return fileDescriptor_74353de561acd7c6, []int{16}
 }
 
 func (m *EvmDebugResp) XXX_Unmarshal(b []byte) error {
@@ -983,7 +1112,7 @@ func (m *EvmQueryAbiReq) Reset()         { *m = EvmQueryAbiReq{} }
 func (m *EvmQueryAbiReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiReq) ProtoMessage()    {}
 func (*EvmQueryAbiReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{15}
+	return fileDescriptor_74353de561acd7c6, []int{17}
 }
 
 func (m *EvmQueryAbiReq) XXX_Unmarshal(b []byte) error {
@@ -1023,7 +1152,7 @@ func (m *EvmQueryAbiResp) Reset()         { *m = EvmQueryAbiResp{} }
 func (m *EvmQueryAbiResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiResp) ProtoMessage()    {}
 func (*EvmQueryAbiResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{16}
+	return fileDescriptor_74353de561acd7c6, []int{18}
 }
 
 func (m *EvmQueryAbiResp) XXX_Unmarshal(b []byte) error {
@@ -1071,7 +1200,7 @@ func (m *EvmQueryReq) Reset()         { *m = EvmQueryReq{} }
 func (m *EvmQueryReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryReq) ProtoMessage()    {}
 func (*EvmQueryReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{17}
+	return fileDescriptor_74353de561acd7c6, []int{19}
 }
 
 func (m *EvmQueryReq) XXX_Unmarshal(b []byte) error {
@@ -1128,7 +1257,7 @@ func (m *EvmQueryResp) Reset()         { *m = EvmQueryResp{} }
 func (m *EvmQueryResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryResp) ProtoMessage()    {}
 func (*EvmQueryResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{18}
+	return fileDescriptor_74353de561acd7c6, []int{20}
 }
 
 func (m *EvmQueryResp) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1331,7 @@ func (m *EvmContractCreateReq) Reset()         { *m = EvmContractCreateReq{} }
 func (m *EvmContractCreateReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCreateReq) ProtoMessage()    {}
 func (*EvmContractCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{19}
+	return fileDescriptor_74353de561acd7c6, []int{21}
 }
 
 func (m *EvmContractCreateReq) XXX_Unmarshal(b []byte) error {
@@ -1298,7 +1427,7 @@ func (m *EvmContractCallReq) Reset()         { *m = EvmContractCallReq{} }
 func (m *EvmContractCallReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCallReq) ProtoMessage()    {}
 func (*EvmContractCallReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{20}
+	return fileDescriptor_74353de561acd7c6, []int{22}
 }
 
 func (m *EvmContractCallReq) XXX_Unmarshal(b []byte) error {
@@ -1398,7 +1527,7 @@ func (m *EvmContractTransferReq) Reset()         { *m = EvmContractTransferReq{}
 func (m *EvmContractTransferReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractTransferReq) ProtoMessage()    {}
 func (*EvmContractTransferReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{21}
+	return fileDescriptor_74353de561acd7c6, []int{23}
 }
 
 func (m *EvmContractTransferReq) XXX_Unmarshal(b []byte) error {
@@ -1461,6 +1590,1028 @@ func (m *EvmContractTransferReq) GetParaName() string {
 	return ""
 }
 
+// 按照位置匹配的事件主题，多个取值之间为或的关系，为空表示匹配任意主题
+type EvmTopicFilter struct {
+	Topics               []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTopicFilter) Reset()         { *m = EvmTopicFilter{} }
+func (m *EvmTopicFilter) String() string { return proto.CompactTextString(m) }
+func (*EvmTopicFilter) ProtoMessage()    {}
+func (*EvmTopicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{24}
+}
+
+func (m *EvmTopicFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTopicFilter.Unmarshal(m, b)
+}
+func (m *EvmTopicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTopicFilter.Marshal(b, m, deterministic)
+}
+func (m *EvmTopicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTopicFilter.Merge(m, src)
+}
+func (m *EvmTopicFilter) XXX_Size() int {
+	return xxx_messageInfo_EvmTopicFilter.Size(m)
+}
+func (m *EvmTopicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTopicFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTopicFilter proto.InternalMessageInfo
+
+func (m *EvmTopicFilter) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type EvmFilterLogsReq struct {
+	// 合约地址集合，为空表示不限制合约
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 依次对应topic0~topic3
+	Topics     []*EvmTopicFilter `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	FromHeight int64             `protobuf:"varint,3,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`
+	// 小于等于0表示不限制结束高度
+	ToHeight int64 `protobuf:"varint,4,opt,name=toHeight,proto3" json:"toHeight,omitempty"`
+	Count    int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 上次查询返回的cursor，从它之后继续查询
+	Cursor               string   `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmFilterLogsReq) Reset()         { *m = EvmFilterLogsReq{} }
+func (m *EvmFilterLogsReq) String() string { return proto.CompactTextString(m) }
+func (*EvmFilterLogsReq) ProtoMessage()    {}
+func (*EvmFilterLogsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{25}
+}
+
+func (m *EvmFilterLogsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmFilterLogsReq.Unmarshal(m, b)
+}
+func (m *EvmFilterLogsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmFilterLogsReq.Marshal(b, m, deterministic)
+}
+func (m *EvmFilterLogsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmFilterLogsReq.Merge(m, src)
+}
+func (m *EvmFilterLogsReq) XXX_Size() int {
+	return xxx_messageInfo_EvmFilterLogsReq.Size(m)
+}
+func (m *EvmFilterLogsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmFilterLogsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmFilterLogsReq proto.InternalMessageInfo
+
+func (m *EvmFilterLogsReq) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *EvmFilterLogsReq) GetTopics() []*EvmTopicFilter {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EvmFilterLogsReq) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *EvmFilterLogsReq) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *EvmFilterLogsReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EvmFilterLogsReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type EvmLogItem struct {
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics   []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data     string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Height   int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex  int32    `protobuf:"varint,5,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	TxHash   string   `protobuf:"bytes,6,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	LogIndex int32    `protobuf:"varint,7,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	// 合约绑定了ABI时，解析出的事件名称和参数
+	EventName            string   `protobuf:"bytes,8,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	JsonData             string   `protobuf:"bytes,9,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmLogItem) Reset()         { *m = EvmLogItem{} }
+func (m *EvmLogItem) String() string { return proto.CompactTextString(m) }
+func (*EvmLogItem) ProtoMessage()    {}
+func (*EvmLogItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{26}
+}
+
+func (m *EvmLogItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmLogItem.Unmarshal(m, b)
+}
+func (m *EvmLogItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmLogItem.Marshal(b, m, deterministic)
+}
+func (m *EvmLogItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogItem.Merge(m, src)
+}
+func (m *EvmLogItem) XXX_Size() int {
+	return xxx_messageInfo_EvmLogItem.Size(m)
+}
+func (m *EvmLogItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogItem proto.InternalMessageInfo
+
+func (m *EvmLogItem) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EvmLogItem) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *EvmLogItem) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EvmLogItem) GetJsonData() string {
+	if m != nil {
+		return m.JsonData
+	}
+	return ""
+}
+
+type EvmFilterLogsResp struct {
+	Logs []*EvmLogItem `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// 还有更多满足条件的日志时不为空，作为下次查询的cursor
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmFilterLogsResp) Reset()         { *m = EvmFilterLogsResp{} }
+func (m *EvmFilterLogsResp) String() string { return proto.CompactTextString(m) }
+func (*EvmFilterLogsResp) ProtoMessage()    {}
+func (*EvmFilterLogsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{27}
+}
+
+func (m *EvmFilterLogsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmFilterLogsResp.Unmarshal(m, b)
+}
+func (m *EvmFilterLogsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmFilterLogsResp.Marshal(b, m, deterministic)
+}
+func (m *EvmFilterLogsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmFilterLogsResp.Merge(m, src)
+}
+func (m *EvmFilterLogsResp) XXX_Size() int {
+	return xxx_messageInfo_EvmFilterLogsResp.Size(m)
+}
+func (m *EvmFilterLogsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmFilterLogsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmFilterLogsResp proto.InternalMessageInfo
+
+func (m *EvmFilterLogsResp) GetLogs() []*EvmLogItem {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *EvmFilterLogsResp) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// 以原始字节数据调用合约，不修改状态数据
+type EvmRawCallReq struct {
+	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Caller               string   `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmRawCallReq) Reset()         { *m = EvmRawCallReq{} }
+func (m *EvmRawCallReq) String() string { return proto.CompactTextString(m) }
+func (*EvmRawCallReq) ProtoMessage()    {}
+func (*EvmRawCallReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{28}
+}
+
+func (m *EvmRawCallReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmRawCallReq.Unmarshal(m, b)
+}
+func (m *EvmRawCallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmRawCallReq.Marshal(b, m, deterministic)
+}
+func (m *EvmRawCallReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmRawCallReq.Merge(m, src)
+}
+func (m *EvmRawCallReq) XXX_Size() int {
+	return xxx_messageInfo_EvmRawCallReq.Size(m)
+}
+func (m *EvmRawCallReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmRawCallReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmRawCallReq proto.InternalMessageInfo
+
+func (m *EvmRawCallReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EvmRawCallReq) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EvmRawCallReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmRawCallReq) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type EvmRawCallResp struct {
+	Ret                  []byte   `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	UsedGas              uint64   `protobuf:"varint,2,opt,name=usedGas,proto3" json:"usedGas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmRawCallResp) Reset()         { *m = EvmRawCallResp{} }
+func (m *EvmRawCallResp) String() string { return proto.CompactTextString(m) }
+func (*EvmRawCallResp) ProtoMessage()    {}
+func (*EvmRawCallResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{29}
+}
+
+func (m *EvmRawCallResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmRawCallResp.Unmarshal(m, b)
+}
+func (m *EvmRawCallResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmRawCallResp.Marshal(b, m, deterministic)
+}
+func (m *EvmRawCallResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmRawCallResp.Merge(m, src)
+}
+func (m *EvmRawCallResp) XXX_Size() int {
+	return xxx_messageInfo_EvmRawCallResp.Size(m)
+}
+func (m *EvmRawCallResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmRawCallResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmRawCallResp proto.InternalMessageInfo
+
+func (m *EvmRawCallResp) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EvmRawCallResp) GetUsedGas() uint64 {
+	if m != nil {
+		return m.UsedGas
+	}
+	return 0
+}
+
+type EvmGetCodeReq struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetCodeReq) Reset()         { *m = EvmGetCodeReq{} }
+func (m *EvmGetCodeReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetCodeReq) ProtoMessage()    {}
+func (*EvmGetCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{30}
+}
+
+func (m *EvmGetCodeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetCodeReq.Unmarshal(m, b)
+}
+func (m *EvmGetCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetCodeReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetCodeReq.Merge(m, src)
+}
+func (m *EvmGetCodeReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetCodeReq.Size(m)
+}
+func (m *EvmGetCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetCodeReq proto.InternalMessageInfo
+
+func (m *EvmGetCodeReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type EvmGetCodeResp struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code                 []byte   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetCodeResp) Reset()         { *m = EvmGetCodeResp{} }
+func (m *EvmGetCodeResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetCodeResp) ProtoMessage()    {}
+func (*EvmGetCodeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{31}
+}
+
+func (m *EvmGetCodeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetCodeResp.Unmarshal(m, b)
+}
+func (m *EvmGetCodeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetCodeResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetCodeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetCodeResp.Merge(m, src)
+}
+func (m *EvmGetCodeResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetCodeResp.Size(m)
+}
+func (m *EvmGetCodeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetCodeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetCodeResp proto.InternalMessageInfo
+
+func (m *EvmGetCodeResp) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmGetCodeResp) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+type EvmGetBalanceReq struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetBalanceReq) Reset()         { *m = EvmGetBalanceReq{} }
+func (m *EvmGetBalanceReq) String() string { return proto.CompactTextString(m) }
+func (*EvmGetBalanceReq) ProtoMessage()    {}
+func (*EvmGetBalanceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{32}
+}
+
+func (m *EvmGetBalanceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetBalanceReq.Unmarshal(m, b)
+}
+func (m *EvmGetBalanceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetBalanceReq.Marshal(b, m, deterministic)
+}
+func (m *EvmGetBalanceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetBalanceReq.Merge(m, src)
+}
+func (m *EvmGetBalanceReq) XXX_Size() int {
+	return xxx_messageInfo_EvmGetBalanceReq.Size(m)
+}
+func (m *EvmGetBalanceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetBalanceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetBalanceReq proto.InternalMessageInfo
+
+func (m *EvmGetBalanceReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type EvmGetBalanceResp struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance              uint64   `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetBalanceResp) Reset()         { *m = EvmGetBalanceResp{} }
+func (m *EvmGetBalanceResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetBalanceResp) ProtoMessage()    {}
+func (*EvmGetBalanceResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{33}
+}
+
+func (m *EvmGetBalanceResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetBalanceResp.Unmarshal(m, b)
+}
+func (m *EvmGetBalanceResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetBalanceResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetBalanceResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetBalanceResp.Merge(m, src)
+}
+func (m *EvmGetBalanceResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetBalanceResp.Size(m)
+}
+func (m *EvmGetBalanceResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetBalanceResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetBalanceResp proto.InternalMessageInfo
+
+func (m *EvmGetBalanceResp) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmGetBalanceResp) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// 在交易所在区块的父区块状态上重新执行交易，并返回执行跟踪信息
+type EvmTraceTxReq struct {
+	TxHash       string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	DisableStack bool   `protobuf:"varint,2,opt,name=disableStack,proto3" json:"disableStack,omitempty"`
+	EnableMemory bool   `protobuf:"varint,3,opt,name=enableMemory,proto3" json:"enableMemory,omitempty"`
+	// 最多记录的指令条数，为0时使用默认值
+	StepLimit            int32    `protobuf:"varint,4,opt,name=stepLimit,proto3" json:"stepLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTraceTxReq) Reset()         { *m = EvmTraceTxReq{} }
+func (m *EvmTraceTxReq) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxReq) ProtoMessage()    {}
+func (*EvmTraceTxReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{34}
+}
+
+func (m *EvmTraceTxReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxReq.Unmarshal(m, b)
+}
+func (m *EvmTraceTxReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxReq.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceTxReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxReq.Merge(m, src)
+}
+func (m *EvmTraceTxReq) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxReq.Size(m)
+}
+func (m *EvmTraceTxReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceTxReq proto.InternalMessageInfo
+
+func (m *EvmTraceTxReq) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmTraceTxReq) GetDisableStack() bool {
+	if m != nil {
+		return m.DisableStack
+	}
+	return false
+}
+
+func (m *EvmTraceTxReq) GetEnableMemory() bool {
+	if m != nil {
+		return m.EnableMemory
+	}
+	return false
+}
+
+func (m *EvmTraceTxReq) GetStepLimit() int32 {
+	if m != nil {
+		return m.StepLimit
+	}
+	return 0
+}
+
+// 单条指令执行前的状态
+type EvmTraceStep struct {
+	Pc                   uint64   `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op                   string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas                  uint64   `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost              uint64   `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth                int32    `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Stack                []string `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory               []string `protobuf:"bytes,7,rep,name=memory,proto3" json:"memory,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmTraceStep) Reset()         { *m = EvmTraceStep{} }
+func (m *EvmTraceStep) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceStep) ProtoMessage()    {}
+func (*EvmTraceStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{35}
+}
+
+func (m *EvmTraceStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceStep.Unmarshal(m, b)
+}
+func (m *EvmTraceStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceStep.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceStep.Merge(m, src)
+}
+func (m *EvmTraceStep) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceStep.Size(m)
+}
+func (m *EvmTraceStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceStep proto.InternalMessageInfo
+
+func (m *EvmTraceStep) GetPc() uint64 {
+	if m != nil {
+		return m.Pc
+	}
+	return 0
+}
+
+func (m *EvmTraceStep) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *EvmTraceStep) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EvmTraceStep) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func (m *EvmTraceStep) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *EvmTraceStep) GetStack() []string {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *EvmTraceStep) GetMemory() []string {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *EvmTraceStep) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// 合约调用树中的一次调用
+type EvmCallFrame struct {
+	Type                 string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                uint64          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas                  uint64          `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed              uint64          `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input                string          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output               string          `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error                string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls                []*EvmCallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvmCallFrame) Reset()         { *m = EvmCallFrame{} }
+func (m *EvmCallFrame) String() string { return proto.CompactTextString(m) }
+func (*EvmCallFrame) ProtoMessage()    {}
+func (*EvmCallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{36}
+}
+
+func (m *EvmCallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallFrame.Unmarshal(m, b)
+}
+func (m *EvmCallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallFrame.Marshal(b, m, deterministic)
+}
+func (m *EvmCallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallFrame.Merge(m, src)
+}
+func (m *EvmCallFrame) XXX_Size() int {
+	return xxx_messageInfo_EvmCallFrame.Size(m)
+}
+func (m *EvmCallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallFrame proto.InternalMessageInfo
+
+func (m *EvmCallFrame) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EvmCallFrame) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EvmCallFrame) GetCalls() []*EvmCallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// 合约存储数据在交易执行前后的变化
+type EvmStorageDiff struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Original             string   `protobuf:"bytes,3,opt,name=original,proto3" json:"original,omitempty"`
+	Current              string   `protobuf:"bytes,4,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmStorageDiff) Reset()         { *m = EvmStorageDiff{} }
+func (m *EvmStorageDiff) String() string { return proto.CompactTextString(m) }
+func (*EvmStorageDiff) ProtoMessage()    {}
+func (*EvmStorageDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{37}
+}
+
+func (m *EvmStorageDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmStorageDiff.Unmarshal(m, b)
+}
+func (m *EvmStorageDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmStorageDiff.Marshal(b, m, deterministic)
+}
+func (m *EvmStorageDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmStorageDiff.Merge(m, src)
+}
+func (m *EvmStorageDiff) XXX_Size() int {
+	return xxx_messageInfo_EvmStorageDiff.Size(m)
+}
+func (m *EvmStorageDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmStorageDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmStorageDiff proto.InternalMessageInfo
+
+func (m *EvmStorageDiff) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmStorageDiff) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EvmStorageDiff) GetOriginal() string {
+	if m != nil {
+		return m.Original
+	}
+	return ""
+}
+
+func (m *EvmStorageDiff) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+type EvmTraceTxResp struct {
+	TxHash               string            `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64             `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int32             `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Failed               bool              `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	GasUsed              uint64            `protobuf:"varint,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	ReturnValue          string            `protobuf:"bytes,6,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	Error                string            `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	RevertReason         string            `protobuf:"bytes,8,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	Steps                []*EvmTraceStep   `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`
+	Truncated            bool              `protobuf:"varint,10,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	CallTree             *EvmCallFrame     `protobuf:"bytes,11,opt,name=callTree,proto3" json:"callTree,omitempty"`
+	StorageDiffs         []*EvmStorageDiff `protobuf:"bytes,12,rep,name=storageDiffs,proto3" json:"storageDiffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EvmTraceTxResp) Reset()         { *m = EvmTraceTxResp{} }
+func (m *EvmTraceTxResp) String() string { return proto.CompactTextString(m) }
+func (*EvmTraceTxResp) ProtoMessage()    {}
+func (*EvmTraceTxResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{38}
+}
+
+func (m *EvmTraceTxResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmTraceTxResp.Unmarshal(m, b)
+}
+func (m *EvmTraceTxResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmTraceTxResp.Marshal(b, m, deterministic)
+}
+func (m *EvmTraceTxResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmTraceTxResp.Merge(m, src)
+}
+func (m *EvmTraceTxResp) XXX_Size() int {
+	return xxx_messageInfo_EvmTraceTxResp.Size(m)
+}
+func (m *EvmTraceTxResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmTraceTxResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmTraceTxResp proto.InternalMessageInfo
+
+func (m *EvmTraceTxResp) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EvmTraceTxResp) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EvmTraceTxResp) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *EvmTraceTxResp) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EvmTraceTxResp) GetReturnValue() string {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *EvmTraceTxResp) GetSteps() []*EvmTraceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *EvmTraceTxResp) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *EvmTraceTxResp) GetCallTree() *EvmCallFrame {
+	if m != nil {
+		return m.CallTree
+	}
+	return nil
+}
+
+func (m *EvmTraceTxResp) GetStorageDiffs() []*EvmStorageDiff {
+	if m != nil {
+		return m.StorageDiffs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EVMContractObject)(nil), "types.EVMContractObject")
 	proto.RegisterType((*EVMContractData)(nil), "types.EVMContractData")
@@ -1469,6 +2620,8 @@ func init() {
 	proto.RegisterType((*EVMContractAction)(nil), "types.EVMContractAction")
 	proto.RegisterType((*ReceiptEVMContract)(nil), "types.ReceiptEVMContract")
 	proto.RegisterType((*EVMStateChangeItem)(nil), "types.EVMStateChangeItem")
+	proto.RegisterType((*EVMContractEventLog)(nil), "types.EVMContractEventLog")
+	proto.RegisterType((*EVMEventLogRecord)(nil), "types.EVMEventLogRecord")
 	proto.RegisterType((*EVMContractDataCmd)(nil), "types.EVMContractDataCmd")
 	proto.RegisterType((*EVMContractStateCmd)(nil), "types.EVMContractStateCmd")
 	proto.RegisterMapType((map[string]string)(nil), "types.EVMContractStateCmd.StorageEntry")
@@ -1486,73 +2639,136 @@ func init() {
 	proto.RegisterType((*EvmContractCreateReq)(nil), "types.EvmContractCreateReq")
 	proto.RegisterType((*EvmContractCallReq)(nil), "types.EvmContractCallReq")
 	proto.RegisterType((*EvmContractTransferReq)(nil), "types.EvmContractTransferReq")
+	proto.RegisterType((*EvmTopicFilter)(nil), "types.EvmTopicFilter")
+	proto.RegisterType((*EvmFilterLogsReq)(nil), "types.EvmFilterLogsReq")
+	proto.RegisterType((*EvmLogItem)(nil), "types.EvmLogItem")
+	proto.RegisterType((*EvmFilterLogsResp)(nil), "types.EvmFilterLogsResp")
+	proto.RegisterType((*EvmRawCallReq)(nil), "types.EvmRawCallReq")
+	proto.RegisterType((*EvmRawCallResp)(nil), "types.EvmRawCallResp")
+	proto.RegisterType((*EvmGetCodeReq)(nil), "types.EvmGetCodeReq")
+	proto.RegisterType((*EvmGetCodeResp)(nil), "types.EvmGetCodeResp")
+	proto.RegisterType((*EvmGetBalanceReq)(nil), "types.EvmGetBalanceReq")
+	proto.RegisterType((*EvmGetBalanceResp)(nil), "types.EvmGetBalanceResp")
+	proto.RegisterType((*EvmTraceTxReq)(nil), "types.EvmTraceTxReq")
+	proto.RegisterType((*EvmTraceStep)(nil), "types.EvmTraceStep")
+	proto.RegisterType((*EvmCallFrame)(nil), "types.EvmCallFrame")
+	proto.RegisterType((*EvmStorageDiff)(nil), "types.EvmStorageDiff")
+	proto.RegisterType((*EvmTraceTxResp)(nil), "types.EvmTraceTxResp")
 }
 
 func init() { proto.RegisterFile("evmcontract.proto", fileDescriptor_74353de561acd7c6) }
 
 var fileDescriptor_74353de561acd7c6 = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x96, 0x63, 0x27, 0x1b, 0xbf, 0x0d, 0xed, 0xae, 0x29, 0x4b, 0xb4, 0x42, 0x28, 0xb2, 0x28,
-	0xac, 0x2a, 0xb1, 0x42, 0xe5, 0x82, 0x2a, 0x81, 0xb4, 0x4a, 0xa3, 0x82, 0xc4, 0xf2, 0x63, 0x0a,
-	0xe1, 0x3c, 0xb1, 0x67, 0xb3, 0x6e, 0xe3, 0x1f, 0xcc, 0x4c, 0xd2, 0xe6, 0xca, 0x99, 0x23, 0x47,
-	0x6e, 0x1c, 0xb9, 0x20, 0x71, 0xe3, 0xcc, 0x1f, 0xc1, 0x99, 0x13, 0x7f, 0x06, 0x7a, 0xcf, 0x63,
-	0x7b, 0xec, 0x4d, 0x2a, 0x90, 0x2a, 0xc4, 0x29, 0xf3, 0xcd, 0x3c, 0xcf, 0x7c, 0xdf, 0xbc, 0xef,
-	0xbd, 0x09, 0x1c, 0x8b, 0x4d, 0x1a, 0xe5, 0x99, 0x96, 0x3c, 0xd2, 0xe7, 0x85, 0xcc, 0x75, 0x1e,
-	0xf4, 0xf5, 0xb6, 0x10, 0x2a, 0xfc, 0xce, 0x81, 0xe3, 0xd9, 0xfc, 0x72, 0x6a, 0x16, 0x3f, 0x5f,
-	0x3c, 0x11, 0x91, 0x0e, 0x02, 0xf0, 0x78, 0x1c, 0xcb, 0xb1, 0x33, 0x71, 0xce, 0x7c, 0x46, 0xe3,
-	0xe0, 0x1e, 0x78, 0x31, 0xd7, 0x7c, 0xdc, 0x9b, 0x38, 0x67, 0x87, 0xf7, 0x4f, 0xce, 0xe9, 0xfb,
-	0x73, 0xeb, 0xdb, 0x87, 0x5c, 0x73, 0x46, 0x31, 0xc1, 0xbb, 0xd0, 0x57, 0x9a, 0x6b, 0x31, 0x76,
-	0x29, 0xf8, 0xf5, 0x9b, 0xc1, 0x8f, 0x71, 0x99, 0x95, 0x51, 0xe1, 0xcf, 0x0e, 0xdc, 0xee, 0x6c,
-	0x14, 0x8c, 0xe1, 0x20, 0x92, 0x82, 0xeb, 0xbc, 0x62, 0x51, 0x41, 0x24, 0x97, 0xf1, 0x54, 0x10,
-	0x11, 0x9f, 0xd1, 0x38, 0xb8, 0x03, 0x7d, 0xbe, 0x4a, 0xb8, 0xa2, 0x03, 0x7d, 0x56, 0x82, 0x5a,
-	0x86, 0x67, 0xc9, 0x08, 0xc0, 0x8b, 0xf2, 0x58, 0x8c, 0xfb, 0x13, 0xe7, 0x6c, 0xc4, 0x68, 0x1c,
-	0x9c, 0xc2, 0x10, 0x7f, 0x3f, 0xe6, 0xea, 0x7a, 0x3c, 0xa0, 0xf9, 0x1a, 0x07, 0x47, 0xe0, 0xf2,
-	0x45, 0x32, 0x3e, 0xa0, 0x2d, 0x70, 0x18, 0xfe, 0xe9, 0xc0, 0x51, 0x57, 0x09, 0x12, 0xc8, 0xf2,
-	0x2c, 0x12, 0x44, 0xd6, 0x63, 0x25, 0xc0, 0x8d, 0xd5, 0x3a, 0x89, 0x92, 0x58, 0xc4, 0x44, 0x77,
-	0xc8, 0x6a, 0x1c, 0x4c, 0xe0, 0x50, 0xe9, 0x5c, 0xf2, 0x65, 0x79, 0xae, 0x4b, 0xe7, 0xda, 0x53,
-	0xc1, 0x47, 0x70, 0x60, 0xe0, 0xd8, 0x9b, 0xb8, 0x67, 0x87, 0xf7, 0xdf, 0xda, 0x73, 0x8f, 0xe7,
-	0x8f, 0xcb, 0xb0, 0x59, 0xa6, 0xe5, 0x96, 0x55, 0x1f, 0x9d, 0x3e, 0x80, 0x91, 0xbd, 0x80, 0x52,
-	0x9e, 0x8a, 0xad, 0xb9, 0x4e, 0x1c, 0x22, 0xeb, 0x0d, 0x5f, 0xad, 0xcb, 0xbb, 0x1c, 0xb1, 0x12,
-	0x3c, 0xe8, 0x7d, 0xe0, 0x84, 0xbf, 0xb6, 0x7d, 0x71, 0x11, 0xe9, 0x24, 0xcf, 0x82, 0x13, 0x18,
-	0xf0, 0x34, 0x5f, 0x67, 0xda, 0xc8, 0x34, 0x08, 0x75, 0x2e, 0xb9, 0xfa, 0x34, 0x49, 0x13, 0x4d,
-	0x5b, 0x79, 0xac, 0xc6, 0x66, 0xed, 0x0b, 0x99, 0x44, 0xa5, 0x1d, 0x5e, 0x61, 0x35, 0xae, 0x93,
-	0xe1, 0x59, 0xc9, 0xa8, 0x53, 0xd9, 0xef, 0xa4, 0x32, 0xcb, 0xb5, 0xa0, 0xf4, 0x60, 0xd2, 0x73,
-	0x2d, 0x76, 0xa4, 0xe6, 0x37, 0x07, 0x02, 0x26, 0x22, 0x91, 0x14, 0xda, 0x22, 0x8f, 0xb4, 0x23,
-	0xbe, 0x5a, 0x89, 0xca, 0x4a, 0x06, 0x05, 0x21, 0x8c, 0xaa, 0xaa, 0xf8, 0xac, 0x71, 0x54, 0x6b,
-	0xce, 0x8e, 0xb9, 0x40, 0x2f, 0xb9, 0xed, 0x18, 0x9c, 0x43, 0xaf, 0xae, 0x95, 0x88, 0x1f, 0x71,
-	0x45, 0x4a, 0x3c, 0x56, 0x41, 0xa4, 0x28, 0x85, 0x36, 0x66, 0xc3, 0x21, 0xc6, 0x3e, 0x51, 0x79,
-	0xc6, 0x84, 0x36, 0x5a, 0x2a, 0x18, 0x5e, 0x41, 0x30, 0x9b, 0x5f, 0x52, 0x42, 0xa7, 0xd7, 0x3c,
-	0x5b, 0x8a, 0x4f, 0xb4, 0x48, 0x77, 0x24, 0xed, 0x14, 0x86, 0x85, 0x14, 0x73, 0x2b, 0x6f, 0x35,
-	0x26, 0xb6, 0x6b, 0x29, 0x45, 0xa6, 0xcb, 0xf5, 0xd2, 0x55, 0xad, 0xb9, 0xf0, 0x47, 0x87, 0x0e,
-	0xb2, 0xab, 0x6d, 0x9a, 0xc6, 0xff, 0x49, 0xc1, 0xf9, 0x7b, 0x0a, 0xce, 0x6f, 0x0a, 0x2e, 0xfc,
-	0xcb, 0x81, 0x57, 0xbb, 0x06, 0x47, 0x7e, 0x2f, 0xa5, 0xc2, 0xfc, 0x76, 0x85, 0x5d, 0x74, 0x2b,
-	0xec, 0x9d, 0x3d, 0x15, 0x36, 0x4d, 0xe3, 0x97, 0x53, 0x64, 0xbe, 0x5d, 0x64, 0x3f, 0x39, 0xf0,
-	0xda, 0x4d, 0xbb, 0xa2, 0xd8, 0xff, 0x85, 0x63, 0x7d, 0x72, 0x6c, 0x78, 0x17, 0x6e, 0x4f, 0xaf,
-	0x45, 0xf4, 0x74, 0x36, 0xbf, 0xc4, 0x6f, 0x99, 0xf8, 0x76, 0xd7, 0xfb, 0x10, 0xfe, 0xe0, 0xc0,
-	0x51, 0x3b, 0x4e, 0x15, 0x65, 0xa2, 0xcb, 0x73, 0x29, 0x78, 0xc8, 0x6a, 0x7c, 0x83, 0x67, 0x6f,
-	0x07, 0xcf, 0xae, 0x5e, 0x77, 0x87, 0xde, 0x37, 0xc0, 0x27, 0xf7, 0x51, 0x40, 0xe9, 0xbc, 0x66,
-	0x22, 0xdc, 0xc2, 0xf1, 0x4c, 0xe9, 0x24, 0xe5, 0x5a, 0xcc, 0xe6, 0x97, 0x8f, 0xb8, 0x42, 0xfe,
-	0xb7, 0xa0, 0xa7, 0x73, 0xc3, 0xbe, 0xa7, 0xf3, 0xda, 0xa3, 0x3d, 0xab, 0x0f, 0x35, 0x29, 0x70,
-	0x5b, 0x29, 0x68, 0x7a, 0xa0, 0xd7, 0xea, 0x81, 0xa6, 0x1b, 0xf5, 0x9b, 0x6e, 0xf4, 0x36, 0x04,
-	0xdd, 0xa3, 0x55, 0x81, 0x71, 0x4b, 0xae, 0x8c, 0x8b, 0x71, 0x18, 0xde, 0x85, 0xc3, 0xd9, 0x26,
-	0x7d, 0x28, 0x16, 0xeb, 0x25, 0x92, 0x3b, 0x81, 0x41, 0x5e, 0xa0, 0x0d, 0x29, 0xa6, 0xcf, 0x0c,
-	0x0a, 0xdf, 0x83, 0x51, 0x13, 0xa6, 0x0a, 0xb4, 0x77, 0x8c, 0x00, 0x0d, 0xba, 0x56, 0x46, 0x8d,
-	0x3d, 0x15, 0xde, 0x83, 0x5b, 0xb3, 0x4d, 0xfa, 0xe5, 0x5a, 0xc8, 0xed, 0xc5, 0x22, 0xc1, 0xbd,
-	0xc7, 0x70, 0x80, 0xc9, 0x12, 0xaa, 0x8a, 0xaf, 0x60, 0xf8, 0x21, 0xdc, 0x6e, 0xc5, 0xaa, 0x62,
-	0x7f, 0x70, 0xa5, 0xb5, 0xd7, 0x68, 0xfd, 0x9a, 0x34, 0xd0, 0xe7, 0x2f, 0x3c, 0x07, 0xab, 0x21,
-	0xc9, 0x8a, 0xb5, 0xae, 0xaa, 0x81, 0xc0, 0xbe, 0xcb, 0x0e, 0xbf, 0x77, 0x48, 0xb4, 0xd9, 0xf7,
-	0x85, 0x9c, 0xfe, 0xd5, 0xc6, 0xb8, 0x8f, 0xe4, 0xcf, 0xb0, 0xf7, 0x19, 0xcb, 0x54, 0x10, 0x2d,
-	0x8b, 0x1d, 0x99, 0x96, 0xca, 0x64, 0xd6, 0x38, 0xfc, 0xdd, 0x81, 0x3b, 0xb3, 0x4d, 0x5a, 0x57,
-	0x2a, 0x36, 0x48, 0x61, 0x0a, 0x82, 0x0c, 0xe4, 0x58, 0x4d, 0xee, 0x08, 0xdc, 0x2b, 0x51, 0x7a,
-	0xca, 0x65, 0x38, 0xac, 0x1f, 0x31, 0xd7, 0x7a, 0xc4, 0xea, 0x46, 0xea, 0xd9, 0x8d, 0xb4, 0xa1,
-	0xdd, 0x6f, 0xd1, 0x36, 0x17, 0x3f, 0xa8, 0x2f, 0x1e, 0x23, 0xc5, 0xf3, 0x22, 0x91, 0xc2, 0xbc,
-	0x83, 0x06, 0xd1, 0x2b, 0xc1, 0x25, 0xa7, 0xa2, 0x18, 0x96, 0x32, 0x2a, 0x1c, 0xfe, 0x81, 0x2f,
-	0x80, 0x25, 0x83, 0xaf, 0x56, 0xc6, 0x78, 0x3b, 0x5f, 0x77, 0xbb, 0x3a, 0x3a, 0xe2, 0xdc, 0x9b,
-	0xe2, 0x3c, 0x4b, 0xdc, 0x3f, 0x97, 0x11, 0x80, 0x27, 0x9e, 0x8b, 0xc8, 0x88, 0xa0, 0xb1, 0x25,
-	0x6d, 0xb8, 0x57, 0x9a, 0xdf, 0x91, 0xf6, 0x8b, 0x03, 0x27, 0x96, 0xb4, 0xaf, 0x24, 0xcf, 0xd4,
-	0x95, 0x90, 0x46, 0xde, 0xce, 0x9e, 0xda, 0xc8, 0x46, 0x81, 0x3d, 0x5b, 0x36, 0x51, 0x72, 0x77,
-	0x52, 0xf2, 0x5a, 0x94, 0xde, 0x04, 0x48, 0xd4, 0x37, 0x89, 0xbe, 0x8e, 0x25, 0x7f, 0x46, 0x62,
-	0x87, 0xcc, 0x9a, 0x69, 0x51, 0x1e, 0xb4, 0x29, 0x2f, 0x06, 0xf4, 0x87, 0xfc, 0xfd, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x17, 0xf5, 0x15, 0xa5, 0x0b, 0x00, 0x00,
+	// 1776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbd, 0x6f, 0x24, 0x49,
+	0x15, 0x57, 0x4f, 0xf7, 0x7c, 0xd5, 0xcc, 0x79, 0xd7, 0x7d, 0x77, 0xa6, 0xb5, 0x42, 0x68, 0x54,
+	0x62, 0xc1, 0x77, 0xba, 0x5b, 0x90, 0x49, 0xe0, 0x04, 0x27, 0x2d, 0xde, 0x39, 0xdf, 0x49, 0x36,
+	0x1f, 0x65, 0x9f, 0x89, 0xcb, 0xdd, 0xcf, 0xe3, 0xde, 0x9d, 0xee, 0x6a, 0xaa, 0x6a, 0x66, 0x6d,
+	0x91, 0x91, 0x02, 0x19, 0x09, 0x12, 0x19, 0x21, 0x09, 0x12, 0x44, 0xc4, 0x24, 0xfc, 0x07, 0xc4,
+	0x44, 0xc4, 0x24, 0xa4, 0xe8, 0x55, 0x55, 0x77, 0x57, 0x8f, 0x67, 0x0c, 0x48, 0x2b, 0x74, 0x91,
+	0xeb, 0xf7, 0xfa, 0x4d, 0xd5, 0xfb, 0xbd, 0xaf, 0x7a, 0x65, 0xb2, 0x0f, 0xeb, 0x22, 0x15, 0xa5,
+	0x96, 0x3c, 0xd5, 0xcf, 0x2a, 0x29, 0xb4, 0x88, 0xfb, 0xfa, 0xae, 0x02, 0x45, 0x7f, 0x1e, 0x90,
+	0xfd, 0xf9, 0xe5, 0xd9, 0xb1, 0xfb, 0xf8, 0xc3, 0xab, 0x97, 0x90, 0xea, 0x38, 0x26, 0x11, 0xcf,
+	0x32, 0x99, 0x04, 0xb3, 0xe0, 0x70, 0xcc, 0xcc, 0x3a, 0x7e, 0x9f, 0x44, 0x19, 0xd7, 0x3c, 0xe9,
+	0xcd, 0x82, 0xc3, 0xc9, 0xd1, 0xc1, 0x33, 0xf3, 0xfb, 0x67, 0xde, 0x6f, 0x5f, 0x70, 0xcd, 0x99,
+	0xd1, 0x89, 0x3f, 0x24, 0x7d, 0xa5, 0xb9, 0x86, 0x24, 0x34, 0xca, 0x5f, 0xba, 0xaf, 0x7c, 0x8e,
+	0x9f, 0x99, 0xd5, 0xa2, 0xbf, 0x0f, 0xc8, 0xa3, 0x8d, 0x8d, 0xe2, 0x84, 0x0c, 0x53, 0x09, 0x5c,
+	0x8b, 0xda, 0x8a, 0x1a, 0xa2, 0x71, 0x25, 0x2f, 0xc0, 0x18, 0x32, 0x66, 0x66, 0x1d, 0xbf, 0x43,
+	0xfa, 0x7c, 0x99, 0x73, 0x65, 0x0e, 0x1c, 0x33, 0x0b, 0x1a, 0x1a, 0x91, 0x47, 0x23, 0x26, 0x51,
+	0x2a, 0x32, 0x48, 0xfa, 0xb3, 0xe0, 0x70, 0xca, 0xcc, 0x3a, 0x7e, 0x42, 0x46, 0xf8, 0xf7, 0x53,
+	0xae, 0x6e, 0x92, 0x81, 0x91, 0x37, 0x38, 0x7e, 0x4c, 0x42, 0x7e, 0x95, 0x27, 0x43, 0xb3, 0x05,
+	0x2e, 0xe9, 0xdf, 0x03, 0xf2, 0x78, 0x93, 0x09, 0x1a, 0x50, 0x8a, 0x32, 0x05, 0x63, 0x6c, 0xc4,
+	0x2c, 0xc0, 0x8d, 0xd5, 0x2a, 0x4f, 0xf3, 0x0c, 0x32, 0x63, 0xee, 0x88, 0x35, 0x38, 0x9e, 0x91,
+	0x89, 0xd2, 0x42, 0xf2, 0x85, 0x3d, 0x37, 0x34, 0xe7, 0xfa, 0xa2, 0xf8, 0x63, 0x32, 0x74, 0x30,
+	0x89, 0x66, 0xe1, 0xe1, 0xe4, 0xe8, 0xab, 0x3b, 0xfc, 0xf8, 0xec, 0xdc, 0xaa, 0xcd, 0x4b, 0x2d,
+	0xef, 0x58, 0xfd, 0xa3, 0x27, 0x1f, 0x91, 0xa9, 0xff, 0x01, 0xa9, 0xbc, 0x82, 0x3b, 0xe7, 0x4e,
+	0x5c, 0xa2, 0xd5, 0x6b, 0xbe, 0x5c, 0x59, 0x5f, 0x4e, 0x99, 0x05, 0x1f, 0xf5, 0xbe, 0x1d, 0xd0,
+	0x3f, 0x76, 0xf3, 0xe2, 0x79, 0xaa, 0x73, 0x51, 0xc6, 0x07, 0x64, 0xc0, 0x0b, 0xb1, 0x2a, 0xb5,
+	0xa3, 0xe9, 0x10, 0xf2, 0x5c, 0x70, 0x75, 0x9a, 0x17, 0xb9, 0x36, 0x5b, 0x45, 0xac, 0xc1, 0xee,
+	0xdb, 0x8f, 0x64, 0x9e, 0xda, 0x74, 0x78, 0x8b, 0x35, 0xb8, 0x09, 0x46, 0xe4, 0x05, 0xa3, 0x09,
+	0x65, 0x7f, 0x23, 0x94, 0xa5, 0xd0, 0x90, 0x0c, 0x5c, 0xd0, 0x85, 0x86, 0x2d, 0xa1, 0xf9, 0x73,
+	0x40, 0x62, 0x06, 0x29, 0xe4, 0x95, 0xf6, 0x8c, 0x47, 0xb3, 0x53, 0xbe, 0x5c, 0x42, 0x9d, 0x4a,
+	0x0e, 0xc5, 0x94, 0x4c, 0xeb, 0xaa, 0xf8, 0x41, 0x9b, 0x51, 0x1d, 0x99, 0xaf, 0xf3, 0x1c, 0x73,
+	0x29, 0xec, 0xea, 0xa0, 0x0c, 0x73, 0x75, 0xa5, 0x20, 0x3b, 0xe1, 0xca, 0x30, 0x89, 0x58, 0x0d,
+	0xd1, 0x44, 0x09, 0xda, 0x25, 0x1b, 0x2e, 0x51, 0xf7, 0xa5, 0x12, 0x25, 0x03, 0xed, 0xb8, 0xd4,
+	0x90, 0x5e, 0x93, 0x78, 0x7e, 0x79, 0x66, 0x02, 0x7a, 0x7c, 0xc3, 0xcb, 0x05, 0x7c, 0xa6, 0xa1,
+	0xd8, 0x12, 0xb4, 0x27, 0x64, 0x54, 0x49, 0xb8, 0xf4, 0xe2, 0xd6, 0x60, 0x63, 0xed, 0x4a, 0x4a,
+	0x28, 0xb5, 0xfd, 0x6e, 0xb3, 0xaa, 0x23, 0xa3, 0x3f, 0x23, 0x6f, 0x7b, 0xce, 0x99, 0xaf, 0xa1,
+	0xd4, 0xa7, 0x62, 0x71, 0x8f, 0x68, 0xb0, 0x85, 0xe8, 0x01, 0x19, 0x68, 0x51, 0xe5, 0xa9, 0x4a,
+	0x7a, 0xb3, 0xf0, 0x70, 0xca, 0x1c, 0xc2, 0xe8, 0x98, 0xde, 0x60, 0x8f, 0x33, 0x6b, 0x8c, 0x63,
+	0x5e, 0x66, 0x70, 0x6b, 0x5c, 0xd2, 0x67, 0x16, 0xd0, 0x5f, 0xd8, 0xbc, 0xaa, 0x4f, 0x65, 0x90,
+	0x0a, 0x99, 0xc5, 0x1f, 0x90, 0x70, 0x29, 0x16, 0xe6, 0xc8, 0xc9, 0xd1, 0x93, 0xfb, 0x59, 0xde,
+	0xa8, 0xa3, 0x1a, 0x5a, 0x71, 0x03, 0xf9, 0xe2, 0xc6, 0xe6, 0x5a, 0xc8, 0x1c, 0x42, 0xd7, 0xea,
+	0xdb, 0xcf, 0xcc, 0x99, 0xa1, 0x39, 0xb3, 0x86, 0xc6, 0xee, 0x5b, 0x53, 0x66, 0x36, 0xd3, 0x1c,
+	0xa2, 0xbf, 0x0d, 0x48, 0xec, 0x1d, 0x83, 0x8d, 0xe7, 0xb8, 0xc8, 0xfe, 0x2f, 0xbd, 0x67, 0xbc,
+	0xa3, 0xf7, 0x8c, 0xdb, 0xde, 0x43, 0xff, 0x11, 0x74, 0x42, 0x65, 0x53, 0xa3, 0xc8, 0xde, 0x4c,
+	0xb3, 0x19, 0x77, 0x9b, 0xcd, 0xf3, 0xcd, 0x66, 0xf3, 0xf5, 0x1d, 0xcd, 0xe6, 0xb8, 0xc8, 0xde,
+	0x4c, 0xbf, 0x19, 0xfb, 0xfd, 0xe6, 0x77, 0x01, 0x79, 0xf7, 0x7e, 0xe5, 0x22, 0xd9, 0x2f, 0x44,
+	0xf1, 0x8e, 0x4d, 0xf1, 0xd2, 0xa7, 0xe4, 0xd1, 0xf1, 0x0d, 0xa4, 0xaf, 0xe6, 0x97, 0x67, 0xf8,
+	0x5b, 0x06, 0x3f, 0xdd, 0x76, 0x55, 0xd2, 0x5f, 0x07, 0xe4, 0x71, 0x57, 0x4f, 0x55, 0x36, 0xd0,
+	0xf6, 0x5c, 0xa3, 0x3c, 0x62, 0x0d, 0xbe, 0x67, 0x67, 0x6f, 0x8b, 0x9d, 0x9b, 0x7c, 0xc3, 0x2d,
+	0x7c, 0xbf, 0x4c, 0xc6, 0x26, 0xfb, 0x8c, 0x82, 0xcd, 0xbc, 0x56, 0x40, 0xef, 0xc8, 0xfe, 0x5c,
+	0xe9, 0xbc, 0xe0, 0x1a, 0xe6, 0x97, 0x67, 0x27, 0x5c, 0xa1, 0xfd, 0x7b, 0xa4, 0xa7, 0x85, 0xb3,
+	0xbe, 0xa7, 0x45, 0x93, 0xa3, 0x3d, 0xaf, 0x25, 0xb7, 0x21, 0x08, 0x3b, 0x21, 0x68, 0xaf, 0x83,
+	0xa8, 0x73, 0x1d, 0xb8, 0xc6, 0xdc, 0x6f, 0x1b, 0xf3, 0xd7, 0x48, 0xbc, 0x79, 0xb4, 0xaa, 0x50,
+	0x6f, 0xc1, 0x95, 0xcb, 0x62, 0x5c, 0xd2, 0xa7, 0x64, 0x32, 0x5f, 0x17, 0x2f, 0xe0, 0x6a, 0xb5,
+	0x40, 0xe3, 0x0e, 0xc8, 0x40, 0x54, 0x98, 0x86, 0x46, 0xa7, 0xcf, 0x1c, 0xa2, 0xdf, 0x24, 0xd3,
+	0x56, 0x4d, 0x55, 0x98, 0xde, 0x19, 0x02, 0x4c, 0xd0, 0x95, 0x72, 0x6c, 0x7c, 0x11, 0x7d, 0x9f,
+	0xec, 0xcd, 0xd7, 0xc5, 0x8f, 0x57, 0x20, 0xef, 0x9e, 0x5f, 0xe5, 0xb8, 0x77, 0x42, 0x86, 0x18,
+	0x2c, 0x50, 0xb5, 0x7e, 0x0d, 0xe9, 0xf7, 0xc8, 0xa3, 0x8e, 0xae, 0xaa, 0x76, 0x2b, 0xd7, 0x5c,
+	0x7b, 0x2d, 0xd7, 0xcf, 0xc9, 0xa4, 0xfe, 0xf9, 0x83, 0xe7, 0xd8, 0x0e, 0x59, 0xad, 0x74, 0x5d,
+	0x0d, 0x06, 0xec, 0x72, 0x36, 0xfd, 0x65, 0x40, 0xa6, 0xed, 0xbe, 0xaa, 0x7a, 0x53, 0x1b, 0xe3,
+	0x3e, 0x92, 0xbf, 0xc6, 0xde, 0xe7, 0x52, 0xa6, 0x86, 0x98, 0xb2, 0x78, 0x39, 0x99, 0x4f, 0x36,
+	0x98, 0x0d, 0xa6, 0x7f, 0x09, 0xc8, 0x3b, 0xf3, 0x75, 0xd1, 0x54, 0xaa, 0x04, 0xae, 0xc1, 0x15,
+	0x84, 0x49, 0xa0, 0xc0, 0x6b, 0x72, 0x8f, 0x49, 0x78, 0x0d, 0xe0, 0xda, 0x35, 0x2e, 0x9b, 0xfb,
+	0x3c, 0xf4, 0xee, 0xf3, 0xa6, 0x91, 0x46, 0x7e, 0x23, 0x6d, 0xcd, 0xee, 0x77, 0xcc, 0x76, 0x8e,
+	0x1f, 0x34, 0x8e, 0x47, 0x4d, 0xb8, 0xad, 0x72, 0x09, 0x6e, 0x24, 0x70, 0xc8, 0x5c, 0x98, 0x5c,
+	0x72, 0x53, 0x14, 0x23, 0x4b, 0xa3, 0xc6, 0xf4, 0x6f, 0x78, 0x03, 0x78, 0x34, 0xf8, 0x72, 0xe9,
+	0x12, 0x6f, 0xeb, 0xa0, 0xe3, 0x57, 0xc7, 0x06, 0xb9, 0xf0, 0x3e, 0xb9, 0xc8, 0x23, 0xf7, 0xdf,
+	0xd3, 0x88, 0x49, 0x04, 0xb7, 0x90, 0x3a, 0x12, 0x66, 0xed, 0x51, 0x1b, 0xed, 0xa4, 0x36, 0xde,
+	0xa0, 0xf6, 0x87, 0x80, 0x1c, 0x78, 0xd4, 0x2e, 0x24, 0x2f, 0xd5, 0x35, 0x48, 0x47, 0x6f, 0x6b,
+	0x4f, 0x6d, 0x69, 0x23, 0xc1, 0x9e, 0x4f, 0xdb, 0x98, 0x14, 0x6e, 0x35, 0x29, 0xea, 0x98, 0xf4,
+	0x15, 0x42, 0x72, 0xf5, 0x93, 0x5c, 0xdf, 0x64, 0x92, 0xbf, 0x36, 0x64, 0x47, 0xcc, 0x93, 0x74,
+	0x4c, 0x1e, 0x6c, 0x98, 0x7c, 0x68, 0xaa, 0xf4, 0x02, 0x87, 0x8a, 0x4f, 0xf2, 0xa5, 0x06, 0x7f,
+	0xe2, 0x08, 0x66, 0x21, 0x9e, 0x62, 0x11, 0xfd, 0x2b, 0x0e, 0xe1, 0xeb, 0xc2, 0x6a, 0x9d, 0x8a,
+	0x85, 0xe9, 0x65, 0xd8, 0xfe, 0x6c, 0x09, 0x40, 0xad, 0xdf, 0x0a, 0xe2, 0x0f, 0x3b, 0xc3, 0xcb,
+	0xe4, 0xe8, 0xdd, 0xfa, 0x82, 0xeb, 0x9c, 0x58, 0x9f, 0x80, 0x3c, 0xae, 0xa5, 0x28, 0x3e, 0xb5,
+	0x93, 0x86, 0x8d, 0xae, 0x27, 0x41, 0x1e, 0x5a, 0xb8, 0xaf, 0x91, 0xf9, 0xda, 0x60, 0xcc, 0xe4,
+	0xd4, 0xb8, 0xb1, 0x6f, 0x67, 0x1f, 0x03, 0x8c, 0xd7, 0x57, 0x52, 0x09, 0xe9, 0x78, 0x3b, 0x44,
+	0xff, 0x19, 0x10, 0x32, 0x5f, 0x17, 0xa7, 0x62, 0x61, 0x26, 0xbe, 0xdd, 0x75, 0xdd, 0x1d, 0xbf,
+	0xc6, 0x5b, 0xc7, 0xaf, 0xb1, 0x1b, 0xbf, 0xda, 0x21, 0x29, 0xda, 0x35, 0x24, 0xf5, 0x77, 0x0d,
+	0x49, 0xce, 0x3c, 0x8b, 0x90, 0xe8, 0x52, 0x2c, 0xec, 0x4f, 0x86, 0xe6, 0x27, 0x0d, 0x46, 0x8f,
+	0x03, 0xce, 0x66, 0x5e, 0x6d, 0xb5, 0x82, 0x4e, 0xff, 0x18, 0x6f, 0xf4, 0x0f, 0x46, 0xf6, 0x37,
+	0xe2, 0xa7, 0xaa, 0xf8, 0x29, 0x89, 0x96, 0x62, 0x61, 0x63, 0x37, 0x39, 0xda, 0x6f, 0x03, 0xe4,
+	0x7c, 0xc3, 0xcc, 0x67, 0xcf, 0x91, 0xbd, 0x8e, 0x23, 0x53, 0xf2, 0xd6, 0x7c, 0x5d, 0x30, 0xfe,
+	0xba, 0x2e, 0xe3, 0x2d, 0x97, 0x5b, 0xf3, 0x86, 0x9d, 0xb6, 0x8e, 0xfa, 0x5f, 0x2e, 0x37, 0xfa,
+	0x5d, 0xb2, 0xe7, 0x1f, 0xa2, 0xaa, 0x7a, 0x4e, 0x08, 0x3a, 0x43, 0x7e, 0x3d, 0x53, 0xf4, 0x3a,
+	0x33, 0x05, 0x7d, 0xcf, 0x98, 0x78, 0x02, 0xfa, 0x58, 0x64, 0xf0, 0xf0, 0x35, 0xf4, 0x31, 0xd9,
+	0xf3, 0x55, 0x1f, 0xec, 0xf8, 0x5b, 0x6e, 0x6d, 0xfa, 0x81, 0xa9, 0x90, 0x13, 0xd0, 0xdf, 0xe7,
+	0x4b, 0x5e, 0xa6, 0xff, 0xe1, 0xb4, 0x13, 0xb2, 0xbf, 0xa1, 0xfd, 0xe0, 0x81, 0x09, 0x19, 0x5e,
+	0x59, 0xc5, 0x9a, 0xa1, 0x83, 0xf4, 0x57, 0x81, 0xa1, 0x78, 0x21, 0x79, 0x0a, 0x17, 0xb7, 0xae,
+	0xdb, 0xb8, 0xc4, 0x0a, 0x3a, 0x89, 0x45, 0xc9, 0x34, 0xcb, 0x15, 0xbf, 0x5a, 0xc2, 0xb9, 0xe6,
+	0xe9, 0x2b, 0x37, 0xb4, 0x76, 0x64, 0xa8, 0x03, 0x25, 0xc2, 0x33, 0x28, 0x84, 0xbc, 0x33, 0x31,
+	0x1a, 0xb1, 0x8e, 0x0c, 0x93, 0x50, 0x69, 0xa8, 0xec, 0xf3, 0xd3, 0xbe, 0x36, 0x5a, 0x01, 0xfd,
+	0x93, 0xbd, 0x37, 0x8d, 0x3d, 0xe7, 0x1a, 0x2a, 0x4c, 0x8a, 0x2a, 0x75, 0x7d, 0xbd, 0x57, 0xa5,
+	0x88, 0x45, 0xe5, 0x32, 0xa9, 0x27, 0x9a, 0xa9, 0x24, 0x6c, 0xa6, 0x12, 0x24, 0xbb, 0xe0, 0xea,
+	0x58, 0xa8, 0x3a, 0x17, 0x6a, 0x88, 0x85, 0x9e, 0x41, 0xa5, 0x6f, 0xea, 0x42, 0x37, 0x00, 0xa5,
+	0xca, 0x30, 0x1a, 0x98, 0x32, 0xb5, 0x00, 0xdd, 0x50, 0x58, 0x12, 0x43, 0x5b, 0xbd, 0x16, 0xa1,
+	0x36, 0x48, 0x29, 0xa4, 0xab, 0x1f, 0x0b, 0xe8, 0xbf, 0xac, 0xd9, 0x98, 0x64, 0x9f, 0x48, 0x2c,
+	0xa6, 0x98, 0x44, 0xcd, 0x24, 0x34, 0x66, 0x66, 0x8d, 0x32, 0xec, 0x48, 0xf5, 0x75, 0x84, 0x6b,
+	0x97, 0xf3, 0x61, 0x93, 0xf3, 0xcd, 0xcc, 0x6d, 0x4d, 0xb7, 0xa0, 0x26, 0xd9, 0xdf, 0x24, 0xf9,
+	0xb9, 0x82, 0x2c, 0x19, 0x34, 0x24, 0x11, 0xb6, 0xe3, 0xc4, 0x70, 0x63, 0x9c, 0x10, 0x2b, 0x8d,
+	0x62, 0x77, 0x25, 0x59, 0xd4, 0xd2, 0x19, 0x7b, 0x74, 0xe2, 0xf7, 0x48, 0x1f, 0xeb, 0x4a, 0x25,
+	0xc4, 0x94, 0xf6, 0xdb, 0x6d, 0x69, 0x37, 0x0c, 0x99, 0xd5, 0xa0, 0xd2, 0xe4, 0xbd, 0x7b, 0x49,
+	0xbc, 0xc8, 0xaf, 0xaf, 0x1f, 0x9e, 0xbe, 0xf0, 0x89, 0xd1, 0xeb, 0xbc, 0x8e, 0x85, 0xcc, 0x17,
+	0x79, 0xc9, 0x97, 0xce, 0x09, 0x0d, 0xc6, 0x7d, 0xdc, 0x4b, 0xb8, 0x9e, 0x74, 0x1c, 0xa4, 0xbf,
+	0x09, 0xc9, 0x5e, 0x9d, 0x24, 0x98, 0xb4, 0xaa, 0xda, 0x99, 0xb5, 0xbb, 0x5e, 0x9f, 0xcd, 0x7b,
+	0x37, 0xf4, 0xde, 0xbb, 0xa8, 0x7d, 0xcd, 0xf3, 0x25, 0x64, 0xe6, 0xc4, 0x11, 0x73, 0xc8, 0xf7,
+	0x76, 0xbf, 0xeb, 0xed, 0x19, 0x99, 0x48, 0xd0, 0x2b, 0x59, 0xda, 0x17, 0xbc, 0xed, 0xc5, 0xbe,
+	0xa8, 0xf5, 0xf0, 0xd0, 0xf7, 0x30, 0x25, 0x53, 0x09, 0x6b, 0x90, 0x9a, 0x01, 0x57, 0xa2, 0x74,
+	0x51, 0xe9, 0xc8, 0x30, 0x0a, 0x58, 0x18, 0x2a, 0x19, 0x6f, 0x46, 0xa1, 0x29, 0x0f, 0x66, 0x35,
+	0xb0, 0xa8, 0xb4, 0x5c, 0x95, 0x29, 0xd7, 0x90, 0x25, 0xc4, 0xd8, 0xde, 0x0a, 0xe2, 0x6f, 0x90,
+	0x11, 0x06, 0xeb, 0x42, 0x02, 0x24, 0x93, 0x59, 0xd0, 0xdd, 0xab, 0x8d, 0x68, 0xa3, 0x14, 0x7f,
+	0x87, 0x4c, 0x55, 0x1b, 0x51, 0x95, 0x4c, 0x37, 0xaf, 0x60, 0x2f, 0xde, 0xac, 0xa3, 0x7a, 0x35,
+	0x30, 0xff, 0xb0, 0xfc, 0xd6, 0xbf, 0x07, 0x00, 0x75, 0x62, 0x11, 0x9c, 0xc5, 0x14, 0x00, 0x00,
 }
@@ -29,6 +29,7 @@ func TicketCmd() *cobra.Command {
 		CountTicketCmd(),
 		CloseTicketCmd(),
 		GetColdAddrByMinerCmd(),
+		PoolCmd(),
 	)
 
 	return cmd
@@ -200,3 +201,212 @@ func coldAddressOfMiner(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
 }
+
+// PoolCmd ticket mining pool
+func PoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Short: "Ticket mining pool management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		PoolCreateCmd(),
+		PoolDepositCmd(),
+		PoolWithdrawCmd(),
+		PoolOpenCmd(),
+		PoolInfoCmd(),
+		PoolDepositorCmd(),
+		PoolWithdrawListCmd(),
+	)
+	return cmd
+}
+
+func createTicketTx(ta *ty.TicketAction) {
+	tx, err := types.CreateFormatTx("ticket", types.Encode(ta))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// PoolCreateCmd create pool
+func PoolCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a mining pool, the signer is the operator",
+		Run:   poolCreate,
+	}
+	cmd.Flags().StringP("miner_addr", "m", "", "miner address of pool tickets, default the operator")
+	cmd.Flags().Int32P("commission", "c", 0, "operator commission, in 1/10000 of reward")
+	return cmd
+}
+
+func poolCreate(cmd *cobra.Command, args []string) {
+	minerAddr, _ := cmd.Flags().GetString("miner_addr")
+	commission, _ := cmd.Flags().GetInt32("commission")
+	create := &ty.TicketPoolCreate{MinerAddress: minerAddr, Commission: commission}
+	createTicketTx(&ty.TicketAction{Value: &ty.TicketAction_PoolCreate{PoolCreate: create}, Ty: ty.TicketActionPoolCreate})
+}
+
+// PoolDepositCmd deposit to pool
+func PoolDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit coins of ticket executor to pool",
+		Run:   poolDeposit,
+	}
+	addPoolAmountFlags(cmd)
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func addPoolAmountFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+}
+
+func poolDeposit(cmd *cobra.Command, args []string) {
+	pool, _ := cmd.Flags().GetString("pool")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	deposit := &ty.TicketPoolDeposit{PoolAddr: pool, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}
+	createTicketTx(&ty.TicketAction{Value: &ty.TicketAction_PoolDeposit{PoolDeposit: deposit}, Ty: ty.TicketActionPoolDeposit})
+}
+
+// PoolWithdrawCmd withdraw from pool
+func PoolWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Withdraw principal and all pending reward from pool",
+		Run:   poolWithdraw,
+	}
+	addPoolAmountFlags(cmd)
+	return cmd
+}
+
+func poolWithdraw(cmd *cobra.Command, args []string) {
+	pool, _ := cmd.Flags().GetString("pool")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	withdraw := &ty.TicketPoolWithdraw{PoolAddr: pool, Amount: int64(amount*types.InputPrecision) * types.Multiple1E4}
+	createTicketTx(&ty.TicketAction{Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: withdraw}, Ty: ty.TicketActionPoolWithdraw})
+}
+
+// PoolOpenCmd open tickets of pool
+func PoolOpenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Open tickets with pool balance, keys of operator and miner must be in wallet",
+		Run:   poolOpen,
+	}
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	cmd.Flags().Int32P("count", "n", 1, "ticket count")
+	return cmd
+}
+
+func poolOpen(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pool, _ := cmd.Flags().GetString("pool")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res rpctypes.ReplyHash
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "ticket.PoolOpen", &ty.TicketPoolOpen{PoolAddr: pool, Count: count}, &res)
+	ctx.Run()
+}
+
+// PoolInfoCmd get pool info
+func PoolInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Get pool info",
+		Run:   poolInfo,
+	}
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	return cmd
+}
+
+func poolInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pool, _ := cmd.Flags().GetString("pool")
+	var params rpctypes.Query4Jrpc
+	params.Execer = "ticket"
+	params.FuncName = "PoolInfo"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: pool})
+
+	var res ty.TicketPool
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// PoolDepositorCmd get depositor shares and pending reward
+func PoolDepositorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depositor",
+		Short: "Get shares and pending reward of depositor",
+		Run:   poolDepositor,
+	}
+	addPoolAddrFlags(cmd)
+	return cmd
+}
+
+func addPoolAddrFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	cmd.Flags().StringP("addr", "a", "", "depositor address")
+	cmd.MarkFlagRequired("addr")
+}
+
+func poolDepositor(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pool, _ := cmd.Flags().GetString("pool")
+	addr, _ := cmd.Flags().GetString("addr")
+	var params rpctypes.Query4Jrpc
+	params.Execer = "ticket"
+	params.FuncName = "PoolDepositor"
+	params.Payload = types.MustPBToJSON(&ty.ReqTicketPoolDepositor{PoolAddr: pool, Addr: addr})
+
+	var res ty.ReplyTicketPoolDepositor
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// PoolWithdrawListCmd list withdraws of depositor
+func PoolWithdrawListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraws",
+		Short: "List withdraws of depositor",
+		Run:   poolWithdrawList,
+	}
+	addPoolAddrFlags(cmd)
+	cmd.Flags().Int32P("count", "c", 10, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, 0 desc, 1 asc")
+	cmd.Flags().Int64P("height", "t", 0, "height of last withdraw in previous page")
+	cmd.Flags().Int64P("index", "i", 0, "index of last withdraw in previous page")
+	return cmd
+}
+
+func poolWithdrawList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pool, _ := cmd.Flags().GetString("pool")
+	addr, _ := cmd.Flags().GetString("addr")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	height, _ := cmd.Flags().GetInt64("height")
+	index, _ := cmd.Flags().GetInt64("index")
+	var params rpctypes.Query4Jrpc
+	params.Execer = "ticket"
+	params.FuncName = "PoolWithdrawList"
+	params.Payload = types.MustPBToJSON(&ty.ReqTicketPoolWithdraws{
+		PoolAddr:  pool,
+		Addr:      addr,
+		Count:     count,
+		Direction: direction,
+		Height:    height,
+		Index:     index,
+	})
+
+	var res ty.ReplyTicketPoolWithdraws
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -45,3 +45,42 @@ func (t *Ticket) Exec_Miner(payload *ty.TicketMiner, tx *types.Transaction, inde
 	actiondb := NewAction(t, tx)
 	return actiondb.TicketMiner(payload, index)
 }
+
+// Exec_PoolCreate exec pool create
+func (t *Ticket) Exec_PoolCreate(payload *ty.TicketPoolCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), ty.TicketX, "ForkTicketPool") {
+		return nil, types.ErrActionNotSupport
+	}
+	actiondb := NewAction(t, tx)
+	return actiondb.PoolCreate(payload)
+}
+
+// Exec_PoolDeposit exec pool deposit
+func (t *Ticket) Exec_PoolDeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), ty.TicketX, "ForkTicketPool") {
+		return nil, types.ErrActionNotSupport
+	}
+	actiondb := NewAction(t, tx)
+	return actiondb.PoolDeposit(payload)
+}
+
+// Exec_PoolWithdraw exec pool withdraw
+func (t *Ticket) Exec_PoolWithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), ty.TicketX, "ForkTicketPool") {
+		return nil, types.ErrActionNotSupport
+	}
+	actiondb := NewAction(t, tx)
+	return actiondb.PoolWithdraw(payload, index)
+}
+
+// Exec_PoolOpen exec pool open
+func (t *Ticket) Exec_PoolOpen(payload *ty.TicketPoolOpen, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), ty.TicketX, "ForkTicketPool") {
+		return nil, types.ErrActionNotSupport
+	}
+	if payload.Count <= 0 {
+		return nil, ty.ErrTicketCount
+	}
+	actiondb := NewAction(t, tx)
+	return actiondb.PoolOpen(payload)
+}
@@ -29,6 +29,14 @@ func (t *Ticket) execDelLocal(receiptData *types.ReceiptData) (*types.LocalDBSet
 			}
 			kv := t.delTicketBind(&ticketlog)
 			dbSet.KV = append(dbSet.KV, kv...)
+		} else if item.Ty == ty.TyLogTicketPoolWithdraw {
+			var withdrawlog ty.ReceiptTicketPoolWithdraw
+			err := types.Decode(item.Log, &withdrawlog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			key := calcPoolWithdrawKey(withdrawlog.PoolAddr, withdrawlog.Addr, withdrawlog.Height, withdrawlog.Index)
+			dbSet.KV = append(dbSet.KV, &types.KeyValue{Key: key, Value: nil})
 		}
 	}
 	return dbSet, nil
@@ -58,3 +66,23 @@ func (t *Ticket) ExecDelLocal_Tclose(payload *ty.TicketClose, tx *types.Transact
 func (t *Ticket) ExecDelLocal_Miner(payload *ty.TicketMiner, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return t.execDelLocal(receiptData)
 }
+
+// ExecDelLocal_PoolCreate exec del local pool create
+func (t *Ticket) ExecDelLocal_PoolCreate(payload *ty.TicketPoolCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
+
+// ExecDelLocal_PoolDeposit exec del local pool deposit
+func (t *Ticket) ExecDelLocal_PoolDeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
+
+// ExecDelLocal_PoolWithdraw exec del local pool withdraw
+func (t *Ticket) ExecDelLocal_PoolWithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
+
+// ExecDelLocal_PoolOpen exec del local pool open
+func (t *Ticket) ExecDelLocal_PoolOpen(payload *ty.TicketPoolOpen, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
@@ -29,6 +29,14 @@ func (t *Ticket) execLocal(receiptData *types.ReceiptData) (*types.LocalDBSet, e
 			}
 			kv := t.saveTicketBind(&ticketlog)
 			dbSet.KV = append(dbSet.KV, kv...)
+		} else if item.Ty == ty.TyLogTicketPoolWithdraw {
+			var withdrawlog ty.ReceiptTicketPoolWithdraw
+			err := types.Decode(item.Log, &withdrawlog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			key := calcPoolWithdrawKey(withdrawlog.PoolAddr, withdrawlog.Addr, withdrawlog.Height, withdrawlog.Index)
+			dbSet.KV = append(dbSet.KV, &types.KeyValue{Key: key, Value: item.Log})
 		}
 	}
 	return dbSet, nil
@@ -58,3 +66,23 @@ func (t *Ticket) ExecLocal_Tclose(payload *ty.TicketClose, tx *types.Transaction
 func (t *Ticket) ExecLocal_Miner(payload *ty.TicketMiner, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return t.execLocal(receiptData)
 }
+
+// ExecLocal_PoolCreate exec local pool create
+func (t *Ticket) ExecLocal_PoolCreate(payload *ty.TicketPoolCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
+
+// ExecLocal_PoolDeposit exec local pool deposit
+func (t *Ticket) ExecLocal_PoolDeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
+
+// ExecLocal_PoolWithdraw exec local pool withdraw
+func (t *Ticket) ExecLocal_PoolWithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
+
+// ExecLocal_PoolOpen exec local pool open
+func (t *Ticket) ExecLocal_PoolOpen(payload *ty.TicketPoolOpen, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
@@ -0,0 +1,403 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+)
+
+/*
+矿池:
+1. 任何人都可以创建矿池, 创建者是运营者, 矿池地址由创建交易的hash生成, 没有私钥
+2. 存款人把ticket合约中的币存入矿池地址, 存入的本金在运营者下一次购买ticket之后才成为份额, 之前不分配收益
+3. 运营者用矿池地址的余额购买ticket, ticket的returnAddress是矿池地址, 挖矿地址是矿池指定的地址
+4. 矿池的ticket挖到区块时, 先扣除运营者的佣金, 剩余的收益按份额累加到每单位本金的收益上
+5. 存款人取款时结算收益, 还没有成为份额的本金可以直接取出;
+   份额和收益从矿池地址的可用余额中转出, 每个存款人最多取出可用余额中按比例属于自己的部分, 冻结在ticket中的币需要运营者先close
+*/
+
+//每单位本金收益的放大倍数, 避免整数除法丢失精度
+var poolRewardScale = big.NewInt(1e12)
+
+//PoolAddress 矿池的地址
+func PoolAddress(txhash []byte) string {
+	return address.ExecAddress(ty.TicketX + "-pool-" + common.ToHex(txhash))
+}
+
+//PoolKey 矿池在statedb中的key
+func PoolKey(poolAddr string) (key []byte) {
+	key = append(key, []byte("mavl-ticket-pool-")...)
+	key = append(key, []byte(poolAddr)...)
+	return key
+}
+
+//PoolDepositorKey 存款人在statedb中的key
+func PoolDepositorKey(poolAddr, addr string) (key []byte) {
+	key = append(key, []byte("mavl-ticket-pooldepositor-")...)
+	key = append(key, []byte(poolAddr+":"+addr)...)
+	return key
+}
+
+//PoolEpochKey 矿池每次购买ticket时每单位本金累计的收益在statedb中的key
+func PoolEpochKey(poolAddr string, epoch int64) []byte {
+	return []byte(fmt.Sprintf("mavl-ticket-poolepoch-%s:%018d", poolAddr, epoch))
+}
+
+func readPool(db dbm.KV, poolAddr string) (*ty.TicketPool, error) {
+	data, err := db.Get(PoolKey(poolAddr))
+	if err == types.ErrNotFound || (err == nil && len(data) == 0) {
+		return nil, ty.ErrPoolNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var pool ty.TicketPool
+	err = types.Decode(data, &pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func readPoolDepositor(db dbm.KV, poolAddr, addr string) (*ty.TicketPoolDepositor, error) {
+	data, err := db.Get(PoolDepositorKey(poolAddr, addr))
+	if err == types.ErrNotFound || (err == nil && len(data) == 0) {
+		return &ty.TicketPoolDepositor{PoolAddr: poolAddr, Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var depositor ty.TicketPoolDepositor
+	err = types.Decode(data, &depositor)
+	if err != nil {
+		return nil, err
+	}
+	return &depositor, nil
+}
+
+func accRewardPerShare(pool *ty.TicketPool) *big.Int {
+	acc, ok := new(big.Int).SetString(pool.AccRewardPerShare, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return acc
+}
+
+//shareReward 本金按累计的每单位收益计算出的总收益
+func shareReward(shares int64, acc *big.Int) int64 {
+	reward := new(big.Int).Mul(big.NewInt(shares), acc)
+	return reward.Quo(reward, poolRewardScale).Int64()
+}
+
+//settlePool 把上次结算之后的收益记入存款人的pendingReward
+func settlePool(pool *ty.TicketPool, depositor *ty.TicketPoolDepositor) {
+	total := shareReward(depositor.Shares, accRewardPerShare(pool))
+	depositor.PendingReward += total - depositor.RewardDebt
+	depositor.RewardDebt = total
+}
+
+//activatePool 存入之后矿池已经购买过ticket, 存入的本金成为份额, 并且结算从购买时开始的收益
+func activatePool(db dbm.KV, pool *ty.TicketPool, depositor *ty.TicketPoolDepositor) error {
+	settlePool(pool, depositor)
+	if depositor.Unstaked == 0 || depositor.DepositEpoch >= pool.Epoch {
+		return nil
+	}
+	data, err := db.Get(PoolEpochKey(pool.PoolAddr, depositor.DepositEpoch+1))
+	if err != nil {
+		return err
+	}
+	start, ok := new(big.Int).SetString(string(data), 10)
+	if !ok {
+		return types.ErrDecode
+	}
+	acc := accRewardPerShare(pool)
+	depositor.PendingReward += shareReward(depositor.Unstaked, acc) - shareReward(depositor.Unstaked, start)
+	depositor.Shares += depositor.Unstaked
+	depositor.Unstaked = 0
+	depositor.RewardDebt = shareReward(depositor.Shares, acc)
+	return nil
+}
+
+func (action *Action) savePool(pool *ty.TicketPool) *types.KeyValue {
+	kv := &types.KeyValue{Key: PoolKey(pool.PoolAddr), Value: types.Encode(pool)}
+	action.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (action *Action) savePoolDepositor(depositor *ty.TicketPoolDepositor) *types.KeyValue {
+	kv := &types.KeyValue{Key: PoolDepositorKey(depositor.PoolAddr, depositor.Addr), Value: types.Encode(depositor)}
+	action.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func poolLog(pool *ty.TicketPool) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty.TyLogTicketPool, Log: types.Encode(&ty.ReceiptTicketPool{Pool: pool})}
+}
+
+//PoolCreate 创建矿池
+func (action *Action) PoolCreate(create *ty.TicketPoolCreate) (*types.Receipt, error) {
+	if create.Commission < 0 || create.Commission > ty.TicketPoolMaxCommission {
+		return nil, ty.ErrPoolCommission
+	}
+	minerAddr := create.MinerAddress
+	if minerAddr == "" {
+		minerAddr = action.fromaddr
+	}
+	if err := address.CheckAddress(minerAddr); err != nil {
+		return nil, err
+	}
+	pool := &ty.TicketPool{
+		PoolAddr:          PoolAddress(action.txhash),
+		Operator:          action.fromaddr,
+		MinerAddress:      minerAddr,
+		Commission:        create.Commission,
+		AccRewardPerShare: "0",
+		CreateTime:        action.blocktime,
+	}
+	if _, err := readPool(action.db, pool.PoolAddr); err != ty.ErrPoolNotFound {
+		return nil, types.ErrInvalidParam
+	}
+	kv := []*types.KeyValue{action.savePool(pool)}
+	logs := []*types.ReceiptLog{poolLog(pool)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//PoolDeposit 把ticket合约中的币存入矿池
+func (action *Action) PoolDeposit(deposit *ty.TicketPoolDeposit) (*types.Receipt, error) {
+	if deposit.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	pool, err := readPool(action.db, deposit.PoolAddr)
+	if err != nil {
+		return nil, err
+	}
+	depositor, err := readPoolDepositor(action.db, pool.PoolAddr, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, pool.PoolAddr, action.execaddr, deposit.Amount)
+	if err != nil {
+		tlog.Error("PoolDeposit.ExecTransfer", "addr", action.fromaddr, "pool", pool.PoolAddr, "amount", deposit.Amount)
+		return nil, err
+	}
+	if err = activatePool(action.db, pool, depositor); err != nil {
+		return nil, err
+	}
+	depositor.Unstaked += deposit.Amount
+	depositor.DepositEpoch = pool.Epoch
+	pool.TotalUnstaked += deposit.Amount
+
+	kv := append(receipt.KV, action.savePoolDepositor(depositor), action.savePool(pool))
+	logs := append(receipt.Logs, poolLog(pool), &types.ReceiptLog{
+		Ty:  ty.TyLogTicketPoolDeposit,
+		Log: types.Encode(&ty.ReceiptTicketPoolDepositor{Depositor: depositor, Amount: deposit.Amount}),
+	})
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//PoolWithdraw 取出本金和结算的收益, 先取出还没有成为份额的本金
+//份额和收益按比例从可用余额中取出, 可用余额不足时先取收益
+func (action *Action) PoolWithdraw(withdraw *ty.TicketPoolWithdraw, index int) (*types.Receipt, error) {
+	if withdraw.Amount < 0 {
+		return nil, types.ErrAmount
+	}
+	pool, err := readPool(action.db, withdraw.PoolAddr)
+	if err != nil {
+		return nil, err
+	}
+	depositor, err := readPoolDepositor(action.db, pool.PoolAddr, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if err = activatePool(action.db, pool, depositor); err != nil {
+		return nil, err
+	}
+	if withdraw.Amount > depositor.Unstaked+depositor.Shares {
+		return nil, ty.ErrPoolShares
+	}
+	unstaked := withdraw.Amount
+	if unstaked > depositor.Unstaked {
+		unstaked = depositor.Unstaked
+	}
+	shares := withdraw.Amount - unstaked
+	reward := depositor.PendingReward
+	if shares > 0 || reward > 0 {
+		limit := action.poolLiquidity(pool, depositor)
+		if reward > limit {
+			reward = limit
+		}
+		if shares > limit-reward {
+			tlog.Error("PoolWithdraw liquidity", "pool", pool.PoolAddr, "addr", action.fromaddr, "shares", shares, "limit", limit-reward)
+			return nil, ty.ErrPoolLiquidity
+		}
+	}
+	value := unstaked + shares + reward
+	if value == 0 {
+		return nil, types.ErrAmount
+	}
+	receipt, err := action.coinsAccount.ExecTransfer(pool.PoolAddr, action.fromaddr, action.execaddr, value)
+	if err != nil {
+		tlog.Error("PoolWithdraw.ExecTransfer", "pool", pool.PoolAddr, "addr", action.fromaddr, "value", value)
+		return nil, err
+	}
+	record := &ty.ReceiptTicketPoolWithdraw{
+		PoolAddr:  pool.PoolAddr,
+		Addr:      action.fromaddr,
+		Amount:    withdraw.Amount,
+		Reward:    reward,
+		Height:    action.height,
+		Index:     int64(index),
+		BlockTime: action.blocktime,
+		TxHash:    common.ToHex(action.txhash),
+	}
+	depositor.WithdrawnReward += reward
+	depositor.PendingReward -= reward
+	depositor.Unstaked -= unstaked
+	depositor.Shares -= shares
+	depositor.RewardDebt = shareReward(depositor.Shares, accRewardPerShare(pool))
+	pool.TotalUnstaked -= unstaked
+	pool.TotalShares -= shares
+
+	kv := append(receipt.KV, action.savePoolDepositor(depositor), action.savePool(pool))
+	logs := append(receipt.Logs, poolLog(pool), &types.ReceiptLog{Ty: ty.TyLogTicketPoolWithdraw, Log: types.Encode(record)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//poolLiquidity 存款人可以从可用余额中取出的份额和收益
+//不包括还没有成为份额的本金, 可用余额和冻结在ticket中的币按比例属于所有份额和收益, 先取款的存款人不能取走其他人的部分
+func (action *Action) poolLiquidity(pool *ty.TicketPool, depositor *ty.TicketPoolDepositor) int64 {
+	acc := action.coinsAccount.LoadExecAccount(pool.PoolAddr, action.execaddr)
+	free := acc.Balance - pool.TotalUnstaked
+	if free <= 0 {
+		return 0
+	}
+	limit := new(big.Int).Mul(big.NewInt(depositor.Shares+depositor.PendingReward), big.NewInt(free))
+	return limit.Quo(limit, big.NewInt(free+acc.Frozen)).Int64()
+}
+
+//PoolOpen 运营者用矿池的余额购买ticket
+func (action *Action) PoolOpen(open *ty.TicketPoolOpen) (*types.Receipt, error) {
+	pool, err := readPool(action.db, open.PoolAddr)
+	if err != nil {
+		return nil, err
+	}
+	if action.fromaddr != pool.Operator {
+		return nil, ty.ErrPoolOperator
+	}
+	if types.IsDappFork(action.height, ty.TicketX, "ForkTicketId") && len(open.PubHashes) < int(open.Count) {
+		return nil, ty.ErrOpenTicketPubHash
+	}
+	receipt, err := action.openTickets(&ty.TicketOpen{
+		MinerAddress:  pool.MinerAddress,
+		ReturnAddress: pool.PoolAddr,
+		Count:         open.Count,
+		RandSeed:      open.RandSeed,
+		PubHashes:     open.PubHashes,
+	})
+	if err != nil || pool.TotalUnstaked == 0 {
+		return receipt, err
+	}
+	//之前存入的本金成为份额, 从现在开始分配收益, 存款人下次操作时按这次的累计收益结算
+	pool.Epoch++
+	pool.TotalShares += pool.TotalUnstaked
+	pool.TotalUnstaked = 0
+	epoch := &types.KeyValue{Key: PoolEpochKey(pool.PoolAddr, pool.Epoch), Value: []byte(accRewardPerShare(pool).String())}
+	action.db.Set(epoch.Key, epoch.Value)
+	receipt.KV = append(receipt.KV, epoch, action.savePool(pool))
+	receipt.Logs = append(receipt.Logs, poolLog(pool))
+	return receipt, nil
+}
+
+//poolReward 矿池的ticket挖矿之后分配收益, 不是矿池的ticket返回nil
+func (action *Action) poolReward(poolAddr, ticketID string, reward int64) (*types.Receipt, error) {
+	pool, err := readPool(action.db, poolAddr)
+	if err == ty.ErrPoolNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	commission := reward * int64(pool.Commission) / ty.TicketPoolMaxCommission
+	//没有存款人的时候收益全部给运营者
+	if pool.TotalShares == 0 {
+		commission = reward
+	}
+	if reward > commission {
+		inc := new(big.Int).Mul(big.NewInt(reward-commission), poolRewardScale)
+		inc.Quo(inc, big.NewInt(pool.TotalShares))
+		pool.AccRewardPerShare = inc.Add(inc, accRewardPerShare(pool)).String()
+	}
+	pool.TotalReward += reward
+	pool.TotalCommission += commission
+
+	operator, err := readPoolDepositor(action.db, pool.PoolAddr, pool.Operator)
+	if err != nil {
+		return nil, err
+	}
+	settlePool(pool, operator)
+	operator.PendingReward += commission
+
+	kv := []*types.KeyValue{action.savePoolDepositor(operator), action.savePool(pool)}
+	r := &ty.ReceiptTicketPoolReward{PoolAddr: pool.PoolAddr, TicketId: ticketID, Reward: reward, Commission: commission}
+	logs := []*types.ReceiptLog{poolLog(pool), {Ty: ty.TyLogTicketPoolReward, Log: types.Encode(r)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func calcPoolWithdrawPrefix(poolAddr, addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolwithdraw:%s:%s:", poolAddr, addr))
+}
+
+func calcPoolWithdrawKey(poolAddr, addr string, height, index int64) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolwithdraw:%s:%s:%018d:%05d", poolAddr, addr, height, index))
+}
+
+// PoolDepositorInfo 存款人的份额和包括未结算的收益, 矿池已经购买ticket时存入的本金计入份额
+func PoolDepositorInfo(db dbm.KV, req *ty.ReqTicketPoolDepositor) (types.Message, error) {
+	pool, err := readPool(db, req.PoolAddr)
+	if err != nil {
+		return nil, err
+	}
+	depositor, err := readPoolDepositor(db, req.PoolAddr, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	pending := *depositor
+	if err = activatePool(db, pool, &pending); err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTicketPoolDepositor{Depositor: &pending, PendingReward: pending.PendingReward}, nil
+}
+
+// PoolWithdrawList 存款人的取款记录
+func PoolWithdrawList(db dbm.Lister, req *ty.ReqTicketPoolWithdraws) (types.Message, error) {
+	if req.PoolAddr == "" || req.Addr == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var key []byte
+	if req.Height > 0 {
+		key = calcPoolWithdrawKey(req.PoolAddr, req.Addr, req.Height, req.Index)
+	}
+	values, err := db.List(calcPoolWithdrawPrefix(req.PoolAddr, req.Addr), key, req.Count, req.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ty.ReplyTicketPoolWithdraws{}
+	for _, value := range values {
+		var record ty.ReceiptTicketPoolWithdraw
+		err = types.Decode(value, &record)
+		if err != nil {
+			return nil, err
+		}
+		reply.Withdraws = append(reply.Withdraws, &record)
+	}
+	return reply, nil
+}
@@ -0,0 +1,257 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	poolPrivA = util.HexToPrivkey("6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b")
+	poolPrivB = util.HexToPrivkey("19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4")
+	poolPrivC = util.HexToPrivkey("7a80a1f75d7360c6123c32a78ecf978c1ac55636f87892df38d8b85a9aeff115")
+)
+
+type poolEnv struct {
+	t      *testing.T
+	driver *Ticket
+	ldb    dbm.DB
+	kvdb   dbm.KVDB
+	acc    *account.DB
+	height int64
+	time   int64
+}
+
+func newPoolEnv(t *testing.T) (*poolEnv, func()) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	acc := account.NewCoinsAccount()
+	acc.SetDB(kvdb)
+	env := &poolEnv{t: t, ldb: ldb, kvdb: kvdb, acc: acc, height: types.GetDappFork(ty.TicketX, "ForkTicketPool"), time: 1539918074}
+	env.driver = newTicket().(*Ticket)
+	env.driver.SetStateDB(kvdb)
+	env.driver.SetLocalDB(kvdb)
+	return env, func() { util.CloseTestDB(dir, ldb) }
+}
+
+func addrOf(priv crypto.PrivKey) string {
+	return address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+}
+
+func (env *poolEnv) exec(priv crypto.PrivKey, action *ty.TicketAction, index int) (*types.Receipt, *types.Transaction, error) {
+	tx, err := types.CreateFormatTx(ty.TicketX, types.Encode(action))
+	require.Nil(env.t, err)
+	tx.Sign(types.SECP256K1, priv)
+	env.driver.SetEnv(env.height, env.time, 0)
+	receipt, err := env.driver.Exec(tx, index)
+	if err != nil {
+		return nil, tx, err
+	}
+	for _, kv := range receipt.KV {
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+	return receipt, tx, nil
+}
+
+func (env *poolEnv) execLocal(tx *types.Transaction, receipt *types.Receipt, index int) {
+	set, err := env.driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, index)
+	require.Nil(env.t, err)
+	for _, kv := range set.KV {
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+}
+
+func (env *poolEnv) balance(addr string) *types.Account {
+	return env.acc.LoadExecAccount(addr, address.ExecAddress(ty.TicketX))
+}
+
+func (env *poolEnv) depositor(pool, addr string) *ty.ReplyTicketPoolDepositor {
+	reply, err := PoolDepositorInfo(env.kvdb, &ty.ReqTicketPoolDepositor{PoolAddr: pool, Addr: addr})
+	require.Nil(env.t, err)
+	return reply.(*ty.ReplyTicketPoolDepositor)
+}
+
+func TestTicketPool(t *testing.T) {
+	env, closeDB := newPoolEnv(t)
+	defer closeDB()
+	addrA, addrB, addrC := addrOf(poolPrivA), addrOf(poolPrivB), addrOf(poolPrivC)
+	price := types.GetP(env.height).TicketPrice
+	execAddr := address.ExecAddress(ty.TicketX)
+	env.acc.SaveExecAccount(execAddr, &types.Account{Addr: addrB, Balance: 2 * price})
+	env.acc.SaveExecAccount(execAddr, &types.Account{Addr: addrC, Balance: price})
+
+	//创建矿池, 佣金10%
+	_, _, err := env.exec(poolPrivA, &ty.TicketAction{Ty: ty.TicketActionPoolCreate,
+		Value: &ty.TicketAction_PoolCreate{PoolCreate: &ty.TicketPoolCreate{Commission: 10001}}}, 1)
+	assert.Equal(t, ty.ErrPoolCommission, err)
+	_, tx, err := env.exec(poolPrivA, &ty.TicketAction{Ty: ty.TicketActionPoolCreate,
+		Value: &ty.TicketAction_PoolCreate{PoolCreate: &ty.TicketPoolCreate{Commission: 1000}}}, 1)
+	require.Nil(t, err)
+	poolAddr := PoolAddress(tx.Hash())
+	pool, err := env.driver.Query_PoolInfo(&types.ReqString{Data: poolAddr})
+	require.Nil(t, err)
+	assert.Equal(t, addrA, pool.(*ty.TicketPool).Operator)
+	assert.Equal(t, addrA, pool.(*ty.TicketPool).MinerAddress)
+
+	//存款
+	for _, d := range []struct {
+		priv   crypto.PrivKey
+		amount int64
+	}{{poolPrivB, 2 * price}, {poolPrivC, price}} {
+		_, _, err = env.exec(d.priv, &ty.TicketAction{Ty: ty.TicketActionPoolDeposit,
+			Value: &ty.TicketAction_PoolDeposit{PoolDeposit: &ty.TicketPoolDeposit{PoolAddr: poolAddr, Amount: d.amount}}}, 1)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 3*price, env.balance(poolAddr).Balance)
+	assert.Equal(t, int64(0), env.balance(addrB).Balance)
+
+	//只有运营者可以用矿池的余额购买ticket
+	open := &ty.TicketPoolOpen{PoolAddr: poolAddr, Count: 3, RandSeed: 1}
+	for i := 0; i < 3; i++ {
+		open.PubHashes = append(open.PubHashes, common.Sha256([]byte(fmt.Sprint(i))))
+	}
+	openAction := &ty.TicketAction{Ty: ty.TicketActionPoolOpen, Value: &ty.TicketAction_PoolOpen{PoolOpen: open}}
+	_, _, err = env.exec(poolPrivB, openAction, 1)
+	assert.Equal(t, ty.ErrPoolOperator, err)
+	receipt, _, err := env.exec(poolPrivA, openAction, 1)
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), env.balance(poolAddr).Balance)
+	assert.Equal(t, 3*price, env.balance(poolAddr).Frozen)
+	var tickets []string
+	for _, log := range receipt.Logs {
+		if log.Ty == ty.TyLogNewTicket {
+			var r ty.ReceiptTicket
+			require.Nil(t, types.Decode(log.Log, &r))
+			tickets = append(tickets, r.TicketId)
+		}
+	}
+	require.Equal(t, 3, len(tickets))
+
+	assert.Equal(t, 2*price, env.depositor(poolAddr, addrB).Depositor.Shares)
+	assert.Equal(t, int64(0), env.depositor(poolAddr, addrB).Depositor.Unstaked)
+
+	//购买ticket之后存入的本金不分配已经购买的ticket的收益, 可以直接取出
+	env.acc.SaveExecAccount(execAddr, &types.Account{Addr: addrC, Balance: price})
+	deposit := &ty.TicketAction{Ty: ty.TicketActionPoolDeposit,
+		Value: &ty.TicketAction_PoolDeposit{PoolDeposit: &ty.TicketPoolDeposit{PoolAddr: poolAddr, Amount: price}}}
+	_, _, err = env.exec(poolPrivC, deposit, 1)
+	require.Nil(t, err)
+
+	//挖矿收益扣除佣金之后按本金分配
+	cfg := types.GetP(env.height)
+	env.time += cfg.TicketFrozenTime
+	reward := 18 * types.Coin
+	_, _, err = env.exec(poolPrivA, &ty.TicketAction{Ty: ty.TicketActionMiner,
+		Value: &ty.TicketAction_Miner{Miner: &ty.TicketMiner{TicketId: tickets[0], Reward: reward}}}, 0)
+	require.Nil(t, err)
+	assert.Equal(t, reward*6/10, env.depositor(poolAddr, addrB).PendingReward)
+	assert.Equal(t, reward*3/10, env.depositor(poolAddr, addrC).PendingReward)
+	assert.Equal(t, reward/10, env.depositor(poolAddr, addrA).PendingReward)
+	assert.Equal(t, price, env.depositor(poolAddr, addrC).Depositor.Unstaked)
+	_, _, err = env.exec(poolPrivC, &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw,
+		Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: &ty.TicketPoolWithdraw{PoolAddr: poolAddr, Amount: 3 * price}}}, 1)
+	assert.Equal(t, ty.ErrPoolShares, err)
+
+	//币冻结在ticket中不能取出, 不能取走还没有买ticket的本金
+	withdraw := &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw,
+		Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: &ty.TicketPoolWithdraw{PoolAddr: poolAddr, Amount: 2 * price}}}
+	_, _, err = env.exec(poolPrivB, withdraw, 1)
+	assert.Equal(t, ty.ErrPoolLiquidity, err)
+	_, _, err = env.exec(poolPrivC, &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw,
+		Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: &ty.TicketPoolWithdraw{PoolAddr: poolAddr, Amount: price}}}, 1)
+	require.Nil(t, err)
+	assert.Equal(t, price, env.balance(addrC).Balance)
+	assert.Equal(t, int64(0), env.balance(poolAddr).Balance)
+
+	//只close一个ticket时, 每个存款人只能按比例取出可用余额
+	env.time += cfg.TicketWithdrawTime + cfg.TicketMinerWaitTime
+	_, _, err = env.exec(poolPrivA, &ty.TicketAction{Ty: ty.TicketActionClose,
+		Value: &ty.TicketAction_Tclose{Tclose: &ty.TicketClose{TicketId: tickets[:1]}}}, 1)
+	require.Nil(t, err)
+	assert.Equal(t, price+reward, env.balance(poolAddr).Balance)
+	_, _, err = env.exec(poolPrivB, withdraw, 1)
+	assert.Equal(t, ty.ErrPoolLiquidity, err)
+	limit := new(big.Int).Mul(big.NewInt(2*price+reward*6/10), big.NewInt(price+reward))
+	limit.Quo(limit, big.NewInt(3*price+reward))
+	part := &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw,
+		Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: &ty.TicketPoolWithdraw{PoolAddr: poolAddr, Amount: limit.Int64() - reward*6/10}}}
+	_, _, err = env.exec(poolPrivB, part, 1)
+	require.Nil(t, err)
+	assert.Equal(t, limit.Int64(), env.balance(addrB).Balance)
+	assert.Equal(t, reward*6/10, env.depositor(poolAddr, addrB).Depositor.WithdrawnReward)
+
+	//运营者close之后可以取出本金
+	_, _, err = env.exec(poolPrivA, &ty.TicketAction{Ty: ty.TicketActionClose,
+		Value: &ty.TicketAction_Tclose{Tclose: &ty.TicketClose{TicketId: tickets[1:]}}}, 1)
+	require.Nil(t, err)
+	withdraw = &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw,
+		Value: &ty.TicketAction_PoolWithdraw{PoolWithdraw: &ty.TicketPoolWithdraw{PoolAddr: poolAddr, Amount: 2*price - (limit.Int64() - reward*6/10)}}}
+	receipt, tx, err = env.exec(poolPrivB, withdraw, 2)
+	require.Nil(t, err)
+	env.execLocal(tx, receipt, 2)
+	assert.Equal(t, 2*price+reward*6/10, env.balance(addrB).Balance)
+	info := env.depositor(poolAddr, addrB)
+	assert.Equal(t, int64(0), info.Depositor.Shares)
+	assert.Equal(t, int64(0), info.PendingReward)
+	assert.Equal(t, reward*6/10, info.Depositor.WithdrawnReward)
+
+	//取款记录
+	list, err := env.driver.Query_PoolWithdrawList(&ty.ReqTicketPoolWithdraws{PoolAddr: poolAddr, Addr: addrB, Count: 10})
+	require.Nil(t, err)
+	withdraws := list.(*ty.ReplyTicketPoolWithdraws).Withdraws
+	require.Equal(t, 1, len(withdraws))
+	assert.Equal(t, 2*price-(limit.Int64()-reward*6/10), withdraws[0].Amount)
+	assert.Equal(t, int64(0), withdraws[0].Reward)
+	assert.Equal(t, common.ToHex(tx.Hash()), withdraws[0].TxHash)
+
+	set, err := env.driver.ExecDelLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 2)
+	require.Nil(t, err)
+	for _, kv := range set.KV {
+		//localdb中value为nil表示删除
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+		}
+	}
+	list, err = env.driver.Query_PoolWithdrawList(&ty.ReqTicketPoolWithdraws{PoolAddr: poolAddr, Addr: addrB, Count: 10})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(list.(*ty.ReplyTicketPoolWithdraws).Withdraws))
+}
+
+func TestTicketPoolNoDepositor(t *testing.T) {
+	env, closeDB := newPoolEnv(t)
+	defer closeDB()
+	env.driver.SetEnv(env.height, env.time, 0)
+	action := &Action{coinsAccount: env.acc, db: env.kvdb, txhash: []byte("hash"), fromaddr: addrOf(poolPrivA),
+		blocktime: env.time, height: env.height, execaddr: address.ExecAddress(ty.TicketX)}
+	receipt, err := action.PoolCreate(&ty.TicketPoolCreate{Commission: 500})
+	require.Nil(t, err)
+	for _, kv := range receipt.KV {
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+	poolAddr := PoolAddress([]byte("hash"))
+
+	//不是矿池的ticket
+	receipt, err = action.poolReward(addrOf(poolPrivB), "id", types.Coin)
+	assert.Nil(t, err)
+	assert.Nil(t, receipt)
+
+	//没有存款人的时候收益全部给运营者
+	receipt, err = action.poolReward(poolAddr, "id", types.Coin)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(receipt.Logs))
+	assert.Equal(t, types.Coin, env.depositor(poolAddr, addrOf(poolPrivA)).PendingReward)
+}
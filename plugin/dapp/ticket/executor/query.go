@@ -49,3 +49,22 @@ func (ticket *Ticket) Query_MinerSourceList(param *types.ReqString) (types.Messa
 func (ticket *Ticket) Query_RandNumHash(param *types.ReqRandHash) (types.Message, error) {
 	return ticket.GetRandNum(param.Hash, param.BlockNum)
 }
+
+// Query_PoolInfo query pool info
+func (ticket *Ticket) Query_PoolInfo(param *types.ReqString) (types.Message, error) {
+	pool, err := readPool(ticket.GetStateDB(), param.Data)
+	if err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+// Query_PoolDepositor query depositor shares and pending reward
+func (ticket *Ticket) Query_PoolDepositor(param *pty.ReqTicketPoolDepositor) (types.Message, error) {
+	return PoolDepositorInfo(ticket.GetStateDB(), param)
+}
+
+// Query_PoolWithdrawList query depositor withdraw list
+func (ticket *Ticket) Query_PoolWithdrawList(param *pty.ReqTicketPoolWithdraws) (types.Message, error) {
+	return PoolWithdrawList(ticket.GetLocalDB(), param)
+}
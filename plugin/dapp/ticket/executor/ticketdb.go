@@ -225,10 +225,6 @@ func (action *Action) TicketBind(tbind *ty.TicketBind) (*types.Receipt, error) {
 
 // TicketOpen ticket open
 func (action *Action) TicketOpen(topen *ty.TicketOpen) (*types.Receipt, error) {
-	prefix := common.ToHex(action.txhash)
-	prefix = topen.MinerAddress + ":" + prefix + ":"
-	var logs []*types.ReceiptLog
-	var kv []*types.KeyValue
 	//addr from
 	if action.fromaddr != topen.ReturnAddress {
 		mineraddr := action.getBind(topen.ReturnAddress)
@@ -240,6 +236,15 @@ func (action *Action) TicketOpen(topen *ty.TicketOpen) (*types.Receipt, error) {
 		}
 	}
 	//action.fromaddr == topen.ReturnAddress or mineraddr == action.fromaddr
+	return action.openTickets(topen)
+}
+
+//openTickets 冻结returnAddress的资金购买ticket, 调用者检查权限
+func (action *Action) openTickets(topen *ty.TicketOpen) (*types.Receipt, error) {
+	prefix := common.ToHex(action.txhash)
+	prefix = topen.MinerAddress + ":" + prefix + ":"
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
 	cfg := types.GetP(action.height)
 	for i := 0; i < int(topen.Count); i++ {
 		id := prefix + fmt.Sprintf("%010d", i)
@@ -352,6 +357,17 @@ func (action *Action) TicketMiner(miner *ty.TicketMiner, index int) (*types.Rece
 	kv = append(kv, receipt1.KV...)
 	logs = append(logs, receipt2.Logs...)
 	kv = append(kv, receipt2.KV...)
+	//矿池的ticket, 收益按本金分给存款人
+	if types.IsDappFork(action.height, ty.TicketX, "ForkTicketPool") {
+		receipt3, err := action.poolReward(t.ReturnAddress, t.TicketId, ticket.MinerValue)
+		if err != nil {
+			return nil, err
+		}
+		if receipt3 != nil {
+			logs = append(logs, receipt3.Logs...)
+			kv = append(kv, receipt3.KV...)
+		}
+	}
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
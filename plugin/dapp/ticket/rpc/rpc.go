@@ -135,3 +135,22 @@ func (c *Jrpc) SetAutoMining(in *ty.MinerFlag, result *rpctypes.Reply) error {
 	*result = reply
 	return nil
 }
+
+// PoolOpen open tickets of pool
+func (g *channelClient) PoolOpen(ctx context.Context, in *ty.TicketPoolOpen) (*types.ReplyHash, error) {
+	data, err := g.ExecWalletFunc(ty.TicketX, "WalletPoolOpen", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.ReplyHash), nil
+}
+
+// PoolOpen open tickets of pool
+func (c *Jrpc) PoolOpen(in *ty.TicketPoolOpen, result *interface{}) error {
+	resp, err := c.cli.PoolOpen(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.ReplyHash{Hash: common.ToHex(resp.GetHash())}
+	return nil
+}
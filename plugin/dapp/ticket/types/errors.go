@@ -29,4 +29,14 @@ var (
 	ErrNoVrf = errors.New("ErrNoVrf")
 	// ErrVrfVerify err type
 	ErrVrfVerify = errors.New("ErrVrfVerify")
+	// ErrPoolNotFound err type
+	ErrPoolNotFound = errors.New("ErrPoolNotFound")
+	// ErrPoolCommission err type
+	ErrPoolCommission = errors.New("ErrPoolCommission")
+	// ErrPoolOperator err type
+	ErrPoolOperator = errors.New("ErrPoolOperator")
+	// ErrPoolShares err type
+	ErrPoolShares = errors.New("ErrPoolShares")
+	// ErrPoolLiquidity err type
+	ErrPoolLiquidity = errors.New("ErrPoolLiquidity")
 )
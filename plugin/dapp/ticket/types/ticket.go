@@ -23,6 +23,14 @@ const (
 	TyLogMinerTicket = 113
 	// TyLogTicketBind bind ticket log type
 	TyLogTicketBind = 114
+	// TyLogTicketPool pool state log type
+	TyLogTicketPool = 115
+	// TyLogTicketPoolDeposit pool deposit log type
+	TyLogTicketPoolDeposit = 116
+	// TyLogTicketPoolWithdraw pool withdraw log type
+	TyLogTicketPoolWithdraw = 117
+	// TyLogTicketPoolReward pool reward log type
+	TyLogTicketPoolReward = 118
 )
 
 //ticket
@@ -41,8 +49,19 @@ const (
 	TicketActionMiner = 16
 	// TicketActionBind action bind
 	TicketActionBind = 17
+	// TicketActionPoolCreate action pool create
+	TicketActionPoolCreate = 18
+	// TicketActionPoolDeposit action pool deposit
+	TicketActionPoolDeposit = 19
+	// TicketActionPoolWithdraw action pool withdraw
+	TicketActionPoolWithdraw = 20
+	// TicketActionPoolOpen action pool open
+	TicketActionPoolOpen = 21
 )
 
+// TicketPoolMaxCommission 矿池佣金比例的分母, 佣金最多为全部收益
+const TicketPoolMaxCommission = 10000
+
 // TicketOldParts old tick type
 const TicketOldParts = 3
 
@@ -61,6 +80,7 @@ func init() {
 	types.RegisterDappFork(TicketX, "Enable", 0)
 	types.RegisterDappFork(TicketX, "ForkTicketId", 1062000)
 	types.RegisterDappFork(TicketX, "ForkTicketVrf", 1770000)
+	types.RegisterDappFork(TicketX, "ForkTicketPool", types.MaxHeight)
 }
 
 // TicketType ticket exec type
@@ -83,10 +103,14 @@ func (ticket *TicketType) GetPayload() types.Message {
 // GetLogMap get log map
 func (ticket *TicketType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{
-		TyLogNewTicket:   {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogNewTicket"},
-		TyLogCloseTicket: {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogCloseTicket"},
-		TyLogMinerTicket: {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogMinerTicket"},
-		TyLogTicketBind:  {Ty: reflect.TypeOf(ReceiptTicketBind{}), Name: "LogTicketBind"},
+		TyLogNewTicket:          {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogNewTicket"},
+		TyLogCloseTicket:        {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogCloseTicket"},
+		TyLogMinerTicket:        {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogMinerTicket"},
+		TyLogTicketBind:         {Ty: reflect.TypeOf(ReceiptTicketBind{}), Name: "LogTicketBind"},
+		TyLogTicketPool:         {Ty: reflect.TypeOf(ReceiptTicketPool{}), Name: "LogTicketPool"},
+		TyLogTicketPoolDeposit:  {Ty: reflect.TypeOf(ReceiptTicketPoolDepositor{}), Name: "LogTicketPoolDeposit"},
+		TyLogTicketPoolWithdraw: {Ty: reflect.TypeOf(ReceiptTicketPoolWithdraw{}), Name: "LogTicketPoolWithdraw"},
+		TyLogTicketPoolReward:   {Ty: reflect.TypeOf(ReceiptTicketPoolReward{}), Name: "LogTicketPoolReward"},
 	}
 }
 
@@ -112,10 +136,14 @@ func (ticket *TicketType) GetName() string {
 // GetTypeMap get type map
 func (ticket *TicketType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Genesis": TicketActionGenesis,
-		"Topen":   TicketActionOpen,
-		"Tbind":   TicketActionBind,
-		"Tclose":  TicketActionClose,
-		"Miner":   TicketActionMiner,
+		"Genesis":      TicketActionGenesis,
+		"Topen":        TicketActionOpen,
+		"Tbind":        TicketActionBind,
+		"Tclose":       TicketActionClose,
+		"Miner":        TicketActionMiner,
+		"PoolCreate":   TicketActionPoolCreate,
+		"PoolDeposit":  TicketActionPoolDeposit,
+		"PoolWithdraw": TicketActionPoolWithdraw,
+		"PoolOpen":     TicketActionPoolOpen,
 	}
 }
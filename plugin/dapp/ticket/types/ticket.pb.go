@@ -142,6 +142,10 @@ type TicketAction struct {
 	//	*TicketAction_Genesis
 	//	*TicketAction_Tclose
 	//	*TicketAction_Miner
+	//	*TicketAction_PoolCreate
+	//	*TicketAction_PoolDeposit
+	//	*TicketAction_PoolWithdraw
+	//	*TicketAction_PoolOpen
 	Value                isTicketAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -198,6 +202,22 @@ type TicketAction_Miner struct {
 	Miner *TicketMiner `protobuf:"bytes,4,opt,name=miner,proto3,oneof"`
 }
 
+type TicketAction_PoolCreate struct {
+	PoolCreate *TicketPoolCreate `protobuf:"bytes,6,opt,name=poolCreate,proto3,oneof"`
+}
+
+type TicketAction_PoolDeposit struct {
+	PoolDeposit *TicketPoolDeposit `protobuf:"bytes,7,opt,name=poolDeposit,proto3,oneof"`
+}
+
+type TicketAction_PoolWithdraw struct {
+	PoolWithdraw *TicketPoolWithdraw `protobuf:"bytes,8,opt,name=poolWithdraw,proto3,oneof"`
+}
+
+type TicketAction_PoolOpen struct {
+	PoolOpen *TicketPoolOpen `protobuf:"bytes,9,opt,name=poolOpen,proto3,oneof"`
+}
+
 func (*TicketAction_Tbind) isTicketAction_Value() {}
 
 func (*TicketAction_Topen) isTicketAction_Value() {}
@@ -208,6 +228,14 @@ func (*TicketAction_Tclose) isTicketAction_Value() {}
 
 func (*TicketAction_Miner) isTicketAction_Value() {}
 
+func (*TicketAction_PoolCreate) isTicketAction_Value() {}
+
+func (*TicketAction_PoolDeposit) isTicketAction_Value() {}
+
+func (*TicketAction_PoolWithdraw) isTicketAction_Value() {}
+
+func (*TicketAction_PoolOpen) isTicketAction_Value() {}
+
 func (m *TicketAction) GetValue() isTicketAction_Value {
 	if m != nil {
 		return m.Value
@@ -250,6 +278,34 @@ func (m *TicketAction) GetMiner() *TicketMiner {
 	return nil
 }
 
+func (m *TicketAction) GetPoolCreate() *TicketPoolCreate {
+	if x, ok := m.GetValue().(*TicketAction_PoolCreate); ok {
+		return x.PoolCreate
+	}
+	return nil
+}
+
+func (m *TicketAction) GetPoolDeposit() *TicketPoolDeposit {
+	if x, ok := m.GetValue().(*TicketAction_PoolDeposit); ok {
+		return x.PoolDeposit
+	}
+	return nil
+}
+
+func (m *TicketAction) GetPoolWithdraw() *TicketPoolWithdraw {
+	if x, ok := m.GetValue().(*TicketAction_PoolWithdraw); ok {
+		return x.PoolWithdraw
+	}
+	return nil
+}
+
+func (m *TicketAction) GetPoolOpen() *TicketPoolOpen {
+	if x, ok := m.GetValue().(*TicketAction_PoolOpen); ok {
+		return x.PoolOpen
+	}
+	return nil
+}
+
 func (m *TicketAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -265,6 +321,10 @@ func (*TicketAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer)
 		(*TicketAction_Genesis)(nil),
 		(*TicketAction_Tclose)(nil),
 		(*TicketAction_Miner)(nil),
+		(*TicketAction_PoolCreate)(nil),
+		(*TicketAction_PoolDeposit)(nil),
+		(*TicketAction_PoolWithdraw)(nil),
+		(*TicketAction_PoolOpen)(nil),
 	}
 }
 
@@ -297,6 +357,26 @@ func _TicketAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Miner); err != nil {
 			return err
 		}
+	case *TicketAction_PoolCreate:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PoolCreate); err != nil {
+			return err
+		}
+	case *TicketAction_PoolDeposit:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PoolDeposit); err != nil {
+			return err
+		}
+	case *TicketAction_PoolWithdraw:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PoolWithdraw); err != nil {
+			return err
+		}
+	case *TicketAction_PoolOpen:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PoolOpen); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TicketAction.Value has unexpected type %T", x)
@@ -347,6 +427,38 @@ func _TicketAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.B
 		err := b.DecodeMessage(msg)
 		m.Value = &TicketAction_Miner{msg}
 		return true, err
+	case 6: // value.poolCreate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TicketPoolCreate)
+		err := b.DecodeMessage(msg)
+		m.Value = &TicketAction_PoolCreate{msg}
+		return true, err
+	case 7: // value.poolDeposit
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TicketPoolDeposit)
+		err := b.DecodeMessage(msg)
+		m.Value = &TicketAction_PoolDeposit{msg}
+		return true, err
+	case 8: // value.poolWithdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TicketPoolWithdraw)
+		err := b.DecodeMessage(msg)
+		m.Value = &TicketAction_PoolWithdraw{msg}
+		return true, err
+	case 9: // value.poolOpen
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TicketPoolOpen)
+		err := b.DecodeMessage(msg)
+		m.Value = &TicketAction_PoolOpen{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -381,6 +493,26 @@ func _TicketAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TicketAction_PoolCreate:
+		s := proto.Size(x.PoolCreate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TicketAction_PoolDeposit:
+		s := proto.Size(x.PoolDeposit)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TicketAction_PoolWithdraw:
+		s := proto.Size(x.PoolWithdraw)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TicketAction_PoolOpen:
+		s := proto.Size(x.PoolOpen)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1205,6 +1337,900 @@ func (m *ReplyBindMiner) GetTxHex() string {
 	return ""
 }
 
+// 矿池, 地址由创建交易的hash生成, 没有私钥, 存入的币只能通过矿池的操作转出
+type TicketPool struct {
+	PoolAddr string `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	//矿池的运营者, 可以用矿池的余额购买ticket
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	//ticket的挖矿地址
+	MinerAddress string `protobuf:"bytes,3,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	//运营者的佣金比例, 万分之几
+	Commission int32 `protobuf:"varint,4,opt,name=commission,proto3" json:"commission,omitempty"`
+	//已经买了ticket, 分配收益的本金
+	TotalShares int64 `protobuf:"varint,5,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+	//每单位本金累计的收益, 放大poolRewardScale倍, 十进制字符串
+	AccRewardPerShare string `protobuf:"bytes,6,opt,name=accRewardPerShare,proto3" json:"accRewardPerShare,omitempty"`
+	//累计的挖矿收益
+	TotalReward int64 `protobuf:"varint,7,opt,name=totalReward,proto3" json:"totalReward,omitempty"`
+	//累计的运营者佣金
+	TotalCommission int64 `protobuf:"varint,8,opt,name=totalCommission,proto3" json:"totalCommission,omitempty"`
+	CreateTime      int64 `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	//存入之后还没有买ticket的本金, 不分配收益
+	TotalUnstaked int64 `protobuf:"varint,10,opt,name=totalUnstaked,proto3" json:"totalUnstaked,omitempty"`
+	//有新存款时购买ticket的次数, 每次购买时之前存入的本金开始分配收益
+	Epoch                int64    `protobuf:"varint,11,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPool) Reset()         { *m = TicketPool{} }
+func (m *TicketPool) String() string { return proto.CompactTextString(m) }
+func (*TicketPool) ProtoMessage()    {}
+func (*TicketPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{17}
+}
+
+func (m *TicketPool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPool.Unmarshal(m, b)
+}
+func (m *TicketPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPool.Marshal(b, m, deterministic)
+}
+func (m *TicketPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPool.Merge(m, src)
+}
+func (m *TicketPool) XXX_Size() int {
+	return xxx_messageInfo_TicketPool.Size(m)
+}
+func (m *TicketPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPool proto.InternalMessageInfo
+
+func (m *TicketPool) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *TicketPool) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *TicketPool) GetMinerAddress() string {
+	if m != nil {
+		return m.MinerAddress
+	}
+	return ""
+}
+
+func (m *TicketPool) GetCommission() int32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalShares() int64 {
+	if m != nil {
+		return m.TotalShares
+	}
+	return 0
+}
+
+func (m *TicketPool) GetAccRewardPerShare() string {
+	if m != nil {
+		return m.AccRewardPerShare
+	}
+	return ""
+}
+
+func (m *TicketPool) GetTotalReward() int64 {
+	if m != nil {
+		return m.TotalReward
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalCommission() int64 {
+	if m != nil {
+		return m.TotalCommission
+	}
+	return 0
+}
+
+func (m *TicketPool) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalUnstaked() int64 {
+	if m != nil {
+		return m.TotalUnstaked
+	}
+	return 0
+}
+
+func (m *TicketPool) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// 存款人在矿池中的份额
+type TicketPoolDepositor struct {
+	PoolAddr string `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Addr     string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	//分配收益的本金
+	Shares int64 `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	//已经结算过的收益, 用于计算新的收益
+	RewardDebt int64 `protobuf:"varint,4,opt,name=rewardDebt,proto3" json:"rewardDebt,omitempty"`
+	//已经结算还没有取出的收益
+	PendingReward int64 `protobuf:"varint,5,opt,name=pendingReward,proto3" json:"pendingReward,omitempty"`
+	//累计取出的收益
+	WithdrawnReward int64 `protobuf:"varint,6,opt,name=withdrawnReward,proto3" json:"withdrawnReward,omitempty"`
+	//存入之后矿池还没有买ticket的本金
+	Unstaked int64 `protobuf:"varint,7,opt,name=unstaked,proto3" json:"unstaked,omitempty"`
+	//存入unstaked时矿池的epoch
+	DepositEpoch         int64    `protobuf:"varint,8,opt,name=depositEpoch,proto3" json:"depositEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolDepositor) Reset()         { *m = TicketPoolDepositor{} }
+func (m *TicketPoolDepositor) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolDepositor) ProtoMessage()    {}
+func (*TicketPoolDepositor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{18}
+}
+
+func (m *TicketPoolDepositor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolDepositor.Unmarshal(m, b)
+}
+func (m *TicketPoolDepositor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolDepositor.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolDepositor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolDepositor.Merge(m, src)
+}
+func (m *TicketPoolDepositor) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolDepositor.Size(m)
+}
+func (m *TicketPoolDepositor) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolDepositor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolDepositor proto.InternalMessageInfo
+
+func (m *TicketPoolDepositor) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *TicketPoolDepositor) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TicketPoolDepositor) GetShares() int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *TicketPoolDepositor) GetRewardDebt() int64 {
+	if m != nil {
+		return m.RewardDebt
+	}
+	return 0
+}
+
+func (m *TicketPoolDepositor) GetPendingReward() int64 {
+	if m != nil {
+		return m.PendingReward
+	}
+	return 0
+}
+
+func (m *TicketPoolDepositor) GetWithdrawnReward() int64 {
+	if m != nil {
+		return m.WithdrawnReward
+	}
+	return 0
+}
+
+func (m *TicketPoolDepositor) GetUnstaked() int64 {
+	if m != nil {
+		return m.Unstaked
+	}
+	return 0
+}
+
+func (m *TicketPoolDepositor) GetDepositEpoch() int64 {
+	if m != nil {
+		return m.DepositEpoch
+	}
+	return 0
+}
+
+type TicketPoolCreate struct {
+	MinerAddress         string   `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	Commission           int32    `protobuf:"varint,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolCreate) Reset()         { *m = TicketPoolCreate{} }
+func (m *TicketPoolCreate) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolCreate) ProtoMessage()    {}
+func (*TicketPoolCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{19}
+}
+
+func (m *TicketPoolCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolCreate.Unmarshal(m, b)
+}
+func (m *TicketPoolCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolCreate.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolCreate.Merge(m, src)
+}
+func (m *TicketPoolCreate) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolCreate.Size(m)
+}
+func (m *TicketPoolCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolCreate proto.InternalMessageInfo
+
+func (m *TicketPoolCreate) GetMinerAddress() string {
+	if m != nil {
+		return m.MinerAddress
+	}
+	return ""
+}
+
+func (m *TicketPoolCreate) GetCommission() int32 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+type TicketPoolDeposit struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolDeposit) Reset()         { *m = TicketPoolDeposit{} }
+func (m *TicketPoolDeposit) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolDeposit) ProtoMessage()    {}
+func (*TicketPoolDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{20}
+}
+
+func (m *TicketPoolDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolDeposit.Unmarshal(m, b)
+}
+func (m *TicketPoolDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolDeposit.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolDeposit.Merge(m, src)
+}
+func (m *TicketPoolDeposit) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolDeposit.Size(m)
+}
+func (m *TicketPoolDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolDeposit proto.InternalMessageInfo
+
+func (m *TicketPoolDeposit) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *TicketPoolDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+//取出本金和所有的收益, amount为0时只取出收益
+type TicketPoolWithdraw struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolWithdraw) Reset()         { *m = TicketPoolWithdraw{} }
+func (m *TicketPoolWithdraw) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolWithdraw) ProtoMessage()    {}
+func (*TicketPoolWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{21}
+}
+
+func (m *TicketPoolWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolWithdraw.Unmarshal(m, b)
+}
+func (m *TicketPoolWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolWithdraw.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolWithdraw.Merge(m, src)
+}
+func (m *TicketPoolWithdraw) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolWithdraw.Size(m)
+}
+func (m *TicketPoolWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolWithdraw proto.InternalMessageInfo
+
+func (m *TicketPoolWithdraw) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *TicketPoolWithdraw) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type TicketPoolOpen struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	RandSeed             int64    `protobuf:"varint,3,opt,name=randSeed,proto3" json:"randSeed,omitempty"`
+	PubHashes            [][]byte `protobuf:"bytes,4,rep,name=pubHashes,proto3" json:"pubHashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolOpen) Reset()         { *m = TicketPoolOpen{} }
+func (m *TicketPoolOpen) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolOpen) ProtoMessage()    {}
+func (*TicketPoolOpen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{22}
+}
+
+func (m *TicketPoolOpen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolOpen.Unmarshal(m, b)
+}
+func (m *TicketPoolOpen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolOpen.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolOpen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolOpen.Merge(m, src)
+}
+func (m *TicketPoolOpen) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolOpen.Size(m)
+}
+func (m *TicketPoolOpen) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolOpen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolOpen proto.InternalMessageInfo
+
+func (m *TicketPoolOpen) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *TicketPoolOpen) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *TicketPoolOpen) GetRandSeed() int64 {
+	if m != nil {
+		return m.RandSeed
+	}
+	return 0
+}
+
+func (m *TicketPoolOpen) GetPubHashes() [][]byte {
+	if m != nil {
+		return m.PubHashes
+	}
+	return nil
+}
+
+type ReceiptTicketPool struct {
+	Pool                 *TicketPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptTicketPool) Reset()         { *m = ReceiptTicketPool{} }
+func (m *ReceiptTicketPool) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPool) ProtoMessage()    {}
+func (*ReceiptTicketPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{23}
+}
+
+func (m *ReceiptTicketPool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPool.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPool.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPool.Merge(m, src)
+}
+func (m *ReceiptTicketPool) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPool.Size(m)
+}
+func (m *ReceiptTicketPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPool proto.InternalMessageInfo
+
+func (m *ReceiptTicketPool) GetPool() *TicketPool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type ReceiptTicketPoolDepositor struct {
+	Depositor            *TicketPoolDepositor `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount               int64                `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReceiptTicketPoolDepositor) Reset()         { *m = ReceiptTicketPoolDepositor{} }
+func (m *ReceiptTicketPoolDepositor) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPoolDepositor) ProtoMessage()    {}
+func (*ReceiptTicketPoolDepositor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{24}
+}
+
+func (m *ReceiptTicketPoolDepositor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPoolDepositor.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPoolDepositor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPoolDepositor.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPoolDepositor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPoolDepositor.Merge(m, src)
+}
+func (m *ReceiptTicketPoolDepositor) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPoolDepositor.Size(m)
+}
+func (m *ReceiptTicketPoolDepositor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPoolDepositor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPoolDepositor proto.InternalMessageInfo
+
+func (m *ReceiptTicketPoolDepositor) GetDepositor() *TicketPoolDepositor {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *ReceiptTicketPoolDepositor) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ReceiptTicketPoolWithdraw struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reward               int64    `protobuf:"varint,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	BlockTime            int64    `protobuf:"varint,7,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	TxHash               string   `protobuf:"bytes,8,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTicketPoolWithdraw) Reset()         { *m = ReceiptTicketPoolWithdraw{} }
+func (m *ReceiptTicketPoolWithdraw) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPoolWithdraw) ProtoMessage()    {}
+func (*ReceiptTicketPoolWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{25}
+}
+
+func (m *ReceiptTicketPoolWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPoolWithdraw.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPoolWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPoolWithdraw.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPoolWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPoolWithdraw.Merge(m, src)
+}
+func (m *ReceiptTicketPoolWithdraw) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPoolWithdraw.Size(m)
+}
+func (m *ReceiptTicketPoolWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPoolWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPoolWithdraw proto.InternalMessageInfo
+
+func (m *ReceiptTicketPoolWithdraw) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetReward() int64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolWithdraw) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type ReceiptTicketPoolReward struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	TicketId             string   `protobuf:"bytes,2,opt,name=ticketId,proto3" json:"ticketId,omitempty"`
+	Reward               int64    `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	Commission           int64    `protobuf:"varint,4,opt,name=commission,proto3" json:"commission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTicketPoolReward) Reset()         { *m = ReceiptTicketPoolReward{} }
+func (m *ReceiptTicketPoolReward) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPoolReward) ProtoMessage()    {}
+func (*ReceiptTicketPoolReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{26}
+}
+
+func (m *ReceiptTicketPoolReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPoolReward.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPoolReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPoolReward.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPoolReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPoolReward.Merge(m, src)
+}
+func (m *ReceiptTicketPoolReward) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPoolReward.Size(m)
+}
+func (m *ReceiptTicketPoolReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPoolReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPoolReward proto.InternalMessageInfo
+
+func (m *ReceiptTicketPoolReward) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *ReceiptTicketPoolReward) GetTicketId() string {
+	if m != nil {
+		return m.TicketId
+	}
+	return ""
+}
+
+func (m *ReceiptTicketPoolReward) GetReward() int64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+func (m *ReceiptTicketPoolReward) GetCommission() int64 {
+	if m != nil {
+		return m.Commission
+	}
+	return 0
+}
+
+type ReqTicketPoolDepositor struct {
+	PoolAddr             string   `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTicketPoolDepositor) Reset()         { *m = ReqTicketPoolDepositor{} }
+func (m *ReqTicketPoolDepositor) String() string { return proto.CompactTextString(m) }
+func (*ReqTicketPoolDepositor) ProtoMessage()    {}
+func (*ReqTicketPoolDepositor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{27}
+}
+
+func (m *ReqTicketPoolDepositor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTicketPoolDepositor.Unmarshal(m, b)
+}
+func (m *ReqTicketPoolDepositor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTicketPoolDepositor.Marshal(b, m, deterministic)
+}
+func (m *ReqTicketPoolDepositor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTicketPoolDepositor.Merge(m, src)
+}
+func (m *ReqTicketPoolDepositor) XXX_Size() int {
+	return xxx_messageInfo_ReqTicketPoolDepositor.Size(m)
+}
+func (m *ReqTicketPoolDepositor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTicketPoolDepositor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTicketPoolDepositor proto.InternalMessageInfo
+
+func (m *ReqTicketPoolDepositor) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *ReqTicketPoolDepositor) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReplyTicketPoolDepositor struct {
+	Depositor *TicketPoolDepositor `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	//包括还没有结算的收益
+	PendingReward        int64    `protobuf:"varint,2,opt,name=pendingReward,proto3" json:"pendingReward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyTicketPoolDepositor) Reset()         { *m = ReplyTicketPoolDepositor{} }
+func (m *ReplyTicketPoolDepositor) String() string { return proto.CompactTextString(m) }
+func (*ReplyTicketPoolDepositor) ProtoMessage()    {}
+func (*ReplyTicketPoolDepositor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{28}
+}
+
+func (m *ReplyTicketPoolDepositor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTicketPoolDepositor.Unmarshal(m, b)
+}
+func (m *ReplyTicketPoolDepositor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTicketPoolDepositor.Marshal(b, m, deterministic)
+}
+func (m *ReplyTicketPoolDepositor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTicketPoolDepositor.Merge(m, src)
+}
+func (m *ReplyTicketPoolDepositor) XXX_Size() int {
+	return xxx_messageInfo_ReplyTicketPoolDepositor.Size(m)
+}
+func (m *ReplyTicketPoolDepositor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTicketPoolDepositor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTicketPoolDepositor proto.InternalMessageInfo
+
+func (m *ReplyTicketPoolDepositor) GetDepositor() *TicketPoolDepositor {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *ReplyTicketPoolDepositor) GetPendingReward() int64 {
+	if m != nil {
+		return m.PendingReward
+	}
+	return 0
+}
+
+type ReqTicketPoolWithdraws struct {
+	PoolAddr  string `protobuf:"bytes,1,opt,name=poolAddr,proto3" json:"poolAddr,omitempty"`
+	Addr      string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count     int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction int32  `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	//上一页最后一条记录的height和index
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTicketPoolWithdraws) Reset()         { *m = ReqTicketPoolWithdraws{} }
+func (m *ReqTicketPoolWithdraws) String() string { return proto.CompactTextString(m) }
+func (*ReqTicketPoolWithdraws) ProtoMessage()    {}
+func (*ReqTicketPoolWithdraws) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{29}
+}
+
+func (m *ReqTicketPoolWithdraws) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTicketPoolWithdraws.Unmarshal(m, b)
+}
+func (m *ReqTicketPoolWithdraws) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTicketPoolWithdraws.Marshal(b, m, deterministic)
+}
+func (m *ReqTicketPoolWithdraws) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTicketPoolWithdraws.Merge(m, src)
+}
+func (m *ReqTicketPoolWithdraws) XXX_Size() int {
+	return xxx_messageInfo_ReqTicketPoolWithdraws.Size(m)
+}
+func (m *ReqTicketPoolWithdraws) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTicketPoolWithdraws.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTicketPoolWithdraws proto.InternalMessageInfo
+
+func (m *ReqTicketPoolWithdraws) GetPoolAddr() string {
+	if m != nil {
+		return m.PoolAddr
+	}
+	return ""
+}
+
+func (m *ReqTicketPoolWithdraws) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqTicketPoolWithdraws) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTicketPoolWithdraws) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqTicketPoolWithdraws) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqTicketPoolWithdraws) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyTicketPoolWithdraws struct {
+	Withdraws            []*ReceiptTicketPoolWithdraw `protobuf:"bytes,1,rep,name=withdraws,proto3" json:"withdraws,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ReplyTicketPoolWithdraws) Reset()         { *m = ReplyTicketPoolWithdraws{} }
+func (m *ReplyTicketPoolWithdraws) String() string { return proto.CompactTextString(m) }
+func (*ReplyTicketPoolWithdraws) ProtoMessage()    {}
+func (*ReplyTicketPoolWithdraws) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{30}
+}
+
+func (m *ReplyTicketPoolWithdraws) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTicketPoolWithdraws.Unmarshal(m, b)
+}
+func (m *ReplyTicketPoolWithdraws) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTicketPoolWithdraws.Marshal(b, m, deterministic)
+}
+func (m *ReplyTicketPoolWithdraws) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTicketPoolWithdraws.Merge(m, src)
+}
+func (m *ReplyTicketPoolWithdraws) XXX_Size() int {
+	return xxx_messageInfo_ReplyTicketPoolWithdraws.Size(m)
+}
+func (m *ReplyTicketPoolWithdraws) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTicketPoolWithdraws.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTicketPoolWithdraws proto.InternalMessageInfo
+
+func (m *ReplyTicketPoolWithdraws) GetWithdraws() []*ReceiptTicketPoolWithdraw {
+	if m != nil {
+		return m.Withdraws
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Ticket)(nil), "types.Ticket")
 	proto.RegisterType((*TicketAction)(nil), "types.TicketAction")
@@ -1223,67 +2249,117 @@ func init() {
 	proto.RegisterType((*ReceiptTicketBind)(nil), "types.ReceiptTicketBind")
 	proto.RegisterType((*ReqBindMiner)(nil), "types.ReqBindMiner")
 	proto.RegisterType((*ReplyBindMiner)(nil), "types.ReplyBindMiner")
+	proto.RegisterType((*TicketPool)(nil), "types.TicketPool")
+	proto.RegisterType((*TicketPoolDepositor)(nil), "types.TicketPoolDepositor")
+	proto.RegisterType((*TicketPoolCreate)(nil), "types.TicketPoolCreate")
+	proto.RegisterType((*TicketPoolDeposit)(nil), "types.TicketPoolDeposit")
+	proto.RegisterType((*TicketPoolWithdraw)(nil), "types.TicketPoolWithdraw")
+	proto.RegisterType((*TicketPoolOpen)(nil), "types.TicketPoolOpen")
+	proto.RegisterType((*ReceiptTicketPool)(nil), "types.ReceiptTicketPool")
+	proto.RegisterType((*ReceiptTicketPoolDepositor)(nil), "types.ReceiptTicketPoolDepositor")
+	proto.RegisterType((*ReceiptTicketPoolWithdraw)(nil), "types.ReceiptTicketPoolWithdraw")
+	proto.RegisterType((*ReceiptTicketPoolReward)(nil), "types.ReceiptTicketPoolReward")
+	proto.RegisterType((*ReqTicketPoolDepositor)(nil), "types.ReqTicketPoolDepositor")
+	proto.RegisterType((*ReplyTicketPoolDepositor)(nil), "types.ReplyTicketPoolDepositor")
+	proto.RegisterType((*ReqTicketPoolWithdraws)(nil), "types.ReqTicketPoolWithdraws")
+	proto.RegisterType((*ReplyTicketPoolWithdraws)(nil), "types.ReplyTicketPoolWithdraws")
 }
 
 func init() { proto.RegisterFile("ticket.proto", fileDescriptor_98a6c21780e82d22) }
 
 var fileDescriptor_98a6c21780e82d22 = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xeb, 0x44,
-	0x10, 0x8e, 0xed, 0x3a, 0x49, 0xa7, 0x4e, 0xcb, 0x59, 0x0a, 0xb2, 0x22, 0x74, 0x14, 0xad, 0x10,
-	0x84, 0x1f, 0x15, 0x28, 0x08, 0x01, 0x37, 0xa8, 0xad, 0xc4, 0x49, 0x25, 0xc2, 0x41, 0xdb, 0xa3,
-	0x83, 0xb8, 0x74, 0xed, 0x4d, 0xce, 0xaa, 0xce, 0xda, 0xac, 0x37, 0xe9, 0xc9, 0x0b, 0x20, 0x71,
-	0xc1, 0x3d, 0x4f, 0xc0, 0x1d, 0xcf, 0xc0, 0xab, 0xa1, 0x1d, 0xaf, 0xff, 0x92, 0x5e, 0x54, 0x02,
-	0xee, 0xf2, 0xcd, 0x7c, 0xe3, 0x99, 0xf9, 0x76, 0x66, 0x37, 0x10, 0x68, 0x11, 0xdf, 0x71, 0x7d,
-	0x96, 0xab, 0x4c, 0x67, 0xc4, 0xd7, 0xdb, 0x9c, 0x17, 0xe3, 0x20, 0xce, 0x56, 0xab, 0x4c, 0x96,
-	0x46, 0xfa, 0x87, 0x0b, 0xfd, 0x17, 0xc8, 0x22, 0x63, 0x18, 0x96, 0xfc, 0xeb, 0x24, 0x74, 0x26,
-	0xce, 0xf4, 0x90, 0xd5, 0x98, 0xbc, 0x0d, 0xfd, 0x42, 0x47, 0x7a, 0x5d, 0x84, 0xee, 0xc4, 0x99,
-	0xfa, 0xcc, 0x22, 0xf2, 0x0e, 0x1c, 0x8a, 0xe2, 0x19, 0x97, 0xbc, 0x10, 0x45, 0xe8, 0x4d, 0x9c,
-	0xe9, 0x90, 0x35, 0x06, 0xf2, 0x14, 0x20, 0x56, 0x3c, 0xd2, 0xfc, 0x85, 0x58, 0xf1, 0xf0, 0x60,
-	0xe2, 0x4c, 0x3d, 0xd6, 0xb2, 0x98, 0xe8, 0x95, 0x90, 0x5c, 0xa1, 0xdb, 0x47, 0x77, 0x63, 0x30,
-	0xd1, 0x08, 0x5e, 0x46, 0xe9, 0x9a, 0x87, 0xc3, 0x32, 0xba, 0xb1, 0x10, 0x0a, 0x01, 0xa2, 0x8b,
-	0x24, 0x51, 0xbc, 0x28, 0xc2, 0x3e, 0xd6, 0xdc, 0xb1, 0x91, 0x77, 0x61, 0xa4, 0xb8, 0x5e, 0x2b,
-	0x59, 0x91, 0x06, 0x48, 0xea, 0x1a, 0xc9, 0x29, 0xf8, 0xb9, 0x12, 0x31, 0x0f, 0x0f, 0x31, 0x49,
-	0x09, 0xe8, 0x6f, 0x2e, 0x04, 0xa5, 0x34, 0x17, 0xb1, 0x16, 0x99, 0x24, 0x1f, 0x80, 0xaf, 0x6f,
-	0x85, 0x4c, 0xb0, 0xd4, 0xa3, 0xf3, 0x27, 0x67, 0x28, 0xe8, 0x59, 0xc9, 0xb9, 0x14, 0x32, 0x99,
-	0xf5, 0x58, 0xc9, 0x40, 0x6a, 0x96, 0x73, 0x89, 0x42, 0xee, 0x52, 0x9f, 0xe7, 0x5c, 0x22, 0xd5,
-	0x30, 0xc8, 0xa7, 0x30, 0x58, 0x5a, 0x01, 0x5d, 0x24, 0x9f, 0x76, 0xc8, 0x56, 0xcb, 0x59, 0x8f,
-	0x55, 0x34, 0xf2, 0x31, 0xf4, 0x75, 0x9c, 0x66, 0x05, 0x47, 0xc5, 0x8f, 0xce, 0x49, 0x27, 0xe0,
-	0xca, 0x78, 0x66, 0x3d, 0x66, 0x39, 0xe4, 0x43, 0xf0, 0x51, 0x12, 0xd4, 0x7f, 0x97, 0x3c, 0x37,
-	0x1e, 0x53, 0x0b, 0x52, 0xc8, 0x31, 0xb8, 0x7a, 0x1b, 0x02, 0x1e, 0xb1, 0xab, 0xb7, 0x97, 0x03,
-	0xf0, 0x37, 0x46, 0x6b, 0xfa, 0xb7, 0x03, 0x47, 0xad, 0x08, 0x42, 0xe0, 0xe0, 0x56, 0xe8, 0x02,
-	0xdb, 0x1b, 0x31, 0xfc, 0x6d, 0x66, 0x44, 0xf1, 0xfb, 0x48, 0x25, 0xd8, 0x87, 0xc7, 0x2c, 0xea,
-	0xcc, 0x95, 0xb7, 0x3f, 0x57, 0xab, 0x2c, 0x11, 0x8b, 0x2d, 0x56, 0x17, 0x30, 0x8b, 0x4c, 0x4c,
-	0xae, 0xc4, 0x66, 0x16, 0x15, 0xaf, 0x50, 0xed, 0x80, 0xd5, 0x98, 0x84, 0x30, 0xd8, 0xa8, 0x05,
-	0xba, 0xfa, 0xe8, 0xaa, 0xa0, 0x89, 0xda, 0xa8, 0xc5, 0x8f, 0x2a, 0xcb, 0x16, 0x78, 0xd0, 0x01,
-	0xab, 0x31, 0xcd, 0xe1, 0xb8, 0xd5, 0xc0, 0xf3, 0x34, 0xf9, 0xbf, 0x7b, 0xa0, 0x5f, 0xc3, 0x21,
-	0xe6, 0xfa, 0x2e, 0x8d, 0x96, 0x26, 0xd9, 0x22, 0x8d, 0x96, 0x98, 0xcc, 0x67, 0xf8, 0xdb, 0x34,
-	0xa2, 0x78, 0xc1, 0xd5, 0x86, 0xdb, 0x6c, 0x15, 0xa4, 0x2f, 0x01, 0x9a, 0xa9, 0xda, 0x1b, 0x74,
-	0xe7, 0x31, 0x83, 0xee, 0x3e, 0x30, 0xe8, 0xf4, 0x4f, 0xa7, 0xfa, 0xb0, 0x99, 0xc1, 0x47, 0x7d,
-	0xf8, 0x14, 0xfc, 0x38, 0x5b, 0x4b, 0x6d, 0x17, 0xbf, 0x04, 0xfb, 0xe9, 0xbc, 0x87, 0xf6, 0x6a,
-	0x0c, 0x43, 0x15, 0xc9, 0xe4, 0x86, 0xf3, 0xc4, 0x6e, 0x7f, 0x8d, 0xcd, 0xee, 0xe7, 0xeb, 0x5b,
-	0x73, 0x6c, 0xbc, 0x08, 0xfd, 0x89, 0x37, 0x0d, 0x58, 0x63, 0xa0, 0x19, 0x8c, 0x3a, 0xe3, 0xff,
-	0xdf, 0x69, 0xd0, 0x34, 0xe4, 0xb5, 0x1a, 0xa2, 0xf3, 0x6a, 0xbe, 0x71, 0x7d, 0x76, 0xee, 0x42,
-	0xaf, 0x73, 0xde, 0xbb, 0xa5, 0xb8, 0xfb, 0xa5, 0xd0, 0xaf, 0x2a, 0x9d, 0xbf, 0x17, 0x85, 0x36,
-	0x87, 0x1f, 0x25, 0x89, 0xb2, 0x45, 0xe3, 0xef, 0xd6, 0x8d, 0xea, 0xb5, 0x6f, 0x54, 0xfa, 0x51,
-	0x55, 0xc8, 0xb5, 0x5c, 0x64, 0x78, 0xc1, 0x56, 0x89, 0x0b, 0x5b, 0x49, 0x63, 0xa0, 0xdf, 0xc0,
-	0x09, 0xe3, 0x79, 0xba, 0x6d, 0xe5, 0x7a, 0x1f, 0x06, 0xa5, 0xbf, 0xa4, 0x1f, 0x9d, 0x8f, 0x3a,
-	0x0b, 0xcf, 0x2a, 0x2f, 0xfd, 0x19, 0x08, 0xc6, 0xfe, 0x14, 0xa5, 0x29, 0xd7, 0xa5, 0xb7, 0x78,
-	0x74, 0x78, 0xb5, 0xa1, 0x77, 0x7c, 0x6b, 0x14, 0xf0, 0xaa, 0x0d, 0x35, 0x98, 0xde, 0xc3, 0x88,
-	0xf1, 0x98, 0x8b, 0x5c, 0xff, 0x8b, 0xa7, 0xe5, 0x29, 0x40, 0xae, 0xf8, 0xe6, 0xa6, 0x2d, 0x52,
-	0xcb, 0x52, 0x8b, 0x7a, 0xd0, 0x88, 0x4a, 0x7f, 0x77, 0xe0, 0x49, 0x27, 0x33, 0xee, 0xcf, 0x14,
-	0x4e, 0xb2, 0x34, 0x99, 0xef, 0x8f, 0xcf, 0xae, 0xd9, 0x30, 0x25, 0xbf, 0x9f, 0xef, 0x9f, 0xee,
-	0xae, 0xf9, 0x71, 0x0b, 0x40, 0x7f, 0x75, 0x20, 0x60, 0xfc, 0x17, 0x53, 0x45, 0x79, 0x6f, 0x8e,
-	0x61, 0x68, 0xde, 0x87, 0x8b, 0x66, 0x1a, 0x6a, 0x6c, 0x1a, 0xce, 0x94, 0x58, 0x0a, 0x8c, 0xb6,
-	0x79, 0x5b, 0x16, 0x23, 0x54, 0xb4, 0xaa, 0x27, 0xd7, 0x63, 0x16, 0x99, 0x79, 0x8c, 0x5f, 0xf1,
-	0xf8, 0xee, 0x32, 0x4a, 0x23, 0x19, 0x97, 0xef, 0xec, 0x90, 0x75, 0x6c, 0xf4, 0x3d, 0x38, 0xc6,
-	0xc3, 0x6e, 0x2a, 0x39, 0x05, 0x5f, 0xbf, 0x9e, 0xf1, 0xd7, 0xb6, 0x8c, 0x12, 0x9c, 0xff, 0xe5,
-	0x40, 0xbf, 0x3c, 0x19, 0xf2, 0x2d, 0x9c, 0x5c, 0xe1, 0x53, 0xdd, 0xc4, 0xbc, 0x69, 0x67, 0xa1,
-	0xdd, 0xd2, 0xf8, 0xad, 0xda, 0xd8, 0xfe, 0x3e, 0xed, 0x91, 0x4f, 0xe0, 0xf8, 0x59, 0x35, 0x58,
-	0x57, 0x58, 0xe9, 0xa8, 0x89, 0xff, 0x41, 0xa4, 0xe3, 0xc0, 0xc2, 0x6b, 0xa9, 0xbf, 0xfc, 0x82,
-	0xf6, 0xc8, 0x67, 0x30, 0xba, 0xe1, 0xfa, 0x62, 0xad, 0xb3, 0xb9, 0x90, 0x42, 0x2e, 0xc9, 0x1b,
-	0x96, 0x50, 0x5f, 0xa3, 0x75, 0x08, 0x26, 0xa3, 0xbd, 0xdb, 0x3e, 0xfe, 0x8b, 0xf9, 0xfc, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x56, 0xb4, 0x54, 0xea, 0x08, 0x00, 0x00,
+	// 1448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x16, 0x45, 0x4b, 0xb6, 0x8e, 0x24, 0x27, 0x9e, 0x38, 0x09, 0x23, 0x5c, 0x04, 0xc2, 0x20,
+	0xf7, 0x5e, 0xdf, 0xdb, 0x20, 0x6d, 0x95, 0xa2, 0x48, 0x82, 0xa2, 0x81, 0xe3, 0xb4, 0x51, 0x80,
+	0xba, 0x09, 0xc6, 0x69, 0x82, 0x76, 0x47, 0x93, 0x63, 0x89, 0x30, 0xc5, 0x61, 0xc8, 0x91, 0x7f,
+	0x8a, 0x2e, 0xba, 0x2a, 0xd0, 0x45, 0xf6, 0x7d, 0x82, 0x2e, 0x0a, 0xf4, 0x19, 0xfa, 0x28, 0x7d,
+	0x8d, 0x2e, 0x8b, 0x39, 0x33, 0xfc, 0x13, 0x65, 0xc3, 0x69, 0xd3, 0x9d, 0xbe, 0x33, 0xe7, 0x70,
+	0xce, 0x7c, 0xe7, 0xd7, 0x86, 0x9e, 0x0c, 0xbc, 0x43, 0x2e, 0xef, 0xc4, 0x89, 0x90, 0x82, 0xb4,
+	0xe4, 0x69, 0xcc, 0xd3, 0x41, 0xcf, 0x13, 0xb3, 0x99, 0x88, 0xb4, 0x90, 0xfe, 0xd4, 0x84, 0xf6,
+	0x0b, 0xd4, 0x22, 0x03, 0x58, 0xd3, 0xfa, 0x4f, 0x7d, 0xc7, 0x1a, 0x5a, 0x5b, 0x1d, 0x96, 0x63,
+	0x72, 0x0d, 0xda, 0xa9, 0x74, 0xe5, 0x3c, 0x75, 0x9a, 0x43, 0x6b, 0xab, 0xc5, 0x0c, 0x22, 0xff,
+	0x82, 0x4e, 0x90, 0x3e, 0xe1, 0x11, 0x4f, 0x83, 0xd4, 0xb1, 0x87, 0xd6, 0xd6, 0x1a, 0x2b, 0x04,
+	0xe4, 0x26, 0x80, 0x97, 0x70, 0x57, 0xf2, 0x17, 0xc1, 0x8c, 0x3b, 0x2b, 0x43, 0x6b, 0xcb, 0x66,
+	0x25, 0x89, 0xb2, 0x9e, 0x05, 0x11, 0x4f, 0xf0, 0xb8, 0x85, 0xc7, 0x85, 0x40, 0x59, 0x23, 0x78,
+	0xe9, 0x86, 0x73, 0xee, 0xac, 0x69, 0xeb, 0x42, 0x42, 0x28, 0xf4, 0x10, 0x6d, 0xfb, 0x7e, 0xc2,
+	0xd3, 0xd4, 0x69, 0xa3, 0xcf, 0x15, 0x19, 0xb9, 0x05, 0xfd, 0x84, 0xcb, 0x79, 0x12, 0x65, 0x4a,
+	0xab, 0xa8, 0x54, 0x15, 0x92, 0x4d, 0x68, 0xc5, 0x49, 0xe0, 0x71, 0xa7, 0x83, 0x97, 0x68, 0x40,
+	0xff, 0xb0, 0xa1, 0xa7, 0xa9, 0xd9, 0xf6, 0x64, 0x20, 0x22, 0xf2, 0x3f, 0x68, 0xc9, 0xfd, 0x20,
+	0xf2, 0xd1, 0xd5, 0xee, 0x68, 0xe3, 0x0e, 0x12, 0x7a, 0x47, 0xeb, 0x3c, 0x0a, 0x22, 0x7f, 0xdc,
+	0x60, 0x5a, 0x03, 0x55, 0x45, 0xcc, 0x23, 0xc7, 0x5a, 0xa2, 0xfa, 0x2c, 0xe6, 0x11, 0xaa, 0x2a,
+	0x0d, 0xf2, 0x01, 0xac, 0x4e, 0x0c, 0x81, 0x4d, 0x54, 0xde, 0xac, 0x28, 0x1b, 0x2e, 0xc7, 0x0d,
+	0x96, 0xa9, 0x91, 0xdb, 0xd0, 0x96, 0x5e, 0x28, 0x52, 0x8e, 0x8c, 0x77, 0x47, 0xa4, 0x62, 0xb0,
+	0xa3, 0x4e, 0xc6, 0x0d, 0x66, 0x74, 0xc8, 0xff, 0xa1, 0x85, 0x94, 0x38, 0x2b, 0x4b, 0x94, 0x77,
+	0xd5, 0x89, 0xf2, 0x05, 0x55, 0xc8, 0x7d, 0x80, 0x58, 0x88, 0x70, 0x07, 0x43, 0x84, 0x84, 0x76,
+	0x47, 0xd7, 0x2b, 0x06, 0xcf, 0xf3, 0xe3, 0x71, 0x83, 0x95, 0x94, 0xc9, 0x27, 0xd0, 0x55, 0xe8,
+	0x31, 0x8f, 0x45, 0x1a, 0x48, 0xe4, 0xb9, 0x3b, 0x72, 0x6a, 0xb6, 0xe6, 0x7c, 0xdc, 0x60, 0x65,
+	0x75, 0xf2, 0x10, 0x7a, 0x0a, 0xbe, 0x0a, 0xe4, 0xd4, 0x4f, 0xdc, 0x63, 0x8c, 0x76, 0x77, 0x74,
+	0xa3, 0x66, 0x9e, 0x29, 0x8c, 0x1b, 0xac, 0x62, 0x40, 0xee, 0xc2, 0x9a, 0xc2, 0x8a, 0x5a, 0x8c,
+	0x62, 0x77, 0x74, 0xb5, 0x66, 0x6c, 0x78, 0xcf, 0x15, 0xc9, 0x3a, 0x34, 0xe5, 0xa9, 0x03, 0x98,
+	0xd1, 0x4d, 0x79, 0xfa, 0x68, 0x15, 0x5a, 0x47, 0x2a, 0xb5, 0xe8, 0x6f, 0x16, 0x74, 0x4b, 0x04,
+	0x11, 0x02, 0x2b, 0xfb, 0x81, 0x4c, 0x31, 0x9a, 0x7d, 0x86, 0xbf, 0x55, 0x49, 0x24, 0xfc, 0xd8,
+	0x4d, 0x7c, 0x0c, 0x9b, 0xcd, 0x0c, 0xaa, 0x94, 0x91, 0x5d, 0x2f, 0xa3, 0x99, 0xf0, 0x83, 0x83,
+	0x53, 0x0c, 0x46, 0x8f, 0x19, 0xa4, 0x6c, 0xe2, 0x24, 0x38, 0x1a, 0xbb, 0xe9, 0x14, 0x93, 0xab,
+	0xc7, 0x72, 0x4c, 0x1c, 0x58, 0x3d, 0x4a, 0x0e, 0xf0, 0xa8, 0x8d, 0x47, 0x19, 0x54, 0x56, 0x47,
+	0xc9, 0xc1, 0xf3, 0x44, 0x88, 0x03, 0xe4, 0xbb, 0xc7, 0x72, 0x4c, 0x63, 0x58, 0x2f, 0x3d, 0xe0,
+	0x59, 0xe8, 0xff, 0xd3, 0x6f, 0xa0, 0xf7, 0xa1, 0x83, 0x77, 0x7d, 0x1e, 0xba, 0x13, 0x75, 0xd9,
+	0x41, 0xe8, 0x4e, 0xf0, 0xb2, 0x16, 0xc3, 0xdf, 0xea, 0x21, 0x09, 0x4f, 0x79, 0x72, 0xc4, 0xcd,
+	0x6d, 0x19, 0xa4, 0x2f, 0x01, 0x8a, 0x22, 0xaa, 0xd5, 0xb5, 0x75, 0x91, 0xba, 0x6e, 0x2e, 0xa9,
+	0x6b, 0xfa, 0xb3, 0x95, 0x7d, 0x18, 0xc3, 0x7d, 0x91, 0x0f, 0x6f, 0x42, 0xcb, 0x13, 0xf3, 0x48,
+	0x9a, 0x3e, 0xa7, 0x41, 0xfd, 0x3a, 0x7b, 0x59, 0x1b, 0x19, 0xc0, 0x5a, 0xe2, 0x46, 0xfe, 0x1e,
+	0xe7, 0xbe, 0x69, 0x76, 0x39, 0x56, 0xad, 0x2e, 0x9e, 0xef, 0xab, 0xb0, 0xf1, 0xd4, 0x69, 0x0d,
+	0xed, 0xad, 0x1e, 0x2b, 0x04, 0x54, 0x40, 0xbf, 0x52, 0xed, 0xef, 0x8e, 0x83, 0xe2, 0x41, 0x76,
+	0xe9, 0x41, 0x74, 0x17, 0xba, 0xa5, 0x6e, 0xb1, 0xd0, 0xfa, 0xed, 0x4a, 0xbc, 0x17, 0x5d, 0x69,
+	0xd6, 0x5d, 0xa1, 0xf7, 0x32, 0x9e, 0xbf, 0x08, 0x52, 0xa9, 0x82, 0xef, 0xfa, 0x7e, 0x62, 0x9c,
+	0xc6, 0xdf, 0xa5, 0x01, 0x62, 0x97, 0x07, 0x08, 0x7d, 0x2f, 0x73, 0xe4, 0x69, 0x74, 0x20, 0x70,
+	0x9e, 0x64, 0x17, 0xa7, 0xc6, 0x93, 0x42, 0x40, 0x1f, 0xc0, 0x25, 0xc6, 0xe3, 0xf0, 0xb4, 0x74,
+	0xd7, 0x7f, 0x61, 0x55, 0x9f, 0x6b, 0xf5, 0xee, 0xa8, 0x5f, 0x29, 0x7b, 0x96, 0x9d, 0xd2, 0xaf,
+	0x81, 0xa0, 0xed, 0x2b, 0x37, 0x0c, 0xb9, 0xd4, 0xa7, 0xe9, 0x85, 0xcd, 0xb3, 0x0a, 0x3d, 0xe4,
+	0xa7, 0x8a, 0x01, 0x3b, 0xab, 0x50, 0x85, 0xe9, 0x31, 0xf4, 0x19, 0xf7, 0x78, 0x10, 0xcb, 0xbf,
+	0x31, 0x49, 0x6f, 0x02, 0xc4, 0x09, 0x3f, 0xda, 0x2b, 0x93, 0x54, 0x92, 0xe4, 0xa4, 0xae, 0x14,
+	0xa4, 0xd2, 0x37, 0x16, 0x6c, 0x54, 0x6e, 0xc6, 0xfa, 0xd9, 0x82, 0x4b, 0x22, 0xf4, 0x77, 0xeb,
+	0xe9, 0xb3, 0x28, 0x56, 0x9a, 0x11, 0x3f, 0xde, 0xad, 0x47, 0x77, 0x51, 0x7c, 0xb1, 0x02, 0xa0,
+	0x3f, 0x58, 0xd0, 0x63, 0xfc, 0xb5, 0xf2, 0x02, 0xad, 0x15, 0x11, 0x6a, 0x1c, 0x6e, 0x17, 0xd9,
+	0x90, 0x63, 0xf5, 0x60, 0x91, 0x04, 0x93, 0x00, 0xad, 0xcd, 0xbd, 0x25, 0x89, 0x22, 0xca, 0x9d,
+	0xe5, 0x99, 0x6b, 0x33, 0x83, 0x54, 0x3e, 0x7a, 0x53, 0xee, 0x1d, 0x3e, 0x72, 0x43, 0x37, 0xf2,
+	0xf4, 0x5a, 0xb1, 0xc6, 0x2a, 0x32, 0xfa, 0x1f, 0x58, 0xc7, 0x60, 0x17, 0x9e, 0x6c, 0x42, 0x4b,
+	0x9e, 0x8c, 0xf9, 0x89, 0x71, 0x43, 0x03, 0xfa, 0xbd, 0x0d, 0x50, 0xcc, 0x07, 0x0c, 0xb2, 0x10,
+	0x61, 0xd9, 0xdd, 0x0c, 0xab, 0x33, 0x11, 0xf3, 0xc4, 0x95, 0x22, 0x73, 0x36, 0xc7, 0xb5, 0x12,
+	0xb1, 0x97, 0x54, 0xab, 0xda, 0x85, 0xc4, 0x6c, 0x16, 0xa4, 0x69, 0x20, 0x22, 0x74, 0xba, 0xc5,
+	0x4a, 0x12, 0x32, 0x84, 0xae, 0x14, 0xd2, 0x0d, 0xf7, 0xa6, 0x6e, 0x82, 0x2d, 0x42, 0xbd, 0xb9,
+	0x2c, 0x22, 0xb7, 0x61, 0xc3, 0xf5, 0x3c, 0x86, 0x1d, 0xfa, 0x39, 0x4f, 0x50, 0x6a, 0x96, 0x9e,
+	0xfa, 0x41, 0xfe, 0x3d, 0x2d, 0x76, 0x56, 0x4b, 0xdf, 0xd3, 0x22, 0x15, 0x7d, 0x84, 0x3b, 0x85,
+	0x5b, 0x7a, 0xc9, 0x5a, 0x14, 0x2f, 0xec, 0x71, 0x9d, 0xda, 0x1e, 0x77, 0x0b, 0xfa, 0x68, 0xf2,
+	0x55, 0x94, 0x4a, 0xf7, 0x90, 0xfb, 0x38, 0x52, 0x6d, 0x56, 0x15, 0xaa, 0x10, 0xf0, 0x58, 0x78,
+	0x53, 0xa7, 0x8b, 0xa7, 0x1a, 0xd0, 0x37, 0x4d, 0xb8, 0x52, 0x5b, 0x0f, 0x44, 0x72, 0x6e, 0x2c,
+	0xb2, 0x5a, 0x68, 0x2e, 0x34, 0x18, 0x4d, 0x9d, 0x49, 0x17, 0x8d, 0x94, 0xef, 0x7a, 0xa8, 0x3d,
+	0xe6, 0xfb, 0x32, 0xdb, 0x41, 0x0b, 0x89, 0xf2, 0x3d, 0xe6, 0x91, 0x1f, 0x44, 0x13, 0xc3, 0x94,
+	0x66, 0xbe, 0x2a, 0x54, 0x5c, 0x1d, 0x9b, 0x55, 0x23, 0x32, 0x7a, 0x6d, 0xcd, 0xd5, 0x82, 0x58,
+	0xf9, 0x3d, 0xcf, 0x68, 0xd0, 0xa4, 0xe7, 0x58, 0xe5, 0x89, 0xaf, 0x1f, 0xf8, 0x19, 0x12, 0xa1,
+	0xe9, 0xae, 0xc8, 0xe8, 0x4b, 0xb8, 0xbc, 0xb8, 0x69, 0x5d, 0x68, 0x1a, 0x54, 0xf3, 0xab, 0xb9,
+	0x98, 0x5f, 0xf4, 0x09, 0x6c, 0xd4, 0x68, 0x3e, 0x97, 0xe4, 0xa2, 0xfe, 0x9a, 0xe5, 0xfa, 0xa3,
+	0x63, 0x20, 0xf5, 0x7d, 0xec, 0x2f, 0x7d, 0xe9, 0x3b, 0x58, 0x2f, 0xbe, 0x84, 0x13, 0xfa, 0xbc,
+	0xaf, 0x2c, 0x9f, 0xcc, 0xe5, 0x99, 0x6b, 0x9f, 0x37, 0x73, 0x57, 0x16, 0x67, 0xee, 0x83, 0x85,
+	0xde, 0x89, 0x1d, 0xe0, 0xdf, 0xb0, 0xa2, 0x2e, 0x5c, 0xba, 0xb6, 0x2b, 0x05, 0x86, 0xc7, 0x34,
+	0x82, 0x41, 0xcd, 0xb6, 0x48, 0xdd, 0x7b, 0xd0, 0xf1, 0x33, 0x60, 0xbe, 0x34, 0x38, 0x6b, 0x11,
+	0x16, 0x09, 0x2b, 0x94, 0xcf, 0x64, 0xea, 0x77, 0x0b, 0x6e, 0xd4, 0x2e, 0xbc, 0x10, 0xf7, 0x67,
+	0x94, 0xca, 0xd2, 0xce, 0x5a, 0x6c, 0x83, 0x2b, 0x95, 0x6d, 0xf0, 0x1a, 0xb4, 0xa7, 0x3c, 0x98,
+	0x4c, 0xa5, 0xa9, 0x0d, 0x83, 0x54, 0x44, 0x82, 0xc8, 0xe7, 0x27, 0xa6, 0x14, 0x34, 0x50, 0xac,
+	0xef, 0x87, 0xc2, 0x3b, 0xc4, 0x5e, 0xa1, 0x2b, 0xa0, 0x10, 0xa8, 0x6f, 0xc9, 0x13, 0x5c, 0x66,
+	0xd7, 0xd0, 0x23, 0x83, 0xe8, 0x8f, 0x16, 0x5c, 0xaf, 0xbd, 0xb0, 0x28, 0xa9, 0xf3, 0xda, 0x72,
+	0x3e, 0x6a, 0x9b, 0xf5, 0x51, 0x6b, 0xde, 0x63, 0x57, 0xde, 0x53, 0x6f, 0xc5, 0x76, 0xa5, 0x54,
+	0xc6, 0x70, 0x8d, 0xf1, 0xd7, 0xef, 0xa0, 0x29, 0xd1, 0x6f, 0xc1, 0x29, 0x2d, 0x2c, 0xef, 0x2a,
+	0x4b, 0x6a, 0x2d, 0xab, 0xb9, 0xa4, 0x65, 0xd1, 0x5f, 0xac, 0x85, 0x67, 0x64, 0xf9, 0x92, 0xbe,
+	0x75, 0xc2, 0x2c, 0xdd, 0x21, 0x55, 0xa0, 0xfd, 0x20, 0xe1, 0x9e, 0xcc, 0x58, 0x6c, 0xb1, 0x42,
+	0xf0, 0x76, 0x49, 0x43, 0xbf, 0xa9, 0x11, 0x55, 0x78, 0xfb, 0x29, 0x74, 0xb2, 0x26, 0x9b, 0x6d,
+	0x69, 0x43, 0x43, 0xd4, 0x99, 0x35, 0xc1, 0x0a, 0x93, 0xd1, 0xaf, 0x16, 0xb4, 0x75, 0x4e, 0x90,
+	0x87, 0x70, 0x49, 0xb7, 0xd4, 0x62, 0x31, 0xb8, 0x92, 0x7f, 0xaa, 0xd8, 0x5b, 0x06, 0x57, 0x73,
+	0x61, 0x79, 0x89, 0xa0, 0x0d, 0xf2, 0x3e, 0xac, 0x3f, 0xc9, 0xb6, 0xc7, 0x1d, 0x64, 0xa1, 0x5f,
+	0xd8, 0x7f, 0x19, 0x84, 0x83, 0x9e, 0x81, 0x4f, 0x23, 0xf9, 0xf1, 0x47, 0xb4, 0x41, 0x3e, 0x84,
+	0xfe, 0x1e, 0x97, 0xdb, 0x73, 0x29, 0x76, 0x83, 0x28, 0x88, 0x26, 0xe4, 0xb2, 0x51, 0xc8, 0xff,
+	0x56, 0x1a, 0xf4, 0xca, 0x97, 0xd1, 0xc6, 0x7e, 0x1b, 0xff, 0x33, 0x73, 0xf7, 0xcf, 0x01, 0x00,
+	0xe0, 0x06, 0x27, 0x82, 0xbe, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
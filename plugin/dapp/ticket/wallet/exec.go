@@ -40,3 +40,13 @@ func (policy *ticketPolicy) On_WalletAutoMiner(req *ty.MinerFlag) (types.Message
 	FlushTicket(policy.getAPI())
 	return &types.Reply{IsOk: true}, nil
 }
+
+// On_WalletPoolOpen open tickets of pool
+func (policy *ticketPolicy) On_WalletPoolOpen(req *ty.TicketPoolOpen) (types.Message, error) {
+	hash, err := policy.poolOpen(req)
+	if err != nil {
+		bizlog.Error("onWalletPoolOpen", "poolOpen error", err.Error())
+		return nil, err
+	}
+	return &types.ReplyHash{Hash: hash}, nil
+}
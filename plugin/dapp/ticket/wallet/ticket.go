@@ -594,6 +594,48 @@ func (policy *ticketPolicy) openticket(mineraddr, returnaddr string, priv crypto
 	return policy.walletOperate.SendTransaction(ta, []byte(ty.TicketX), priv, "")
 }
 
+//poolOpen 运营者用矿池的余额购买ticket, pubHash由矿池挖矿地址的私钥生成, 钱包中需要有运营者和挖矿地址的私钥
+func (policy *ticketPolicy) poolOpen(req *ty.TicketPoolOpen) ([]byte, error) {
+	if req.Count <= 0 || req.Count > ty.TicketCountOpenOnce {
+		return nil, ty.ErrTicketCount
+	}
+	msg, err := policy.getAPI().Query(ty.TicketX, "PoolInfo", &types.ReqString{Data: req.PoolAddr})
+	if err != nil {
+		return nil, err
+	}
+	pool := msg.(*ty.TicketPool)
+	minerPriv, operatorPriv, err := policy.getPoolKeys(pool)
+	if err != nil {
+		return nil, err
+	}
+	open := &ty.TicketPoolOpen{PoolAddr: pool.PoolAddr, Count: req.Count, RandSeed: types.Now().UnixNano()}
+	for i := 0; i < int(open.Count); i++ {
+		privHash := common.Sha256([]byte(fmt.Sprintf("%x:%d:%d", minerPriv.Bytes(), i, open.RandSeed)))
+		open.PubHashes = append(open.PubHashes, common.Sha256(privHash))
+	}
+	ta := &ty.TicketAction{Value: &ty.TicketAction_PoolOpen{PoolOpen: open}, Ty: ty.TicketActionPoolOpen}
+	return policy.walletOperate.SendTransaction(ta, []byte(ty.TicketX), operatorPriv, "")
+}
+
+func (policy *ticketPolicy) getPoolKeys(pool *ty.TicketPool) (crypto.PrivKey, crypto.PrivKey, error) {
+	operater := policy.getWalletOperate()
+	operater.GetMutex().Lock()
+	defer operater.GetMutex().Unlock()
+	ok, err := operater.CheckWalletStatus()
+	if !ok && err != types.ErrOnlyTicketUnLocked {
+		return nil, nil, err
+	}
+	minerPriv, err := operater.GetPrivKeyByAddr(pool.MinerAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	operatorPriv, err := operater.GetPrivKeyByAddr(pool.Operator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minerPriv, operatorPriv, nil
+}
+
 func (policy *ticketPolicy) buyTicketOne(height int64, priv crypto.PrivKey) ([]byte, int, error) {
 	//ticket balance and coins balance
 	addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
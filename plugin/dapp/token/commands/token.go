@@ -45,6 +45,16 @@ func TokenCmd() *cobra.Command {
 		CreateRawTokenMintTxCmd(),
 		CreateRawTokenBurnTxCmd(),
 		GetTokenLogsCmd(),
+		CreateRawTokenApproveTxCmd(),
+		CreateRawTokenTransferFromTxCmd(),
+		CreateRawTokenFreezeTxCmd(),
+		CreateRawTokenUnfreezeTxCmd(),
+		CreateRawTokenPauseTxCmd(),
+		CreateRawTokenUnpauseTxCmd(),
+		CreateRawTokenTransferOwnershipTxCmd(),
+		GetTokenAllowanceCmd(),
+		GetTokenAllowancesCmd(),
+		GetTokenFrozenAddrsCmd(),
 	)
 
 	return cmd
@@ -583,3 +593,321 @@ func getTokenLogsFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("symbol", "s", "", "token symbol")
 	cmd.MarkFlagRequired("symbol")
 }
+
+// CreateRawTokenApproveTxCmd create raw token approve transaction
+func CreateRawTokenApproveTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Create a token approve transaction, amount 0 to cancel the approval",
+		Run:   tokenApprove,
+	}
+	addTokenApproveFlags(cmd)
+	return cmd
+}
+
+func addTokenApproveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("spender", "p", "", "spender address")
+	cmd.MarkFlagRequired("spender")
+
+	cmd.Flags().Float64P("amount", "a", 0, "amount of allowance")
+	cmd.MarkFlagRequired("amount")
+}
+
+func tokenApprove(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	spender, _ := cmd.Flags().GetString("spender")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	params := &tokenty.TokenApprove{
+		Symbol:  symbol,
+		Spender: spender,
+		Amount:  int64((amount+0.000001)*1e4) * 1e4,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenApproveTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenTransferFromTxCmd create raw token transfer from transaction
+func CreateRawTokenTransferFromTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_from",
+		Short: "Create a transaction spending the allowance of from address",
+		Run:   tokenTransferFrom,
+	}
+	addTokenTransferFromFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferFromFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("from", "m", "", "address which approved the allowance")
+	cmd.MarkFlagRequired("from")
+
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func tokenTransferFrom(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+
+	params := &tokenty.TokenTransferFrom{
+		Symbol: symbol,
+		From:   from,
+		To:     to,
+		Amount: int64((amount+0.000001)*1e4) * 1e4,
+		Note:   note,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenTransferFromTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenFreezeTxCmd create raw token freeze address transaction
+func CreateRawTokenFreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Create a transaction freezing the token of an address",
+		Run:   tokenFreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func addTokenFreezeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "d", "", "account address")
+	cmd.MarkFlagRequired("addr")
+}
+
+func tokenFreeze(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	params := &tokenty.TokenFreeze{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenFreezeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUnfreezeTxCmd create raw token unfreeze address transaction
+func CreateRawTokenUnfreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze",
+		Short: "Create a transaction unfreezing the token of an address",
+		Run:   tokenUnfreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func tokenUnfreeze(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	params := &tokenty.TokenUnfreeze{
+		Symbol: symbol,
+		Addr:   addr,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUnfreezeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenPauseTxCmd create raw token pause transaction
+func CreateRawTokenPauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a transaction pausing all transfers of the token",
+		Run:   tokenPause,
+	}
+	addTokenSymbolFlags(cmd)
+	return cmd
+}
+
+func addTokenSymbolFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+}
+
+func tokenPause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	params := &tokenty.TokenPause{
+		Symbol: symbol,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenUnpauseTxCmd create raw token unpause transaction
+func CreateRawTokenUnpauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause",
+		Short: "Create a transaction resuming transfers of the token",
+		Run:   tokenUnpause,
+	}
+	addTokenSymbolFlags(cmd)
+	return cmd
+}
+
+func tokenUnpause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	params := &tokenty.TokenUnpause{
+		Symbol: symbol,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUnpauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenTransferOwnershipTxCmd create raw token transfer ownership transaction
+func CreateRawTokenTransferOwnershipTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_owner",
+		Short: "Create a transaction transferring the ownership of the token",
+		Run:   tokenTransferOwnership,
+	}
+	addTokenTransferOwnershipFlags(cmd)
+	return cmd
+}
+
+func addTokenTransferOwnershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("owner", "o", "", "new owner address")
+	cmd.MarkFlagRequired("owner")
+}
+
+func tokenTransferOwnership(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	owner, _ := cmd.Flags().GetString("owner")
+
+	params := &tokenty.TokenTransferOwnership{
+		Symbol:   symbol,
+		NewOwner: owner,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenTransferOwnershipTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GetTokenAllowanceCmd get allowance of spender
+func GetTokenAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance",
+		Short: "Get the allowance owner approved to spender",
+		Run:   getTokenAllowance,
+	}
+	addGetTokenAllowanceFlags(cmd)
+	return cmd
+}
+
+func addGetTokenAllowanceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+
+	cmd.Flags().StringP("spender", "p", "", "spender address")
+	cmd.MarkFlagRequired("spender")
+}
+
+func getTokenAllowance(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	owner, _ := cmd.Flags().GetString("owner")
+	spender, _ := cmd.Flags().GetString("spender")
+
+	req := &tokenty.ReqTokenAllowance{Symbol: symbol, Owner: owner, Spender: spender}
+	var res tokenty.TokenAllowance
+	queryToken(cmd, "GetTokenAllowance", req, &res)
+}
+
+// GetTokenAllowancesCmd get allowances by owner or spender
+func GetTokenAllowancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowances",
+		Short: "Get allowances approved by owner or to spender",
+		Run:   getTokenAllowances,
+	}
+	addGetTokenAllowancesFlags(cmd)
+	return cmd
+}
+
+func addGetTokenAllowancesFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.Flags().StringP("spender", "p", "", "spender address, used when owner is not set")
+}
+
+func getTokenAllowances(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	owner, _ := cmd.Flags().GetString("owner")
+	spender, _ := cmd.Flags().GetString("spender")
+
+	req := &tokenty.ReqTokenAllowances{Symbol: symbol, Owner: owner, Spender: spender}
+	var res tokenty.ReplyTokenAllowances
+	queryToken(cmd, "GetTokenAllowances", req, &res)
+}
+
+// GetTokenFrozenAddrsCmd get frozen addresses of token
+func GetTokenFrozenAddrsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen_addrs",
+		Short: "Get frozen addresses of token",
+		Run:   getTokenFrozenAddrs,
+	}
+	addTokenSymbolFlags(cmd)
+	return cmd
+}
+
+func getTokenFrozenAddrs(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	var res tokenty.ReplyTokenFrozenAddrs
+	queryToken(cmd, "GetTokenFrozenAddrs", &types.ReqString{Data: symbol}, &res)
+}
+
+func queryToken(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, res)
+	ctx.Run()
+}
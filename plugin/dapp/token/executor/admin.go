@@ -0,0 +1,303 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// token的管理功能:
+// 1) approve/transferFrom 授权第三方在额度内转出token
+// 2) freeze/unfreeze owner冻结地址, 冻结的地址不能转出和转入
+// 3) pause/unpause owner暂停token, 暂停期间不能转账
+// 4) transferOwnership 转移owner权限
+//
+// 暂停和冻结检查转账的每一方: transfer检查转出和转入地址, transferToExec和withdraw检查用户地址和执行器地址,
+// transferFrom检查owner, 授权地址和转入地址, trade成交通过CheckTokenTransfer检查买卖双方.
+// 已经转入其它执行器(paracross, multisig等)的token在执行器内部用ExecTransfer等转移, 不经过token执行器,
+// 这些执行器有意不做检查: 冻结的地址不能再转入和提出, 执行器中的余额只能留在执行器内部
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+func getAllowance(db dbm.KV, symbol, owner, spender string) (*pty.TokenAllowance, error) {
+	allowance := &pty.TokenAllowance{Symbol: symbol, Owner: owner, Spender: spender}
+	value, err := db.Get(calcTokenAllowanceKey(symbol, owner, spender))
+	if err == types.ErrNotFound {
+		return allowance, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = types.Decode(value, allowance); err != nil {
+		return nil, err
+	}
+	return allowance, nil
+}
+
+func getAddrFreeze(db dbm.KV, symbol, addr string) (*pty.TokenAddrFreeze, error) {
+	freeze := &pty.TokenAddrFreeze{Symbol: symbol, Addr: addr}
+	value, err := db.Get(calcTokenFreezeKey(symbol, addr))
+	if err == types.ErrNotFound {
+		return freeze, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = types.Decode(value, freeze); err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
+
+// CheckTokenTransfer 检查token是否暂停以及参与转账的地址是否被冻结, 其它执行器(如trade成交)转移token资产之前也直接调用
+func CheckTokenTransfer(db dbm.KV, height int64, symbol string, addrs ...string) error {
+	if !types.IsDappFork(height, pty.TokenX, pty.ForkTokenAdminX) {
+		return nil
+	}
+	value, err := db.Get(calcTokenKey(symbol))
+	if err == types.ErrNotFound {
+		// 没有创建记录的资产不受管理功能的限制
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var token pty.Token
+	if err = types.Decode(value, &token); err != nil {
+		return err
+	}
+	if token.Paused {
+		return pty.ErrTokenPaused
+	}
+	for _, addr := range addrs {
+		freeze, err := getAddrFreeze(db, symbol, addr)
+		if err != nil {
+			return err
+		}
+		if freeze.Frozen {
+			return pty.ErrTokenAddrFrozen
+		}
+	}
+	return nil
+}
+
+func (t *tokenDB) setPaused(paused bool) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if t.token.Paused == paused {
+		if paused {
+			return nil, nil, pty.ErrTokenPaused
+		}
+		return nil, nil, pty.ErrTokenNotPaused
+	}
+	prevToken := t.token
+	t.token.Paused = paused
+
+	kvs := append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, t.token.Owner))...)
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenPause, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &t.token})}}
+	return kvs, logs, nil
+}
+
+func (t *tokenDB) transferOwnership(db dbm.KV, newOwner string) ([]*types.KeyValue, []*types.ReceiptLog, error) {
+	if t.token.Owner == newOwner {
+		return nil, nil, types.ErrInvalidParam
+	}
+	prevToken := t.token
+	t.token.Owner = newOwner
+
+	// 删除原owner下的记录, 按owner查询token时只能查到新的owner
+	kvs := append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, newOwner))...)
+	kvs = append(kvs, &types.KeyValue{Key: calcTokenAddrNewKeyS(t.token.Symbol, prevToken.Owner), Value: nil})
+	// ForkExecKey之前创建的token使用旧的key
+	oldKey := calcTokenAddrKeyS(t.token.Symbol, prevToken.Owner)
+	if _, err := db.Get(oldKey); err == nil {
+		kvs = append(kvs, &types.KeyValue{Key: oldKey, Value: nil})
+	} else if err != types.ErrNotFound {
+		return nil, nil, err
+	}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenOwnership, Log: types.Encode(&pty.ReceiptTokenAmount{Prev: &prevToken, Current: &t.token})}}
+	return kvs, logs, nil
+}
+
+// loadOwnerToken 加载token并检查交易发起者是token的owner
+func (action *tokenAction) loadOwnerToken(symbol string) (*tokenDB, error) {
+	if symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("token admin", "symbol", symbol, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, pty.ErrTokenOwner
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) approve(approve *pty.TokenApprove) (*types.Receipt, error) {
+	if approve == nil || approve.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if approve.GetAmount() < 0 || approve.GetAmount() > types.MaxTokenBalance {
+		return nil, types.ErrAmount
+	}
+	if err := address.CheckAddress(approve.GetSpender()); err != nil {
+		return nil, err
+	}
+	if approve.GetSpender() == action.fromaddr {
+		return nil, types.ErrInvalidParam
+	}
+	if _, err := loadTokenDB(action.db, approve.GetSymbol()); err != nil {
+		return nil, err
+	}
+
+	prev, err := getAllowance(action.db, approve.GetSymbol(), action.fromaddr, approve.GetSpender())
+	if err != nil {
+		return nil, err
+	}
+	current := *prev
+	current.Amount = approve.GetAmount()
+	kv, log := allowanceKVLog(prev, &current)
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func allowanceKVLog(prev, current *pty.TokenAllowance) (*types.KeyValue, *types.ReceiptLog) {
+	kv := &types.KeyValue{Key: calcTokenAllowanceKey(current.Symbol, current.Owner, current.Spender), Value: types.Encode(current)}
+	log := &types.ReceiptLog{Ty: pty.TyLogTokenAllowance, Log: types.Encode(&pty.ReceiptTokenAllowance{Prev: prev, Current: current})}
+	return kv, log
+}
+
+func (action *tokenAction) transferFrom(transfer *pty.TokenTransferFrom) (*types.Receipt, error) {
+	if transfer == nil || transfer.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if transfer.GetAmount() <= 0 || transfer.GetAmount() > types.MaxTokenBalance {
+		return nil, types.ErrAmount
+	}
+	if err := address.CheckAddress(transfer.GetFrom()); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(transfer.GetTo()); err != nil {
+		return nil, err
+	}
+	err := CheckTokenTransfer(action.db, action.height, transfer.GetSymbol(), transfer.GetFrom(), transfer.GetTo(), action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	prev, err := getAllowance(action.db, transfer.GetSymbol(), transfer.GetFrom(), action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Amount < transfer.GetAmount() {
+		tokenlog.Error("token transferFrom", "symbol", transfer.GetSymbol(), "from", transfer.GetFrom(), "spender", action.fromaddr,
+			"allowance", prev.Amount, "amount", transfer.GetAmount())
+		return nil, pty.ErrTokenAllowance
+	}
+
+	tokenAccount, err := account.NewAccountDB(pty.TokenX, transfer.GetSymbol(), action.db)
+	if err != nil {
+		return nil, err
+	}
+	var receipt *types.Receipt
+	if drivers.IsDriverAddress(transfer.GetTo(), action.height) {
+		receipt, err = tokenAccount.TransferToExec(transfer.GetFrom(), transfer.GetTo(), transfer.GetAmount())
+	} else {
+		receipt, err = tokenAccount.Transfer(transfer.GetFrom(), transfer.GetTo(), transfer.GetAmount())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current := *prev
+	current.Amount -= transfer.GetAmount()
+	kv, log := allowanceKVLog(prev, &current)
+	receipt.KV = append(receipt.KV, kv)
+	receipt.Logs = append(receipt.Logs, log)
+	return receipt, nil
+}
+
+func (action *tokenAction) setFreeze(symbol, addr string, frozen bool) (*types.Receipt, error) {
+	if err := address.CheckAddress(addr); err != nil {
+		return nil, err
+	}
+	if _, err := action.loadOwnerToken(symbol); err != nil {
+		return nil, err
+	}
+	prev, err := getAddrFreeze(action.db, symbol, addr)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Frozen == frozen {
+		if frozen {
+			return nil, pty.ErrTokenAddrFrozen
+		}
+		return nil, pty.ErrTokenAddrNotFrozen
+	}
+	current := *prev
+	current.Frozen = frozen
+	kv := &types.KeyValue{Key: calcTokenFreezeKey(symbol, addr), Value: types.Encode(&current)}
+	log := &types.ReceiptLog{Ty: pty.TyLogTokenFreeze, Log: types.Encode(&pty.ReceiptTokenFreeze{Prev: prev, Current: &current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+func (action *tokenAction) freeze(freeze *pty.TokenFreeze) (*types.Receipt, error) {
+	if freeze == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setFreeze(freeze.GetSymbol(), freeze.GetAddr(), true)
+}
+
+func (action *tokenAction) unfreeze(unfreeze *pty.TokenUnfreeze) (*types.Receipt, error) {
+	if unfreeze == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setFreeze(unfreeze.GetSymbol(), unfreeze.GetAddr(), false)
+}
+
+func (action *tokenAction) setPaused(symbol string, paused bool) (*types.Receipt, error) {
+	tokendb, err := action.loadOwnerToken(symbol)
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := tokendb.setPaused(paused)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) pause(pause *pty.TokenPause) (*types.Receipt, error) {
+	if pause == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setPaused(pause.GetSymbol(), true)
+}
+
+func (action *tokenAction) unpause(unpause *pty.TokenUnpause) (*types.Receipt, error) {
+	if unpause == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return action.setPaused(unpause.GetSymbol(), false)
+}
+
+func (action *tokenAction) transferOwnership(transfer *pty.TokenTransferOwnership) (*types.Receipt, error) {
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(transfer.GetNewOwner()); err != nil {
+		return nil, err
+	}
+	tokendb, err := action.loadOwnerToken(transfer.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	kvs, logs, err := tokendb.transferOwnership(action.db, transfer.GetNewOwner())
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
@@ -0,0 +1,285 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type adminEnv struct {
+	t       *testing.T
+	exec    drivers.Driver
+	stateDB dbm.DB
+	ldb     dbm.DB
+	height  int64
+}
+
+func newAdminEnv(t *testing.T) (*adminEnv, func()) {
+	types.SetTitleOnlyForTest("chain33")
+	dir, ldb, kvdb := util.CreateTestDB()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	for key, value := range map[string]string{"mavl-manage-token-blacklist": "bty", "mavl-manage-token-finisher": string(Nodes[0])} {
+		item := &types.ConfigItem{
+			Key:   key,
+			Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{value}}},
+		}
+		stateDB.Set([]byte(item.Key), types.Encode(item))
+	}
+	exec := newToken()
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	env := &adminEnv{t: t, exec: exec, stateDB: stateDB, ldb: ldb, height: types.GetDappFork(pty.TokenX, pty.ForkTokenAdminX)}
+	return env, func() { util.CloseTestDB(dir, ldb) }
+}
+
+func (env *adminEnv) execTx(tx *types.Transaction, priv string) error {
+	tx, err := signTx(tx, priv)
+	require.Nil(env.t, err)
+	env.height++
+	env.exec.SetEnv(env.height, 1539918074+env.height, 0)
+	receipt, err := env.exec.Exec(tx, 1)
+	if err != nil {
+		return err
+	}
+	for _, kv := range receipt.KV {
+		if kv.Value == nil {
+			env.stateDB.Delete(kv.Key)
+		} else {
+			env.stateDB.Set(kv.Key, kv.Value)
+		}
+	}
+	set, err := env.exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	require.Nil(env.t, err)
+	for _, kv := range set.KV {
+		//localdb中value为nil表示删除
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+		} else {
+			env.ldb.Set(kv.Key, kv.Value)
+		}
+	}
+	return nil
+}
+
+func (env *adminEnv) send(priv string, action string, param types.Message) error {
+	tx, err := types.CallCreateTransaction(pty.TokenX, action, param)
+	require.Nil(env.t, err)
+	return env.execTx(tx, priv)
+}
+
+func (env *adminEnv) transfer(priv string, to string, amount int64) error {
+	action := &pty.TokenAction{
+		Ty:    pty.ActionTransfer,
+		Value: &pty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: Symbol, Amount: amount, To: to}},
+	}
+	tx := &types.Transaction{Execer: []byte(pty.TokenX), Payload: types.Encode(action), To: to, Fee: 1e6}
+	return env.execTx(tx, priv)
+}
+
+func (env *adminEnv) balance(addr string) int64 {
+	accDB, _ := account.NewAccountDB(pty.TokenX, Symbol, env.stateDB)
+	return accDB.LoadAccount(addr).Balance
+}
+
+func (env *adminEnv) createToken(total int64) {
+	owner := string(Nodes[0])
+	require.Nil(env.t, env.send(PrivKeyA, "TokenPreCreate", &pty.TokenPreCreate{
+		Name: Symbol, Symbol: Symbol, Introduction: Symbol, Total: total, Owner: owner}))
+	require.Nil(env.t, env.send(PrivKeyA, "TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: owner}))
+}
+
+func TestTokenAllowance(t *testing.T) {
+	env, closeDB := newAdminEnv(t)
+	defer closeDB()
+	total := int64(1000 * 1e8)
+	env.createToken(total)
+	addrA, addrB, addrC := string(Nodes[0]), string(Nodes[1]), string(Nodes[2])
+
+	//授权B转出A的token
+	assert.Equal(t, types.ErrInvalidParam, env.send(PrivKeyA, "TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: addrA, Amount: 1e8}))
+	require.Nil(t, env.send(PrivKeyA, "TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: addrB, Amount: 10 * 1e8}))
+
+	transferFrom := &pty.TokenTransferFrom{Symbol: Symbol, From: addrA, To: addrC, Amount: 11 * 1e8}
+	assert.Equal(t, pty.ErrTokenAllowance, env.send(PrivKeyB, "TokenTransferFrom", transferFrom))
+	assert.Equal(t, pty.ErrTokenAllowance, env.send(PrivKeyC, "TokenTransferFrom", transferFrom))
+	transferFrom.Amount = 4 * 1e8
+	require.Nil(t, env.send(PrivKeyB, "TokenTransferFrom", transferFrom))
+	assert.Equal(t, total-4*1e8, env.balance(addrA))
+	assert.Equal(t, int64(4*1e8), env.balance(addrC))
+
+	tokenExec := env.exec.(*token)
+	reply, err := tokenExec.Query_GetTokenAllowance(&pty.ReqTokenAllowance{Symbol: Symbol, Owner: addrA, Spender: addrB})
+	require.Nil(t, err)
+	assert.Equal(t, int64(6*1e8), reply.(*pty.TokenAllowance).Amount)
+	reply, err = tokenExec.Query_GetTokenAllowances(&pty.ReqTokenAllowances{Symbol: Symbol, Spender: addrB})
+	require.Nil(t, err)
+	allowances := reply.(*pty.ReplyTokenAllowances).Allowances
+	require.Equal(t, 1, len(allowances))
+	assert.Equal(t, addrA, allowances[0].Owner)
+	assert.Equal(t, int64(6*1e8), allowances[0].Amount)
+
+	//额度为0时取消授权
+	require.Nil(t, env.send(PrivKeyA, "TokenApprove", &pty.TokenApprove{Symbol: Symbol, Spender: addrB, Amount: 0}))
+	assert.Equal(t, pty.ErrTokenAllowance, env.send(PrivKeyB, "TokenTransferFrom", transferFrom))
+	reply, err = tokenExec.Query_GetTokenAllowances(&pty.ReqTokenAllowances{Symbol: Symbol, Owner: addrA})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*pty.ReplyTokenAllowances).Allowances))
+}
+
+func TestTokenFreezeAndPause(t *testing.T) {
+	env, closeDB := newAdminEnv(t)
+	defer closeDB()
+	total := int64(1000 * 1e8)
+	env.createToken(total)
+	addrA, addrB, addrC := string(Nodes[0]), string(Nodes[1]), string(Nodes[2])
+	require.Nil(t, env.transfer(PrivKeyA, addrB, 10*1e8))
+
+	//只有owner可以冻结地址
+	assert.Equal(t, pty.ErrTokenOwner, env.send(PrivKeyB, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: addrB}))
+	require.Nil(t, env.send(PrivKeyA, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: addrB}))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.send(PrivKeyA, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: addrB}))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.transfer(PrivKeyB, addrC, 1e8))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.transfer(PrivKeyA, addrB, 1e8))
+	reply, err := env.exec.(*token).Query_GetTokenFrozenAddrs(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.Equal(t, []string{addrB}, reply.(*pty.ReplyTokenFrozenAddrs).Addrs)
+
+	require.Nil(t, env.send(PrivKeyA, "TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: addrB}))
+	assert.Equal(t, pty.ErrTokenAddrNotFrozen, env.send(PrivKeyA, "TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: addrB}))
+	require.Nil(t, env.transfer(PrivKeyB, addrC, 1e8))
+	reply, err = env.exec.(*token).Query_GetTokenFrozenAddrs(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*pty.ReplyTokenFrozenAddrs).Addrs))
+
+	//暂停期间不能转账
+	require.Nil(t, env.send(PrivKeyA, "TokenPause", &pty.TokenPause{Symbol: Symbol}))
+	assert.Equal(t, pty.ErrTokenPaused, env.send(PrivKeyA, "TokenPause", &pty.TokenPause{Symbol: Symbol}))
+	assert.Equal(t, pty.ErrTokenPaused, env.transfer(PrivKeyB, addrC, 1e8))
+	info, err := env.exec.(*token).Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.True(t, info.(*pty.LocalToken).Paused)
+	require.Nil(t, env.send(PrivKeyA, "TokenUnpause", &pty.TokenUnpause{Symbol: Symbol}))
+	require.Nil(t, env.transfer(PrivKeyB, addrC, 1e8))
+	assert.Equal(t, int64(2*1e8), env.balance(addrC))
+
+	//转移owner之后原owner不能再管理
+	require.Nil(t, env.send(PrivKeyA, "TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: addrB}))
+	assert.Equal(t, pty.ErrTokenOwner, env.send(PrivKeyA, "TokenPause", &pty.TokenPause{Symbol: Symbol}))
+	_, err = env.stateDB.Get(calcTokenAddrNewKeyS(Symbol, addrA))
+	assert.NotNil(t, err)
+	_, err = env.stateDB.Get(calcTokenAddrNewKeyS(Symbol, addrB))
+	assert.Nil(t, err)
+	require.Nil(t, env.send(PrivKeyB, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: addrA}))
+	info, err = env.exec.(*token).Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.Equal(t, addrB, info.(*pty.LocalToken).Owner)
+
+	history, err := env.exec.(*token).Query_GetTokenHistory(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.Equal(t, 7, len(history.(*pty.ReplyTokenLogs).Logs))
+}
+
+func (env *adminEnv) execAction(priv string, execName string, action *pty.TokenAction) error {
+	tx := &types.Transaction{Execer: []byte(pty.TokenX), Payload: types.Encode(action), To: address.ExecAddress(execName), Fee: 1e6}
+	return env.execTx(tx, priv)
+}
+
+func (env *adminEnv) transferToExec(priv string, execName string, amount int64) error {
+	return env.execAction(priv, execName, &pty.TokenAction{
+		Ty: pty.TokenActionTransferToExec,
+		Value: &pty.TokenAction_TransferToExec{TransferToExec: &types.AssetsTransferToExec{
+			Cointoken: Symbol, Amount: amount, ExecName: execName, To: address.ExecAddress(execName)}},
+	})
+}
+
+func (env *adminEnv) withdraw(priv string, execName string, amount int64) error {
+	return env.execAction(priv, execName, &pty.TokenAction{
+		Ty: pty.ActionWithdraw,
+		Value: &pty.TokenAction_Withdraw{Withdraw: &types.AssetsWithdraw{
+			Cointoken: Symbol, Amount: amount, ExecName: execName, To: address.ExecAddress(execName)}},
+	})
+}
+
+func TestTokenFreezeExec(t *testing.T) {
+	env, closeDB := newAdminEnv(t)
+	defer closeDB()
+	env.createToken(1000 * 1e8)
+	addrB, addrC := string(Nodes[1]), string(Nodes[2])
+	execName := "paracross"
+	execAddr := address.ExecAddress(execName)
+	require.Nil(t, env.transfer(PrivKeyA, addrB, 10*1e8))
+	require.Nil(t, env.transferToExec(PrivKeyB, execName, 5*1e8))
+
+	//冻结的地址不能转入和提出执行器
+	require.Nil(t, env.send(PrivKeyA, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: addrB}))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.transferToExec(PrivKeyB, execName, 1e8))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.withdraw(PrivKeyB, execName, 1e8))
+
+	//执行器内部的转移不经过token执行器, 有意不做检查, 冻结地址在执行器中的余额只能留在执行器内部
+	accDB, err := account.NewAccountDB(pty.TokenX, Symbol, env.stateDB)
+	require.Nil(t, err)
+	_, err = accDB.ExecTransfer(addrB, addrC, execAddr, 1e8)
+	assert.Nil(t, err)
+
+	//执行器地址被冻结时, 任何地址都不能转入和提出
+	require.Nil(t, env.send(PrivKeyA, "TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: addrB}))
+	require.Nil(t, env.send(PrivKeyA, "TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: execAddr}))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.withdraw(PrivKeyB, execName, 1e8))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.transferToExec(PrivKeyA, execName, 1e8))
+	require.Nil(t, env.send(PrivKeyA, "TokenUnfreeze", &pty.TokenUnfreeze{Symbol: Symbol, Addr: execAddr}))
+	require.Nil(t, env.withdraw(PrivKeyB, execName, 4*1e8))
+	assert.Equal(t, int64(9*1e8), env.balance(addrB))
+}
+
+func TestTokenAdminExecDelLocal(t *testing.T) {
+	env, closeDB := newAdminEnv(t)
+	defer closeDB()
+	env.createToken(1000 * 1e8)
+	addrA, addrB := string(Nodes[0]), string(Nodes[1])
+
+	tx, err := types.CallCreateTransaction(pty.TokenX, "TokenTransferOwnership", &pty.TokenTransferOwnership{Symbol: Symbol, NewOwner: addrB})
+	require.Nil(t, err)
+	tx, err = signTx(tx, PrivKeyA)
+	require.Nil(t, err)
+	env.height++
+	env.exec.SetEnv(env.height, 1539918074, 0)
+	receipt, err := env.exec.Exec(tx, 1)
+	require.Nil(t, err)
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := env.exec.ExecLocal(tx, receiptData, 1)
+	require.Nil(t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+		} else {
+			env.ldb.Set(kv.Key, kv.Value)
+		}
+	}
+
+	set, err = env.exec.ExecDelLocal(tx, receiptData, 1)
+	require.Nil(t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+		} else {
+			env.ldb.Set(kv.Key, kv.Value)
+		}
+	}
+	info, err := env.exec.(*token).Query_GetTokenInfo(&types.ReqString{Data: Symbol})
+	require.Nil(t, err)
+	assert.Equal(t, addrA, info.(*pty.LocalToken).Owner)
+	_, err = loadLocalToken(Symbol, addrB, pty.TokenStatusCreated, env.exec.(*token).GetLocalDB())
+	assert.NotNil(t, err)
+}
@@ -16,6 +16,9 @@ func (t *token) Exec_Transfer(payload *types.AssetsTransfer, tx *types.Transacti
 	if err != nil {
 		return nil, err
 	}
+	if err = CheckTokenTransfer(t.GetStateDB(), t.GetHeight(), token, tx.From(), tx.GetRealToAddr()); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionTransfer,
 		Value: &tokenty.TokenAction_Transfer{
@@ -31,6 +34,10 @@ func (t *token) Exec_Withdraw(payload *types.AssetsWithdraw, tx *types.Transacti
 	if err != nil {
 		return nil, err
 	}
+	//执行器地址同样是转账的一方
+	if err = CheckTokenTransfer(t.GetStateDB(), t.GetHeight(), token, tx.From(), tx.GetRealToAddr()); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.ActionWithdraw,
 		Value: &tokenty.TokenAction_Withdraw{
@@ -61,6 +68,10 @@ func (t *token) Exec_TransferToExec(payload *types.AssetsTransferToExec, tx *typ
 	if err != nil {
 		return nil, err
 	}
+	//执行器地址同样是转账的一方
+	if err = CheckTokenTransfer(t.GetStateDB(), t.GetHeight(), token, tx.From(), tx.GetRealToAddr()); err != nil {
+		return nil, err
+	}
 	tokenAction := tokenty.TokenAction{
 		Ty: tokenty.TokenActionTransferToExec,
 		Value: &tokenty.TokenAction_TransferToExec{
@@ -79,3 +90,59 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_TokenApprove(payload *tokenty.TokenApprove, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.approve(payload)
+}
+
+func (t *token) Exec_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.transferFrom(payload)
+}
+
+func (t *token) Exec_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.freeze(payload)
+}
+
+func (t *token) Exec_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.unfreeze(payload)
+}
+
+func (t *token) Exec_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.pause(payload)
+}
+
+func (t *token) Exec_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.unpause(payload)
+}
+
+func (t *token) Exec_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(t.GetHeight(), tokenty.TokenX, tokenty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	action := newTokenAction(t, "", tx)
+	return action.transferOwnership(payload)
+}
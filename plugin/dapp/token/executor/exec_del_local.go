@@ -187,3 +187,35 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_TokenApprove(payload *tokenty.TokenApprove, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set, err := t.execLocalAdmin(receiptData, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecDelLocal_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTransferFrom(payload, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionFreeze, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionUnfreeze, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionPause, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionUnpause, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionTransferOwnership, tx, receiptData, index, true)
+}
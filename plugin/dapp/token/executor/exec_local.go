@@ -281,3 +281,165 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+// execLocalAdmin 根据管理功能的log更新授权, 冻结地址和token信息的索引, isDel时恢复到之前的状态
+func (t *token) execLocalAdmin(receiptData *types.ReceiptData, isDel bool) ([]*types.KeyValue, error) {
+	var set []*types.KeyValue
+	for _, item := range receiptData.Logs {
+		switch item.Ty {
+		case tokenty.TyLogTokenAllowance:
+			var receipt tokenty.ReceiptTokenAllowance
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			allowance := receipt.Current
+			if isDel {
+				allowance = receipt.Prev
+			}
+			set = append(set, allowanceLocalKVs(allowance)...)
+		case tokenty.TyLogTokenFreeze:
+			var receipt tokenty.ReceiptTokenFreeze
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			freeze := receipt.Current
+			if isDel {
+				freeze = receipt.Prev
+			}
+			kv := &types.KeyValue{Key: calcTokenFrozenKeyLocal(freeze.Symbol, freeze.Addr)}
+			if freeze.Frozen {
+				kv.Value = types.Encode(freeze)
+			}
+			set = append(set, kv)
+		case tokenty.TyLogTokenPause, tokenty.TyLogTokenOwnership:
+			var receipt tokenty.ReceiptTokenAmount
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			from, to := receipt.Prev, receipt.Current
+			if isDel {
+				from, to = to, from
+			}
+			kvs, err := t.updateLocalToken(from, to)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, kvs...)
+		}
+	}
+	return set, nil
+}
+
+func allowanceLocalKVs(allowance *tokenty.TokenAllowance) []*types.KeyValue {
+	var value []byte
+	if allowance.Amount > 0 {
+		value = types.Encode(allowance)
+	}
+	return []*types.KeyValue{
+		{Key: calcTokenAllowanceOwnerKeyLocal(allowance.Symbol, allowance.Owner, allowance.Spender), Value: value},
+		{Key: calcTokenAllowanceSpenderKeyLocal(allowance.Symbol, allowance.Spender, allowance.Owner), Value: value},
+	}
+}
+
+// updateLocalToken 同步暂停状态和owner, owner变化时LocalToken的key也要跟着变化
+func (t *token) updateLocalToken(from, to *tokenty.Token) ([]*types.KeyValue, error) {
+	localToken, err := loadLocalToken(from.Symbol, from.Owner, tokenty.TokenStatusCreated, t.GetLocalDB())
+	if err != nil {
+		return nil, err
+	}
+	localToken.Paused = to.Paused
+	localToken.Owner = to.Owner
+	var set []*types.KeyValue
+	if from.Owner != to.Owner {
+		set = append(set, &types.KeyValue{Key: calcTokenStatusKeyLocal(from.Symbol, from.Owner, tokenty.TokenStatusCreated), Value: nil})
+	}
+	key := calcTokenStatusKeyLocal(to.Symbol, to.Owner, tokenty.TokenStatusCreated)
+	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
+	return set, nil
+}
+
+// tokenHistoryKVs 在token的变更历史中添加或者删除一条记录
+func (t *token) tokenHistoryKVs(symbol string, actionType int32, tx *types.Transaction, index int, isDel bool) ([]*types.KeyValue, error) {
+	table := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	var err error
+	if isDel {
+		err = table.Del([]byte(txIndex))
+	} else {
+		err = table.Add(&tokenty.LocalLogs{Symbol: symbol, TxIndex: txIndex, ActionType: actionType, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return table.Save()
+}
+
+func (t *token) execLocalAdminWithHistory(symbol string, actionType int32, tx *types.Transaction, receiptData *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	set, err := t.execLocalAdmin(receiptData, isDel)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := t.tokenHistoryKVs(symbol, actionType, tx, index, isDel)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: append(set, kvs...)}, nil
+}
+
+// execLocalTransferFrom 更新授权额度的索引, 以及接收方的资产列表和收币数量
+func (t *token) execLocalTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, receiptData *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	set, err := t.execLocalAdmin(receiptData, isDel)
+	if err != nil {
+		return nil, err
+	}
+	kv, err := updateAddrReciver(t.GetLocalDB(), payload.Symbol, payload.To, payload.Amount, !isDel)
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv)
+	if !isDel {
+		set = append(set, AddTokenToAssets(payload.To, t.GetLocalDB(), payload.Symbol)...)
+	}
+	if cfg.SaveTokenTxList {
+		var txInfo []byte
+		if !isDel {
+			txInfo = makeReplyTxInfo(tx, t.GetHeight(), int64(index), payload.Symbol)
+		}
+		for _, key := range tokenTxkeys(payload.Symbol, payload.From, payload.To, t.GetHeight(), int64(index)) {
+			set = append(set, &types.KeyValue{Key: key, Value: txInfo})
+		}
+	}
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecLocal_TokenApprove(payload *tokenty.TokenApprove, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set, err := t.execLocalAdmin(receiptData, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: set}, nil
+}
+
+func (t *token) ExecLocal_TokenTransferFrom(payload *tokenty.TokenTransferFrom, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalTransferFrom(payload, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionFreeze, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenUnfreeze(payload *tokenty.TokenUnfreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionUnfreeze, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionPause, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenUnpause(payload *tokenty.TokenUnpause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionUnpause, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenTransferOwnership(payload *tokenty.TokenTransferOwnership, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdminWithHistory(payload.Symbol, tokenty.TokenActionTransferOwnership, tx, receiptData, index, false)
+}
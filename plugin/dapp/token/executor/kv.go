@@ -16,6 +16,13 @@ var (
 	tokenPreCreatedSTONew = "mavl-token-create-sto-"
 
 	tokenPreCreatedSTONewLocal = "LODB-token-create-sto-"
+
+	tokenAllowance = "mavl-token-allowance-"
+	tokenFreeze    = "mavl-token-freeze-"
+
+	tokenAllowanceOwnerLocal   = "LODB-token-allowance-owner:"
+	tokenAllowanceSpenderLocal = "LODB-token-allowance-spender:"
+	tokenFrozenLocal           = "LODB-token-frozen:"
 )
 
 func calcTokenKey(token string) (key []byte) {
@@ -54,3 +61,37 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, addr))
 }
+
+func calcTokenAllowanceKey(token, owner, spender string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowance+"%s-%s-%s", token, owner, spender))
+}
+
+func calcTokenFreezeKey(token, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFreeze+"%s-%s", token, addr))
+}
+
+//按owner和spender分别索引授权额度
+func calcTokenAllowanceOwnerKeyLocal(token, owner, spender string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowanceOwnerLocal+"%s:%s:%s", token, owner, spender))
+}
+
+func calcTokenAllowanceOwnerPrefixLocal(token, owner string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowanceOwnerLocal+"%s:%s:", token, owner))
+}
+
+func calcTokenAllowanceSpenderKeyLocal(token, spender, owner string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowanceSpenderLocal+"%s:%s:%s", token, spender, owner))
+}
+
+func calcTokenAllowanceSpenderPrefixLocal(token, spender string) []byte {
+	return []byte(fmt.Sprintf(tokenAllowanceSpenderLocal+"%s:%s:", token, spender))
+}
+
+//被冻结的地址列表
+func calcTokenFrozenKeyLocal(token, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenLocal+"%s:%s", token, addr))
+}
+
+func calcTokenFrozenPrefixLocal(token string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozenLocal+"%s:", token))
+}
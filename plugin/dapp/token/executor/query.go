@@ -91,3 +91,70 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetTokenAllowance 获取owner给spender的授权额度
+func (t *token) Query_GetTokenAllowance(in *tokenty.ReqTokenAllowance) (types.Message, error) {
+	if in == nil || in.Symbol == "" || in.Owner == "" || in.Spender == "" {
+		return nil, types.ErrInvalidParam
+	}
+	allowance, err := getAllowance(t.GetStateDB(), in.Symbol, in.Owner, in.Spender)
+	if err != nil {
+		return nil, err
+	}
+	return allowance, nil
+}
+
+// Query_GetTokenAllowances 按owner或者spender获取授权列表
+func (t *token) Query_GetTokenAllowances(in *tokenty.ReqTokenAllowances) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var prefix []byte
+	if in.Owner != "" {
+		prefix = calcTokenAllowanceOwnerPrefixLocal(in.Symbol, in.Owner)
+	} else if in.Spender != "" {
+		prefix = calcTokenAllowanceSpenderPrefixLocal(in.Symbol, in.Spender)
+	} else {
+		return nil, types.ErrInvalidParam
+	}
+	values, err := t.GetLocalDB().List(prefix, nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	var reply tokenty.ReplyTokenAllowances
+	for _, value := range values {
+		// delete impl by set nil
+		if len(value) == 0 {
+			continue
+		}
+		var allowance tokenty.TokenAllowance
+		if err = types.Decode(value, &allowance); err != nil {
+			return nil, err
+		}
+		reply.Allowances = append(reply.Allowances, &allowance)
+	}
+	return &reply, nil
+}
+
+// Query_GetTokenFrozenAddrs 获取token被冻结的地址
+func (t *token) Query_GetTokenFrozenAddrs(in *types.ReqString) (types.Message, error) {
+	if in == nil || in.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	values, err := t.GetLocalDB().List(calcTokenFrozenPrefixLocal(in.Data), nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	var reply tokenty.ReplyTokenFrozenAddrs
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		var freeze tokenty.TokenAddrFreeze
+		if err = types.Decode(value, &freeze); err != nil {
+			return nil, err
+		}
+		reply.Addrs = append(reply.Addrs, freeze.Addr)
+	}
+	return &reply, nil
+}
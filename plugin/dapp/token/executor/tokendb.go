@@ -603,6 +603,9 @@ func (action *tokenAction) burn(burn *pty.TokenBurn) (*types.Receipt, error) {
 		tokenlog.Error("Can't burn category", "category", tokendb.token.Category, "support", pty.CategoryMintBurnSupport)
 		return nil, types.ErrNotSupport
 	}
+	if err = CheckTokenTransfer(action.db, action.height, burn.GetSymbol(), action.fromaddr); err != nil {
+		return nil, err
+	}
 
 	kvs, logs, err := tokendb.burn(action.db, burn.Amount)
 	if err != nil {
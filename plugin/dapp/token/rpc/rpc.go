@@ -142,3 +142,94 @@ func (c *Jrpc) CreateRawTokenBurnTx(param *tokenty.TokenBurn, result *interface{
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawTokenApproveTx 创建未签名的授权交易
+func (c *Jrpc) CreateRawTokenApproveTx(param *tokenty.TokenApprove, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Spender == "" || param.Amount < 0 {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenApprove", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenTransferFromTx 创建未签名的授权转账交易
+func (c *Jrpc) CreateRawTokenTransferFromTx(param *tokenty.TokenTransferFrom, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.From == "" || param.To == "" || param.Amount <= 0 {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenTransferFrom", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenFreezeTx 创建未签名的冻结地址交易
+func (c *Jrpc) CreateRawTokenFreezeTx(param *tokenty.TokenFreeze, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Addr == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenFreeze", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUnfreezeTx 创建未签名的解冻地址交易
+func (c *Jrpc) CreateRawTokenUnfreezeTx(param *tokenty.TokenUnfreeze, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.Addr == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenUnfreeze", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenPauseTx 创建未签名的暂停Token交易
+func (c *Jrpc) CreateRawTokenPauseTx(param *tokenty.TokenPause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenPause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenUnpauseTx 创建未签名的恢复Token交易
+func (c *Jrpc) CreateRawTokenUnpauseTx(param *tokenty.TokenUnpause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenUnpause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenTransferOwnershipTx 创建未签名的转移Token owner交易
+func (c *Jrpc) CreateRawTokenTransferOwnershipTx(param *tokenty.TokenTransferOwnership, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.NewOwner == "" {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(tokenty.TokenX), "TokenTransferOwnership", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
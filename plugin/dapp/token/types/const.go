@@ -23,6 +23,20 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionApprove for token approve
+	TokenActionApprove = 14
+	// TokenActionTransferFrom for token transfer from
+	TokenActionTransferFrom = 15
+	// TokenActionFreeze for token freeze addr
+	TokenActionFreeze = 16
+	// TokenActionUnfreeze for token unfreeze addr
+	TokenActionUnfreeze = 17
+	// TokenActionPause for token pause
+	TokenActionPause = 18
+	// TokenActionUnpause for token unpause
+	TokenActionUnpause = 19
+	// TokenActionTransferOwnership for token transfer ownership
+	TokenActionTransferOwnership = 20
 )
 
 // token status
@@ -49,6 +63,8 @@ var (
 	ForkTokenSymbolWithNumberX = "ForkTokenSymbolWithNumber"
 	// ForkTokenCheckX  fork check impl bug
 	ForkTokenCheckX = "ForkTokenCheck"
+	// ForkTokenAdminX fork allowance, freeze, pause and ownership transfer
+	ForkTokenAdminX = "ForkTokenAdmin"
 )
 
 const (
@@ -82,6 +98,14 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogTokenAllowance log for token allowance change
+	TyLogTokenAllowance = 325
+	// TyLogTokenFreeze log for token addr freeze and unfreeze
+	TyLogTokenFreeze = 326
+	// TyLogTokenPause log for token pause and unpause
+	TyLogTokenPause = 327
+	// TyLogTokenOwnership log for token ownership transfer
+	TyLogTokenOwnership = 328
 )
 
 const (
@@ -35,4 +35,14 @@ var (
 	ErrTokenBlacklist = errors.New("ErrTokenBlacklist")
 	// ErrTokenNotExist error token symbol not exist
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
+	// ErrTokenPaused error token is paused
+	ErrTokenPaused = errors.New("ErrTokenPaused")
+	// ErrTokenNotPaused error token is not paused
+	ErrTokenNotPaused = errors.New("ErrTokenNotPaused")
+	// ErrTokenAddrFrozen error addr is frozen for token
+	ErrTokenAddrFrozen = errors.New("ErrTokenAddrFrozen")
+	// ErrTokenAddrNotFrozen error addr is not frozen for token
+	ErrTokenAddrNotFrozen = errors.New("ErrTokenAddrNotFrozen")
+	// ErrTokenAllowance error allowance is not enough
+	ErrTokenAllowance = errors.New("ErrTokenAllowanceNotEnough")
 )
@@ -36,6 +36,13 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_TokenApprove
+	//	*TokenAction_TokenTransferFrom
+	//	*TokenAction_TokenFreeze
+	//	*TokenAction_TokenUnfreeze
+	//	*TokenAction_TokenPause
+	//	*TokenAction_TokenUnpause
+	//	*TokenAction_TokenTransferOwnership
 	Value                isTokenAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -108,6 +115,34 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_TokenApprove struct {
+	TokenApprove *TokenApprove `protobuf:"bytes,11,opt,name=tokenApprove,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferFrom struct {
+	TokenTransferFrom *TokenTransferFrom `protobuf:"bytes,12,opt,name=tokenTransferFrom,proto3,oneof"`
+}
+
+type TokenAction_TokenFreeze struct {
+	TokenFreeze *TokenFreeze `protobuf:"bytes,13,opt,name=tokenFreeze,proto3,oneof"`
+}
+
+type TokenAction_TokenUnfreeze struct {
+	TokenUnfreeze *TokenUnfreeze `protobuf:"bytes,14,opt,name=tokenUnfreeze,proto3,oneof"`
+}
+
+type TokenAction_TokenPause struct {
+	TokenPause *TokenPause `protobuf:"bytes,15,opt,name=tokenPause,proto3,oneof"`
+}
+
+type TokenAction_TokenUnpause struct {
+	TokenUnpause *TokenUnpause `protobuf:"bytes,16,opt,name=tokenUnpause,proto3,oneof"`
+}
+
+type TokenAction_TokenTransferOwnership struct {
+	TokenTransferOwnership *TokenTransferOwnership `protobuf:"bytes,17,opt,name=tokenTransferOwnership,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -126,6 +161,20 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_TokenApprove) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferFrom) isTokenAction_Value() {}
+
+func (*TokenAction_TokenFreeze) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUnfreeze) isTokenAction_Value() {}
+
+func (*TokenAction_TokenPause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenUnpause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenTransferOwnership) isTokenAction_Value() {}
+
 func (m *TokenAction) GetValue() isTokenAction_Value {
 	if m != nil {
 		return m.Value
@@ -196,6 +245,55 @@ func (m *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (m *TokenAction) GetTokenApprove() *TokenApprove {
+	if x, ok := m.GetValue().(*TokenAction_TokenApprove); ok {
+		return x.TokenApprove
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenTransferFrom() *TokenTransferFrom {
+	if x, ok := m.GetValue().(*TokenAction_TokenTransferFrom); ok {
+		return x.TokenTransferFrom
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenFreeze() *TokenFreeze {
+	if x, ok := m.GetValue().(*TokenAction_TokenFreeze); ok {
+		return x.TokenFreeze
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUnfreeze() *TokenUnfreeze {
+	if x, ok := m.GetValue().(*TokenAction_TokenUnfreeze); ok {
+		return x.TokenUnfreeze
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenPause() *TokenPause {
+	if x, ok := m.GetValue().(*TokenAction_TokenPause); ok {
+		return x.TokenPause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenUnpause() *TokenUnpause {
+	if x, ok := m.GetValue().(*TokenAction_TokenUnpause); ok {
+		return x.TokenUnpause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenTransferOwnership() *TokenTransferOwnership {
+	if x, ok := m.GetValue().(*TokenAction_TokenTransferOwnership); ok {
+		return x.TokenTransferOwnership
+	}
+	return nil
+}
+
 func (m *TokenAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -215,6 +313,13 @@ func (*TokenAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_TokenApprove)(nil),
+		(*TokenAction_TokenTransferFrom)(nil),
+		(*TokenAction_TokenFreeze)(nil),
+		(*TokenAction_TokenUnfreeze)(nil),
+		(*TokenAction_TokenPause)(nil),
+		(*TokenAction_TokenUnpause)(nil),
+		(*TokenAction_TokenTransferOwnership)(nil),
 	}
 }
 
@@ -267,6 +372,41 @@ func _TokenAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.TokenBurn); err != nil {
 			return err
 		}
+	case *TokenAction_TokenApprove:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenApprove); err != nil {
+			return err
+		}
+	case *TokenAction_TokenTransferFrom:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenTransferFrom); err != nil {
+			return err
+		}
+	case *TokenAction_TokenFreeze:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenFreeze); err != nil {
+			return err
+		}
+	case *TokenAction_TokenUnfreeze:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenUnfreeze); err != nil {
+			return err
+		}
+	case *TokenAction_TokenPause:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenPause); err != nil {
+			return err
+		}
+	case *TokenAction_TokenUnpause:
+		b.EncodeVarint(16<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenUnpause); err != nil {
+			return err
+		}
+	case *TokenAction_TokenTransferOwnership:
+		b.EncodeVarint(17<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TokenTransferOwnership); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TokenAction.Value has unexpected type %T", x)
@@ -349,6 +489,62 @@ func _TokenAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Value = &TokenAction_TokenBurn{msg}
 		return true, err
+	case 11: // value.tokenApprove
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenApprove)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenApprove{msg}
+		return true, err
+	case 12: // value.tokenTransferFrom
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenTransferFrom)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenTransferFrom{msg}
+		return true, err
+	case 13: // value.tokenFreeze
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenFreeze)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenFreeze{msg}
+		return true, err
+	case 14: // value.tokenUnfreeze
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenUnfreeze)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenUnfreeze{msg}
+		return true, err
+	case 15: // value.tokenPause
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenPause)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenPause{msg}
+		return true, err
+	case 16: // value.tokenUnpause
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenUnpause)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenUnpause{msg}
+		return true, err
+	case 17: // value.tokenTransferOwnership
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TokenTransferOwnership)
+		err := b.DecodeMessage(msg)
+		m.Value = &TokenAction_TokenTransferOwnership{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -403,6 +599,41 @@ func _TokenAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TokenAction_TokenApprove:
+		s := proto.Size(x.TokenApprove)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenTransferFrom:
+		s := proto.Size(x.TokenTransferFrom)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenFreeze:
+		s := proto.Size(x.TokenFreeze)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenUnfreeze:
+		s := proto.Size(x.TokenUnfreeze)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenPause:
+		s := proto.Size(x.TokenPause)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenUnpause:
+		s := proto.Size(x.TokenUnpause)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TokenAction_TokenTransferOwnership:
+		s := proto.Size(x.TokenTransferOwnership)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -681,9 +912,359 @@ func (m *TokenBurn) GetSymbol() string {
 
 func (m *TokenBurn) GetAmount() int64 {
 	if m != nil {
-		return m.Amount
+		return m.Amount
+	}
+	return 0
+}
+
+// 授权spender从交易发起者的账户中转出不超过amount的token, amount为0表示取消授权
+type TokenApprove struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Spender              string   `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenApprove) Reset()         { *m = TokenApprove{} }
+func (m *TokenApprove) String() string { return proto.CompactTextString(m) }
+func (*TokenApprove) ProtoMessage()    {}
+func (*TokenApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{6}
+}
+
+func (m *TokenApprove) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenApprove.Unmarshal(m, b)
+}
+func (m *TokenApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenApprove.Marshal(b, m, deterministic)
+}
+func (m *TokenApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenApprove.Merge(m, src)
+}
+func (m *TokenApprove) XXX_Size() int {
+	return xxx_messageInfo_TokenApprove.Size(m)
+}
+func (m *TokenApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenApprove proto.InternalMessageInfo
+
+func (m *TokenApprove) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenApprove) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *TokenApprove) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// spender使用授权额度从from转账到to
+type TokenTransferFrom struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferFrom) Reset()         { *m = TokenTransferFrom{} }
+func (m *TokenTransferFrom) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferFrom) ProtoMessage()    {}
+func (*TokenTransferFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{7}
+}
+
+func (m *TokenTransferFrom) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferFrom.Unmarshal(m, b)
+}
+func (m *TokenTransferFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferFrom.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferFrom.Merge(m, src)
+}
+func (m *TokenTransferFrom) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferFrom.Size(m)
+}
+func (m *TokenTransferFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferFrom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferFrom proto.InternalMessageInfo
+
+func (m *TokenTransferFrom) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TokenTransferFrom) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TokenTransferFrom) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// owner冻结地址, 冻结之后的地址不能转出和转入token
+type TokenFreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenFreeze) Reset()         { *m = TokenFreeze{} }
+func (m *TokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenFreeze) ProtoMessage()    {}
+func (*TokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{8}
+}
+
+func (m *TokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFreeze.Unmarshal(m, b)
+}
+func (m *TokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFreeze.Marshal(b, m, deterministic)
+}
+func (m *TokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFreeze.Merge(m, src)
+}
+func (m *TokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenFreeze.Size(m)
+}
+func (m *TokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenFreeze proto.InternalMessageInfo
+
+func (m *TokenFreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenFreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type TokenUnfreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUnfreeze) Reset()         { *m = TokenUnfreeze{} }
+func (m *TokenUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenUnfreeze) ProtoMessage()    {}
+func (*TokenUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{9}
+}
+
+func (m *TokenUnfreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUnfreeze.Unmarshal(m, b)
+}
+func (m *TokenUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUnfreeze.Marshal(b, m, deterministic)
+}
+func (m *TokenUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUnfreeze.Merge(m, src)
+}
+func (m *TokenUnfreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenUnfreeze.Size(m)
+}
+func (m *TokenUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUnfreeze proto.InternalMessageInfo
+
+func (m *TokenUnfreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenUnfreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// owner暂停token, 暂停期间所有的转账都失败
+type TokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenPause) Reset()         { *m = TokenPause{} }
+func (m *TokenPause) String() string { return proto.CompactTextString(m) }
+func (*TokenPause) ProtoMessage()    {}
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{10}
+}
+
+func (m *TokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenPause.Unmarshal(m, b)
+}
+func (m *TokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenPause.Marshal(b, m, deterministic)
+}
+func (m *TokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPause.Merge(m, src)
+}
+func (m *TokenPause) XXX_Size() int {
+	return xxx_messageInfo_TokenPause.Size(m)
+}
+func (m *TokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPause proto.InternalMessageInfo
+
+func (m *TokenPause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+type TokenUnpause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenUnpause) Reset()         { *m = TokenUnpause{} }
+func (m *TokenUnpause) String() string { return proto.CompactTextString(m) }
+func (*TokenUnpause) ProtoMessage()    {}
+func (*TokenUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{11}
+}
+
+func (m *TokenUnpause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUnpause.Unmarshal(m, b)
+}
+func (m *TokenUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUnpause.Marshal(b, m, deterministic)
+}
+func (m *TokenUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUnpause.Merge(m, src)
+}
+func (m *TokenUnpause) XXX_Size() int {
+	return xxx_messageInfo_TokenUnpause.Size(m)
+}
+func (m *TokenUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUnpause proto.InternalMessageInfo
+
+func (m *TokenUnpause) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// 把token的owner权限转给newOwner
+type TokenTransferOwnership struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	NewOwner             string   `protobuf:"bytes,2,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenTransferOwnership) Reset()         { *m = TokenTransferOwnership{} }
+func (m *TokenTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferOwnership) ProtoMessage()    {}
+func (*TokenTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{12}
+}
+
+func (m *TokenTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenTransferOwnership.Unmarshal(m, b)
+}
+func (m *TokenTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenTransferOwnership.Marshal(b, m, deterministic)
+}
+func (m *TokenTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferOwnership.Merge(m, src)
+}
+func (m *TokenTransferOwnership) XXX_Size() int {
+	return xxx_messageInfo_TokenTransferOwnership.Size(m)
+}
+func (m *TokenTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferOwnership proto.InternalMessageInfo
+
+func (m *TokenTransferOwnership) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return 0
+	return ""
 }
 
 // state db
@@ -697,6 +1278,7 @@ type Token struct {
 	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
 	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -706,7 +1288,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{6}
+	return fileDescriptor_3aff0bcd502840ab, []int{13}
 }
 
 func (m *Token) XXX_Unmarshal(b []byte) error {
@@ -790,6 +1372,131 @@ func (m *Token) GetCategory() int32 {
 	return 0
 }
 
+func (m *Token) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type TokenAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAllowance) Reset()         { *m = TokenAllowance{} }
+func (m *TokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*TokenAllowance) ProtoMessage()    {}
+func (*TokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{14}
+}
+
+func (m *TokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAllowance.Unmarshal(m, b)
+}
+func (m *TokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *TokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAllowance.Merge(m, src)
+}
+func (m *TokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_TokenAllowance.Size(m)
+}
+func (m *TokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAllowance proto.InternalMessageInfo
+
+func (m *TokenAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *TokenAllowance) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type TokenAddrFreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenAddrFreeze) Reset()         { *m = TokenAddrFreeze{} }
+func (m *TokenAddrFreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenAddrFreeze) ProtoMessage()    {}
+func (*TokenAddrFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{15}
+}
+
+func (m *TokenAddrFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAddrFreeze.Unmarshal(m, b)
+}
+func (m *TokenAddrFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAddrFreeze.Marshal(b, m, deterministic)
+}
+func (m *TokenAddrFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAddrFreeze.Merge(m, src)
+}
+func (m *TokenAddrFreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenAddrFreeze.Size(m)
+}
+func (m *TokenAddrFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAddrFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAddrFreeze proto.InternalMessageInfo
+
+func (m *TokenAddrFreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenAddrFreeze) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TokenAddrFreeze) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // log
 type ReceiptToken struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -804,7 +1511,7 @@ func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
 func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
 func (*ReceiptToken) ProtoMessage()    {}
 func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{7}
+	return fileDescriptor_3aff0bcd502840ab, []int{16}
 }
 
 func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
@@ -858,7 +1565,7 @@ func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
 func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTokenAmount) ProtoMessage()    {}
 func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{8}
+	return fileDescriptor_3aff0bcd502840ab, []int{17}
 }
 
 func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
@@ -893,6 +1600,100 @@ func (m *ReceiptTokenAmount) GetCurrent() *Token {
 	return nil
 }
 
+type ReceiptTokenAllowance struct {
+	Prev                 *TokenAllowance `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenAllowance `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptTokenAllowance) Reset()         { *m = ReceiptTokenAllowance{} }
+func (m *ReceiptTokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenAllowance) ProtoMessage()    {}
+func (*ReceiptTokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{18}
+}
+
+func (m *ReceiptTokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenAllowance.Unmarshal(m, b)
+}
+func (m *ReceiptTokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenAllowance.Merge(m, src)
+}
+func (m *ReceiptTokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenAllowance.Size(m)
+}
+func (m *ReceiptTokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenAllowance proto.InternalMessageInfo
+
+func (m *ReceiptTokenAllowance) GetPrev() *TokenAllowance {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenAllowance) GetCurrent() *TokenAllowance {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTokenFreeze struct {
+	Prev                 *TokenAddrFreeze `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenAddrFreeze `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptTokenFreeze) Reset()         { *m = ReceiptTokenFreeze{} }
+func (m *ReceiptTokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenFreeze) ProtoMessage()    {}
+func (*ReceiptTokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{19}
+}
+
+func (m *ReceiptTokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenFreeze.Unmarshal(m, b)
+}
+func (m *ReceiptTokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenFreeze.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenFreeze.Merge(m, src)
+}
+func (m *ReceiptTokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenFreeze.Size(m)
+}
+func (m *ReceiptTokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenFreeze proto.InternalMessageInfo
+
+func (m *ReceiptTokenFreeze) GetPrev() *TokenAddrFreeze {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenFreeze) GetCurrent() *TokenAddrFreeze {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
 // local
 type LocalToken struct {
 	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -913,6 +1714,7 @@ type LocalToken struct {
 	RevokedHeight        int64    `protobuf:"varint,15,opt,name=revokedHeight,proto3" json:"revokedHeight,omitempty"`
 	RevokedTime          int64    `protobuf:"varint,16,opt,name=revokedTime,proto3" json:"revokedTime,omitempty"`
 	Category             int32    `protobuf:"varint,17,opt,name=category,proto3" json:"category,omitempty"`
+	Paused               bool     `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -922,7 +1724,7 @@ func (m *LocalToken) Reset()         { *m = LocalToken{} }
 func (m *LocalToken) String() string { return proto.CompactTextString(m) }
 func (*LocalToken) ProtoMessage()    {}
 func (*LocalToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{9}
+	return fileDescriptor_3aff0bcd502840ab, []int{20}
 }
 
 func (m *LocalToken) XXX_Unmarshal(b []byte) error {
@@ -1062,6 +1864,13 @@ func (m *LocalToken) GetCategory() int32 {
 	return 0
 }
 
+func (m *LocalToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 type LocalLogs struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
@@ -1076,7 +1885,7 @@ func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
 func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
 func (*LocalLogs) ProtoMessage()    {}
 func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{10}
+	return fileDescriptor_3aff0bcd502840ab, []int{21}
 }
 
 func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
@@ -1140,7 +1949,7 @@ func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
 func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqTokens) ProtoMessage()    {}
 func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{11}
+	return fileDescriptor_3aff0bcd502840ab, []int{22}
 }
 
 func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
@@ -1200,7 +2009,7 @@ func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
 func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokens) ProtoMessage()    {}
 func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{12}
+	return fileDescriptor_3aff0bcd502840ab, []int{23}
 }
 
 func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
@@ -1240,7 +2049,7 @@ func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
 func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
 func (*TokenRecv) ProtoMessage()    {}
 func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{13}
+	return fileDescriptor_3aff0bcd502840ab, []int{24}
 }
 
 func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
@@ -1286,7 +2095,7 @@ func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{}
 func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
 func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{14}
+	return fileDescriptor_3aff0bcd502840ab, []int{25}
 }
 
 func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
@@ -1327,7 +2136,7 @@ func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
 func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBalance) ProtoMessage()    {}
 func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{15}
+	return fileDescriptor_3aff0bcd502840ab, []int{26}
 }
 
 func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
@@ -1381,7 +2190,7 @@ func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
 func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountTokenAssets) ProtoMessage()    {}
 func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{16}
+	return fileDescriptor_3aff0bcd502840ab, []int{27}
 }
 
 func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1428,7 +2237,7 @@ func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
 func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
 func (*TokenAsset) ProtoMessage()    {}
 func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{17}
+	return fileDescriptor_3aff0bcd502840ab, []int{28}
 }
 
 func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
@@ -1474,7 +2283,7 @@ func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets
 func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccountTokenAssets) ProtoMessage()    {}
 func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{18}
+	return fileDescriptor_3aff0bcd502840ab, []int{29}
 }
 
 func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1518,7 +2327,7 @@ func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
 func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrTokens) ProtoMessage()    {}
 func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{19}
+	return fileDescriptor_3aff0bcd502840ab, []int{30}
 }
 
 func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
@@ -1599,7 +2408,7 @@ func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
 func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenTx) ProtoMessage()    {}
 func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{20}
+	return fileDescriptor_3aff0bcd502840ab, []int{31}
 }
 
 func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
@@ -1680,7 +2489,7 @@ func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
 func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokenLogs) ProtoMessage()    {}
 func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{21}
+	return fileDescriptor_3aff0bcd502840ab, []int{32}
 }
 
 func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
@@ -1708,6 +2517,195 @@ func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
 	return nil
 }
 
+type ReqTokenAllowance struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenAllowance) Reset()         { *m = ReqTokenAllowance{} }
+func (m *ReqTokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenAllowance) ProtoMessage()    {}
+func (*ReqTokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{33}
+}
+
+func (m *ReqTokenAllowance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenAllowance.Unmarshal(m, b)
+}
+func (m *ReqTokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenAllowance.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenAllowance.Merge(m, src)
+}
+func (m *ReqTokenAllowance) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenAllowance.Size(m)
+}
+func (m *ReqTokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenAllowance proto.InternalMessageInfo
+
+func (m *ReqTokenAllowance) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+// 按owner或者spender查询授权列表, 两个都设置时按owner查询
+type ReqTokenAllowances struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenAllowances) Reset()         { *m = ReqTokenAllowances{} }
+func (m *ReqTokenAllowances) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenAllowances) ProtoMessage()    {}
+func (*ReqTokenAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{34}
+}
+
+func (m *ReqTokenAllowances) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenAllowances.Unmarshal(m, b)
+}
+func (m *ReqTokenAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenAllowances.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenAllowances.Merge(m, src)
+}
+func (m *ReqTokenAllowances) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenAllowances.Size(m)
+}
+func (m *ReqTokenAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenAllowances proto.InternalMessageInfo
+
+func (m *ReqTokenAllowances) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowances) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqTokenAllowances) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+type ReplyTokenAllowances struct {
+	Allowances           []*TokenAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyTokenAllowances) Reset()         { *m = ReplyTokenAllowances{} }
+func (m *ReplyTokenAllowances) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenAllowances) ProtoMessage()    {}
+func (*ReplyTokenAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{35}
+}
+
+func (m *ReplyTokenAllowances) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenAllowances.Unmarshal(m, b)
+}
+func (m *ReplyTokenAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenAllowances.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenAllowances.Merge(m, src)
+}
+func (m *ReplyTokenAllowances) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenAllowances.Size(m)
+}
+func (m *ReplyTokenAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenAllowances proto.InternalMessageInfo
+
+func (m *ReplyTokenAllowances) GetAllowances() []*TokenAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+type ReplyTokenFrozenAddrs struct {
+	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyTokenFrozenAddrs) Reset()         { *m = ReplyTokenFrozenAddrs{} }
+func (m *ReplyTokenFrozenAddrs) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenFrozenAddrs) ProtoMessage()    {}
+func (*ReplyTokenFrozenAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{36}
+}
+
+func (m *ReplyTokenFrozenAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Unmarshal(m, b)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenFrozenAddrs.Merge(m, src)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenFrozenAddrs.Size(m)
+}
+func (m *ReplyTokenFrozenAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenFrozenAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenFrozenAddrs proto.InternalMessageInfo
+
+func (m *ReplyTokenFrozenAddrs) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenAction)(nil), "types.TokenAction")
 	proto.RegisterType((*TokenPreCreate)(nil), "types.TokenPreCreate")
@@ -1715,9 +2713,20 @@ func init() {
 	proto.RegisterType((*TokenRevokeCreate)(nil), "types.TokenRevokeCreate")
 	proto.RegisterType((*TokenMint)(nil), "types.TokenMint")
 	proto.RegisterType((*TokenBurn)(nil), "types.TokenBurn")
+	proto.RegisterType((*TokenApprove)(nil), "types.TokenApprove")
+	proto.RegisterType((*TokenTransferFrom)(nil), "types.TokenTransferFrom")
+	proto.RegisterType((*TokenFreeze)(nil), "types.TokenFreeze")
+	proto.RegisterType((*TokenUnfreeze)(nil), "types.TokenUnfreeze")
+	proto.RegisterType((*TokenPause)(nil), "types.TokenPause")
+	proto.RegisterType((*TokenUnpause)(nil), "types.TokenUnpause")
+	proto.RegisterType((*TokenTransferOwnership)(nil), "types.TokenTransferOwnership")
 	proto.RegisterType((*Token)(nil), "types.Token")
+	proto.RegisterType((*TokenAllowance)(nil), "types.TokenAllowance")
+	proto.RegisterType((*TokenAddrFreeze)(nil), "types.TokenAddrFreeze")
 	proto.RegisterType((*ReceiptToken)(nil), "types.ReceiptToken")
 	proto.RegisterType((*ReceiptTokenAmount)(nil), "types.ReceiptTokenAmount")
+	proto.RegisterType((*ReceiptTokenAllowance)(nil), "types.ReceiptTokenAllowance")
+	proto.RegisterType((*ReceiptTokenFreeze)(nil), "types.ReceiptTokenFreeze")
 	proto.RegisterType((*LocalToken)(nil), "types.LocalToken")
 	proto.RegisterType((*LocalLogs)(nil), "types.LocalLogs")
 	proto.RegisterType((*ReqTokens)(nil), "types.ReqTokens")
@@ -1731,82 +2740,111 @@ func init() {
 	proto.RegisterType((*ReqAddrTokens)(nil), "types.ReqAddrTokens")
 	proto.RegisterType((*ReqTokenTx)(nil), "types.ReqTokenTx")
 	proto.RegisterType((*ReplyTokenLogs)(nil), "types.ReplyTokenLogs")
+	proto.RegisterType((*ReqTokenAllowance)(nil), "types.ReqTokenAllowance")
+	proto.RegisterType((*ReqTokenAllowances)(nil), "types.ReqTokenAllowances")
+	proto.RegisterType((*ReplyTokenAllowances)(nil), "types.ReplyTokenAllowances")
+	proto.RegisterType((*ReplyTokenFrozenAddrs)(nil), "types.ReplyTokenFrozenAddrs")
 }
 
 func init() { proto.RegisterFile("token.proto", fileDescriptor_3aff0bcd502840ab) }
 
 var fileDescriptor_3aff0bcd502840ab = []byte{
-	// 1115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdd, 0x6a, 0xe3, 0xc6,
-	0x17, 0xb7, 0x2d, 0x7f, 0x44, 0xc7, 0x89, 0x13, 0xcf, 0x7f, 0x37, 0x7f, 0x91, 0x2e, 0x8b, 0x11,
-	0x4b, 0x49, 0xa1, 0x84, 0x90, 0xd0, 0x52, 0x68, 0xa1, 0x38, 0x25, 0xbb, 0xde, 0x76, 0xbb, 0x2d,
-	0x53, 0x43, 0xef, 0x0a, 0x5a, 0xe9, 0xc4, 0x11, 0xb1, 0x25, 0x65, 0x34, 0x76, 0xec, 0xa7, 0xe9,
-	0x7d, 0x2f, 0xfa, 0x08, 0xbd, 0xe8, 0xcb, 0xf4, 0x35, 0xca, 0x9c, 0x19, 0xc9, 0x23, 0x3b, 0x2e,
-	0xe4, 0xae, 0xf4, 0x4e, 0xe7, 0xeb, 0x37, 0x73, 0xce, 0xf9, 0x9d, 0xe3, 0x31, 0x74, 0x65, 0x7a,
-	0x87, 0xc9, 0x59, 0x26, 0x52, 0x99, 0xb2, 0x96, 0x5c, 0x65, 0x98, 0x9f, 0xf4, 0xa5, 0x08, 0x92,
-	0x3c, 0x08, 0x65, 0x9c, 0x1a, 0xcb, 0xc9, 0x41, 0x10, 0x86, 0xe9, 0x3c, 0x91, 0x5a, 0xf4, 0xff,
-	0x6c, 0x42, 0x77, 0xac, 0x02, 0x87, 0xe4, 0xc4, 0xbe, 0x86, 0x1e, 0xe1, 0xfc, 0x28, 0xf0, 0x1b,
-	0x81, 0x81, 0x44, 0xaf, 0x3e, 0xa8, 0x9f, 0x76, 0x2f, 0x9e, 0x9f, 0x11, 0xe2, 0xd9, 0xb8, 0x62,
-	0x1c, 0xd5, 0xf8, 0x86, 0x3b, 0x1b, 0x41, 0x9f, 0x34, 0xaf, 0xe3, 0x24, 0xce, 0x6f, 0x0d, 0x46,
-	0x83, 0x30, 0x3c, 0x1b, 0xc3, 0xb6, 0x8f, 0x6a, 0x7c, 0x3b, 0xa8, 0x44, 0xe2, 0xb8, 0x48, 0xef,
-	0x8a, 0xdb, 0x38, 0xdb, 0x48, 0xb6, 0xbd, 0x44, 0xb2, 0x95, 0xec, 0x12, 0xf6, 0xa8, 0x10, 0x37,
-	0x28, 0xbc, 0x66, 0x25, 0x9d, 0x61, 0x9e, 0xa3, 0xcc, 0xc7, 0xc6, 0x38, 0xaa, 0xf1, 0xd2, 0x51,
-	0x05, 0x3d, 0xc4, 0xf2, 0x36, 0x12, 0xc1, 0x83, 0xd7, 0x7a, 0x24, 0xe8, 0x67, 0x63, 0x54, 0x41,
-	0x85, 0x23, 0x3b, 0x87, 0xce, 0x04, 0x13, 0xcc, 0xe3, 0xdc, 0x6b, 0x53, 0xcc, 0xb3, 0x4a, 0xcc,
-	0x1b, 0x6d, 0x1b, 0xd5, 0x78, 0xe1, 0xc6, 0xae, 0xa1, 0x57, 0x1c, 0x39, 0x4e, 0xaf, 0x97, 0x18,
-	0x7a, 0x7b, 0x14, 0xf8, 0xd1, 0xa3, 0x37, 0xd4, 0x2e, 0x54, 0xf6, 0x8a, 0x86, 0x9d, 0x83, 0x4b,
-	0x79, 0x7f, 0x1f, 0x27, 0xd2, 0x73, 0x09, 0xe1, 0xc8, 0x2e, 0x92, 0xd2, 0x8f, 0x6a, 0x7c, 0xed,
-	0x54, 0x46, 0x5c, 0xcd, 0x45, 0xe2, 0xc1, 0x76, 0x84, 0xd2, 0x97, 0x11, 0x4a, 0x60, 0x3d, 0x68,
-	0x8c, 0x57, 0x5e, 0x67, 0x50, 0x3f, 0x6d, 0xf1, 0xc6, 0x78, 0x75, 0xd5, 0x81, 0xd6, 0x22, 0x98,
-	0xce, 0xd1, 0xff, 0xa3, 0x0e, 0xbd, 0x2a, 0x31, 0x18, 0x83, 0x66, 0x12, 0xcc, 0x34, 0x7b, 0x5c,
-	0x4e, 0xdf, 0xec, 0x18, 0xda, 0xf9, 0x6a, 0xf6, 0x21, 0x9d, 0x12, 0x1f, 0x5c, 0x6e, 0x24, 0xe6,
-	0xc3, 0x7e, 0x9c, 0x48, 0x91, 0x46, 0x73, 0xe2, 0x20, 0xf5, 0xd8, 0xe5, 0x15, 0x1d, 0x7b, 0x06,
-	0x2d, 0x99, 0xca, 0x60, 0x4a, 0xfd, 0x73, 0xb8, 0x16, 0x94, 0x36, 0x13, 0x71, 0x88, 0xd4, 0x20,
-	0x87, 0x6b, 0x41, 0x69, 0xd3, 0x87, 0x04, 0x05, 0xb5, 0xc0, 0xe5, 0x5a, 0x60, 0x27, 0xb0, 0x17,
-	0x06, 0x12, 0x27, 0xa9, 0x28, 0x72, 0x28, 0x65, 0x7f, 0x08, 0xfd, 0x2d, 0x52, 0x5a, 0xd7, 0xad,
-	0x57, 0xae, 0x5b, 0xc2, 0x37, 0x2c, 0xf8, 0x12, 0xa2, 0x42, 0xbc, 0xa7, 0x41, 0x7c, 0x09, 0x6e,
-	0xd9, 0xab, 0x9d, 0xa1, 0xc7, 0xd0, 0x0e, 0x66, 0x6a, 0x80, 0x29, 0xd6, 0xe1, 0x46, 0x2a, 0x83,
-	0xa9, 0x53, 0x4f, 0x0d, 0xfe, 0xab, 0x0e, 0x2d, 0x8a, 0xfe, 0x17, 0xf6, 0xcd, 0x83, 0x4e, 0xa8,
-	0xaa, 0x99, 0x0a, 0x6a, 0x9b, 0xcb, 0x0b, 0x91, 0xee, 0x25, 0x03, 0x39, 0xcf, 0x69, 0x64, 0x5a,
-	0xdc, 0x48, 0x95, 0x4e, 0xbb, 0x1b, 0x9d, 0x1e, 0xc3, 0x3e, 0xc7, 0x10, 0xe3, 0x4c, 0xea, 0x7c,
-	0x9f, 0xd4, 0x21, 0xeb, 0x44, 0xc7, 0x3e, 0xd1, 0xff, 0x05, 0x98, 0x8d, 0x3a, 0xa4, 0xaa, 0xb2,
-	0x01, 0x34, 0x33, 0x81, 0x0b, 0xb3, 0x41, 0xf7, 0x2b, 0x3b, 0x8b, 0x2c, 0xec, 0x63, 0xe8, 0x84,
-	0x73, 0x21, 0xd0, 0x34, 0x64, 0xd3, 0xa9, 0x30, 0xfa, 0xbf, 0x35, 0x01, 0xde, 0xa5, 0x61, 0x30,
-	0xfd, 0xef, 0x34, 0xe9, 0x15, 0x1c, 0x90, 0x0b, 0x46, 0x23, 0x8c, 0x27, 0xb7, 0x7a, 0x69, 0x39,
-	0xbc, 0xaa, 0x64, 0x03, 0xe8, 0x1a, 0xc5, 0x38, 0x9e, 0x21, 0xad, 0x29, 0x87, 0xdb, 0x2a, 0x76,
-	0x0e, 0xff, 0xcb, 0x04, 0x66, 0x41, 0xf9, 0x93, 0xa4, 0xd1, 0xba, 0xe4, 0xf9, 0x98, 0x89, 0x7d,
-	0x0a, 0xfd, 0x8a, 0x9a, 0x90, 0xf7, 0xc9, 0x7f, 0xdb, 0xc0, 0x5e, 0x80, 0x9b, 0x09, 0x0c, 0xe3,
-	0x5c, 0x15, 0xef, 0x80, 0x52, 0x58, 0x2b, 0xd8, 0x19, 0x30, 0x2a, 0x56, 0xb9, 0x9f, 0xe3, 0x19,
-	0xe6, 0x5e, 0x8f, 0xc0, 0x1e, 0xb1, 0xa8, 0xac, 0x05, 0x2d, 0x88, 0x22, 0xeb, 0x43, 0x9d, 0x75,
-	0x45, 0xa9, 0xb2, 0x36, 0x0a, 0xba, 0xdb, 0x91, 0xce, 0xda, 0x52, 0x55, 0x28, 0xde, 0xdf, 0xa0,
-	0xf8, 0x1c, 0x5c, 0xe2, 0xca, 0xbb, 0x74, 0x92, 0xef, 0xe4, 0xb7, 0x07, 0x1d, 0xb9, 0x7c, 0x9b,
-	0x44, 0xb8, 0x34, 0x7c, 0x29, 0x44, 0xf6, 0x12, 0x40, 0x3f, 0x18, 0xc6, 0xab, 0x0c, 0x0d, 0xcf,
-	0x2d, 0x8d, 0x42, 0x94, 0xcb, 0x51, 0x90, 0xdf, 0x12, 0x5b, 0x5c, 0x6e, 0x24, 0xff, 0x01, 0x5c,
-	0x8e, 0xf7, 0x44, 0x50, 0x1a, 0xc1, 0xfb, 0x39, 0x8a, 0xd5, 0x70, 0xaa, 0x0f, 0xde, 0xe3, 0xa5,
-	0x6c, 0x31, 0xa2, 0x51, 0x61, 0x84, 0x02, 0xa6, 0x68, 0xcf, 0x19, 0x38, 0x04, 0xac, 0xb1, 0x5e,
-	0x02, 0xe8, 0x4b, 0xff, 0x90, 0x4c, 0x57, 0x74, 0xe8, 0x1e, 0xb7, 0x34, 0xfe, 0x17, 0xd0, 0xe5,
-	0x98, 0x4d, 0x57, 0xe6, 0xe8, 0x4f, 0x4a, 0x98, 0xfa, 0xc0, 0x39, 0xed, 0x5e, 0xf4, 0xcd, 0x48,
-	0xad, 0xe7, 0xa7, 0x40, 0xf6, 0x3f, 0x33, 0x3b, 0x93, 0x63, 0xb8, 0xd0, 0x43, 0x70, 0x87, 0x89,
-	0x29, 0x94, 0x16, 0xd4, 0xa8, 0x09, 0x0c, 0x17, 0x66, 0x5f, 0xd2, 0xb7, 0xff, 0x2d, 0x1c, 0xd3,
-	0x81, 0xc3, 0x28, 0x12, 0x2a, 0xf4, 0x75, 0x2a, 0xcc, 0xd9, 0xe7, 0x00, 0xb2, 0x00, 0x2c, 0xce,
-	0x3f, 0xaa, 0xbe, 0x55, 0xc2, 0x05, 0xb7, 0x7c, 0xfc, 0x18, 0x0e, 0x8b, 0xaa, 0x5d, 0x05, 0xd3,
-	0x20, 0x09, 0x89, 0x71, 0x41, 0x14, 0x09, 0xcc, 0x73, 0xd4, 0x18, 0x2e, 0x5f, 0x2b, 0x14, 0x37,
-	0x28, 0xfc, 0x27, 0x7b, 0xd8, 0x6d, 0x95, 0xaa, 0x23, 0x2e, 0x31, 0x44, 0x61, 0x66, 0xdd, 0x48,
-	0xfe, 0x5b, 0x78, 0xce, 0xf1, 0x7e, 0xa8, 0x9f, 0x7f, 0x7a, 0x4f, 0xd1, 0xdb, 0x42, 0x71, 0xc1,
-	0xe0, 0x9b, 0xdc, 0x0b, 0xd1, 0x82, 0x6a, 0x54, 0xa0, 0xde, 0x03, 0xac, 0x01, 0x76, 0x72, 0xec,
-	0x14, 0x3a, 0xe6, 0xb1, 0x69, 0xb6, 0x5b, 0xaf, 0x78, 0xd3, 0x68, 0x2d, 0x2f, 0xcc, 0xfe, 0x7b,
-	0xf8, 0xbf, 0xae, 0xe8, 0xf6, 0xe5, 0x2e, 0x4d, 0xbe, 0x5a, 0xdc, 0xe8, 0xe9, 0xda, 0x91, 0xdb,
-	0x5e, 0xfe, 0xaf, 0x75, 0x38, 0x50, 0xb9, 0x46, 0x51, 0xd1, 0x19, 0x06, 0x4d, 0x95, 0x54, 0xb1,
-	0x32, 0xd5, 0xf7, 0x4e, 0x22, 0x96, 0x4c, 0xd0, 0x3c, 0x34, 0x4c, 0x78, 0x01, 0x6e, 0x14, 0x0b,
-	0xd4, 0x5b, 0xb4, 0xa9, 0x17, 0x41, 0xa9, 0x50, 0x31, 0x3a, 0xd3, 0x16, 0x59, 0xb4, 0xa0, 0x2a,
-	0x7b, 0x23, 0xd2, 0xd9, 0x77, 0xb8, 0x32, 0xeb, 0xb2, 0x10, 0xfd, 0xdf, 0xeb, 0x00, 0x45, 0xe3,
-	0xc7, 0xcb, 0x9d, 0x25, 0x64, 0xd0, 0xbc, 0x99, 0x06, 0x13, 0x73, 0x41, 0xfa, 0x5e, 0x1f, 0xe5,
-	0xd8, 0x47, 0xfd, 0xf3, 0xf5, 0x8e, 0xa1, 0x7d, 0xab, 0x17, 0x8e, 0x5e, 0xe6, 0x46, 0x52, 0x58,
-	0x31, 0x2d, 0x81, 0xb6, 0xde, 0xf1, 0x24, 0x94, 0xc5, 0xea, 0xac, 0x8b, 0xe5, 0x7f, 0x0e, 0xbd,
-	0xf5, 0x94, 0xd1, 0x6a, 0x79, 0x05, 0xcd, 0x69, 0x3a, 0xd9, 0xa4, 0x79, 0xb9, 0x7a, 0x38, 0x59,
-	0x2f, 0xae, 0x4d, 0x31, 0xd9, 0x57, 0x70, 0xf8, 0x06, 0x65, 0x85, 0xe9, 0xc7, 0x26, 0x66, 0x63,
-	0x02, 0x4e, 0x0e, 0xab, 0x3c, 0xc9, 0xfd, 0xda, 0x87, 0x36, 0xfd, 0x5d, 0xb9, 0xfc, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xe2, 0xae, 0x58, 0x34, 0xe6, 0x0c, 0x00, 0x00,
+	// 1515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x6d, 0x6b, 0x1b, 0x47,
+	0x10, 0x96, 0x7c, 0x92, 0xa5, 0x1b, 0x5b, 0xb2, 0xb5, 0x49, 0xd4, 0xc3, 0x4d, 0x83, 0x39, 0x42,
+	0x48, 0x4a, 0xeb, 0x9a, 0x98, 0x84, 0x86, 0x04, 0x8a, 0x52, 0xe2, 0x28, 0x6d, 0x5e, 0xca, 0x56,
+	0x21, 0x85, 0x96, 0xc2, 0xe5, 0xb4, 0xb6, 0x8f, 0xc8, 0x77, 0x97, 0xbd, 0x93, 0x6c, 0xa5, 0xdf,
+	0xfb, 0x37, 0xfa, 0x0f, 0xfa, 0x13, 0xfa, 0xa1, 0x7f, 0xac, 0x65, 0x67, 0x5f, 0xb4, 0xab, 0x17,
+	0x83, 0x21, 0x1f, 0x4a, 0xbf, 0x69, 0xde, 0x9e, 0xd9, 0x99, 0x9d, 0x99, 0x9d, 0x13, 0x6c, 0x94,
+	0xd9, 0x3b, 0x96, 0xee, 0xe5, 0x3c, 0x2b, 0x33, 0x52, 0x2f, 0xa7, 0x39, 0x2b, 0x76, 0x3a, 0x25,
+	0x8f, 0xd2, 0x22, 0x8a, 0xcb, 0x24, 0x53, 0x92, 0x9d, 0x56, 0x14, 0xc7, 0xd9, 0x38, 0x2d, 0x25,
+	0x19, 0xfe, 0xde, 0x84, 0x8d, 0x81, 0x30, 0xec, 0xa1, 0x12, 0xf9, 0x06, 0xda, 0x88, 0xf3, 0x03,
+	0x67, 0xdf, 0x72, 0x16, 0x95, 0x2c, 0xa8, 0xee, 0x56, 0x6f, 0x6f, 0xdc, 0xbd, 0xb6, 0x87, 0x88,
+	0x7b, 0x03, 0x47, 0xd8, 0xaf, 0xd0, 0x39, 0x75, 0xd2, 0x87, 0x0e, 0x72, 0x0e, 0x93, 0x34, 0x29,
+	0x4e, 0x14, 0xc6, 0x1a, 0x62, 0x04, 0x36, 0x86, 0x2d, 0xef, 0x57, 0xe8, 0xa2, 0x91, 0x41, 0xa2,
+	0x6c, 0x92, 0xbd, 0xd3, 0xa7, 0xf1, 0x16, 0x91, 0x6c, 0xb9, 0x41, 0xb2, 0x99, 0xe4, 0x00, 0x9a,
+	0x98, 0x88, 0x23, 0xc6, 0x83, 0x9a, 0x13, 0x4e, 0xaf, 0x28, 0x58, 0x59, 0x0c, 0x94, 0xb0, 0x5f,
+	0xa1, 0x46, 0x51, 0x18, 0x9d, 0x25, 0xe5, 0xc9, 0x90, 0x47, 0x67, 0x41, 0x7d, 0x89, 0xd1, 0x1b,
+	0x25, 0x14, 0x46, 0x5a, 0x91, 0xec, 0x43, 0xe3, 0x98, 0xa5, 0xac, 0x48, 0x8a, 0x60, 0x1d, 0x6d,
+	0xae, 0x3a, 0x36, 0x4f, 0xa5, 0xac, 0x5f, 0xa1, 0x5a, 0x8d, 0x3c, 0x81, 0xb6, 0x76, 0x39, 0xc8,
+	0x9e, 0x9c, 0xb3, 0x38, 0x68, 0xa2, 0xe1, 0xa7, 0x4b, 0x4f, 0x28, 0x55, 0x30, 0xed, 0x0e, 0x87,
+	0xec, 0x83, 0x8f, 0x71, 0xbf, 0x48, 0xd2, 0x32, 0xf0, 0x11, 0x61, 0xdb, 0x4e, 0x92, 0xe0, 0xf7,
+	0x2b, 0x74, 0xa6, 0x64, 0x2c, 0x1e, 0x8f, 0x79, 0x1a, 0xc0, 0xa2, 0x85, 0xe0, 0x1b, 0x0b, 0x41,
+	0x90, 0x07, 0xb0, 0x89, 0x44, 0x2f, 0xcf, 0x79, 0x36, 0x61, 0xc1, 0x06, 0x1a, 0x5d, 0xb1, 0x8d,
+	0x94, 0xa8, 0x5f, 0xa1, 0x8e, 0xaa, 0xb9, 0x4b, 0x1d, 0xc7, 0x21, 0xcf, 0x4e, 0x83, 0xcd, 0xc5,
+	0xbb, 0xb4, 0xe5, 0xe6, 0x2e, 0x6d, 0x26, 0xb9, 0xaf, 0x0a, 0xfd, 0x90, 0x33, 0xf6, 0x81, 0x05,
+	0x2d, 0xc4, 0x20, 0x4e, 0x65, 0xa1, 0xa4, 0x5f, 0xa1, 0xb6, 0x22, 0x79, 0x04, 0x2d, 0x24, 0x5f,
+	0xa7, 0x47, 0xd2, 0xb2, 0xed, 0xdc, 0xcf, 0xc0, 0x96, 0xf5, 0x2b, 0xd4, 0x55, 0x26, 0x07, 0x00,
+	0xb2, 0xce, 0xa3, 0x71, 0xc1, 0x82, 0x2d, 0x34, 0xed, 0x38, 0x2d, 0x21, 0x04, 0xfd, 0x0a, 0xb5,
+	0xd4, 0x4c, 0xbe, 0x5e, 0xa7, 0x39, 0x9a, 0x6d, 0x2f, 0xe6, 0x4b, 0x89, 0x4c, 0xbe, 0x14, 0x4d,
+	0xde, 0x40, 0xd7, 0x09, 0xfd, 0xd5, 0x59, 0xca, 0x78, 0x71, 0x92, 0xe4, 0x41, 0x07, 0x41, 0x3e,
+	0x5b, 0x96, 0x34, 0xa3, 0xd4, 0xaf, 0xd0, 0x15, 0xe6, 0xa4, 0x0d, 0x6b, 0x83, 0x69, 0xd0, 0xd8,
+	0xad, 0xde, 0xae, 0xd3, 0xb5, 0xc1, 0xf4, 0x71, 0x03, 0xea, 0x93, 0x68, 0x34, 0x66, 0xe1, 0x5f,
+	0x55, 0x68, 0xbb, 0xcd, 0x4d, 0x08, 0xd4, 0xd2, 0xe8, 0x54, 0x4e, 0x00, 0x9f, 0xe2, 0x6f, 0xd2,
+	0x85, 0xf5, 0x62, 0x7a, 0xfa, 0x36, 0x1b, 0x61, 0x4f, 0xfb, 0x54, 0x51, 0x24, 0x84, 0xcd, 0x24,
+	0x2d, 0x79, 0x36, 0x1c, 0xe3, 0x1c, 0xc1, 0x3e, 0xf5, 0xa9, 0xc3, 0x23, 0x57, 0xa1, 0x5e, 0x66,
+	0x65, 0x34, 0xc2, 0x1e, 0xf4, 0xa8, 0x24, 0x04, 0x37, 0xe7, 0x49, 0xcc, 0xb0, 0xc9, 0x3c, 0x2a,
+	0x09, 0xc1, 0xcd, 0xc4, 0xa1, 0xb1, 0x8d, 0x7c, 0x2a, 0x09, 0xb2, 0x03, 0xcd, 0x38, 0x2a, 0xd9,
+	0x71, 0xc6, 0x75, 0x0c, 0x86, 0x0e, 0x7b, 0xd0, 0x59, 0x18, 0x2c, 0xd6, 0x71, 0xab, 0xce, 0x71,
+	0x0d, 0xfc, 0x9a, 0x05, 0x6f, 0x20, 0x9c, 0xe1, 0x71, 0x39, 0x88, 0x87, 0xe0, 0x9b, 0x7e, 0x5b,
+	0x69, 0xda, 0x85, 0xf5, 0xe8, 0x54, 0x0c, 0x61, 0xb4, 0xf5, 0xa8, 0xa2, 0x8c, 0x31, 0x76, 0xdb,
+	0x65, 0x8d, 0x7f, 0x82, 0x4d, 0xbb, 0x05, 0x57, 0xda, 0x07, 0xd0, 0x28, 0x72, 0x96, 0x0e, 0xcd,
+	0xc9, 0x35, 0x69, 0x21, 0x7b, 0x0e, 0xf2, 0x6f, 0x2a, 0x2d, 0x4e, 0x1f, 0xae, 0x82, 0x27, 0x50,
+	0x3b, 0x12, 0xcd, 0x2d, 0xb1, 0xf1, 0xb7, 0x28, 0xba, 0x32, 0x53, 0x25, 0xb1, 0x56, 0x66, 0x96,
+	0xa3, 0x9a, 0xed, 0x48, 0xd8, 0xa6, 0x59, 0x29, 0x2b, 0x41, 0x14, 0x5c, 0x56, 0xb2, 0xf0, 0x81,
+	0x7a, 0x9f, 0x54, 0x1b, 0x5f, 0xe0, 0x36, 0x1a, 0x0e, 0x75, 0x48, 0xf8, 0x3b, 0x7c, 0x08, 0x2d,
+	0xa7, 0xad, 0x2f, 0x65, 0x7c, 0x13, 0x60, 0xd6, 0xd8, 0xab, 0x2c, 0xc3, 0x5b, 0x2a, 0xe9, 0xba,
+	0x6f, 0x57, 0xe9, 0x3d, 0x87, 0xee, 0xf2, 0x56, 0x5d, 0x79, 0xa6, 0x1d, 0x68, 0xa6, 0xec, 0xec,
+	0x95, 0x55, 0x61, 0x86, 0x0e, 0xff, 0xa9, 0x42, 0x1d, 0xe1, 0xfe, 0x83, 0x2d, 0x1a, 0x40, 0x23,
+	0x16, 0x8d, 0x93, 0x71, 0xec, 0x50, 0x9f, 0x6a, 0x12, 0xcf, 0x55, 0x46, 0xe5, 0xb8, 0xc0, 0x17,
+	0xae, 0x4e, 0x15, 0xe5, 0x34, 0xb5, 0xef, 0x36, 0xb5, 0xb0, 0xc1, 0xc4, 0x0e, 0xf1, 0x85, 0x6a,
+	0x52, 0x45, 0x85, 0xb9, 0x1a, 0x56, 0xbd, 0xd1, 0x28, 0x3b, 0x8b, 0xd2, 0xf8, 0x92, 0x6d, 0x6a,
+	0x37, 0x81, 0xb7, 0xaa, 0x09, 0x9c, 0xda, 0x0c, 0x5f, 0xc3, 0x96, 0xf4, 0x38, 0x1c, 0xf2, 0xcb,
+	0xd7, 0xa2, 0xd0, 0x3d, 0xe2, 0xd9, 0x07, 0x26, 0xd3, 0xde, 0xa4, 0x8a, 0x0a, 0x07, 0xb0, 0x49,
+	0x59, 0xcc, 0x92, 0xbc, 0x94, 0x17, 0x7a, 0xb9, 0x30, 0x66, 0x29, 0xf5, 0xec, 0x94, 0x86, 0xbf,
+	0x02, 0xb1, 0x51, 0x7b, 0xb2, 0xbd, 0x76, 0xa1, 0x96, 0x73, 0x36, 0x51, 0x1b, 0xdd, 0xa6, 0xb3,
+	0x43, 0xa1, 0x84, 0xdc, 0x82, 0x46, 0x3c, 0xe6, 0x9c, 0xa9, 0xe1, 0x32, 0xaf, 0xa4, 0x85, 0x61,
+	0x01, 0xd7, 0x1c, 0x7c, 0x73, 0x0b, 0x77, 0x1c, 0x17, 0xce, 0xd2, 0x68, 0x94, 0x94, 0xaf, 0xaf,
+	0xe6, 0x7d, 0xad, 0xd0, 0x36, 0x4e, 0xb9, 0x1b, 0x94, 0xba, 0x84, 0xcf, 0x1d, 0x8f, 0x5d, 0x07,
+	0xc3, 0x5c, 0x95, 0x72, 0xb9, 0x3f, 0xef, 0x72, 0x95, 0xba, 0xf1, 0xf9, 0x77, 0x0d, 0xe0, 0x79,
+	0x16, 0x47, 0xa3, 0xff, 0x4f, 0xbb, 0xdd, 0x84, 0x16, 0xaa, 0xb0, 0x61, 0x9f, 0x25, 0xc7, 0x27,
+	0x72, 0x5b, 0xf4, 0xa8, 0xcb, 0x24, 0xbb, 0xb0, 0xa1, 0x18, 0x83, 0xe4, 0x94, 0x61, 0xf7, 0x79,
+	0xd4, 0x66, 0x91, 0x7d, 0xb8, 0x92, 0x73, 0x96, 0x47, 0xe6, 0x5b, 0x40, 0xa2, 0x6d, 0xa0, 0xe6,
+	0x32, 0x11, 0xf9, 0x02, 0x3a, 0x0e, 0x1b, 0x91, 0x37, 0x51, 0x7f, 0x51, 0x40, 0xae, 0x83, 0x9f,
+	0x73, 0x16, 0x27, 0x85, 0x48, 0x5e, 0x0b, 0x43, 0x98, 0x31, 0xc8, 0x1e, 0x10, 0x4c, 0x96, 0x59,
+	0x8c, 0x93, 0x53, 0x56, 0xe0, 0x4e, 0xe7, 0xd1, 0x25, 0x12, 0x11, 0x35, 0xc7, 0x57, 0x5d, 0x47,
+	0xbd, 0x25, 0xa3, 0x76, 0x98, 0x22, 0x6a, 0xc5, 0xc0, 0xb3, 0x6d, 0xcb, 0xa8, 0x2d, 0x96, 0x33,
+	0xac, 0x3a, 0x2b, 0x87, 0x15, 0x71, 0x86, 0xd5, 0x18, 0x7c, 0xac, 0xa1, 0xe7, 0xd9, 0x71, 0x71,
+	0xd1, 0xb3, 0x5c, 0x9e, 0x3f, 0x4b, 0x87, 0xec, 0x5c, 0x3f, 0xcb, 0x8a, 0x24, 0x37, 0x00, 0xe4,
+	0x17, 0xdc, 0x60, 0x9a, 0x33, 0xd5, 0xe8, 0x16, 0x47, 0x20, 0x96, 0xe7, 0xfd, 0xa8, 0x38, 0xc1,
+	0x2a, 0xf2, 0xa9, 0xa2, 0xc2, 0x33, 0xf0, 0x29, 0x7b, 0x8f, 0x85, 0x8b, 0x43, 0xf6, 0xfd, 0x98,
+	0xf1, 0x69, 0x6f, 0x24, 0x1d, 0x37, 0xa9, 0xa1, 0xad, 0x4a, 0x59, 0x73, 0x2a, 0x45, 0x00, 0xa3,
+	0x75, 0xe0, 0xed, 0x7a, 0x08, 0x2c, 0xb1, 0x6e, 0x00, 0xc8, 0x43, 0xbf, 0x4a, 0x47, 0x53, 0x74,
+	0xda, 0xa4, 0x16, 0x27, 0xfc, 0x1a, 0x36, 0x28, 0xcb, 0x47, 0x53, 0xe5, 0xfa, 0x8e, 0x81, 0xa9,
+	0xee, 0x7a, 0xd6, 0xde, 0x3c, 0xeb, 0x2b, 0x8d, 0x1c, 0xde, 0x53, 0x0b, 0x10, 0x65, 0xf1, 0x44,
+	0x36, 0xc7, 0x3b, 0x96, 0xaa, 0x44, 0xd5, 0x4b, 0xdd, 0x82, 0x9c, 0xc5, 0x13, 0xb5, 0xfc, 0xe0,
+	0xef, 0xf0, 0x3b, 0xe8, 0xa2, 0x43, 0xd1, 0xc1, 0xc2, 0xf4, 0x30, 0xe3, 0xca, 0xf7, 0xbe, 0xda,
+	0xdb, 0x05, 0x57, 0xfb, 0xdf, 0x76, 0x3f, 0x1e, 0xe3, 0x09, 0xb5, 0x74, 0xc2, 0x04, 0xb6, 0x74,
+	0xd6, 0x1e, 0x47, 0x23, 0x1c, 0x6a, 0xd7, 0xc1, 0x17, 0x33, 0x9c, 0x15, 0x05, 0x93, 0x18, 0x3e,
+	0x9d, 0x31, 0x44, 0xcd, 0xa0, 0xf9, 0x8f, 0xf6, 0x10, 0xb0, 0x59, 0x22, 0x8f, 0xec, 0x9c, 0xc5,
+	0xe6, 0xad, 0x51, 0x54, 0xf8, 0x4c, 0x4c, 0xd1, 0xf7, 0x3d, 0xf9, 0x3d, 0x2e, 0x47, 0x10, 0x7e,
+	0xec, 0x89, 0x5a, 0x50, 0xf8, 0x2a, 0x76, 0x4d, 0x5a, 0x50, 0x6b, 0x0e, 0xd4, 0x4b, 0x80, 0x19,
+	0xc0, 0xca, 0x1a, 0xbb, 0x0d, 0x0d, 0xf5, 0xf5, 0xaf, 0xe6, 0x5f, 0x5b, 0x7f, 0x64, 0x4a, 0x2e,
+	0xd5, 0xe2, 0xf0, 0x25, 0x7c, 0x22, 0x33, 0xba, 0x78, 0xb8, 0x03, 0x15, 0xaf, 0x24, 0xe7, 0xee,
+	0x74, 0xa6, 0x48, 0x6d, 0xad, 0xf0, 0x8f, 0x2a, 0xb4, 0x44, 0xac, 0xc3, 0xa1, 0xbe, 0x19, 0xfd,
+	0x48, 0x56, 0xdd, 0x47, 0x72, 0x69, 0x21, 0x9a, 0x4a, 0x90, 0x75, 0x28, 0x09, 0x71, 0x2d, 0xc3,
+	0x84, 0x33, 0x39, 0x5d, 0x6b, 0x72, 0x40, 0x18, 0x86, 0xb0, 0x91, 0x91, 0xd6, 0x51, 0x22, 0x09,
+	0x91, 0x59, 0xb1, 0x91, 0x7e, 0xcf, 0xa6, 0x6a, 0x8c, 0x6a, 0x32, 0xfc, 0xb3, 0x0a, 0xa0, 0x2f,
+	0x7e, 0x70, 0x7e, 0xe1, 0x7a, 0x3b, 0x8a, 0x8e, 0xd5, 0x01, 0xf1, 0xf7, 0xcc, 0x95, 0x67, 0xbb,
+	0xba, 0xf8, 0x78, 0x5d, 0x58, 0x3f, 0x91, 0x83, 0x48, 0x0e, 0x79, 0x45, 0x09, 0xac, 0x04, 0x87,
+	0xc0, 0x3a, 0xb2, 0x25, 0x61, 0x92, 0xd5, 0xb0, 0x16, 0xd4, 0xfb, 0xd0, 0x9e, 0x75, 0x19, 0x8e,
+	0x96, 0x9b, 0x50, 0x1b, 0x65, 0xc7, 0xf3, 0x65, 0x6e, 0x46, 0x0f, 0x45, 0x69, 0xf8, 0x33, 0x74,
+	0x74, 0x9c, 0x1f, 0x7d, 0x7b, 0x0a, 0x7f, 0x01, 0xb2, 0x00, 0x5e, 0x7c, 0x34, 0xf4, 0x17, 0x70,
+	0x75, 0x16, 0xb2, 0x85, 0x7f, 0x0f, 0x20, 0x32, 0x94, 0x0a, 0x7f, 0xc5, 0x36, 0x61, 0x29, 0x86,
+	0x5f, 0xc2, 0xb5, 0x19, 0xdc, 0x21, 0xee, 0x63, 0xa2, 0x40, 0xb1, 0xde, 0x44, 0x8a, 0x75, 0xb3,
+	0x4b, 0xe2, 0xee, 0x13, 0x55, 0x85, 0xe4, 0x11, 0x6c, 0x3d, 0x65, 0xa5, 0x33, 0x22, 0xf4, 0x22,
+	0x31, 0x37, 0x3a, 0x76, 0xb6, 0xdc, 0x06, 0x2b, 0xc2, 0xca, 0xdb, 0x75, 0xfc, 0xe3, 0xed, 0xe0,
+	0xdf, 0x01, 0x00, 0xa8, 0xb4, 0xb0, 0xdc, 0xb0, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,6 +24,7 @@ func init() {
 	types.RegisterDappFork(TokenX, ForkTokenPriceX, 560000)
 	types.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 1298600)
 	types.RegisterDappFork(TokenX, ForkTokenCheckX, 1600000)
+	types.RegisterDappFork(TokenX, ForkTokenAdminX, types.MaxHeight)
 }
 
 // TokenType 执行器基类结构体
@@ -51,15 +52,22 @@ func (t *TokenType) GetPayload() types.Message {
 // GetTypeMap 根据action的name获取type
 func (t *TokenType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Transfer":          ActionTransfer,
-		"Genesis":           ActionGenesis,
-		"Withdraw":          ActionWithdraw,
-		"TokenPreCreate":    TokenActionPreCreate,
-		"TokenFinishCreate": TokenActionFinishCreate,
-		"TokenRevokeCreate": TokenActionRevokeCreate,
-		"TransferToExec":    TokenActionTransferToExec,
-		"TokenMint":         TokenActionMint,
-		"TokenBurn":         TokenActionBurn,
+		"Transfer":               ActionTransfer,
+		"Genesis":                ActionGenesis,
+		"Withdraw":               ActionWithdraw,
+		"TokenPreCreate":         TokenActionPreCreate,
+		"TokenFinishCreate":      TokenActionFinishCreate,
+		"TokenRevokeCreate":      TokenActionRevokeCreate,
+		"TransferToExec":         TokenActionTransferToExec,
+		"TokenMint":              TokenActionMint,
+		"TokenBurn":              TokenActionBurn,
+		"TokenApprove":           TokenActionApprove,
+		"TokenTransferFrom":      TokenActionTransferFrom,
+		"TokenFreeze":            TokenActionFreeze,
+		"TokenUnfreeze":          TokenActionUnfreeze,
+		"TokenPause":             TokenActionPause,
+		"TokenUnpause":           TokenActionUnpause,
+		"TokenTransferOwnership": TokenActionTransferOwnership,
 	}
 }
 
@@ -80,6 +88,10 @@ func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
 		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
 		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenAllowance:       {Ty: reflect.TypeOf(ReceiptTokenAllowance{}), Name: "LogTokenAllowance"},
+		TyLogTokenFreeze:          {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogTokenFreeze"},
+		TyLogTokenPause:           {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenPause"},
+		TyLogTokenOwnership:       {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogTokenOwnership"},
 	}
 }
 
@@ -43,6 +43,13 @@ func TradeCmd() *cobra.Command {
 		ShowTokenBuyOrdersStatusCmd(),
 
 		ShowOnesOrdersStatusCmd(),
+		ShowPairOrdersCmd(),
+
+		CreateRawLimitOrderTxCmd(),
+		CreateRawRevokeLimitOrderTxCmd(),
+		ShowLimitOrderDepthCmd(),
+		ShowLimitOrderFillsCmd(),
+		ShowOnesLimitOrdersCmd(),
 	)
 
 	return cmd
@@ -199,6 +206,9 @@ func parseSellOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			AssetExec:      o.AssetExec,
+			PriceExec:      o.PriceExec,
+			PriceSymbol:    o.PriceSymbol,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
@@ -355,6 +365,9 @@ func parseBuyOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			AssetExec:      o.AssetExec,
+			PriceExec:      o.PriceExec,
+			PriceSymbol:    o.PriceSymbol,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
@@ -429,6 +442,9 @@ func parseTradeOrders(arg interface{}) (interface{}, error) {
 			MinBoardlot:    o.MinBoardlot,
 			TotalBoardlot:  o.TotalBoardlot,
 			TradedBoardlot: o.TradedBoardlot,
+			AssetExec:      o.AssetExec,
+			PriceExec:      o.PriceExec,
+			PriceSymbol:    o.PriceSymbol,
 		}
 		order.AmountPerBoardlot = strconv.FormatFloat(float64(o.AmountPerBoardlot)/float64(types.Coin), 'f', 4, 64)
 		order.PricePerBoardlot = strconv.FormatFloat(float64(o.PricePerBoardlot)/float64(types.Coin), 'f', 4, 64)
@@ -464,11 +480,20 @@ func addTokenSellFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("total", "t", 0, "total tokens to be sold")
 	cmd.MarkFlagRequired("total")
+
+	addPriceAssetFlags(cmd)
+}
+
+func addPriceAssetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("price_exec", "", "", "exec of the asset used to price, default coins")
+	cmd.Flags().StringP("price_symbol", "", "", "symbol of the asset used to price, default coins")
 }
 
 func tokenSell(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	symbol, _ := cmd.Flags().GetString("symbol")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
 	min, _ := cmd.Flags().GetInt64("min")
 	price, _ := cmd.Flags().GetFloat64("price")
 	fee, _ := cmd.Flags().GetFloat64("fee")
@@ -485,6 +510,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 		TotalBoardlot:     totalInt64,
 		Fee:               feeInt64 * 1e4,
 		AssetExec:         "token",
+		PriceExec:         priceExec,
+		PriceSymbol:       priceSymbol,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeSellTx", params, nil)
@@ -587,11 +614,15 @@ func addTokenBuyLimitFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("total", "t", 0, "total tokens to buy")
 	cmd.MarkFlagRequired("total")
+
+	addPriceAssetFlags(cmd)
 }
 
 func tokenBuyLimit(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	symbol, _ := cmd.Flags().GetString("symbol")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
 	min, _ := cmd.Flags().GetInt64("min")
 	price, _ := cmd.Flags().GetFloat64("price")
 	fee, _ := cmd.Flags().GetFloat64("fee")
@@ -608,6 +639,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 		TotalBoardlot:     totalInt64,
 		Fee:               feeInt64 * 1e4,
 		AssetExec:         "token",
+		PriceExec:         priceExec,
+		PriceSymbol:       priceSymbol,
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeBuyLimitTx", params, nil)
@@ -682,3 +715,263 @@ func buyRevoke(cmd *cobra.Command, args []string) {
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeRevokeBuyTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+/************* limit order book *************/
+
+// CreateRawLimitOrderTxCmd : create raw limit order transaction, matched with the order book
+func CreateRawLimitOrderTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit_order",
+		Short: "Create a limit order transaction matched against the order book",
+		Run:   limitOrder,
+	}
+	addLimitOrderFlags(cmd)
+	return cmd
+}
+
+func addLimitOrderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("exec", "e", "token", "asset executor")
+
+	cmd.Flags().BoolP("sell", "", false, "sell order, buy order if not set")
+
+	cmd.Flags().Float64P("price", "p", 0, "price per token")
+	cmd.MarkFlagRequired("price")
+
+	cmd.Flags().Float64P("amount", "a", 0, "amount of tokens")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee")
+}
+
+func limitOrder(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	exec, _ := cmd.Flags().GetString("exec")
+	isSell, _ := cmd.Flags().GetBool("sell")
+	price, _ := cmd.Flags().GetFloat64("price")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	priceInt64 := int64(price * 1e4)
+	amountInt64 := int64(amount * 1e4)
+	feeInt64 := int64(fee * 1e4)
+	params := &pty.TradeLimitOrderTx{
+		TokenSymbol: symbol,
+		AssetExec:   exec,
+		IsSell:      isSell,
+		Price:       priceInt64 * 1e4,
+		Amount:      amountInt64 * 1e4,
+		Fee:         feeInt64 * 1e4,
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeLimitOrderTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawRevokeLimitOrderTxCmd : create raw revoke limit order transaction
+func CreateRawRevokeLimitOrderTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke_limit",
+		Short: "Create a revoke limit order transaction",
+		Run:   revokeLimitOrder,
+	}
+	addRevokeLimitOrderFlags(cmd)
+	return cmd
+}
+
+func addRevokeLimitOrderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("order_id", "o", "", "order id")
+	cmd.MarkFlagRequired("order_id")
+
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee")
+}
+
+func revokeLimitOrder(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	orderID, _ := cmd.Flags().GetString("order_id")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+
+	feeInt64 := int64(fee * 1e4)
+	params := &pty.TradeRevokeLimitOrderTx{
+		OrderID: orderID,
+		Fee:     feeInt64 * 1e4,
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeRevokeLimitOrderTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// ShowLimitOrderDepthCmd : show depth of the order book
+func ShowLimitOrderDepthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depth",
+		Short: "Show depth of the limit order book",
+		Run:   showLimitOrderDepth,
+	}
+	addShowLimitOrderDepthFlags(cmd)
+	return cmd
+}
+
+func addShowLimitOrderDepthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+	cmd.Flags().StringP("exec", "e", "token", "asset executor")
+	cmd.Flags().Int32P("count", "c", 10, "price levels of each side")
+}
+
+func showLimitOrderDepth(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	exec, _ := cmd.Flags().GetString("exec")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	req := &pty.ReqTradeDepth{TokenSymbol: symbol, AssetExec: exec, Count: count}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetLimitOrderDepth"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeDepth
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ShowLimitOrderFillsCmd : show fills of a token or an address
+func ShowLimitOrderFillsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fills",
+		Short: "Show limit order fills of a token or an address",
+		Run:   showLimitOrderFills,
+	}
+	addShowLimitOrderFillsFlags(cmd)
+	return cmd
+}
+
+func addShowLimitOrderFillsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.Flags().StringP("exec", "e", "token", "asset executor")
+	cmd.Flags().StringP("address", "a", "", "address, show fills of the token if not set")
+	cmd.Flags().Int32P("count", "c", 10, "fills count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction must be 0 (previous-page) or 1 (next-page)")
+	cmd.Flags().StringP("from", "f", "", "start from key")
+}
+
+func showLimitOrderFills(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	exec, _ := cmd.Flags().GetString("exec")
+	addr, _ := cmd.Flags().GetString("address")
+	count, _ := cmd.Flags().GetInt32("count")
+	dir, _ := cmd.Flags().GetInt32("direction")
+	from, _ := cmd.Flags().GetString("from")
+
+	req := &pty.ReqTradeFills{
+		TokenSymbol: symbol,
+		AssetExec:   exec,
+		Addr:        addr,
+		PrimaryKey:  from,
+		Count:       count,
+		Direction:   dir,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetLimitOrderFills"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeFills
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ShowOnesLimitOrdersCmd : show one's limit orders
+func ShowOnesLimitOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit_orders",
+		Short: "Show one's limit orders of the order book",
+		Run:   showOnesLimitOrders,
+	}
+	addShowOnesLimitOrdersFlags(cmd)
+	return cmd
+}
+
+func addShowOnesLimitOrdersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("address", "a", "", "address")
+	cmd.MarkFlagRequired("address")
+	cmd.Flags().Int32P("count", "c", 10, "orders count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction must be 0 (previous-page) or 1 (next-page)")
+	cmd.Flags().StringP("from", "f", "", "start from key")
+}
+
+func showOnesLimitOrders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("address")
+	count, _ := cmd.Flags().GetInt32("count")
+	dir, _ := cmd.Flags().GetInt32("direction")
+	from, _ := cmd.Flags().GetString("from")
+
+	req := &pty.ReqTradeLimitOrders{Addr: addr, PrimaryKey: from, Count: count, Direction: dir}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetOnesLimitOrders"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeLimitOrders
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// ShowPairOrdersCmd : show orders of a trading pair
+func ShowPairOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair_orders",
+		Short: "Show sell or buy orders of a trading pair",
+		Run:   showPairOrders,
+	}
+	addShowPairOrdersFlags(cmd)
+	return cmd
+}
+
+func addShowPairOrdersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("exec", "e", "token", "asset exec")
+	cmd.Flags().StringP("symbol", "s", "", "asset symbol")
+	cmd.MarkFlagRequired("symbol")
+	addPriceAssetFlags(cmd)
+	cmd.Flags().BoolP("buy", "b", false, "show buy orders, default sell orders")
+	cmd.Flags().BoolP("finished", "", false, "show finished orders, default unfinished")
+	cmd.Flags().Int32P("count", "c", 10, "orders count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction must be 0 (previous-page) or 1 (next-page)")
+	cmd.Flags().StringP("from", "f", "", "start from key")
+}
+
+func showPairOrders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	exec, _ := cmd.Flags().GetString("exec")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
+	buy, _ := cmd.Flags().GetBool("buy")
+	finished, _ := cmd.Flags().GetBool("finished")
+	count, _ := cmd.Flags().GetInt32("count")
+	dir, _ := cmd.Flags().GetInt32("direction")
+	from, _ := cmd.Flags().GetString("from")
+
+	req := &pty.ReqPairOrders{
+		AssetExec:   exec,
+		TokenSymbol: symbol,
+		PriceExec:   priceExec,
+		PriceSymbol: priceSymbol,
+		IsSell:      !buy,
+		IsFinished:  finished,
+		FromKey:     from,
+		Count:       count,
+		Direction:   dir,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetPairOrders"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeOrders
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.SetResultCb(parseTradeOrders)
+	ctx.Run()
+}
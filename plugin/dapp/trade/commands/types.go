@@ -20,6 +20,9 @@ type tradeOrderResult struct {
 	Key               string `json:"key"`
 	BlockTime         int64  `json:"blockTime"`
 	IsSellOrder       bool   `json:"isSellOrder"`
+	AssetExec         string `json:"assetExec,omitempty"`
+	PriceExec         string `json:"priceExec,omitempty"`
+	PriceSymbol       string `json:"priceSymbol,omitempty"`
 }
 
 type replySellOrdersResult struct {
@@ -34,6 +34,14 @@ func (t *trade) ExecDelLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
+func (t *trade) ExecDelLocal_LimitOrder(order *pty.TradeForLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localDelLog(tx, receipt, index, 0)
+}
+
+func (t *trade) ExecDelLocal_RevokeLimitOrder(revoke *pty.TradeForRevokeLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localDelLog(tx, receipt, index, 0)
+}
+
 func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, index int, tradedBoardlot int64) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTable(t.GetLocalDB())
@@ -89,6 +97,20 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 			}
 			kv := t.deleteSellMarket(receipt.Base, txIndex, table)
 			set.KV = append(set.KV, kv...)
+		} else if item.Ty == pty.TyLogTradeLimitOrder || item.Ty == pty.TyLogTradeLimitRevoke {
+			var receipt pty.ReceiptTradeLimitOrder
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			set.KV = append(set.KV, genLimitOrderLocalKV(&receipt, true))
+		} else if item.Ty == pty.TyLogTradeLimitFill {
+			var receipt pty.ReceiptTradeLimitFill
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			set.KV = append(set.KV, genLimitFillLocalKV(receipt.Fill, true)...)
 		}
 	}
 	newKvs, err := table.Save()
@@ -97,6 +119,15 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 		return nil, err
 	}
 	set.KV = append(set.KV, newKvs...)
+	//最后恢复这个交易补齐交易对索引之前的数据
+	if types.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradePriceX) {
+		kvs, err := t.DelRollbackKV(tx, tx.Execer)
+		if err != nil {
+			tradelog.Error("trade DelRollbackKV failed", "error", err)
+			return nil, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
 	for _, kv := range set.KV {
 		t.GetLocalDB().Set(kv.Key, kv.Value)
 	}
@@ -34,8 +34,23 @@ func (t *trade) ExecLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Tra
 	return t.localAddLog(tx, receipt, index)
 }
 
+func (t *trade) ExecLocal_LimitOrder(order *pty.TradeForLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localAddLog(tx, receipt, index)
+}
+
+func (t *trade) ExecLocal_RevokeLimitOrder(revoke *pty.TradeForRevokeLimitOrder, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localAddLog(tx, receipt, index)
+}
+
 func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
+	kvs, err := t.reindexPair()
+	if err != nil {
+		tradelog.Error("trade reindexPair failed", "error", err)
+		return nil, err
+	}
+	//补齐的索引和进度记录回滚数据, 区块回滚时恢复
+	set.KV = append(set.KV, t.AddRollbackKV(tx, tx.Execer, kvs)...)
 	table := NewOrderTable(t.GetLocalDB())
 	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
 
@@ -92,6 +107,20 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 			kv := t.saveSellMarket(receipt.Base, tx, txIndex, table)
 			//tradelog.Info("saveSellMarket", "kv", kv)
 			set.KV = append(set.KV, kv...)
+		} else if item.Ty == pty.TyLogTradeLimitOrder || item.Ty == pty.TyLogTradeLimitRevoke {
+			var receipt pty.ReceiptTradeLimitOrder
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			set.KV = append(set.KV, genLimitOrderLocalKV(&receipt, false))
+		} else if item.Ty == pty.TyLogTradeLimitFill {
+			var receipt pty.ReceiptTradeLimitFill
+			err := types.Decode(item.Log, &receipt)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			set.KV = append(set.KV, genLimitFillLocalKV(receipt.Fill, false)...)
 		}
 	}
 	newKvs, err := table.Save()
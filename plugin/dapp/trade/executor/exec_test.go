@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/33cn/chain33/account"
@@ -318,6 +319,107 @@ func TestTrade_Exec_BuyLimit(t *testing.T) {
 	ldb.Close()
 }
 
+func TestTrade_Exec_PriceToken(t *testing.T) {
+	priceSymbol := "PRICE"
+	execAddr := address.ExecAddress("trade")
+	addrA, addrB := string(Nodes[0]), string(Nodes[1])
+	height := types.GetDappFork(pty.TradeX, pty.ForkTradePriceX)
+
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	accToken, _ := account.NewAccountDB(AssetExecPara, Symbol, kvdb)
+	accToken.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 100000})
+	accPrice, _ := account.NewAccountDB(AssetExecToken, priceSymbol, kvdb)
+	accPrice.SaveExecAccount(execAddr, &types.Account{Addr: addrB, Balance: 100000})
+
+	driver := newTrade()
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+	index := 0
+	exec := func(tx *types.Transaction, priv string) (*types.Receipt, error) {
+		tx, err := signTx(tx, priv)
+		assert.Nil(t, err)
+		index++
+		driver.SetEnv(height, 1539918074, 0)
+		receipt, err := driver.Exec(tx, index)
+		if err != nil {
+			return nil, err
+		}
+		_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, index)
+		assert.Nil(t, err)
+		return receipt, nil
+	}
+	pairOrders := func(isSell, isFinished bool) []*pty.ReplyTradeOrder {
+		req := &pty.ReqPairOrders{AssetExec: AssetExecPara, TokenSymbol: Symbol, PriceExec: AssetExecToken,
+			PriceSymbol: priceSymbol, IsSell: isSell, IsFinished: isFinished, Count: 10}
+		reply, err := driver.Query("GetPairOrders", types.Encode(req))
+		if err == types.ErrNotFound {
+			return nil
+		}
+		assert.Nil(t, err)
+		return reply.(*pty.ReplyTradeOrders).Orders
+	}
+
+	// 计价资产不能和交易的资产相同
+	tx, _ := pty.CreateRawTradeSellTx(&pty.TradeSellTx{TokenSymbol: Symbol, AmountPerBoardlot: 100, MinBoardlot: 1,
+		PricePerBoardlot: 3, TotalBoardlot: 10, AssetExec: AssetExecToken, PriceExec: AssetExecToken, PriceSymbol: Symbol})
+	_, err := exec(tx, PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 计价资产只能在token和paracross执行器中
+	tx, _ = pty.CreateRawTradeSellTx(&pty.TradeSellTx{TokenSymbol: Symbol, AmountPerBoardlot: 100, MinBoardlot: 1,
+		PricePerBoardlot: 3, TotalBoardlot: 10, AssetExec: AssetExecPara, PriceExec: "evm", PriceSymbol: priceSymbol})
+	_, err = exec(tx, PrivKeyA)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	tx, _ = pty.CreateRawTradeBuyLimitTx(&pty.TradeBuyLimitTx{TokenSymbol: Symbol, AmountPerBoardlot: 100, MinBoardlot: 1,
+		PricePerBoardlot: 5, TotalBoardlot: 10, AssetExec: "evm", PriceExec: AssetExecToken, PriceSymbol: priceSymbol})
+	_, err = exec(tx, PrivKeyB)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 卖单用PRICE计价, 买家支付PRICE
+	tx, _ = pty.CreateRawTradeSellTx(&pty.TradeSellTx{TokenSymbol: Symbol, AmountPerBoardlot: 100, MinBoardlot: 1,
+		PricePerBoardlot: 3, TotalBoardlot: 10, AssetExec: AssetExecPara, PriceExec: AssetExecToken, PriceSymbol: priceSymbol})
+	_, err = exec(tx, PrivKeyA)
+	assert.Nil(t, err)
+	sellID := hex.EncodeToString(tx.Hash())
+	assert.Equal(t, 1, len(pairOrders(true, false)))
+	assert.Equal(t, priceSymbol, pairOrders(true, false)[0].PriceSymbol)
+
+	tx, _ = pty.CreateRawTradeBuyTx(&pty.TradeBuyTx{SellID: sellID, BoardlotCnt: 4})
+	_, err = exec(tx, PrivKeyB)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(400), accToken.LoadExecAccount(addrB, execAddr).Balance)
+	assert.Equal(t, int64(100000-12), accPrice.LoadExecAccount(addrB, execAddr).Balance)
+	assert.Equal(t, int64(12), accPrice.LoadExecAccount(addrA, execAddr).Balance)
+	coins := account.NewCoinsAccount()
+	coins.SetDB(kvdb)
+	assert.Equal(t, int64(0), coins.LoadExecAccount(addrA, execAddr).Balance)
+
+	// 买单冻结PRICE, 撤单后退回
+	tx, _ = pty.CreateRawTradeBuyLimitTx(&pty.TradeBuyLimitTx{TokenSymbol: Symbol, AmountPerBoardlot: 100, MinBoardlot: 1,
+		PricePerBoardlot: 5, TotalBoardlot: 10, AssetExec: AssetExecPara, PriceExec: AssetExecToken, PriceSymbol: priceSymbol})
+	_, err = exec(tx, PrivKeyB)
+	assert.Nil(t, err)
+	buyID := hex.EncodeToString(tx.Hash())
+	assert.Equal(t, int64(50), accPrice.LoadExecAccount(addrB, execAddr).Frozen)
+	assert.Equal(t, 1, len(pairOrders(false, false)))
+
+	tx, _ = pty.CreateRawTradeSellMarketTx(&pty.TradeSellMarketTx{BuyID: buyID, BoardlotCnt: 2})
+	_, err = exec(tx, PrivKeyA)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12+10), accPrice.LoadExecAccount(addrA, execAddr).Balance)
+	assert.Equal(t, int64(600), accToken.LoadExecAccount(addrB, execAddr).Balance)
+
+	tx, _ = pty.CreateRawTradeRevokeBuyTx(&pty.TradeRevokeBuyTx{BuyID: buyID})
+	_, err = exec(tx, PrivKeyB)
+	assert.Nil(t, err)
+	accB := accPrice.LoadExecAccount(addrB, execAddr)
+	assert.Equal(t, int64(0), accB.Frozen)
+	assert.Equal(t, int64(100000-12-10), accB.Balance)
+	assert.Equal(t, 0, len(pairOrders(false, false)))
+	assert.Equal(t, 1, len(pairOrders(false, true)))
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName("trade", signType))
@@ -25,6 +25,15 @@ const (
 	buyIDPrefix    = "mavl-trade-buy-"
 	// Addr-Status-Type-Height-Key
 	orderASTHK = "LODB-trade-order-asthk:"
+
+	// 撮合模式的限价单
+	limitOrderPrefix     = "mavl-trade-order-"
+	limitBookPrefix      = "mavl-trade-book-"
+	limitLevelPrefix     = "mavl-trade-level-"
+	limitNodePrefix      = "mavl-trade-node-"
+	limitOrderAddrPrefix = "LODB-trade-limitorder:"
+	limitFillAssetPrefix = "LODB-trade-fill:"
+	limitFillAddrPrefix  = "LODB-trade-fill-addr:"
 )
 
 // sell order 4 key, 4prefix
@@ -86,6 +95,60 @@ func calcTokenBuyID(hash string) string {
 	return buyIDPrefix + hash
 }
 
+// 撮合模式的限价单, orderID 为交易hash
+func calcLimitOrderKey(orderID string) []byte {
+	return []byte(limitOrderPrefix + orderID)
+}
+
+func limitOrderSide(isSell bool) string {
+	if isSell {
+		return "sell"
+	}
+	return "buy"
+}
+
+// 订单簿一侧最先和最后成交的价格档位
+func calcLimitBookKey(exec, symbol string, isSell bool) []byte {
+	return []byte(fmt.Sprintf(limitBookPrefix+"%s-%s-%s", exec, symbol, limitOrderSide(isSell)))
+}
+
+// 订单簿的一个价格档位
+func calcLimitLevelKey(exec, symbol string, isSell bool, price int64) []byte {
+	return []byte(fmt.Sprintf(limitLevelPrefix+"%s-%s-%s-%d", exec, symbol, limitOrderSide(isSell), price))
+}
+
+// 订单在价格档位队列中的位置
+func calcLimitNodeKey(orderID string) []byte {
+	return []byte(limitNodePrefix + orderID)
+}
+
+// 特定账户下的撮合限价单, 按下单的先后排序
+func calcLimitOrderAddrKey(addr string, heightIndex string) []byte {
+	return []byte(fmt.Sprintf(limitOrderAddrPrefix+"%s:%s", addr, heightIndex))
+}
+
+func calcLimitOrderAddrPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf(limitOrderAddrPrefix+"%s:", addr))
+}
+
+// 交易对的成交记录
+func calcLimitFillAssetKey(exec, symbol string, heightIndex string, seq int32) []byte {
+	return []byte(fmt.Sprintf(limitFillAssetPrefix+"%s:%s:%s:%03d", exec, symbol, heightIndex, seq))
+}
+
+func calcLimitFillAssetPrefix(exec, symbol string) []byte {
+	return []byte(fmt.Sprintf(limitFillAssetPrefix+"%s:%s:", exec, symbol))
+}
+
+// 特定账户的成交记录
+func calcLimitFillAddrKey(addr string, heightIndex string, seq int32) []byte {
+	return []byte(fmt.Sprintf(limitFillAddrPrefix+"%s:%s:%03d", addr, heightIndex, seq))
+}
+
+func calcLimitFillAddrPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf(limitFillAddrPrefix+"%s:", addr))
+}
+
 // buy limit order 4 key, 4prefix
 // 特定状态下的买单
 func calcTokenBuyOrderKey(token string, addr string, status int32, orderID string, height int64) []byte {
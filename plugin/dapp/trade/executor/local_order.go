@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"strconv"
@@ -58,6 +59,7 @@ var opt_order_table = &table.Option{
 		"owner_asset_isFinished",
 		"owner_isFinished",
 		// "owner_statusPrefix", // 状态可以定制组合 , 成交历史需求
+		"pair_isSell_isFinished", // 按交易对列出买单/卖单, 升级前的订单由reindexPair补齐
 	},
 }
 
@@ -118,6 +120,8 @@ func (r *OrderRow) Get(key string) ([]byte, error) {
 		return []byte(fmt.Sprintf("%s_%s_%d", r.Owner, r.asset(), r.isFinished())), nil
 	case "owner_isFinished":
 		return []byte(fmt.Sprintf("%s_%d", r.Owner, r.isFinished())), nil
+	case "pair_isSell_isFinished":
+		return []byte(fmt.Sprintf("%s_%s_%d_%d", r.asset(), r.price(), r.isSell(), r.isFinished())), nil
 	default:
 		return nil, types.ErrNotFound
 	}
@@ -127,6 +131,14 @@ func (r *OrderRow) asset() string {
 	return r.LocalOrder.AssetExec + "." + r.LocalOrder.AssetSymbol
 }
 
+// 计价资产, 没有指定时为coins
+func (r *OrderRow) price() string {
+	if r.LocalOrder.PriceExec == "" {
+		return "coins." + types.GetCoinSymbol()
+	}
+	return r.LocalOrder.PriceExec + "." + r.LocalOrder.PriceSymbol
+}
+
 func (r *OrderRow) isSell() int {
 	if r.IsSellOrder {
 		return 1
@@ -171,6 +183,67 @@ func NewOrderTable(kvdb dbm.KV) *table.Table {
 	return t
 }
 
+// 交易对索引是ForkTradePriceX加入的, 升级之前已经存在的订单在本地数据中没有这个索引,
+// 分叉之后每个trade交易按主键顺序补齐pairReindexBatch个订单, 进度记在pairReindexKey中,
+// 值是最后补齐的订单主键, 全部补齐后是pairReindexDone
+var (
+	pairReindexKey  = []byte("LODB-trade-pair-reindex")
+	pairReindexDone = []byte("done")
+)
+
+const pairReindexBatch = 1000
+
+func (t *trade) reindexPair() ([]*types.KeyValue, error) {
+	if !types.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradePriceX) {
+		return nil, nil
+	}
+	ldb := t.GetLocalDB()
+	primary, err := ldb.Get(pairReindexKey)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if bytes.Equal(primary, pairReindexDone) {
+		return nil, nil
+	}
+	rows, err := NewOrderTable(ldb).ListIndex("primary", nil, primary, pairReindexBatch, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	// 在空的内存数据库上建一个只有交易对索引的关联表, 生成的索引key和订单表的一致
+	memdb, err := dbm.NewGoMemDB("trade-pair", "", 0)
+	if err != nil {
+		return nil, err
+	}
+	defer memdb.Close()
+	rowMeta := NewOrderRow()
+	rowMeta.SetPayload(&pty.LocalOrder{})
+	pair, err := table.NewTable(rowMeta, memdb, &table.Option{
+		Prefix:  opt_order_table.Prefix,
+		Name:    opt_order_table.Name,
+		Primary: opt_order_table.Primary,
+		Join:    true,
+		Index:   []string{"pair_isSell_isFinished"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err := pair.Add(row.Data); err != nil {
+			return nil, err
+		}
+	}
+	kvs, err := pair.Save()
+	if err != nil {
+		return nil, err
+	}
+	next := pairReindexDone
+	if len(rows) == pairReindexBatch {
+		next = rows[len(rows)-1].Primary
+	}
+	tradelog.Info("reindexPair", "height", t.GetHeight(), "orders", len(rows), "done", len(rows) < pairReindexBatch)
+	return append(kvs, &types.KeyValue{Key: pairReindexKey, Value: next}), nil
+}
+
 func (t *trade) genSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 	sellorder *pty.SellOrder, txIndex string) *pty.LocalOrder {
 
@@ -192,6 +265,8 @@ func (t *trade) genSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       true,
 		AssetExec:         sellorder.AssetExec,
+		PriceExec:         sellorder.PriceExec,
+		PriceSymbol:       sellorder.PriceSymbol,
 		IsFinished:        false,
 	}
 	return order
@@ -289,6 +364,8 @@ func (t *trade) genSellMarket(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       true,
 		AssetExec:         sell.AssetExec,
+		PriceExec:         sell.PriceExec,
+		PriceSymbol:       sell.PriceSymbol,
 
 		IsFinished: true,
 	}
@@ -315,6 +392,8 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       false,
 		AssetExec:         buy.AssetExec,
+		PriceExec:         buy.PriceExec,
+		PriceSymbol:       buy.PriceSymbol,
 		IsFinished:        false,
 	}
 	return order
@@ -388,6 +467,8 @@ func (t *trade) genBuyMarket(tx *types.Transaction, buy *pty.ReceiptBuyBase, txI
 		BlockTime:         t.GetBlockTime(),
 		IsSellOrder:       true,
 		AssetExec:         buy.AssetExec,
+		PriceExec:         buy.PriceExec,
+		PriceSymbol:       buy.PriceSymbol,
 		IsFinished:        true,
 	}
 	return order
@@ -3,7 +3,9 @@ package executor
 import (
 	"testing"
 
+	"github.com/33cn/chain33/common/db/table"
 	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 	//"github.com/33cn/chain33/common/db"
 	//"github.com/33cn/chain33/common/db/table"
@@ -64,3 +66,85 @@ func TestListAll(t *testing.T) {
 	t.Log(kvs)
 	ldb.Close()
 }
+
+func TestReindexPair(t *testing.T) {
+	dir, ldb, tdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	// 升级之前的订单表没有交易对索引
+	var index []string
+	for _, name := range opt_order_table.Index {
+		if name != "pair_isSell_isFinished" {
+			index = append(index, name)
+		}
+	}
+	rowMeta := NewOrderRow()
+	rowMeta.SetPayload(&pty.LocalOrder{})
+	odb, err := table.NewTable(rowMeta, tdb, &table.Option{
+		Prefix:  opt_order_table.Prefix,
+		Name:    opt_order_table.Name,
+		Primary: opt_order_table.Primary,
+		Index:   index,
+	})
+	assert.Nil(t, err)
+	for _, order := range []*pty.LocalOrder{order1, order2} {
+		o := *order
+		o.TxHash = []string{o.Key}
+		assert.Nil(t, odb.Add(&o))
+	}
+	kvs, err := odb.Save()
+	assert.Nil(t, err)
+	for _, kv := range kvs {
+		tdb.Set(kv.Key, kv.Value)
+	}
+
+	driver := newTrade().(*trade)
+	driver.SetLocalDB(tdb)
+	req := &pty.ReqPairOrders{AssetExec: "a", TokenSymbol: "A", Count: 10}
+	_, err = driver.GetPairOrders(req)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//分叉之前不补齐
+	height := types.GetDappFork(pty.TradeX, pty.ForkTradePriceX)
+	driver.SetEnv(height-1, 1539918074, 0)
+	kvs, err = driver.reindexPair()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(kvs))
+
+	driver.SetEnv(height, 1539918074, 0)
+	tx := &types.Transaction{Execer: []byte(pty.TradeX)}
+	kvs, err = driver.reindexPair()
+	assert.Nil(t, err)
+	for _, kv := range driver.AddRollbackKV(tx, tx.Execer, kvs) {
+		tdb.Set(kv.Key, kv.Value)
+	}
+	reply, err := driver.GetPairOrders(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(reply.(*pty.ReplyTradeOrders).Orders))
+	value, err := tdb.Get(pairReindexKey)
+	assert.Nil(t, err)
+	assert.Equal(t, pairReindexDone, value)
+
+	//只补齐一次
+	kvs, err = driver.reindexPair()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(kvs))
+
+	//回滚后索引和进度都恢复, 再次执行时重新补齐
+	kvs, err = driver.DelRollbackKV(tx, tx.Execer)
+	assert.Nil(t, err)
+	for _, kv := range kvs {
+		//localdb中value为nil表示删除
+		if kv.Value == nil {
+			ldb.Delete(kv.Key)
+		} else {
+			tdb.Set(kv.Key, kv.Value)
+		}
+	}
+	_, err = driver.GetPairOrders(req)
+	assert.Equal(t, types.ErrNotFound, err)
+	_, err = tdb.Get(pairReindexKey)
+	assert.Equal(t, types.ErrNotFound, err)
+	kvs, err = driver.reindexPair()
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(kvs))
+}
@@ -0,0 +1,779 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 撮合模式的限价单:
+// 1) 下单时冻结资产, 卖单冻结token, 买单冻结 price*amount 的coins
+// 2) 新订单按价格时间优先和对手盘的订单成交, 成交价格为maker的价格, 买单多冻结的部分退回
+// 3) 未成交的部分挂在订单簿中, 同一价格档位按时间排队
+// 4) 一笔交易最多成交或撤销 TradeMaxFillsPerTx 个对手订单, 之后仍能成交的剩余部分直接撤销
+// 5) 资产是token时, 下单者被冻结或者token暂停不能下单; 撮合到被冻结的maker时撤销maker的订单, 继续撮合
+// 6) 撮合到自己的订单时撤销自己挂着的订单, 继续撮合
+// 订单簿保存在statedb中, 每个价格档位和每个订单的队列位置分别保存:
+// 一侧的价格档位按成交优先级组成双向链表, 档位上的订单按时间组成双向链表, 下单和撤单只读写相邻的档位和订单
+
+import (
+	"math"
+
+	"github.com/33cn/chain33/account"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+// calcLimitOrderValue 计算 amount 个token按 price 成交需要的coins
+func calcLimitOrderValue(amount, price int64) (int64, error) {
+	lots := amount / pty.TradeLotSize
+	ticks := price / pty.TradePriceTick
+	if ticks != 0 && lots > math.MaxInt64/ticks {
+		return 0, types.ErrAmount
+	}
+	return lots * ticks, nil
+}
+
+func limitOrderOnStatus(isSell bool) int32 {
+	if isSell {
+		return pty.TradeOrderStatusOnSale
+	}
+	return pty.TradeOrderStatusOnBuy
+}
+
+func limitOrderDoneStatus(isSell bool) int32 {
+	if isSell {
+		return pty.TradeOrderStatusSoldOut
+	}
+	return pty.TradeOrderStatusBoughtOut
+}
+
+func limitOrderRevokedStatus(isSell bool) int32 {
+	if isSell {
+		return pty.TradeOrderStatusRevoked
+	}
+	return pty.TradeOrderStatusBuyRevoked
+}
+
+func limitOrderHalfRevokedStatus(isSell bool) int32 {
+	if isSell {
+		return pty.TradeOrderStatusSellHalfRevoked
+	}
+	return pty.TradeOrderStatusBuyHalfRevoked
+}
+
+// limitOrderCross taker 的价格是否可以和 price 成交
+func limitOrderCross(taker *pty.LimitOrder, price int64) bool {
+	if taker.IsSell {
+		return taker.Price <= price
+	}
+	return taker.Price >= price
+}
+
+// limitPriceBefore 同一侧中价格 a 是否比 b 优先成交, 卖单价低优先, 买单价高优先
+func limitPriceBefore(isSell bool, a, b int64) bool {
+	if isSell {
+		return a < b
+	}
+	return a > b
+}
+
+func getLimitOrder(db dbm.KV, orderID string) (*pty.LimitOrder, error) {
+	value, err := db.Get(calcLimitOrderKey(orderID))
+	if err != nil {
+		return nil, err
+	}
+	var order pty.LimitOrder
+	if err = types.Decode(value, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// orderBook 缓存一个交易对在执行过程中读写的订单簿数据, 最后统一生成kv
+type orderBook struct {
+	db     dbm.KV
+	exec   string
+	symbol string
+	sides  map[bool]*pty.TradeBookSide
+	levels map[string]*pty.TradePriceLevel
+	nodes  map[string]*pty.TradeOrderNode
+	orders map[string]*pty.LimitOrder
+	dirty  map[string]types.Message
+	keys   []string
+}
+
+func newOrderBook(db dbm.KV, exec, symbol string) *orderBook {
+	return &orderBook{
+		db:     db,
+		exec:   exec,
+		symbol: symbol,
+		sides:  make(map[bool]*pty.TradeBookSide),
+		levels: make(map[string]*pty.TradePriceLevel),
+		nodes:  make(map[string]*pty.TradeOrderNode),
+		orders: make(map[string]*pty.LimitOrder),
+		dirty:  make(map[string]types.Message),
+	}
+}
+
+func (b *orderBook) load(key []byte, msg types.Message) error {
+	value, err := b.db.Get(key)
+	if err == types.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return types.Decode(value, msg)
+}
+
+func (b *orderBook) markDirty(key []byte, msg types.Message) {
+	if _, ok := b.dirty[string(key)]; !ok {
+		b.keys = append(b.keys, string(key))
+	}
+	b.dirty[string(key)] = msg
+}
+
+func (b *orderBook) side(isSell bool) (*pty.TradeBookSide, error) {
+	if side, ok := b.sides[isSell]; ok {
+		return side, nil
+	}
+	side := &pty.TradeBookSide{}
+	if err := b.load(calcLimitBookKey(b.exec, b.symbol, isSell), side); err != nil {
+		return nil, err
+	}
+	b.sides[isSell] = side
+	return side, nil
+}
+
+func (b *orderBook) setSide(isSell bool, side *pty.TradeBookSide) {
+	b.markDirty(calcLimitBookKey(b.exec, b.symbol, isSell), side)
+}
+
+// level 读取价格档位, 没有订单的档位不在链表中
+func (b *orderBook) level(isSell bool, price int64) (*pty.TradePriceLevel, error) {
+	key := calcLimitLevelKey(b.exec, b.symbol, isSell, price)
+	if level, ok := b.levels[string(key)]; ok {
+		return level, nil
+	}
+	level := &pty.TradePriceLevel{}
+	if err := b.load(key, level); err != nil {
+		return nil, err
+	}
+	level.Price = price
+	b.levels[string(key)] = level
+	return level, nil
+}
+
+func (b *orderBook) setLevel(isSell bool, level *pty.TradePriceLevel) {
+	b.markDirty(calcLimitLevelKey(b.exec, b.symbol, isSell, level.Price), level)
+}
+
+func (b *orderBook) node(orderID string) (*pty.TradeOrderNode, error) {
+	if node, ok := b.nodes[orderID]; ok {
+		return node, nil
+	}
+	node := &pty.TradeOrderNode{}
+	if err := b.load(calcLimitNodeKey(orderID), node); err != nil {
+		return nil, err
+	}
+	b.nodes[orderID] = node
+	return node, nil
+}
+
+func (b *orderBook) setNode(orderID string, node *pty.TradeOrderNode) {
+	b.markDirty(calcLimitNodeKey(orderID), node)
+}
+
+func (b *orderBook) order(orderID string) (*pty.LimitOrder, error) {
+	if order, ok := b.orders[orderID]; ok {
+		return order, nil
+	}
+	order, err := getLimitOrder(b.db, orderID)
+	if err != nil {
+		return nil, err
+	}
+	b.orders[orderID] = order
+	return order, nil
+}
+
+func (b *orderBook) setOrder(order *pty.LimitOrder) {
+	b.orders[order.OrderID] = order
+	b.markDirty(calcLimitOrderKey(order.OrderID), order)
+}
+
+// best 返回一侧最优先成交的订单, 没有订单时返回nil
+func (b *orderBook) best(isSell bool) (*pty.LimitOrder, error) {
+	side, err := b.side(isSell)
+	if err != nil {
+		return nil, err
+	}
+	if side.Best == 0 {
+		return nil, nil
+	}
+	level, err := b.level(isSell, side.Best)
+	if err != nil {
+		return nil, err
+	}
+	if level.Head == "" {
+		tradelog.Error("orderBook best", "exec", b.exec, "symbol", b.symbol, "price", side.Best, "err", "empty level")
+		return nil, types.ErrNotFound
+	}
+	return b.order(level.Head)
+}
+
+// linkLevel 把新的价格档位按成交优先级插入链表, 价格在两端时直接插入, 否则从最优档位开始查找位置
+func (b *orderBook) linkLevel(isSell bool, level *pty.TradePriceLevel) error {
+	side, err := b.side(isSell)
+	if err != nil {
+		return err
+	}
+	level.Prev, level.Next = 0, 0
+	defer b.setSide(isSell, side)
+	if side.Best == 0 {
+		side.Best, side.Worst = level.Price, level.Price
+		return nil
+	}
+	if limitPriceBefore(isSell, level.Price, side.Best) {
+		best, err := b.level(isSell, side.Best)
+		if err != nil {
+			return err
+		}
+		best.Prev, level.Next = level.Price, best.Price
+		b.setLevel(isSell, best)
+		side.Best = level.Price
+		return nil
+	}
+	prev, err := b.level(isSell, side.Worst)
+	if err != nil {
+		return err
+	}
+	if limitPriceBefore(isSell, side.Worst, level.Price) {
+		side.Worst = level.Price
+	} else {
+		prev, err = b.level(isSell, side.Best)
+		if err != nil {
+			return err
+		}
+		for limitPriceBefore(isSell, prev.Next, level.Price) {
+			if prev, err = b.level(isSell, prev.Next); err != nil {
+				return err
+			}
+		}
+		next, err := b.level(isSell, prev.Next)
+		if err != nil {
+			return err
+		}
+		next.Prev, level.Next = level.Price, next.Price
+		b.setLevel(isSell, next)
+	}
+	prev.Next, level.Prev = level.Price, prev.Price
+	b.setLevel(isSell, prev)
+	return nil
+}
+
+// unlinkLevel 把没有订单的价格档位从链表中移除
+func (b *orderBook) unlinkLevel(isSell bool, level *pty.TradePriceLevel) error {
+	side, err := b.side(isSell)
+	if err != nil {
+		return err
+	}
+	if level.Prev == 0 {
+		side.Best = level.Next
+	} else {
+		prev, err := b.level(isSell, level.Prev)
+		if err != nil {
+			return err
+		}
+		prev.Next = level.Next
+		b.setLevel(isSell, prev)
+	}
+	if level.Next == 0 {
+		side.Worst = level.Prev
+	} else {
+		next, err := b.level(isSell, level.Next)
+		if err != nil {
+			return err
+		}
+		next.Prev = level.Prev
+		b.setLevel(isSell, next)
+	}
+	level.Prev, level.Next = 0, 0
+	b.setSide(isSell, side)
+	return nil
+}
+
+// push 把订单排到对应价格档位的队尾
+func (b *orderBook) push(order *pty.LimitOrder) error {
+	level, err := b.level(order.IsSell, order.Price)
+	if err != nil {
+		return err
+	}
+	if level.Orders == 0 {
+		if err = b.linkLevel(order.IsSell, level); err != nil {
+			return err
+		}
+	}
+	node := &pty.TradeOrderNode{Prev: level.Tail}
+	if level.Tail == "" {
+		level.Head = order.OrderID
+	} else {
+		tail, err := b.node(level.Tail)
+		if err != nil {
+			return err
+		}
+		tail.Next = order.OrderID
+		b.setNode(level.Tail, tail)
+	}
+	level.Tail = order.OrderID
+	level.Orders++
+	level.Amount += order.Amount - order.Executed
+	b.nodes[order.OrderID] = node
+	b.setNode(order.OrderID, node)
+	b.setLevel(order.IsSell, level)
+	return nil
+}
+
+// fill 订单成交 amount 后更新价格档位上未成交的数量
+func (b *orderBook) fill(order *pty.LimitOrder, amount int64) error {
+	level, err := b.level(order.IsSell, order.Price)
+	if err != nil {
+		return err
+	}
+	level.Amount -= amount
+	b.setLevel(order.IsSell, level)
+	return nil
+}
+
+// remove 把订单从队列中移除, 价格档位为空时同时移除档位
+func (b *orderBook) remove(order *pty.LimitOrder) error {
+	level, err := b.level(order.IsSell, order.Price)
+	if err != nil {
+		return err
+	}
+	node, err := b.node(order.OrderID)
+	if err != nil {
+		return err
+	}
+	if node.Prev == "" {
+		level.Head = node.Next
+	} else {
+		prev, err := b.node(node.Prev)
+		if err != nil {
+			return err
+		}
+		prev.Next = node.Next
+		b.setNode(node.Prev, prev)
+	}
+	if node.Next == "" {
+		level.Tail = node.Prev
+	} else {
+		next, err := b.node(node.Next)
+		if err != nil {
+			return err
+		}
+		next.Prev = node.Prev
+		b.setNode(node.Next, next)
+	}
+	// 移除的订单不会再入队, 它的位置不再读取, 不需要写回
+	level.Orders--
+	level.Amount -= order.Amount - order.Executed
+	b.setLevel(order.IsSell, level)
+	if level.Orders != 0 {
+		return nil
+	}
+	return b.unlinkLevel(order.IsSell, level)
+}
+
+func (b *orderBook) save() []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, key := range b.keys {
+		kv := &types.KeyValue{Key: []byte(key), Value: types.Encode(b.dirty[key])}
+		b.db.Set(kv.Key, kv.Value)
+		kvs = append(kvs, kv)
+	}
+	return kvs
+}
+
+func limitOrderLog(ty int32, prev, current *pty.LimitOrder) *types.ReceiptLog {
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(&pty.ReceiptTradeLimitOrder{Prev: prev, Current: current})}
+}
+
+func mergeReceipt(receipt *types.Receipt, r *types.Receipt) {
+	receipt.KV = append(receipt.KV, r.KV...)
+	receipt.Logs = append(receipt.Logs, r.Logs...)
+}
+
+func (action *tradeAction) tradeLimitOrder(req *pty.TradeForLimitOrder) (*types.Receipt, error) {
+	if req.Price <= 0 || req.Price%pty.TradePriceTick != 0 {
+		return nil, pty.ErrTLimitOrderPrice
+	}
+	if req.Amount <= 0 || req.Amount%pty.TradeLotSize != 0 {
+		return nil, pty.ErrTLimitOrderAmount
+	}
+	if !checkAsset(action.height, req.AssetExec, req.TokenSymbol) {
+		return nil, types.ErrInvalidParam
+	}
+	value, err := calcLimitOrderValue(req.Amount, req.Price)
+	if err != nil {
+		return nil, err
+	}
+	err = checkTokenTransfer(action.height, action.db, assetExec(action.height, req.AssetExec), req.TokenSymbol, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	accDB, err := createAccountDB(action.height, action.db, req.AssetExec, req.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var frozen *types.Receipt
+	if req.IsSell {
+		frozen, err = accDB.ExecFrozen(action.fromaddr, action.execaddr, req.Amount)
+	} else {
+		frozen, err = action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, value)
+	}
+	if err != nil {
+		tradelog.Error("trade limit order", "addr", action.fromaddr, "execaddr", action.execaddr, "isSell", req.IsSell,
+			"amount", req.Amount, "value", value)
+		return nil, err
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	mergeReceipt(receipt, frozen)
+
+	order := &pty.LimitOrder{
+		OrderID:     action.txhash,
+		TokenSymbol: req.TokenSymbol,
+		AssetExec:   req.AssetExec,
+		Owner:       action.fromaddr,
+		IsSell:      req.IsSell,
+		Price:       req.Price,
+		Amount:      req.Amount,
+		Status:      limitOrderOnStatus(req.IsSell),
+		Height:      action.height,
+		Index:       action.index,
+		BlockTime:   action.blocktime,
+		TxHash:      action.txhash,
+	}
+	book := newOrderBook(action.db, req.AssetExec, req.TokenSymbol)
+	var fills, steps int32
+	var maker *pty.LimitOrder
+	for ; order.Executed < order.Amount; steps++ {
+		maker, err = book.best(!order.IsSell)
+		if err != nil {
+			return nil, err
+		}
+		if maker == nil || !limitOrderCross(order, maker.Price) || steps >= pty.TradeMaxFillsPerTx {
+			break
+		}
+		// 自己的订单和被冻结的maker不能成交, 撤销maker的订单后继续撮合
+		revoke := maker.Owner == order.Owner
+		if !revoke {
+			err = checkTokenTransfer(action.height, action.db, assetExec(action.height, order.AssetExec), order.TokenSymbol, maker.Owner)
+			if err != nil && err != tokenty.ErrTokenAddrFrozen {
+				return nil, err
+			}
+			revoke = err == tokenty.ErrTokenAddrFrozen
+		}
+		if revoke {
+			tradelog.Info("trade limit order revoke maker", "orderID", maker.OrderID, "owner", maker.Owner)
+			r, err := action.revokeLimitOrder(accDB, book, maker)
+			if err != nil {
+				return nil, err
+			}
+			mergeReceipt(receipt, r)
+			continue
+		}
+		amount := order.Amount - order.Executed
+		if rest := maker.Amount - maker.Executed; rest < amount {
+			amount = rest
+		}
+		r, err := action.settleLimitFill(accDB, order, maker, amount)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+
+		prev := *maker
+		current := prev
+		current.Executed += amount
+		order.Executed += amount
+		if err = book.fill(&current, amount); err != nil {
+			return nil, err
+		}
+		if current.Executed == current.Amount {
+			current.Status = limitOrderDoneStatus(current.IsSell)
+			if err = book.remove(&current); err != nil {
+				return nil, err
+			}
+		}
+		book.setOrder(&current)
+		fill := &pty.TradeFill{
+			MakerOrderID: current.OrderID,
+			TakerOrderID: order.OrderID,
+			TokenSymbol:  order.TokenSymbol,
+			AssetExec:    order.AssetExec,
+			Price:        current.Price,
+			Amount:       amount,
+			TakerIsSell:  order.IsSell,
+			Height:       action.height,
+			Index:        action.index,
+			BlockTime:    action.blocktime,
+			TxHash:       action.txhash,
+			Seq:          fills,
+		}
+		fill.Buyer, fill.Seller = limitFillParties(order, &current)
+		receipt.Logs = append(receipt.Logs, limitOrderLog(pty.TyLogTradeLimitOrder, &prev, &current))
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pty.TyLogTradeLimitFill, Log: types.Encode(&pty.ReceiptTradeLimitFill{Fill: fill})})
+		fills++
+	}
+
+	if order.Executed == order.Amount {
+		order.Status = limitOrderDoneStatus(order.IsSell)
+	} else if maker != nil && limitOrderCross(order, maker.Price) {
+		// 达到成交次数上限, 剩余部分如果挂单会和对手盘交叉, 直接撤销, 没有成交时(只撤销了maker)为撤销状态
+		r, err := action.unfreezeLimitOrder(accDB, order)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+		order.Status = limitOrderRevokedStatus(order.IsSell)
+		if order.Executed > 0 {
+			order.Status = limitOrderHalfRevokedStatus(order.IsSell)
+		}
+	} else if err = book.push(order); err != nil {
+		return nil, err
+	}
+	book.setOrder(order)
+	receipt.Logs = append(receipt.Logs, limitOrderLog(pty.TyLogTradeLimitOrder, nil, order))
+	receipt.KV = append(receipt.KV, book.save()...)
+	return receipt, nil
+}
+
+// limitFillParties 返回成交的买方和卖方
+func limitFillParties(taker, maker *pty.LimitOrder) (buyer, seller string) {
+	if taker.IsSell {
+		return maker.Owner, taker.Owner
+	}
+	return taker.Owner, maker.Owner
+}
+
+// settleLimitFill token从卖方转给买方, coins按maker的价格从买方转给卖方, 双方的冻结检查在撮合之前完成
+func (action *tradeAction) settleLimitFill(accDB *account.DB, taker, maker *pty.LimitOrder, amount int64) (*types.Receipt, error) {
+	buyer, seller := limitFillParties(taker, maker)
+	value, err := calcLimitOrderValue(amount, maker.Price)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	r, err := accDB.ExecTransferFrozen(seller, buyer, action.execaddr, amount)
+	if err != nil {
+		tradelog.Error("trade limit fill token", "seller", seller, "buyer", buyer, "amount", amount, "err", err)
+		return nil, err
+	}
+	mergeReceipt(receipt, r)
+	r, err = action.coinsAccount.ExecTransferFrozen(buyer, seller, action.execaddr, value)
+	if err != nil {
+		tradelog.Error("trade limit fill coins", "buyer", buyer, "seller", seller, "value", value, "err", err)
+		return nil, err
+	}
+	mergeReceipt(receipt, r)
+
+	// 买单作为taker时按自己的价格冻结, 按maker的价格成交, 差价解冻
+	if !taker.IsSell && taker.Price > maker.Price {
+		frozen, err := calcLimitOrderValue(amount, taker.Price)
+		if err != nil {
+			return nil, err
+		}
+		r, err = action.coinsAccount.ExecActive(buyer, action.execaddr, frozen-value)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+	}
+	return receipt, nil
+}
+
+// unfreezeLimitOrder 解冻订单未成交部分的资产
+func (action *tradeAction) unfreezeLimitOrder(accDB *account.DB, order *pty.LimitOrder) (*types.Receipt, error) {
+	rest := order.Amount - order.Executed
+	if order.IsSell {
+		return accDB.ExecActive(order.Owner, action.execaddr, rest)
+	}
+	value, err := calcLimitOrderValue(rest, order.Price)
+	if err != nil {
+		return nil, err
+	}
+	return action.coinsAccount.ExecActive(order.Owner, action.execaddr, value)
+}
+
+// revokeLimitOrder 撤销订单簿中的订单并解冻未成交部分, 部分成交的订单为半撤销状态
+func (action *tradeAction) revokeLimitOrder(accDB *account.DB, book *orderBook, order *pty.LimitOrder) (*types.Receipt, error) {
+	receipt, err := action.unfreezeLimitOrder(accDB, order)
+	if err != nil {
+		tradelog.Error("trade revoke limit order", "addr", order.Owner, "orderID", order.OrderID, "err", err)
+		return nil, err
+	}
+	if err = book.remove(order); err != nil {
+		return nil, err
+	}
+	prev := *order
+	current := prev
+	current.Status = limitOrderRevokedStatus(order.IsSell)
+	if current.Executed > 0 {
+		current.Status = limitOrderHalfRevokedStatus(order.IsSell)
+	}
+	book.setOrder(&current)
+	receipt.Logs = append(receipt.Logs, limitOrderLog(pty.TyLogTradeLimitRevoke, &prev, &current))
+	return receipt, nil
+}
+
+func (action *tradeAction) tradeRevokeLimitOrder(revoke *pty.TradeForRevokeLimitOrder) (*types.Receipt, error) {
+	if revoke.OrderID == "" {
+		return nil, types.ErrInvalidParam
+	}
+	order, err := getLimitOrder(action.db, revoke.OrderID)
+	if err != nil {
+		return nil, pty.ErrTLimitOrderNotExist
+	}
+	if order.Owner != action.fromaddr {
+		return nil, pty.ErrTLimitOrderRevoke
+	}
+	if order.Status != limitOrderOnStatus(order.IsSell) {
+		return nil, pty.ErrTLimitOrderFinished
+	}
+	accDB, err := createAccountDB(action.height, action.db, order.AssetExec, order.TokenSymbol)
+	if err != nil {
+		return nil, err
+	}
+	book := newOrderBook(action.db, order.AssetExec, order.TokenSymbol)
+	receipt, err := action.revokeLimitOrder(accDB, book, order)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, book.save()...)
+	return receipt, nil
+}
+
+// genLimitOrderLocalKV 地址下的订单索引保存订单的最新状态, 回滚时恢复为之前的状态
+func genLimitOrderLocalKV(receipt *pty.ReceiptTradeLimitOrder, isDel bool) *types.KeyValue {
+	order := receipt.Current
+	key := calcLimitOrderAddrKey(order.Owner, dapp.HeightIndexStr(order.Height, order.Index))
+	if !isDel {
+		return &types.KeyValue{Key: key, Value: types.Encode(order)}
+	}
+	if receipt.Prev == nil {
+		return &types.KeyValue{Key: key, Value: nil}
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(receipt.Prev)}
+}
+
+// genLimitFillLocalKV 成交记录按交易对, 买方和卖方分别索引
+func genLimitFillLocalKV(fill *pty.TradeFill, isDel bool) []*types.KeyValue {
+	heightIndex := dapp.HeightIndexStr(fill.Height, fill.Index)
+	var value []byte
+	if !isDel {
+		value = types.Encode(fill)
+	}
+	return []*types.KeyValue{
+		{Key: calcLimitFillAssetKey(fill.AssetExec, fill.TokenSymbol, heightIndex, fill.Seq), Value: value},
+		{Key: calcLimitFillAddrKey(fill.Buyer, heightIndex, fill.Seq), Value: value},
+		{Key: calcLimitFillAddrKey(fill.Seller, heightIndex, fill.Seq), Value: value},
+	}
+}
+
+// getLimitOrderDepth 每一侧按成交优先级返回 count 个价格档位的挂单数量
+func getLimitOrderDepth(db dbm.KV, req *pty.ReqTradeDepth) (types.Message, error) {
+	if req.TokenSymbol == "" || req.Count <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	book := newOrderBook(db, req.AssetExec, req.TokenSymbol)
+	var reply pty.ReplyTradeDepth
+	for _, isSell := range []bool{true, false} {
+		side, err := book.side(isSell)
+		if err != nil {
+			return nil, err
+		}
+		var levels []*pty.TradeDepthLevel
+		for price := side.Best; price != 0 && len(levels) < int(req.Count); {
+			level, err := book.level(isSell, price)
+			if err != nil {
+				return nil, err
+			}
+			levels = append(levels, &pty.TradeDepthLevel{Price: price, Amount: level.Amount, Orders: level.Orders})
+			price = level.Next
+		}
+		if isSell {
+			reply.Sells = levels
+		} else {
+			reply.Buys = levels
+		}
+	}
+	return &reply, nil
+}
+
+func (t *trade) getLimitOrderFills(req *pty.ReqTradeFills) (types.Message, error) {
+	if req.Count <= 0 || (req.Direction != 1 && req.Direction != 0) {
+		return nil, types.ErrInvalidParam
+	}
+	var prefix []byte
+	if req.Addr != "" {
+		prefix = calcLimitFillAddrPrefix(req.Addr)
+	} else if req.TokenSymbol != "" {
+		prefix = calcLimitFillAssetPrefix(req.AssetExec, req.TokenSymbol)
+	} else {
+		return nil, types.ErrInvalidParam
+	}
+	values, err := listLocal(t.GetLocalDB(), prefix, req.PrimaryKey, req.Count, req.Direction)
+	if err != nil {
+		return nil, err
+	}
+	var reply pty.ReplyTradeFills
+	for _, value := range values {
+		var fill pty.TradeFill
+		if err = types.Decode(value, &fill); err != nil {
+			return nil, err
+		}
+		heightIndex := dapp.HeightIndexStr(fill.Height, fill.Index)
+		key := calcLimitFillAssetKey(fill.AssetExec, fill.TokenSymbol, heightIndex, fill.Seq)
+		if req.Addr != "" {
+			key = calcLimitFillAddrKey(req.Addr, heightIndex, fill.Seq)
+		}
+		reply.Fills = append(reply.Fills, &pty.ReplyTradeFill{Fill: &fill, Key: string(key)})
+	}
+	return &reply, nil
+}
+
+func (t *trade) getOnesLimitOrders(req *pty.ReqTradeLimitOrders) (types.Message, error) {
+	if req.Addr == "" || req.Count <= 0 || (req.Direction != 1 && req.Direction != 0) {
+		return nil, types.ErrInvalidParam
+	}
+	values, err := listLocal(t.GetLocalDB(), calcLimitOrderAddrPrefix(req.Addr), req.PrimaryKey, req.Count, req.Direction)
+	if err != nil {
+		return nil, err
+	}
+	var reply pty.ReplyTradeLimitOrders
+	for _, value := range values {
+		var order pty.LimitOrder
+		if err = types.Decode(value, &order); err != nil {
+			return nil, err
+		}
+		key := calcLimitOrderAddrKey(order.Owner, dapp.HeightIndexStr(order.Height, order.Index))
+		reply.Orders = append(reply.Orders, &pty.ReplyTradeLimitOrder{Order: &order, Key: string(key)})
+	}
+	return &reply, nil
+}
+
+// listLocal 分页读取localdb, List 只返回value, 翻页用的key由调用者根据value重新计算
+func listLocal(db dbm.KVDB, prefix []byte, primaryKey string, count, direction int32) ([][]byte, error) {
+	var key []byte
+	if primaryKey != "" {
+		key = []byte(primaryKey)
+	}
+	values, err := db.List(prefix, key, count, direction)
+	if err == types.ErrNotFound {
+		return nil, nil
+	}
+	return values, err
+}
@@ -0,0 +1,320 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderBookEnv struct {
+	t      *testing.T
+	driver drivers.Driver
+	ldb    dbm.DB
+	kvdb   dbm.KVDB
+	coins  *account.DB
+	token  *account.DB
+	height int64
+	index  int
+}
+
+func newOrderBookEnv(t *testing.T) (*orderBookEnv, func()) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	coins := account.NewCoinsAccount()
+	coins.SetDB(kvdb)
+	token, err := account.NewAccountDB(AssetExecToken, Symbol, kvdb)
+	require.Nil(t, err)
+	env := &orderBookEnv{t: t, ldb: ldb, kvdb: kvdb, coins: coins, token: token,
+		height: types.GetDappFork(pty.TradeX, pty.ForkTradeOrderBookX)}
+	env.driver = newTrade()
+	env.driver.SetStateDB(kvdb)
+	env.driver.SetLocalDB(kvdb)
+	return env, func() { util.CloseTestDB(dir, ldb) }
+}
+
+func (env *orderBookEnv) exec(priv string, tx *types.Transaction) (*types.Receipt, *types.Transaction, error) {
+	tx, err := signTx(tx, priv)
+	require.Nil(env.t, err)
+	env.driver.SetEnv(env.height, 1539918074, 0)
+	env.index++
+	receipt, err := env.driver.Exec(tx, env.index)
+	if err != nil {
+		return nil, tx, err
+	}
+	_, err = env.driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+	require.Nil(env.t, err)
+	return receipt, tx, nil
+}
+
+func (env *orderBookEnv) order(priv string, isSell bool, price, amount int64) (*types.Receipt, *types.Transaction, error) {
+	tx, err := pty.CreateRawTradeLimitOrderTx(&pty.TradeLimitOrderTx{TokenSymbol: Symbol, AssetExec: AssetExecToken,
+		IsSell: isSell, Price: price, Amount: amount})
+	require.Nil(env.t, err)
+	return env.exec(priv, tx)
+}
+
+func (env *orderBookEnv) revoke(priv string, orderID string) error {
+	tx, err := pty.CreateRawTradeRevokeLimitOrderTx(&pty.TradeRevokeLimitOrderTx{OrderID: orderID})
+	require.Nil(env.t, err)
+	_, _, err = env.exec(priv, tx)
+	return err
+}
+
+func (env *orderBookEnv) depth() *pty.ReplyTradeDepth {
+	reply, err := env.driver.Query("GetLimitOrderDepth", types.Encode(&pty.ReqTradeDepth{TokenSymbol: Symbol, AssetExec: AssetExecToken, Count: 10}))
+	require.Nil(env.t, err)
+	return reply.(*pty.ReplyTradeDepth)
+}
+
+func (env *orderBookEnv) fills(addr string) []*pty.ReplyTradeFill {
+	reply, err := env.driver.Query("GetLimitOrderFills", types.Encode(&pty.ReqTradeFills{TokenSymbol: Symbol, AssetExec: AssetExecToken, Addr: addr, Count: 10}))
+	require.Nil(env.t, err)
+	return reply.(*pty.ReplyTradeFills).Fills
+}
+
+func TestTradeLimitOrderBook(t *testing.T) {
+	env, closeDB := newOrderBookEnv(t)
+	defer closeDB()
+	execAddr := address.ExecAddress(pty.TradeX)
+	addrA, addrB, addrC := string(Nodes[0]), string(Nodes[1]), string(Nodes[2])
+	env.token.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 10 * types.Coin})
+	env.coins.SaveExecAccount(execAddr, &types.Account{Addr: addrB, Balance: 10 * types.Coin})
+	env.coins.SaveExecAccount(execAddr, &types.Account{Addr: addrC, Balance: 10 * types.Coin})
+
+	//价格和数量必须是最小单位的整数倍
+	_, _, err := env.order(PrivKeyA, true, types.Coin+1, types.Coin)
+	assert.Equal(t, pty.ErrTLimitOrderPrice, err)
+	_, _, err = env.order(PrivKeyA, true, types.Coin, 0)
+	assert.Equal(t, pty.ErrTLimitOrderAmount, err)
+
+	//卖单挂在订单簿中, 同价格按时间排队
+	_, _, err = env.order(PrivKeyA, true, 110*types.Coin/100, 2*types.Coin)
+	require.Nil(t, err)
+	_, tx, err := env.order(PrivKeyA, true, types.Coin, 3*types.Coin)
+	require.Nil(t, err)
+	sellID := tx.Hash()
+	depth := env.depth()
+	require.Equal(t, 2, len(depth.Sells))
+	assert.Equal(t, &pty.TradeDepthLevel{Price: types.Coin, Amount: 3 * types.Coin, Orders: 1}, depth.Sells[0])
+	assert.Equal(t, int64(110*types.Coin/100), depth.Sells[1].Price)
+	assert.Equal(t, 0, len(depth.Buys))
+	assert.Equal(t, 5*types.Coin, env.token.LoadExecAccount(addrA, execAddr).Frozen)
+
+	//买单按卖单的价格从低到高成交, 多冻结的coins退回
+	receipt, buyTx, err := env.order(PrivKeyB, false, 120*types.Coin/100, 4*types.Coin)
+	require.Nil(t, err)
+	buyIndex := env.index
+	var fills []*pty.TradeFill
+	for _, log := range receipt.Logs {
+		if log.Ty == pty.TyLogTradeLimitFill {
+			var r pty.ReceiptTradeLimitFill
+			require.Nil(t, types.Decode(log.Log, &r))
+			fills = append(fills, r.Fill)
+		}
+	}
+	require.Equal(t, 2, len(fills))
+	assert.Equal(t, types.Coin, fills[0].Price)
+	assert.Equal(t, 3*types.Coin, fills[0].Amount)
+	assert.Equal(t, int64(110*types.Coin/100), fills[1].Price)
+	assert.Equal(t, types.Coin, fills[1].Amount)
+	assert.Equal(t, addrB, fills[1].Buyer)
+	assert.Equal(t, addrA, fills[1].Seller)
+
+	paid := 3*types.Coin + 110*types.Coin/100
+	accB := env.coins.LoadExecAccount(addrB, execAddr)
+	assert.Equal(t, 10*types.Coin-paid, accB.Balance)
+	assert.Equal(t, int64(0), accB.Frozen)
+	assert.Equal(t, 4*types.Coin, env.token.LoadExecAccount(addrB, execAddr).Balance)
+	assert.Equal(t, paid, env.coins.LoadExecAccount(addrA, execAddr).Balance)
+	assert.Equal(t, types.Coin, env.token.LoadExecAccount(addrA, execAddr).Frozen)
+
+	reply, err := env.driver.Query("GetLimitOrder", types.Encode(&types.ReqString{Data: hex.EncodeToString(sellID)}))
+	require.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusSoldOut), reply.(*pty.LimitOrder).Status)
+	depth = env.depth()
+	require.Equal(t, 1, len(depth.Sells))
+	assert.Equal(t, types.Coin, depth.Sells[0].Amount)
+
+	//不交叉的买单挂单, 只能由下单者撤销
+	_, tx, err = env.order(PrivKeyC, false, types.Coin, 2*types.Coin)
+	require.Nil(t, err)
+	assert.Equal(t, 2*types.Coin, env.coins.LoadExecAccount(addrC, execAddr).Frozen)
+	assert.Equal(t, 1, len(env.depth().Buys))
+	assert.Equal(t, pty.ErrTLimitOrderRevoke, env.revoke(PrivKeyB, hex.EncodeToString(tx.Hash())))
+	require.Nil(t, env.revoke(PrivKeyC, hex.EncodeToString(tx.Hash())))
+	assert.Equal(t, int64(0), env.coins.LoadExecAccount(addrC, execAddr).Frozen)
+	assert.Equal(t, 0, len(env.depth().Buys))
+	assert.Equal(t, pty.ErrTLimitOrderFinished, env.revoke(PrivKeyC, hex.EncodeToString(tx.Hash())))
+
+	//token暂停或冻结时不能成交
+	tokenTransferCheck = func(db dbm.KV, height int64, symbol string, addrs ...string) error {
+		return tokenty.ErrTokenPaused
+	}
+	_, _, err = env.order(PrivKeyC, false, 110*types.Coin/100, types.Coin)
+	tokenTransferCheck = tokenexec.CheckTokenTransfer
+	assert.Equal(t, tokenty.ErrTokenPaused, err)
+
+	//被冻结的maker撤销订单, 部分成交的为半撤销, taker继续撮合后挂单
+	tokenTransferCheck = func(db dbm.KV, height int64, symbol string, addrs ...string) error {
+		for _, addr := range addrs {
+			if addr == addrA {
+				return tokenty.ErrTokenAddrFrozen
+			}
+		}
+		return nil
+	}
+	frozenReceipt, _, err := env.order(PrivKeyC, false, 110*types.Coin/100, types.Coin)
+	tokenTransferCheck = tokenexec.CheckTokenTransfer
+	require.Nil(t, err)
+	var revoked []*pty.LimitOrder
+	for _, log := range frozenReceipt.Logs {
+		assert.NotEqual(t, int32(pty.TyLogTradeLimitFill), log.Ty)
+		if log.Ty == pty.TyLogTradeLimitRevoke {
+			var r pty.ReceiptTradeLimitOrder
+			require.Nil(t, types.Decode(log.Log, &r))
+			revoked = append(revoked, r.Current)
+		}
+	}
+	require.Equal(t, 1, len(revoked))
+	assert.Equal(t, addrA, revoked[0].Owner)
+	assert.Equal(t, int32(pty.TradeOrderStatusSellHalfRevoked), revoked[0].Status)
+	assert.Equal(t, int64(0), env.token.LoadExecAccount(addrA, execAddr).Frozen)
+	depth = env.depth()
+	assert.Equal(t, 0, len(depth.Sells))
+	require.Equal(t, 1, len(depth.Buys))
+	assert.Equal(t, types.Coin, depth.Buys[0].Amount)
+
+	//成交历史
+	assert.Equal(t, 2, len(env.fills("")))
+	assert.Equal(t, 2, len(env.fills(addrB)))
+	reply, err = env.driver.Query("GetOnesLimitOrders", types.Encode(&pty.ReqTradeLimitOrders{Addr: addrA, Count: 10}))
+	require.Nil(t, err)
+	orders := reply.(*pty.ReplyTradeLimitOrders).Orders
+	require.Equal(t, 2, len(orders))
+
+	//回滚买单的本地数据
+	env.driver.SetEnv(env.height, 1539918074, 0)
+	set, err := env.driver.ExecDelLocal(buyTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, buyIndex)
+	require.Nil(t, err)
+	for _, kv := range set.KV {
+		//localdb中value为nil表示删除
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+		}
+	}
+	assert.Equal(t, 0, len(env.fills("")))
+	reply, err = env.driver.Query("GetOnesLimitOrders", types.Encode(&pty.ReqTradeLimitOrders{Addr: addrB, Count: 10}))
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*pty.ReplyTradeLimitOrders).Orders))
+}
+
+func orderLogs(receipt *types.Receipt, ty int32) []*pty.LimitOrder {
+	var orders []*pty.LimitOrder
+	for _, log := range receipt.Logs {
+		if log.Ty == ty {
+			var r pty.ReceiptTradeLimitOrder
+			types.Decode(log.Log, &r)
+			orders = append(orders, r.Current)
+		}
+	}
+	return orders
+}
+
+func TestTradeLimitOrderLevels(t *testing.T) {
+	env, closeDB := newOrderBookEnv(t)
+	defer closeDB()
+	execAddr := address.ExecAddress(pty.TradeX)
+	addrA, addrB := string(Nodes[0]), string(Nodes[1])
+	env.token.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 10 * types.Coin})
+	env.coins.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 10 * types.Coin})
+	env.coins.SaveExecAccount(execAddr, &types.Account{Addr: addrB, Balance: 10 * types.Coin})
+
+	//价格档位按价格插入链表的头部, 尾部和中间, 同价格的订单排队
+	var ids []string
+	for _, price := range []int64{120, 100, 110, 130, 110} {
+		_, tx, err := env.order(PrivKeyA, true, price*types.Coin/100, types.Coin)
+		require.Nil(t, err)
+		ids = append(ids, hex.EncodeToString(tx.Hash()))
+	}
+	depth := env.depth()
+	require.Equal(t, 4, len(depth.Sells))
+	for i, price := range []int64{100, 110, 120, 130} {
+		assert.Equal(t, price*types.Coin/100, depth.Sells[i].Price)
+	}
+	assert.Equal(t, &pty.TradeDepthLevel{Price: 110 * types.Coin / 100, Amount: 2 * types.Coin, Orders: 2}, depth.Sells[1])
+
+	//撤销队首的订单, 档位中剩下的订单继续排队
+	require.Nil(t, env.revoke(PrivKeyA, ids[2]))
+	assert.Equal(t, &pty.TradeDepthLevel{Price: 110 * types.Coin / 100, Amount: types.Coin, Orders: 1}, env.depth().Sells[1])
+
+	//成交后清空的档位从链表中移除, 部分成交的订单撤销后为半撤销
+	receipt, _, err := env.order(PrivKeyB, false, 120*types.Coin/100, 25*types.Coin/10)
+	require.Nil(t, err)
+	makers := orderLogs(receipt, pty.TyLogTradeLimitOrder)
+	require.Equal(t, 4, len(makers))
+	assert.Equal(t, ids[1], makers[0].OrderID)
+	assert.Equal(t, ids[4], makers[1].OrderID)
+	assert.Equal(t, ids[0], makers[2].OrderID)
+	depth = env.depth()
+	require.Equal(t, 2, len(depth.Sells))
+	assert.Equal(t, &pty.TradeDepthLevel{Price: 120 * types.Coin / 100, Amount: types.Coin / 2, Orders: 1}, depth.Sells[0])
+	require.Nil(t, env.revoke(PrivKeyA, ids[0]))
+	reply, err := env.driver.Query("GetLimitOrder", types.Encode(&types.ReqString{Data: ids[0]}))
+	require.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusSellHalfRevoked), reply.(*pty.LimitOrder).Status)
+	assert.Equal(t, int64(130*types.Coin/100), env.depth().Sells[0].Price)
+
+	//和自己的订单交叉时撤销自己挂着的订单, 新订单继续撮合后挂单
+	receipt, _, err = env.order(PrivKeyA, false, 130*types.Coin/100, types.Coin)
+	require.Nil(t, err)
+	revoked := orderLogs(receipt, pty.TyLogTradeLimitRevoke)
+	require.Equal(t, 1, len(revoked))
+	assert.Equal(t, ids[3], revoked[0].OrderID)
+	assert.Equal(t, int32(pty.TradeOrderStatusRevoked), revoked[0].Status)
+	depth = env.depth()
+	assert.Equal(t, 0, len(depth.Sells))
+	require.Equal(t, 1, len(depth.Buys))
+	assert.Equal(t, types.Coin, depth.Buys[0].Amount)
+	assert.Equal(t, int64(0), env.token.LoadExecAccount(addrA, execAddr).Frozen)
+}
+
+func TestTradeLimitOrderMaxFills(t *testing.T) {
+	env, closeDB := newOrderBookEnv(t)
+	defer closeDB()
+	execAddr := address.ExecAddress(pty.TradeX)
+	addrA := string(Nodes[0])
+	env.token.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 10 * types.Coin})
+	env.coins.SaveExecAccount(execAddr, &types.Account{Addr: addrA, Balance: 10 * types.Coin})
+
+	for i := 0; i <= pty.TradeMaxFillsPerTx; i++ {
+		_, _, err := env.order(PrivKeyA, true, types.Coin, pty.TradeLotSize)
+		require.Nil(t, err)
+	}
+
+	//达到成交次数上限时只撤销了自己的订单, 没有成交的新订单为撤销状态
+	receipt, tx, err := env.order(PrivKeyA, false, types.Coin, pty.TradeLotSize)
+	require.Nil(t, err)
+	assert.Equal(t, pty.TradeMaxFillsPerTx, len(orderLogs(receipt, pty.TyLogTradeLimitRevoke)))
+	reply, err := env.driver.Query("GetLimitOrder", types.Encode(&types.ReqString{Data: hex.EncodeToString(tx.Hash())}))
+	require.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusBuyRevoked), reply.(*pty.LimitOrder).Status)
+	assert.Equal(t, int64(0), env.coins.LoadExecAccount(addrA, execAddr).Frozen)
+	depth := env.depth()
+	assert.Equal(t, 0, len(depth.Buys))
+	require.Equal(t, 1, len(depth.Sells))
+	assert.Equal(t, int64(pty.TradeLotSize), depth.Sells[0].Amount)
+}
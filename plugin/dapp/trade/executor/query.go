@@ -64,6 +64,39 @@ func (t *trade) Query_GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error)
 	return t.GetOneOrder(req)
 }
 
+// 按交易对分页显示订单
+func (t *trade) Query_GetPairOrders(req *pty.ReqPairOrders) (types.Message, error) {
+	return t.GetPairOrders(req)
+}
+
+// 撮合模式的限价单
+// 订单簿深度, 从statedb中读取
+func (t *trade) Query_GetLimitOrderDepth(req *pty.ReqTradeDepth) (types.Message, error) {
+	return getLimitOrderDepth(t.GetStateDB(), req)
+}
+
+// 交易对或者地址的成交历史
+func (t *trade) Query_GetLimitOrderFills(req *pty.ReqTradeFills) (types.Message, error) {
+	return t.getLimitOrderFills(req)
+}
+
+// 根据orderID查询订单
+func (t *trade) Query_GetLimitOrder(req *types.ReqString) (types.Message, error) {
+	if req.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	order, err := getLimitOrder(t.GetStateDB(), req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// 地址的撮合限价单
+func (t *trade) Query_GetOnesLimitOrders(req *pty.ReqTradeLimitOrders) (types.Message, error) {
+	return t.getOnesLimitOrders(req)
+}
+
 func (t *trade) GetOnesSellOrder(addrTokens *pty.ReqAddrAssets) (types.Message, error) {
 	var keys [][]byte
 	if 0 == len(addrTokens.Token) {
@@ -302,6 +335,8 @@ func sellOrder2reply(sellOrder *pty.SellOrder) *pty.ReplySellOrder {
 		Height:            sellOrder.Height,
 		Key:               sellOrder.SellID,
 		AssetExec:         sellOrder.AssetExec,
+		PriceExec:         sellOrder.PriceExec,
+		PriceSymbol:       sellOrder.PriceSymbol,
 	}
 	return reply
 }
@@ -345,6 +380,8 @@ func txResult2sellOrderReply(txResult *types.TxResult) *pty.ReplySellOrder {
 				Height:            receipt.Base.Height,
 				Key:               txhash,
 				AssetExec:         receipt.Base.AssetExec,
+				PriceExec:         receipt.Base.PriceExec,
+				PriceSymbol:       receipt.Base.PriceSymbol,
 			}
 			tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 			return reply
@@ -369,6 +406,8 @@ func buyOrder2reply(buyOrder *pty.BuyLimitOrder) *pty.ReplyBuyOrder {
 		Height:            buyOrder.Height,
 		Key:               buyOrder.BuyID,
 		AssetExec:         buyOrder.AssetExec,
+		PriceExec:         buyOrder.PriceExec,
+		PriceSymbol:       buyOrder.PriceSymbol,
 	}
 	return reply
 }
@@ -411,6 +450,8 @@ func txResult2buyOrderReply(txResult *types.TxResult) *pty.ReplyBuyOrder {
 				Height:            receipt.Base.Height,
 				Key:               txhash,
 				AssetExec:         receipt.Base.AssetExec,
+				PriceExec:         receipt.Base.PriceExec,
+				PriceSymbol:       receipt.Base.PriceSymbol,
 			}
 			tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 			return reply
@@ -486,6 +527,8 @@ func buyBase2Order(base *pty.ReceiptBuyBase, txHash string, blockTime int64) *pt
 		BlockTime:         blockTime,
 		IsSellOrder:       false,
 		AssetExec:         base.AssetExec,
+		PriceExec:         base.PriceExec,
+		PriceSymbol:       base.PriceSymbol,
 	}
 	tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 	return reply
@@ -524,6 +567,8 @@ func sellBase2Order(base *pty.ReceiptSellBase, txHash string, blockTime int64) *
 		BlockTime:         blockTime,
 		IsSellOrder:       true,
 		AssetExec:         base.AssetExec,
+		PriceExec:         base.PriceExec,
+		PriceSymbol:       base.PriceSymbol,
 	}
 	tradelog.Debug("txResult2sellOrderReply", "show reply", reply)
 	return reply
@@ -732,9 +777,42 @@ func fmtReply(order *pty.LocalOrder) *pty.ReplyTradeOrder {
 		BlockTime:         order.BlockTime,
 		IsSellOrder:       order.IsSellOrder,
 		AssetExec:         order.AssetExec,
+		PriceExec:         order.PriceExec,
+		PriceSymbol:       order.PriceSymbol,
 	}
 }
 
+// GetPairOrders 按交易对分页列出订单
+func (t *trade) GetPairOrders(req *pty.ReqPairOrders) (types.Message, error) {
+	if req.AssetExec == "" || req.TokenSymbol == "" || (req.PriceExec == "") != (req.PriceSymbol == "") {
+		return nil, types.ErrInvalidParam
+	}
+	order := pty.LocalOrder{
+		AssetExec:   req.AssetExec,
+		AssetSymbol: req.TokenSymbol,
+		PriceExec:   req.PriceExec,
+		PriceSymbol: req.PriceSymbol,
+		IsSellOrder: req.IsSell,
+		IsFinished:  req.IsFinished,
+		TxIndex:     req.FromKey,
+	}
+	rows, err := list(t.GetLocalDB(), "pair_isSell_isFinished", &order, req.Count, req.Direction)
+	if err != nil {
+		tradelog.Error("GetPairOrders", "err", err)
+		return nil, err
+	}
+	var replys pty.ReplyTradeOrders
+	for _, row := range rows {
+		o, ok := row.Data.(*pty.LocalOrder)
+		if !ok {
+			tradelog.Error("GetPairOrders", "err", "bad row type")
+			return nil, types.ErrTypeAsset
+		}
+		replys.Orders = append(replys.Orders, fmtReply(o))
+	}
+	return &replys, nil
+}
+
 func (t *trade) GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error) {
 	query := NewOrderTable(t.GetLocalDB())
 	tradelog.Debug("query GetData dbg", "primary", req.FromKey)
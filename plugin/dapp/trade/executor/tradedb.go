@@ -61,6 +61,8 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 		TxHash:            txhash,
 		Height:            selldb.Height,
 		AssetExec:         selldb.AssetExec,
+		PriceExec:         selldb.PriceExec,
+		PriceSymbol:       selldb.PriceSymbol,
 	}
 	if pty.TyLogTradeSellLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeSellLimit{Base: base}
@@ -91,6 +93,8 @@ func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash str
 		TxHash:            txhash,
 		Height:            selldb.Height,
 		AssetExec:         selldb.AssetExec,
+		PriceExec:         selldb.PriceExec,
+		PriceSymbol:       selldb.PriceSymbol,
 	}
 
 	receipt := &pty.ReceiptTradeBuyMarket{Base: base}
@@ -183,6 +187,8 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 		TxHash:            txhash,
 		Height:            buydb.Height,
 		AssetExec:         buydb.AssetExec,
+		PriceExec:         buydb.PriceExec,
+		PriceSymbol:       buydb.PriceSymbol,
 	}
 	if pty.TyLogTradeBuyLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeBuyLimit{Base: base}
@@ -231,6 +237,8 @@ func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt in
 		TxHash:            txhash,
 		Height:            buydb.Height,
 		AssetExec:         buydb.AssetExec,
+		PriceExec:         buydb.PriceExec,
+		PriceSymbol:       buydb.PriceSymbol,
 	}
 	receiptSellMarket := &pty.ReceiptSellMarket{Base: base}
 	log.Log = types.Encode(receiptSellMarket)
@@ -246,13 +254,31 @@ type tradeAction struct {
 	blocktime    int64
 	height       int64
 	execaddr     string
+	index        int64
 }
 
-func newTradeAction(t *trade, tx *types.Transaction) *tradeAction {
+func newTradeAction(t *trade, tx *types.Transaction, index int) *tradeAction {
 	hash := hex.EncodeToString(tx.Hash())
 	fromaddr := tx.From()
 	return &tradeAction{t.GetCoinsAccount(), t.GetStateDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer))}
+		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), int64(index)}
+}
+
+// 订单的计价资产账户, 没有指定时为coins
+func (action *tradeAction) priceAccountDB(priceExec, priceSymbol string) (*account.DB, error) {
+	if priceExec == "" {
+		return action.coinsAccount, nil
+	}
+	return account.NewAccountDB(priceExec, priceSymbol, action.db)
+}
+
+// checkFillTransfer 成交之前检查交易的资产和计价资产是否可以在卖方和买方之间转移
+func (action *tradeAction) checkFillTransfer(exec, symbol, priceExec, priceSymbol, seller, buyer string) error {
+	err := checkTokenTransfer(action.height, action.db, assetExec(action.height, exec), symbol, seller, buyer)
+	if err != nil {
+		return err
+	}
+	return checkTokenTransfer(action.height, action.db, priceExec, priceSymbol, buyer, seller)
 }
 
 func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, error) {
@@ -262,6 +288,9 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 	if !checkAsset(action.height, sell.AssetExec, sell.TokenSymbol) {
 		return nil, types.ErrInvalidParam
 	}
+	if !checkPrice(action.height, sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceSymbol) {
+		return nil, types.ErrInvalidParam
+	}
 
 	accDB, err := createAccountDB(action.height, action.db, sell.AssetExec, sell.TokenSymbol)
 	if err != nil {
@@ -292,6 +321,8 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 		Status:            pty.TradeOrderStatusOnSale,
 		Height:            action.height,
 		AssetExec:         sell.AssetExec,
+		PriceExec:         sell.PriceExec,
+		PriceSymbol:       sell.PriceSymbol,
 	}
 
 	tokendb := newSellDB(sellOrder)
@@ -333,8 +364,17 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, pty.ErrTCntLessThanMinBoardlot
 	}
 
+	err = action.checkFillTransfer(sellOrder.AssetExec, sellOrder.TokenSymbol, sellOrder.PriceExec, sellOrder.PriceSymbol,
+		sellOrder.Address, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
 	//首先购买费用的划转
-	receiptFromAcc, err := action.coinsAccount.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+	priceAcc, err := action.priceAccountDB(sellOrder.PriceExec, sellOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
 	if err != nil {
 		tradelog.Error("account.Transfer ", "addrFrom", action.fromaddr, "addrTo", sellOrder.Address,
 			"amount", buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
@@ -352,7 +392,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 			"addrTo", action.fromaddr, "execaddr", action.execaddr,
 			"amount", buyOrder.BoardlotCnt*sellOrder.AmountPerBoardlot)
 		//因为未能成功将对应的token进行转账，所以需要将购买方的账户资金进行回退
-		action.coinsAccount.ExecTransfer(sellOrder.Address, action.fromaddr, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+		priceAcc.ExecTransfer(sellOrder.Address, action.fromaddr, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
 		return nil, err
 	}
 
@@ -459,10 +499,17 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 	if !checkAsset(action.height, buy.AssetExec, buy.TokenSymbol) {
 		return nil, types.ErrInvalidParam
 	}
+	if !checkPrice(action.height, buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceSymbol) {
+		return nil, types.ErrInvalidParam
+	}
 
 	// check enough bty
+	priceAcc, err := action.priceAccountDB(buy.PriceExec, buy.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
 	amount := buy.PricePerBoardlot * buy.TotalBoardlot
-	receipt, err := action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, amount)
+	receipt, err := priceAcc.ExecFrozen(action.fromaddr, action.execaddr, amount)
 	if err != nil {
 		tradelog.Error("trade tradeBuyLimit ", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", amount)
 		return nil, err
@@ -482,6 +529,8 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 		Status:            pty.TradeOrderStatusOnBuy,
 		Height:            action.height,
 		AssetExec:         buy.AssetExec,
+		PriceExec:         buy.PriceExec,
+		PriceSymbol:       buy.PriceSymbol,
 	}
 
 	tokendb := newBuyDB(buyOrder)
@@ -520,6 +569,11 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		return nil, pty.ErrTCntLessThanMinBoardlot
 	}
 
+	err = action.checkFillTransfer(buyOrder.AssetExec, buyOrder.TokenSymbol, buyOrder.PriceExec, buyOrder.PriceSymbol,
+		action.fromaddr, buyOrder.Address)
+	if err != nil {
+		return nil, err
+	}
 	// 打token
 	accDB, err := createAccountDB(action.height, action.db, buyOrder.AssetExec, buyOrder.TokenSymbol)
 	if err != nil {
@@ -539,7 +593,11 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	//首先购买费用的划转
 	amount := sellOrder.BoardlotCnt * buyOrder.PricePerBoardlot
 	tradelog.Debug("tradeSellMarket", "step2 cnt", sellOrder.BoardlotCnt, "price", buyOrder.PricePerBoardlot, "amount", amount)
-	receiptFromAcc, err := action.coinsAccount.ExecTransferFrozen(buyOrder.Address, action.fromaddr, action.execaddr, amount)
+	priceAcc, err := action.priceAccountDB(buyOrder.PriceExec, buyOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receiptFromAcc, err := priceAcc.ExecTransferFrozen(buyOrder.Address, action.fromaddr, action.execaddr, amount)
 	if err != nil {
 		tradelog.Error("account.Transfer ", "addrFrom", buyOrder.Address, "addrTo", action.fromaddr,
 			"amount", amount)
@@ -596,7 +654,11 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
 	tradeRest := (buyOrder.TotalBoardlot - buyOrder.BoughtBoardlot) * buyOrder.PricePerBoardlot
 	//tradelog.Info("tradeRevokeBuyLimit", "total-b", buyOrder.TotalBoardlot, "price", buyOrder.PricePerBoardlot, "amount", tradeRest)
-	receiptFromExecAcc, err := action.coinsAccount.ExecActive(buyOrder.Address, action.execaddr, tradeRest)
+	priceAcc, err := action.priceAccountDB(buyOrder.PriceExec, buyOrder.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+	receiptFromExecAcc, err := priceAcc.ExecActive(buyOrder.Address, action.execaddr, tradeRest)
 	if err != nil {
 		tradelog.Error("account.ExecActive bty ", "addrFrom", buyOrder.Address, "execaddr", action.execaddr, "amount", tradeRest)
 		return nil, err
@@ -8,6 +8,8 @@ import (
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	pt "github.com/33cn/plugin/plugin/dapp/trade/types"
 )
 
@@ -34,9 +36,23 @@ func GetExecSymbol(order *pt.SellOrder) (string, string) {
 	return order.AssetExec, order.TokenSymbol
 }
 
+// tradeAssetExecs 交易和计价的资产只能在这些执行器中, 成交时通过它们的账户结算
+var tradeAssetExecs = map[string]bool{
+	"token":     true,
+	"paracross": true,
+}
+
+// checkAssetExec ForkTradePriceX之后交易和计价的资产都要检查执行器
+func checkAssetExec(height int64, exec string) bool {
+	if !types.IsDappFork(height, pt.TradeX, pt.ForkTradePriceX) {
+		return true
+	}
+	return tradeAssetExecs[exec]
+}
+
 func checkAsset(height int64, exec, symbol string) bool {
 	if types.IsDappFork(height, pt.TradeX, pt.ForkTradeAssetX) {
-		if exec == "" || symbol == "" {
+		if exec == "" || symbol == "" || !checkAssetExec(height, exec) {
 			return false
 		}
 	} else {
@@ -47,10 +63,39 @@ func checkAsset(height int64, exec, symbol string) bool {
 	return true
 }
 
-func createAccountDB(height int64, db db.KV, exec, symbol string) (*account.DB, error) {
+// 计价资产为空时用coins计价, 否则计价资产和交易的资产不能相同
+func checkPrice(height int64, exec, symbol, priceExec, priceSymbol string) bool {
+	if !types.IsDappFork(height, pt.TradeX, pt.ForkTradePriceX) {
+		return priceExec == "" && priceSymbol == ""
+	}
+	if priceExec == "" && priceSymbol == "" {
+		return true
+	}
+	if priceExec == "" || priceSymbol == "" || !checkAssetExec(height, priceExec) {
+		return false
+	}
+	return priceExec != exec || priceSymbol != symbol
+}
+
+// assetExec 交易的资产所在的执行器, ForkTradeAssetX之前只有token
+func assetExec(height int64, exec string) string {
 	if types.IsDappFork(height, pt.TradeX, pt.ForkTradeAssetX) {
-		return account.NewAccountDB(exec, symbol, db)
+		return exec
 	}
+	return defaultAssetExec
+}
+
+func createAccountDB(height int64, db db.KV, exec, symbol string) (*account.DB, error) {
+	return account.NewAccountDB(assetExec(height, exec), symbol, db)
+}
 
-	return account.NewAccountDB(defaultAssetExec, symbol, db)
+// tokenTransferCheck token执行器的转账检查, 直接调用, 不依赖token执行器是否注册
+var tokenTransferCheck = tokenexec.CheckTokenTransfer
+
+// checkTokenTransfer 成交时转移的资产是token时, 和token转账一样检查token是否暂停以及买卖双方是否被冻结
+func checkTokenTransfer(height int64, db db.KV, exec, symbol string, addrs ...string) error {
+	if exec != tokenty.TokenX {
+		return nil
+	}
+	return tokenTransferCheck(db, height, symbol, addrs...)
 }
@@ -28,6 +28,8 @@ func (jrpc *Jrpc) CreateRawTradeSellTx(in *ptypes.TradeSellTx, result *interface
 		Stoptime:          0,
 		Crowdfund:         false,
 		AssetExec:         in.AssetExec,
+		PriceExec:         in.PriceExec,
+		PriceSymbol:       in.PriceSymbol,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeSellTx(context.Background(), param)
@@ -85,6 +87,8 @@ func (jrpc *Jrpc) CreateRawTradeBuyLimitTx(in *ptypes.TradeBuyLimitTx, result *i
 		PricePerBoardlot:  in.PricePerBoardlot,
 		TotalBoardlot:     in.TotalBoardlot,
 		AssetExec:         in.AssetExec,
+		PriceExec:         in.PriceExec,
+		PriceSymbol:       in.PriceSymbol,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeBuyLimitTx(context.Background(), param)
@@ -129,3 +133,41 @@ func (jrpc *Jrpc) CreateRawTradeRevokeBuyTx(in *ptypes.TradeRevokeBuyTx, result
 	*result = hex.EncodeToString(reply.Data)
 	return nil
 }
+
+//CreateRawTradeLimitOrderTx : 创建撮合模式的限价单, 自动和对手盘成交, 未成交部分挂单
+func (jrpc *Jrpc) CreateRawTradeLimitOrderTx(in *ptypes.TradeLimitOrderTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	param := &ptypes.TradeForLimitOrder{
+		TokenSymbol: in.TokenSymbol,
+		AssetExec:   in.AssetExec,
+		IsSell:      in.IsSell,
+		Price:       in.Price,
+		Amount:      in.Amount,
+	}
+
+	reply, err := jrpc.cli.CreateRawTradeLimitOrderTx(context.Background(), param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+//CreateRawTradeRevokeLimitOrderTx : 撤销撮合模式的限价单
+func (jrpc *Jrpc) CreateRawTradeRevokeLimitOrderTx(in *ptypes.TradeRevokeLimitOrderTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	param := &ptypes.TradeForRevokeLimitOrder{
+		OrderID: in.OrderID,
+	}
+
+	reply, err := jrpc.cli.CreateRawTradeRevokeLimitOrderTx(context.Background(), param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
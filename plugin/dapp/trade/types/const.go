@@ -12,6 +12,8 @@ const (
 	TradeSellMarket
 	TradeBuyLimit
 	TradeRevokeBuy
+	TradeLimitOrder
+	TradeRevokeLimitOrder
 )
 
 // log
@@ -23,6 +25,10 @@ const (
 	TyLogTradeSellMarket = 330
 	TyLogTradeBuyLimit   = 331
 	TyLogTradeBuyRevoke  = 332
+
+	TyLogTradeLimitOrder  = 333
+	TyLogTradeLimitFill   = 334
+	TyLogTradeLimitRevoke = 335
 )
 
 // 0->not start, 1->on sale, 2->sold out, 3->revoke, 4->expired
@@ -90,4 +96,18 @@ const (
 	ForkTradeBuyLimitX = "ForkTradeBuyLimit"
 	// ForkTradeIDX id without prefix
 	ForkTradeIDX = "ForkTradeID"
+	// ForkTradeOrderBookX support continuous matching limit orders
+	ForkTradeOrderBookX = "ForkTradeOrderBook"
+	// ForkTradePriceX support token priced orders
+	ForkTradePriceX = "ForkTradePrice"
+)
+
+// 撮合模式的限价单
+const (
+	// TradeLotSize 数量必须是它的整数倍
+	TradeLotSize = 1e4
+	// TradePriceTick 价格必须是它的整数倍
+	TradePriceTick = 1e4
+	// TradeMaxFillsPerTx 一笔交易最多成交的次数, 超过后未成交部分撤销
+	TradeMaxFillsPerTx = 100
 )
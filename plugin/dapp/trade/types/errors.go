@@ -37,4 +37,14 @@ var (
 	ErrTBuyOrderRevoke = errors.New("ErrTradeBuyOrderRevokeNotAllowed")
 	//ErrTCntLessThanMinBoardlot :
 	ErrTCntLessThanMinBoardlot = errors.New("ErrTradeCountLessThanMinBoardlot")
+	//ErrTLimitOrderNotExist :
+	ErrTLimitOrderNotExist = errors.New("ErrTradeLimitOrderNotExist")
+	//ErrTLimitOrderFinished :
+	ErrTLimitOrderFinished = errors.New("ErrTradeLimitOrderFinished")
+	//ErrTLimitOrderRevoke :
+	ErrTLimitOrderRevoke = errors.New("ErrTradeLimitOrderRevokeNotAllowed")
+	//ErrTLimitOrderPrice :
+	ErrTLimitOrderPrice = errors.New("ErrTradeLimitOrderPrice")
+	//ErrTLimitOrderAmount :
+	ErrTLimitOrderAmount = errors.New("ErrTradeLimitOrderAmount")
 )
@@ -24,6 +24,9 @@ var (
 		"BuyLimit":   TradeBuyLimit,
 		"SellMarket": TradeSellMarket,
 		"RevokeBuy":  TradeRevokeBuy,
+
+		"LimitOrder":       TradeLimitOrder,
+		"RevokeLimitOrder": TradeRevokeLimitOrder,
 	}
 
 	logInfo = map[int64]*types.LogInfo{
@@ -33,6 +36,10 @@ var (
 		TyLogTradeSellMarket: {Ty: reflect.TypeOf(ReceiptSellMarket{}), Name: "LogTradeSellMarket"},
 		TyLogTradeBuyLimit:   {Ty: reflect.TypeOf(ReceiptTradeBuyLimit{}), Name: "LogTradeBuyLimit"},
 		TyLogTradeBuyRevoke:  {Ty: reflect.TypeOf(ReceiptTradeBuyRevoke{}), Name: "LogTradeBuyRevoke"},
+
+		TyLogTradeLimitOrder:  {Ty: reflect.TypeOf(ReceiptTradeLimitOrder{}), Name: "LogTradeLimitOrder"},
+		TyLogTradeLimitFill:   {Ty: reflect.TypeOf(ReceiptTradeLimitFill{}), Name: "LogTradeLimitFill"},
+		TyLogTradeLimitRevoke: {Ty: reflect.TypeOf(ReceiptTradeLimitOrder{}), Name: "LogTradeLimitRevoke"},
 	}
 )
 
@@ -55,6 +62,8 @@ func init() {
 	types.RegisterDappFork(TradeX, ForkTradeBuyLimitX, 301000)
 	types.RegisterDappFork(TradeX, ForkTradeAssetX, 1010000)
 	types.RegisterDappFork(TradeX, ForkTradeIDX, 1450000)
+	types.RegisterDappFork(TradeX, ForkTradeOrderBookX, types.MaxHeight)
+	types.RegisterDappFork(TradeX, ForkTradePriceX, types.MaxHeight)
 }
 
 type tradeType struct {
@@ -90,6 +99,10 @@ func (t *tradeType) ActionName(tx *types.Transaction) string {
 		return "sellmarkettoken"
 	} else if action.Ty == TradeRevokeBuy && action.GetRevokeBuy() != nil {
 		return "revokebuytoken"
+	} else if action.Ty == TradeLimitOrder && action.GetLimitOrder() != nil {
+		return "limitorder"
+	} else if action.Ty == TradeRevokeLimitOrder && action.GetRevokeLimitOrder() != nil {
+		return "revokelimitorder"
 	}
 	return "unknown"
 }
@@ -162,6 +175,22 @@ func (t *tradeType) CreateTx(action string, message json.RawMessage) (*types.Tra
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawTradeRevokeBuyTx(&param)
+	} else if action == "TradeLimitOrder" {
+		var param TradeLimitOrderTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawTradeLimitOrderTx(&param)
+	} else if action == "TradeRevokeLimitOrder" {
+		var param TradeRevokeLimitOrderTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawTradeRevokeLimitOrderTx(&param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -182,6 +211,8 @@ func CreateRawTradeSellTx(parm *TradeSellTx) (*types.Transaction, error) {
 		Stoptime:          0,
 		Crowdfund:         false,
 		AssetExec:         parm.AssetExec,
+		PriceExec:         parm.PriceExec,
+		PriceSymbol:       parm.PriceSymbol,
 	}
 	sell := &Trade{
 		Ty:    TradeSellLimit,
@@ -229,6 +260,8 @@ func CreateRawTradeBuyLimitTx(parm *TradeBuyLimitTx) (*types.Transaction, error)
 		PricePerBoardlot:  parm.PricePerBoardlot,
 		TotalBoardlot:     parm.TotalBoardlot,
 		AssetExec:         parm.AssetExec,
+		PriceExec:         parm.PriceExec,
+		PriceSymbol:       parm.PriceSymbol,
 	}
 	buyLimit := &Trade{
 		Ty:    TradeBuyLimit,
@@ -263,3 +296,35 @@ func CreateRawTradeRevokeBuyTx(parm *TradeRevokeBuyTx) (*types.Transaction, erro
 	}
 	return types.CreateFormatTx(types.ExecName(TradeX), types.Encode(buy))
 }
+
+//CreateRawTradeLimitOrderTx : 创建撮合模式的限价单交易
+func CreateRawTradeLimitOrderTx(parm *TradeLimitOrderTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	v := &TradeForLimitOrder{
+		TokenSymbol: parm.TokenSymbol,
+		AssetExec:   parm.AssetExec,
+		IsSell:      parm.IsSell,
+		Price:       parm.Price,
+		Amount:      parm.Amount,
+	}
+	limitOrder := &Trade{
+		Ty:    TradeLimitOrder,
+		Value: &Trade_LimitOrder{v},
+	}
+	return types.CreateFormatTx(types.ExecName(TradeX), types.Encode(limitOrder))
+}
+
+//CreateRawTradeRevokeLimitOrderTx : 创建撤销撮合模式限价单的交易
+func CreateRawTradeRevokeLimitOrderTx(parm *TradeRevokeLimitOrderTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	v := &TradeForRevokeLimitOrder{OrderID: parm.OrderID}
+	revoke := &Trade{
+		Ty:    TradeRevokeLimitOrder,
+		Value: &Trade_RevokeLimitOrder{v},
+	}
+	return types.CreateFormatTx(types.ExecName(TradeX), types.Encode(revoke))
+}
@@ -34,6 +34,8 @@ type Trade struct {
 	//	*Trade_BuyLimit
 	//	*Trade_SellMarket
 	//	*Trade_RevokeBuy
+	//	*Trade_LimitOrder
+	//	*Trade_RevokeLimitOrder
 	Value                isTrade_Value `protobuf_oneof:"value"`
 	Ty                   int32         `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -94,6 +96,14 @@ type Trade_RevokeBuy struct {
 	RevokeBuy *TradeForRevokeBuy `protobuf:"bytes,7,opt,name=revokeBuy,proto3,oneof"`
 }
 
+type Trade_LimitOrder struct {
+	LimitOrder *TradeForLimitOrder `protobuf:"bytes,8,opt,name=limitOrder,proto3,oneof"`
+}
+
+type Trade_RevokeLimitOrder struct {
+	RevokeLimitOrder *TradeForRevokeLimitOrder `protobuf:"bytes,9,opt,name=revokeLimitOrder,proto3,oneof"`
+}
+
 func (*Trade_SellLimit) isTrade_Value() {}
 
 func (*Trade_BuyMarket) isTrade_Value() {}
@@ -106,6 +116,10 @@ func (*Trade_SellMarket) isTrade_Value() {}
 
 func (*Trade_RevokeBuy) isTrade_Value() {}
 
+func (*Trade_LimitOrder) isTrade_Value() {}
+
+func (*Trade_RevokeLimitOrder) isTrade_Value() {}
+
 func (m *Trade) GetValue() isTrade_Value {
 	if m != nil {
 		return m.Value
@@ -155,6 +169,20 @@ func (m *Trade) GetRevokeBuy() *TradeForRevokeBuy {
 	return nil
 }
 
+func (m *Trade) GetLimitOrder() *TradeForLimitOrder {
+	if x, ok := m.GetValue().(*Trade_LimitOrder); ok {
+		return x.LimitOrder
+	}
+	return nil
+}
+
+func (m *Trade) GetRevokeLimitOrder() *TradeForRevokeLimitOrder {
+	if x, ok := m.GetValue().(*Trade_RevokeLimitOrder); ok {
+		return x.RevokeLimitOrder
+	}
+	return nil
+}
+
 func (m *Trade) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -171,6 +199,8 @@ func (*Trade) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Trade_BuyLimit)(nil),
 		(*Trade_SellMarket)(nil),
 		(*Trade_RevokeBuy)(nil),
+		(*Trade_LimitOrder)(nil),
+		(*Trade_RevokeLimitOrder)(nil),
 	}
 }
 
@@ -208,6 +238,16 @@ func _Trade_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.RevokeBuy); err != nil {
 			return err
 		}
+	case *Trade_LimitOrder:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.LimitOrder); err != nil {
+			return err
+		}
+	case *Trade_RevokeLimitOrder:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RevokeLimitOrder); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Trade.Value has unexpected type %T", x)
@@ -266,6 +306,22 @@ func _Trade_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Value = &Trade_RevokeBuy{msg}
 		return true, err
+	case 8: // value.limitOrder
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TradeForLimitOrder)
+		err := b.DecodeMessage(msg)
+		m.Value = &Trade_LimitOrder{msg}
+		return true, err
+	case 9: // value.revokeLimitOrder
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TradeForRevokeLimitOrder)
+		err := b.DecodeMessage(msg)
+		m.Value = &Trade_RevokeLimitOrder{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -305,6 +361,16 @@ func _Trade_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Trade_LimitOrder:
+		s := proto.Size(x.LimitOrder)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Trade_RevokeLimitOrder:
+		s := proto.Size(x.RevokeLimitOrder)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -327,7 +393,10 @@ type TradeForSell struct {
 	Stoptime  int64 `protobuf:"varint,7,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,8,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
 	// 资产来源
-	AssetExec            string   `protobuf:"bytes,9,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetExec string `protobuf:"bytes,9,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	// 计价资产, 为空时用coins计价
+	PriceExec            string   `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -421,6 +490,20 @@ func (m *TradeForSell) GetAssetExec() string {
 	return ""
 }
 
+func (m *TradeForSell) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *TradeForSell) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
 // 购买者发起交易用来购买token持有者之前挂单出售的token
 // 其中的hash为token出售者发起出售交易的hash
 type TradeForBuy struct {
@@ -518,6 +601,8 @@ type TradeForBuyLimit struct {
 	PricePerBoardlot     int64    `protobuf:"varint,4,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
 	TotalBoardlot        int64    `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	AssetExec            string   `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -590,6 +675,20 @@ func (m *TradeForBuyLimit) GetAssetExec() string {
 	return ""
 }
 
+func (m *TradeForBuyLimit) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *TradeForBuyLimit) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
 // 现价卖单
 type TradeForSellMarket struct {
 	BuyID                string   `protobuf:"bytes,1,opt,name=buyID,proto3" json:"buyID,omitempty"`
@@ -678,6 +777,120 @@ func (m *TradeForRevokeBuy) GetBuyID() string {
 	return ""
 }
 
+// 撮合模式的限价单, 按价格时间优先自动和对手盘成交, 未成交部分挂在订单簿中
+type TradeForLimitOrder struct {
+	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec   string `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	IsSell      bool   `protobuf:"varint,3,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	// 每个token的价格, 单位同coins
+	Price int64 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// token的数量
+	Amount               int64    `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeForLimitOrder) Reset()         { *m = TradeForLimitOrder{} }
+func (m *TradeForLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*TradeForLimitOrder) ProtoMessage()    {}
+func (*TradeForLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{7}
+}
+
+func (m *TradeForLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeForLimitOrder.Unmarshal(m, b)
+}
+func (m *TradeForLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeForLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *TradeForLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForLimitOrder.Merge(m, src)
+}
+func (m *TradeForLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_TradeForLimitOrder.Size(m)
+}
+func (m *TradeForLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeForLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeForLimitOrder proto.InternalMessageInfo
+
+func (m *TradeForLimitOrder) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *TradeForLimitOrder) GetIsSell() bool {
+	if m != nil {
+		return m.IsSell
+	}
+	return false
+}
+
+func (m *TradeForLimitOrder) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TradeForLimitOrder) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// 撤销撮合模式的限价单
+type TradeForRevokeLimitOrder struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeForRevokeLimitOrder) Reset()         { *m = TradeForRevokeLimitOrder{} }
+func (m *TradeForRevokeLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*TradeForRevokeLimitOrder) ProtoMessage()    {}
+func (*TradeForRevokeLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{8}
+}
+
+func (m *TradeForRevokeLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeForRevokeLimitOrder.Unmarshal(m, b)
+}
+func (m *TradeForRevokeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeForRevokeLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *TradeForRevokeLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForRevokeLimitOrder.Merge(m, src)
+}
+func (m *TradeForRevokeLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_TradeForRevokeLimitOrder.Size(m)
+}
+func (m *TradeForRevokeLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeForRevokeLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeForRevokeLimitOrder proto.InternalMessageInfo
+
+func (m *TradeForRevokeLimitOrder) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
 // 数据库部分
 type SellOrder struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -694,10 +907,13 @@ type SellOrder struct {
 	Stoptime  int64 `protobuf:"varint,9,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,10,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
 	//此处使用tx的hash来指定
-	SellID               string   `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	Status               int32    `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
-	Height               int64    `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	SellID    string `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	Status    int32  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	Height    int64  `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec string `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	// 计价资产, 为空时用coins计价
+	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -707,7 +923,7 @@ func (m *SellOrder) Reset()         { *m = SellOrder{} }
 func (m *SellOrder) String() string { return proto.CompactTextString(m) }
 func (*SellOrder) ProtoMessage()    {}
 func (*SellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{7}
+	return fileDescriptor_ee944bd90e8a0312, []int{9}
 }
 
 func (m *SellOrder) XXX_Unmarshal(b []byte) error {
@@ -826,6 +1042,20 @@ func (m *SellOrder) GetAssetExec() string {
 	return ""
 }
 
+func (m *SellOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *SellOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
 // 限价买单数据库记录
 type BuyLimitOrder struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -839,6 +1069,8 @@ type BuyLimitOrder struct {
 	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
 	Height               int64    `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
 	AssetExec            string   `protobuf:"bytes,11,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,12,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,13,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -848,7 +1080,7 @@ func (m *BuyLimitOrder) Reset()         { *m = BuyLimitOrder{} }
 func (m *BuyLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*BuyLimitOrder) ProtoMessage()    {}
 func (*BuyLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{8}
+	return fileDescriptor_ee944bd90e8a0312, []int{10}
 }
 
 func (m *BuyLimitOrder) XXX_Unmarshal(b []byte) error {
@@ -946,189 +1178,690 @@ func (m *BuyLimitOrder) GetAssetExec() string {
 	return ""
 }
 
-// 执行器日志部分
-type ReceiptBuyBase struct {
-	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	AmountPerBoardlot    string   `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	PricePerBoardlot     string   `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	BoughtBoardlot       int64    `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
-	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+func (m *BuyLimitOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *BuyLimitOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+// 撮合模式的限价单
+type LimitOrder struct {
+	OrderID     string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	TokenSymbol string `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec   string `protobuf:"bytes,3,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	Owner       string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsSell      bool   `protobuf:"varint,5,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	Price       int64  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Amount      int64  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 已经成交的数量
+	Executed             int64    `protobuf:"varint,8,opt,name=executed,proto3" json:"executed,omitempty"`
+	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	Height               int64    `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	BlockTime            int64    `protobuf:"varint,12,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	TxHash               string   `protobuf:"bytes,13,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
-func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
-func (*ReceiptBuyBase) ProtoMessage()    {}
-func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{9}
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{11}
 }
 
-func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReceiptBuyBase.Unmarshal(m, b)
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LimitOrder.Unmarshal(m, b)
 }
-func (m *ReceiptBuyBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReceiptBuyBase.Marshal(b, m, deterministic)
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
 }
-func (m *ReceiptBuyBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptBuyBase.Merge(m, src)
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
 }
-func (m *ReceiptBuyBase) XXX_Size() int {
-	return xxx_messageInfo_ReceiptBuyBase.Size(m)
+func (m *LimitOrder) XXX_Size() int {
+	return xxx_messageInfo_LimitOrder.Size(m)
 }
-func (m *ReceiptBuyBase) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiptBuyBase.DiscardUnknown(m)
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiptBuyBase proto.InternalMessageInfo
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
 
-func (m *ReceiptBuyBase) GetTokenSymbol() string {
+func (m *LimitOrder) GetOrderID() string {
 	if m != nil {
-		return m.TokenSymbol
+		return m.OrderID
 	}
 	return ""
 }
 
-func (m *ReceiptBuyBase) GetOwner() string {
+func (m *LimitOrder) GetTokenSymbol() string {
 	if m != nil {
-		return m.Owner
+		return m.TokenSymbol
 	}
 	return ""
 }
 
-func (m *ReceiptBuyBase) GetAmountPerBoardlot() string {
+func (m *LimitOrder) GetAssetExec() string {
 	if m != nil {
-		return m.AmountPerBoardlot
+		return m.AssetExec
 	}
 	return ""
 }
 
-func (m *ReceiptBuyBase) GetMinBoardlot() int64 {
+func (m *LimitOrder) GetOwner() string {
 	if m != nil {
-		return m.MinBoardlot
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
-func (m *ReceiptBuyBase) GetPricePerBoardlot() string {
+func (m *LimitOrder) GetIsSell() bool {
 	if m != nil {
-		return m.PricePerBoardlot
+		return m.IsSell
 	}
-	return ""
+	return false
 }
 
-func (m *ReceiptBuyBase) GetTotalBoardlot() int64 {
+func (m *LimitOrder) GetPrice() int64 {
 	if m != nil {
-		return m.TotalBoardlot
+		return m.Price
 	}
 	return 0
 }
 
-func (m *ReceiptBuyBase) GetBoughtBoardlot() int64 {
+func (m *LimitOrder) GetAmount() int64 {
 	if m != nil {
-		return m.BoughtBoardlot
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *ReceiptBuyBase) GetBuyID() string {
+func (m *LimitOrder) GetExecuted() int64 {
 	if m != nil {
-		return m.BuyID
+		return m.Executed
 	}
-	return ""
+	return 0
 }
 
-func (m *ReceiptBuyBase) GetStatus() string {
+func (m *LimitOrder) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
-	return ""
+	return 0
 }
 
-func (m *ReceiptBuyBase) GetSellID() string {
+func (m *LimitOrder) GetHeight() int64 {
 	if m != nil {
-		return m.SellID
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *ReceiptBuyBase) GetTxHash() string {
+func (m *LimitOrder) GetIndex() int64 {
 	if m != nil {
-		return m.TxHash
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *ReceiptBuyBase) GetHeight() int64 {
+func (m *LimitOrder) GetBlockTime() int64 {
 	if m != nil {
-		return m.Height
+		return m.BlockTime
 	}
 	return 0
 }
 
-func (m *ReceiptBuyBase) GetAssetExec() string {
+func (m *LimitOrder) GetTxHash() string {
 	if m != nil {
-		return m.AssetExec
+		return m.TxHash
 	}
 	return ""
 }
 
-type ReceiptSellBase struct {
-	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	//每一手出售的token的数量
-	AmountPerBoardlot string `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	//每一手token的价格
-	PricePerBoardlot string `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot    int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	SoldBoardlot     int64  `protobuf:"varint,7,opt,name=soldBoardlot,proto3" json:"soldBoardlot,omitempty"`
-	//此次出售的起始时间，如果非众筹则可以忽略此时间
-	Starttime int64 `protobuf:"varint,8,opt,name=starttime,proto3" json:"starttime,omitempty"`
-	Stoptime  int64 `protobuf:"varint,9,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
-	Crowdfund bool  `protobuf:"varint,10,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
-	//此处使用tx的hash来指定
-	SellID string `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	// buyid
-	BuyID                string   `protobuf:"bytes,13,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	TxHash               string   `protobuf:"bytes,14,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
-	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+// 订单簿中一侧最先和最后成交的价格档位, 0表示没有挂单
+type TradeBookSide struct {
+	Best                 int64    `protobuf:"varint,1,opt,name=best,proto3" json:"best,omitempty"`
+	Worst                int64    `protobuf:"varint,2,opt,name=worst,proto3" json:"worst,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
-func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
-func (*ReceiptSellBase) ProtoMessage()    {}
-func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{10}
+func (m *TradeBookSide) Reset()         { *m = TradeBookSide{} }
+func (m *TradeBookSide) String() string { return proto.CompactTextString(m) }
+func (*TradeBookSide) ProtoMessage()    {}
+func (*TradeBookSide) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{12}
 }
 
-func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReceiptSellBase.Unmarshal(m, b)
+func (m *TradeBookSide) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeBookSide.Unmarshal(m, b)
 }
-func (m *ReceiptSellBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReceiptSellBase.Marshal(b, m, deterministic)
+func (m *TradeBookSide) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeBookSide.Marshal(b, m, deterministic)
 }
-func (m *ReceiptSellBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptSellBase.Merge(m, src)
+func (m *TradeBookSide) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeBookSide.Merge(m, src)
 }
-func (m *ReceiptSellBase) XXX_Size() int {
-	return xxx_messageInfo_ReceiptSellBase.Size(m)
+func (m *TradeBookSide) XXX_Size() int {
+	return xxx_messageInfo_TradeBookSide.Size(m)
 }
-func (m *ReceiptSellBase) XXX_DiscardUnknown() {
+func (m *TradeBookSide) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeBookSide.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeBookSide proto.InternalMessageInfo
+
+func (m *TradeBookSide) GetBest() int64 {
+	if m != nil {
+		return m.Best
+	}
+	return 0
+}
+
+func (m *TradeBookSide) GetWorst() int64 {
+	if m != nil {
+		return m.Worst
+	}
+	return 0
+}
+
+// 订单簿中的一个价格档位, 同一侧的档位按成交优先级组成双向链表, 档位上的订单按时间排队
+type TradePriceLevel struct {
+	Price int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// 前后档位的价格, 0表示没有
+	Prev int64 `protobuf:"varint,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	Next int64 `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	// 队首和队尾的订单
+	Head string `protobuf:"bytes,4,opt,name=head,proto3" json:"head,omitempty"`
+	Tail string `protobuf:"bytes,5,opt,name=tail,proto3" json:"tail,omitempty"`
+	// 未成交的数量和订单数
+	Amount               int64    `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Orders               int32    `protobuf:"varint,7,opt,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradePriceLevel) Reset()         { *m = TradePriceLevel{} }
+func (m *TradePriceLevel) String() string { return proto.CompactTextString(m) }
+func (*TradePriceLevel) ProtoMessage()    {}
+func (*TradePriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{13}
+}
+
+func (m *TradePriceLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradePriceLevel.Unmarshal(m, b)
+}
+func (m *TradePriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradePriceLevel.Marshal(b, m, deterministic)
+}
+func (m *TradePriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradePriceLevel.Merge(m, src)
+}
+func (m *TradePriceLevel) XXX_Size() int {
+	return xxx_messageInfo_TradePriceLevel.Size(m)
+}
+func (m *TradePriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradePriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradePriceLevel proto.InternalMessageInfo
+
+func (m *TradePriceLevel) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TradePriceLevel) GetPrev() int64 {
+	if m != nil {
+		return m.Prev
+	}
+	return 0
+}
+
+func (m *TradePriceLevel) GetNext() int64 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *TradePriceLevel) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *TradePriceLevel) GetTail() string {
+	if m != nil {
+		return m.Tail
+	}
+	return ""
+}
+
+func (m *TradePriceLevel) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TradePriceLevel) GetOrders() int32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+// 订单在价格档位队列中的前后订单, 空表示没有
+type TradeOrderNode struct {
+	Prev                 string   `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Next                 string   `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeOrderNode) Reset()         { *m = TradeOrderNode{} }
+func (m *TradeOrderNode) String() string { return proto.CompactTextString(m) }
+func (*TradeOrderNode) ProtoMessage()    {}
+func (*TradeOrderNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{14}
+}
+
+func (m *TradeOrderNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeOrderNode.Unmarshal(m, b)
+}
+func (m *TradeOrderNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeOrderNode.Marshal(b, m, deterministic)
+}
+func (m *TradeOrderNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeOrderNode.Merge(m, src)
+}
+func (m *TradeOrderNode) XXX_Size() int {
+	return xxx_messageInfo_TradeOrderNode.Size(m)
+}
+func (m *TradeOrderNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeOrderNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeOrderNode proto.InternalMessageInfo
+
+func (m *TradeOrderNode) GetPrev() string {
+	if m != nil {
+		return m.Prev
+	}
+	return ""
+}
+
+func (m *TradeOrderNode) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// 一笔撮合成交记录, 按maker的价格成交
+type TradeFill struct {
+	MakerOrderID string `protobuf:"bytes,1,opt,name=makerOrderID,proto3" json:"makerOrderID,omitempty"`
+	TakerOrderID string `protobuf:"bytes,2,opt,name=takerOrderID,proto3" json:"takerOrderID,omitempty"`
+	TokenSymbol  string `protobuf:"bytes,3,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec    string `protobuf:"bytes,4,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	Price        int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Amount       int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Buyer        string `protobuf:"bytes,7,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller       string `protobuf:"bytes,8,opt,name=seller,proto3" json:"seller,omitempty"`
+	TakerIsSell  bool   `protobuf:"varint,9,opt,name=takerIsSell,proto3" json:"takerIsSell,omitempty"`
+	Height       int64  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	Index        int64  `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	BlockTime    int64  `protobuf:"varint,12,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	TxHash       string `protobuf:"bytes,13,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	// 同一笔交易中的成交序号
+	Seq                  int32    `protobuf:"varint,14,opt,name=seq,proto3" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeFill) Reset()         { *m = TradeFill{} }
+func (m *TradeFill) String() string { return proto.CompactTextString(m) }
+func (*TradeFill) ProtoMessage()    {}
+func (*TradeFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{15}
+}
+
+func (m *TradeFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeFill.Unmarshal(m, b)
+}
+func (m *TradeFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeFill.Marshal(b, m, deterministic)
+}
+func (m *TradeFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeFill.Merge(m, src)
+}
+func (m *TradeFill) XXX_Size() int {
+	return xxx_messageInfo_TradeFill.Size(m)
+}
+func (m *TradeFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeFill proto.InternalMessageInfo
+
+func (m *TradeFill) GetMakerOrderID() string {
+	if m != nil {
+		return m.MakerOrderID
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTakerOrderID() string {
+	if m != nil {
+		return m.TakerOrderID
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *TradeFill) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *TradeFill) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TradeFill) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TradeFill) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *TradeFill) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *TradeFill) GetTakerIsSell() bool {
+	if m != nil {
+		return m.TakerIsSell
+	}
+	return false
+}
+
+func (m *TradeFill) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TradeFill) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TradeFill) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *TradeFill) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TradeFill) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+// 执行器日志部分
+type ReceiptBuyBase struct {
+	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AmountPerBoardlot    string   `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	PricePerBoardlot     string   `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	BoughtBoardlot       int64    `protobuf:"varint,7,opt,name=boughtBoardlot,proto3" json:"boughtBoardlot,omitempty"`
+	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec            string   `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,14,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,15,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
+func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
+func (*ReceiptBuyBase) ProtoMessage()    {}
+func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{16}
+}
+
+func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptBuyBase.Unmarshal(m, b)
+}
+func (m *ReceiptBuyBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptBuyBase.Marshal(b, m, deterministic)
+}
+func (m *ReceiptBuyBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptBuyBase.Merge(m, src)
+}
+func (m *ReceiptBuyBase) XXX_Size() int {
+	return xxx_messageInfo_ReceiptBuyBase.Size(m)
+}
+func (m *ReceiptBuyBase) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptBuyBase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptBuyBase proto.InternalMessageInfo
+
+func (m *ReceiptBuyBase) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetAmountPerBoardlot() string {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetMinBoardlot() int64 {
+	if m != nil {
+		return m.MinBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetPricePerBoardlot() string {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetTotalBoardlot() int64 {
+	if m != nil {
+		return m.TotalBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetBoughtBoardlot() int64 {
+	if m != nil {
+		return m.BoughtBoardlot
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetBuyID() string {
+	if m != nil {
+		return m.BuyID
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetSellID() string {
+	if m != nil {
+		return m.SellID
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReceiptBuyBase) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReceiptBuyBase) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+type ReceiptSellBase struct {
+	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	//每一手出售的token的数量
+	AmountPerBoardlot string `protobuf:"bytes,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot       int64  `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	//每一手token的价格
+	PricePerBoardlot string `protobuf:"bytes,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot    int64  `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	SoldBoardlot     int64  `protobuf:"varint,7,opt,name=soldBoardlot,proto3" json:"soldBoardlot,omitempty"`
+	//此次出售的起始时间，如果非众筹则可以忽略此时间
+	Starttime int64 `protobuf:"varint,8,opt,name=starttime,proto3" json:"starttime,omitempty"`
+	Stoptime  int64 `protobuf:"varint,9,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
+	Crowdfund bool  `protobuf:"varint,10,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
+	//此处使用tx的hash来指定
+	SellID string `protobuf:"bytes,11,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	Status string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	// buyid
+	BuyID                string   `protobuf:"bytes,13,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	TxHash               string   `protobuf:"bytes,14,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
+	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
+func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
+func (*ReceiptSellBase) ProtoMessage()    {}
+func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{17}
+}
+
+func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptSellBase.Unmarshal(m, b)
+}
+func (m *ReceiptSellBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptSellBase.Marshal(b, m, deterministic)
+}
+func (m *ReceiptSellBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptSellBase.Merge(m, src)
+}
+func (m *ReceiptSellBase) XXX_Size() int {
+	return xxx_messageInfo_ReceiptSellBase.Size(m)
+}
+func (m *ReceiptSellBase) XXX_DiscardUnknown() {
 	xxx_messageInfo_ReceiptSellBase.DiscardUnknown(m)
 }
 
@@ -1246,6 +1979,20 @@ func (m *ReceiptSellBase) GetAssetExec() string {
 	return ""
 }
 
+func (m *ReceiptSellBase) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReceiptSellBase) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
 type ReceiptTradeBuyMarket struct {
 	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1257,7 +2004,7 @@ func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
 func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
 func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{11}
+	return fileDescriptor_ee944bd90e8a0312, []int{18}
 }
 
 func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
@@ -1296,7 +2043,7 @@ func (m *ReceiptTradeBuyLimit) Reset()         { *m = ReceiptTradeBuyLimit{} }
 func (m *ReceiptTradeBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyLimit) ProtoMessage()    {}
 func (*ReceiptTradeBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{12}
+	return fileDescriptor_ee944bd90e8a0312, []int{19}
 }
 
 func (m *ReceiptTradeBuyLimit) XXX_Unmarshal(b []byte) error {
@@ -1335,7 +2082,7 @@ func (m *ReceiptTradeBuyRevoke) Reset()         { *m = ReceiptTradeBuyRevoke{} }
 func (m *ReceiptTradeBuyRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyRevoke) ProtoMessage()    {}
 func (*ReceiptTradeBuyRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{13}
+	return fileDescriptor_ee944bd90e8a0312, []int{20}
 }
 
 func (m *ReceiptTradeBuyRevoke) XXX_Unmarshal(b []byte) error {
@@ -1374,7 +2121,7 @@ func (m *ReceiptTradeSellLimit) Reset()         { *m = ReceiptTradeSellLimit{} }
 func (m *ReceiptTradeSellLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellLimit) ProtoMessage()    {}
 func (*ReceiptTradeSellLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{14}
+	return fileDescriptor_ee944bd90e8a0312, []int{21}
 }
 
 func (m *ReceiptTradeSellLimit) XXX_Unmarshal(b []byte) error {
@@ -1413,7 +2160,7 @@ func (m *ReceiptSellMarket) Reset()         { *m = ReceiptSellMarket{} }
 func (m *ReceiptSellMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellMarket) ProtoMessage()    {}
 func (*ReceiptSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{15}
+	return fileDescriptor_ee944bd90e8a0312, []int{22}
 }
 
 func (m *ReceiptSellMarket) XXX_Unmarshal(b []byte) error {
@@ -1452,7 +2199,7 @@ func (m *ReceiptTradeSellRevoke) Reset()         { *m = ReceiptTradeSellRevoke{}
 func (m *ReceiptTradeSellRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellRevoke) ProtoMessage()    {}
 func (*ReceiptTradeSellRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{16}
+	return fileDescriptor_ee944bd90e8a0312, []int{23}
 }
 
 func (m *ReceiptTradeSellRevoke) XXX_Unmarshal(b []byte) error {
@@ -1464,18 +2211,104 @@ func (m *ReceiptTradeSellRevoke) XXX_Marshal(b []byte, deterministic bool) ([]by
 func (m *ReceiptTradeSellRevoke) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_ReceiptTradeSellRevoke.Merge(m, src)
 }
-func (m *ReceiptTradeSellRevoke) XXX_Size() int {
-	return xxx_messageInfo_ReceiptTradeSellRevoke.Size(m)
+func (m *ReceiptTradeSellRevoke) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeSellRevoke.Size(m)
+}
+func (m *ReceiptTradeSellRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeSellRevoke.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeSellRevoke proto.InternalMessageInfo
+
+func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ReceiptTradeLimitOrder struct {
+	Prev                 *LimitOrder `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *LimitOrder `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptTradeLimitOrder) Reset()         { *m = ReceiptTradeLimitOrder{} }
+func (m *ReceiptTradeLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeLimitOrder) ProtoMessage()    {}
+func (*ReceiptTradeLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{24}
+}
+
+func (m *ReceiptTradeLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeLimitOrder.Unmarshal(m, b)
+}
+func (m *ReceiptTradeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeLimitOrder.Merge(m, src)
+}
+func (m *ReceiptTradeLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeLimitOrder.Size(m)
+}
+func (m *ReceiptTradeLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeLimitOrder proto.InternalMessageInfo
+
+func (m *ReceiptTradeLimitOrder) GetPrev() *LimitOrder {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTradeLimitOrder) GetCurrent() *LimitOrder {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTradeLimitFill struct {
+	Fill                 *TradeFill `protobuf:"bytes,1,opt,name=fill,proto3" json:"fill,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReceiptTradeLimitFill) Reset()         { *m = ReceiptTradeLimitFill{} }
+func (m *ReceiptTradeLimitFill) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeLimitFill) ProtoMessage()    {}
+func (*ReceiptTradeLimitFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{25}
+}
+
+func (m *ReceiptTradeLimitFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeLimitFill.Unmarshal(m, b)
+}
+func (m *ReceiptTradeLimitFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeLimitFill.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeLimitFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeLimitFill.Merge(m, src)
 }
-func (m *ReceiptTradeSellRevoke) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiptTradeSellRevoke.DiscardUnknown(m)
+func (m *ReceiptTradeLimitFill) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeLimitFill.Size(m)
+}
+func (m *ReceiptTradeLimitFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeLimitFill.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiptTradeSellRevoke proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptTradeLimitFill proto.InternalMessageInfo
 
-func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
+func (m *ReceiptTradeLimitFill) GetFill() *TradeFill {
 	if m != nil {
-		return m.Base
+		return m.Fill
 	}
 	return nil
 }
@@ -1497,7 +2330,7 @@ func (m *ReqAddrAssets) Reset()         { *m = ReqAddrAssets{} }
 func (m *ReqAddrAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrAssets) ProtoMessage()    {}
 func (*ReqAddrAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{17}
+	return fileDescriptor_ee944bd90e8a0312, []int{26}
 }
 
 func (m *ReqAddrAssets) XXX_Unmarshal(b []byte) error {
@@ -1561,7 +2394,9 @@ func (m *ReqAddrAssets) GetFromKey() string {
 }
 
 // 获取Token未完成卖单的交易列表
-// 	 fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
+//	fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
 // 传当前页最后一个；当要获得上一页时， 传当前页第一个。 	 count
 // :获取交易列表的个数。 	 direction :查找方式；0，上一页；1，下一页。
 // 越靠后的也单价越贵
@@ -1580,7 +2415,7 @@ func (m *ReqTokenSellOrder) Reset()         { *m = ReqTokenSellOrder{} }
 func (m *ReqTokenSellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenSellOrder) ProtoMessage()    {}
 func (*ReqTokenSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{18}
+	return fileDescriptor_ee944bd90e8a0312, []int{27}
 }
 
 func (m *ReqTokenSellOrder) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +2486,7 @@ func (m *ReqTokenBuyOrder) Reset()         { *m = ReqTokenBuyOrder{} }
 func (m *ReqTokenBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBuyOrder) ProtoMessage()    {}
 func (*ReqTokenBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{19}
+	return fileDescriptor_ee944bd90e8a0312, []int{28}
 }
 
 func (m *ReqTokenBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1722,6 +2557,8 @@ type ReplyBuyOrder struct {
 	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
 	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
 	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1731,7 +2568,7 @@ func (m *ReplyBuyOrder) Reset()         { *m = ReplyBuyOrder{} }
 func (m *ReplyBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrder) ProtoMessage()    {}
 func (*ReplyBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{20}
+	return fileDescriptor_ee944bd90e8a0312, []int{29}
 }
 
 func (m *ReplyBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1850,6 +2687,20 @@ func (m *ReplyBuyOrder) GetAssetExec() string {
 	return ""
 }
 
+func (m *ReplyBuyOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReplyBuyOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
 type ReplySellOrder struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -1865,214 +2716,585 @@ type ReplySellOrder struct {
 	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
 	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
 	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
+func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
+func (*ReplySellOrder) ProtoMessage()    {}
+func (*ReplySellOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{30}
+}
+
+func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySellOrder.Unmarshal(m, b)
+}
+func (m *ReplySellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySellOrder.Marshal(b, m, deterministic)
+}
+func (m *ReplySellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySellOrder.Merge(m, src)
+}
+func (m *ReplySellOrder) XXX_Size() int {
+	return xxx_messageInfo_ReplySellOrder.Size(m)
+}
+func (m *ReplySellOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySellOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySellOrder proto.InternalMessageInfo
+
+func (m *ReplySellOrder) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetMinBoardlot() int64 {
+	if m != nil {
+		return m.MinBoardlot
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetTotalBoardlot() int64 {
+	if m != nil {
+		return m.TotalBoardlot
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetSoldBoardlot() int64 {
+	if m != nil {
+		return m.SoldBoardlot
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetBuyID() string {
+	if m != nil {
+		return m.BuyID
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetSellID() string {
+	if m != nil {
+		return m.SellID
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplySellOrder) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReplySellOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+type ReplySellOrders struct {
+	SellOrders           []*ReplySellOrder `protobuf:"bytes,1,rep,name=sellOrders,proto3" json:"sellOrders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
+func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
+func (*ReplySellOrders) ProtoMessage()    {}
+func (*ReplySellOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{31}
+}
+
+func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplySellOrders.Unmarshal(m, b)
+}
+func (m *ReplySellOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplySellOrders.Marshal(b, m, deterministic)
+}
+func (m *ReplySellOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplySellOrders.Merge(m, src)
+}
+func (m *ReplySellOrders) XXX_Size() int {
+	return xxx_messageInfo_ReplySellOrders.Size(m)
+}
+func (m *ReplySellOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplySellOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplySellOrders proto.InternalMessageInfo
+
+func (m *ReplySellOrders) GetSellOrders() []*ReplySellOrder {
+	if m != nil {
+		return m.SellOrders
+	}
+	return nil
+}
+
+type ReplyBuyOrders struct {
+	BuyOrders            []*ReplyBuyOrder `protobuf:"bytes,1,rep,name=buyOrders,proto3" json:"buyOrders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
+func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
+func (*ReplyBuyOrders) ProtoMessage()    {}
+func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{32}
+}
+
+func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyBuyOrders.Unmarshal(m, b)
+}
+func (m *ReplyBuyOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyBuyOrders.Marshal(b, m, deterministic)
+}
+func (m *ReplyBuyOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyBuyOrders.Merge(m, src)
+}
+func (m *ReplyBuyOrders) XXX_Size() int {
+	return xxx_messageInfo_ReplyBuyOrders.Size(m)
+}
+func (m *ReplyBuyOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyBuyOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyBuyOrders proto.InternalMessageInfo
+
+func (m *ReplyBuyOrders) GetBuyOrders() []*ReplyBuyOrder {
+	if m != nil {
+		return m.BuyOrders
+	}
+	return nil
+}
+
+type ReplyTradeOrder struct {
+	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AmountPerBoardlot    int64    `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
+	PricePerBoardlot     int64    `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
+	TradedBoardlot       int64    `protobuf:"varint,7,opt,name=tradedBoardlot,proto3" json:"tradedBoardlot,omitempty"`
+	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
+	BlockTime            int64    `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	IsSellOrder          bool     `protobuf:"varint,15,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
+	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
-func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
-func (*ReplySellOrder) ProtoMessage()    {}
-func (*ReplySellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{21}
+func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
+func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeOrder) ProtoMessage()    {}
+func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{33}
 }
 
-func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplySellOrder.Unmarshal(m, b)
+func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeOrder.Unmarshal(m, b)
 }
-func (m *ReplySellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplySellOrder.Marshal(b, m, deterministic)
+func (m *ReplyTradeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeOrder.Marshal(b, m, deterministic)
 }
-func (m *ReplySellOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplySellOrder.Merge(m, src)
+func (m *ReplyTradeOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeOrder.Merge(m, src)
 }
-func (m *ReplySellOrder) XXX_Size() int {
-	return xxx_messageInfo_ReplySellOrder.Size(m)
+func (m *ReplyTradeOrder) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeOrder.Size(m)
 }
-func (m *ReplySellOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplySellOrder.DiscardUnknown(m)
+func (m *ReplyTradeOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplySellOrder proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTradeOrder proto.InternalMessageInfo
 
-func (m *ReplySellOrder) GetTokenSymbol() string {
+func (m *ReplyTradeOrder) GetTokenSymbol() string {
 	if m != nil {
 		return m.TokenSymbol
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetOwner() string {
+func (m *ReplyTradeOrder) GetOwner() string {
 	if m != nil {
 		return m.Owner
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetAmountPerBoardlot() int64 {
+func (m *ReplyTradeOrder) GetAmountPerBoardlot() int64 {
 	if m != nil {
 		return m.AmountPerBoardlot
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetMinBoardlot() int64 {
+func (m *ReplyTradeOrder) GetMinBoardlot() int64 {
 	if m != nil {
 		return m.MinBoardlot
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetPricePerBoardlot() int64 {
+func (m *ReplyTradeOrder) GetPricePerBoardlot() int64 {
 	if m != nil {
 		return m.PricePerBoardlot
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetTotalBoardlot() int64 {
+func (m *ReplyTradeOrder) GetTotalBoardlot() int64 {
 	if m != nil {
 		return m.TotalBoardlot
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetSoldBoardlot() int64 {
+func (m *ReplyTradeOrder) GetTradedBoardlot() int64 {
 	if m != nil {
-		return m.SoldBoardlot
+		return m.TradedBoardlot
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetBuyID() string {
+func (m *ReplyTradeOrder) GetBuyID() string {
 	if m != nil {
 		return m.BuyID
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetStatus() int32 {
+func (m *ReplyTradeOrder) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetSellID() string {
+func (m *ReplyTradeOrder) GetSellID() string {
 	if m != nil {
 		return m.SellID
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetTxHash() string {
+func (m *ReplyTradeOrder) GetTxHash() string {
 	if m != nil {
 		return m.TxHash
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetHeight() int64 {
+func (m *ReplyTradeOrder) GetHeight() int64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-func (m *ReplySellOrder) GetKey() string {
+func (m *ReplyTradeOrder) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *ReplySellOrder) GetAssetExec() string {
+func (m *ReplyTradeOrder) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ReplyTradeOrder) GetIsSellOrder() bool {
+	if m != nil {
+		return m.IsSellOrder
+	}
+	return false
+}
+
+func (m *ReplyTradeOrder) GetAssetExec() string {
 	if m != nil {
 		return m.AssetExec
 	}
 	return ""
 }
 
-type ReplySellOrders struct {
-	SellOrders           []*ReplySellOrder `protobuf:"bytes,1,rep,name=sellOrders,proto3" json:"sellOrders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ReplyTradeOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
 }
 
-func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
-func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
-func (*ReplySellOrders) ProtoMessage()    {}
-func (*ReplySellOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{22}
+func (m *ReplyTradeOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
 }
 
-func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplySellOrders.Unmarshal(m, b)
+type ReplyTradeOrders struct {
+	Orders               []*ReplyTradeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *ReplySellOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplySellOrders.Marshal(b, m, deterministic)
+
+func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
+func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeOrders) ProtoMessage()    {}
+func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{34}
 }
-func (m *ReplySellOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplySellOrders.Merge(m, src)
+
+func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeOrders.Unmarshal(m, b)
 }
-func (m *ReplySellOrders) XXX_Size() int {
-	return xxx_messageInfo_ReplySellOrders.Size(m)
+func (m *ReplyTradeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeOrders.Marshal(b, m, deterministic)
 }
-func (m *ReplySellOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplySellOrders.DiscardUnknown(m)
+func (m *ReplyTradeOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeOrders.Merge(m, src)
+}
+func (m *ReplyTradeOrders) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeOrders.Size(m)
+}
+func (m *ReplyTradeOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplySellOrders proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTradeOrders proto.InternalMessageInfo
 
-func (m *ReplySellOrders) GetSellOrders() []*ReplySellOrder {
+func (m *ReplyTradeOrders) GetOrders() []*ReplyTradeOrder {
 	if m != nil {
-		return m.SellOrders
+		return m.Orders
 	}
 	return nil
 }
 
-type ReplyBuyOrders struct {
-	BuyOrders            []*ReplyBuyOrder `protobuf:"bytes,1,rep,name=buyOrders,proto3" json:"buyOrders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ReqSellToken struct {
+	Sell                 *TradeForSell `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell,omitempty"`
+	Owner                string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
-func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
-func (*ReplyBuyOrders) ProtoMessage()    {}
-func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{23}
+func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
+func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
+func (*ReqSellToken) ProtoMessage()    {}
+func (*ReqSellToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{35}
 }
 
-func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyBuyOrders.Unmarshal(m, b)
+func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSellToken.Unmarshal(m, b)
 }
-func (m *ReplyBuyOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyBuyOrders.Marshal(b, m, deterministic)
+func (m *ReqSellToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSellToken.Marshal(b, m, deterministic)
 }
-func (m *ReplyBuyOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyBuyOrders.Merge(m, src)
+func (m *ReqSellToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSellToken.Merge(m, src)
+}
+func (m *ReqSellToken) XXX_Size() int {
+	return xxx_messageInfo_ReqSellToken.Size(m)
+}
+func (m *ReqSellToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSellToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSellToken proto.InternalMessageInfo
+
+func (m *ReqSellToken) GetSell() *TradeForSell {
+	if m != nil {
+		return m.Sell
+	}
+	return nil
+}
+
+func (m *ReqSellToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type ReqRevokeSell struct {
+	Revoke               *TradeForRevokeSell `protobuf:"bytes,1,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	Owner                string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
+func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
+func (*ReqRevokeSell) ProtoMessage()    {}
+func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{36}
+}
+
+func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRevokeSell.Unmarshal(m, b)
+}
+func (m *ReqRevokeSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRevokeSell.Marshal(b, m, deterministic)
+}
+func (m *ReqRevokeSell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRevokeSell.Merge(m, src)
+}
+func (m *ReqRevokeSell) XXX_Size() int {
+	return xxx_messageInfo_ReqRevokeSell.Size(m)
+}
+func (m *ReqRevokeSell) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRevokeSell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRevokeSell proto.InternalMessageInfo
+
+func (m *ReqRevokeSell) GetRevoke() *TradeForRevokeSell {
+	if m != nil {
+		return m.Revoke
+	}
+	return nil
+}
+
+func (m *ReqRevokeSell) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type ReqBuyToken struct {
+	Buy                  *TradeForBuy `protobuf:"bytes,1,opt,name=buy,proto3" json:"buy,omitempty"`
+	Buyer                string       `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
+func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
+func (*ReqBuyToken) ProtoMessage()    {}
+func (*ReqBuyToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{37}
+}
+
+func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqBuyToken.Unmarshal(m, b)
+}
+func (m *ReqBuyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqBuyToken.Marshal(b, m, deterministic)
+}
+func (m *ReqBuyToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqBuyToken.Merge(m, src)
 }
-func (m *ReplyBuyOrders) XXX_Size() int {
-	return xxx_messageInfo_ReplyBuyOrders.Size(m)
+func (m *ReqBuyToken) XXX_Size() int {
+	return xxx_messageInfo_ReqBuyToken.Size(m)
 }
-func (m *ReplyBuyOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyBuyOrders.DiscardUnknown(m)
+func (m *ReqBuyToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqBuyToken.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyBuyOrders proto.InternalMessageInfo
+var xxx_messageInfo_ReqBuyToken proto.InternalMessageInfo
 
-func (m *ReplyBuyOrders) GetBuyOrders() []*ReplyBuyOrder {
+func (m *ReqBuyToken) GetBuy() *TradeForBuy {
 	if m != nil {
-		return m.BuyOrders
+		return m.Buy
 	}
 	return nil
 }
 
-type ReplyTradeOrder struct {
-	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+func (m *ReqBuyToken) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+type LocalOrder struct {
+	AssetSymbol          string   `protobuf:"bytes,1,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
 	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	AmountPerBoardlot    int64    `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
 	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
@@ -2082,507 +3304,764 @@ type ReplyTradeOrder struct {
 	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
 	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
 	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	TxHash               string   `protobuf:"bytes,11,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	TxHash               []string `protobuf:"bytes,11,rep,name=txHash,proto3" json:"txHash,omitempty"`
 	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
 	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
 	BlockTime            int64    `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
 	IsSellOrder          bool     `protobuf:"varint,15,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
 	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TxIndex              string   `protobuf:"bytes,17,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	IsFinished           bool     `protobuf:"varint,18,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
+	PriceExec            string   `protobuf:"bytes,19,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,20,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
-func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
-func (*ReplyTradeOrder) ProtoMessage()    {}
-func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{24}
+func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
+func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
+func (*LocalOrder) ProtoMessage()    {}
+func (*LocalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{38}
 }
 
-func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyTradeOrder.Unmarshal(m, b)
+func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalOrder.Unmarshal(m, b)
 }
-func (m *ReplyTradeOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTradeOrder.Marshal(b, m, deterministic)
+func (m *LocalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalOrder.Marshal(b, m, deterministic)
 }
-func (m *ReplyTradeOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTradeOrder.Merge(m, src)
+func (m *LocalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalOrder.Merge(m, src)
 }
-func (m *ReplyTradeOrder) XXX_Size() int {
-	return xxx_messageInfo_ReplyTradeOrder.Size(m)
+func (m *LocalOrder) XXX_Size() int {
+	return xxx_messageInfo_LocalOrder.Size(m)
 }
-func (m *ReplyTradeOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTradeOrder.DiscardUnknown(m)
+func (m *LocalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTradeOrder proto.InternalMessageInfo
+var xxx_messageInfo_LocalOrder proto.InternalMessageInfo
 
-func (m *ReplyTradeOrder) GetTokenSymbol() string {
+func (m *LocalOrder) GetAssetSymbol() string {
 	if m != nil {
-		return m.TokenSymbol
+		return m.AssetSymbol
 	}
 	return ""
 }
 
-func (m *ReplyTradeOrder) GetOwner() string {
+func (m *LocalOrder) GetOwner() string {
 	if m != nil {
 		return m.Owner
 	}
 	return ""
 }
 
-func (m *ReplyTradeOrder) GetAmountPerBoardlot() int64 {
+func (m *LocalOrder) GetAmountPerBoardlot() int64 {
 	if m != nil {
 		return m.AmountPerBoardlot
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetMinBoardlot() int64 {
+func (m *LocalOrder) GetMinBoardlot() int64 {
 	if m != nil {
 		return m.MinBoardlot
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetPricePerBoardlot() int64 {
+func (m *LocalOrder) GetPricePerBoardlot() int64 {
 	if m != nil {
 		return m.PricePerBoardlot
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetTotalBoardlot() int64 {
+func (m *LocalOrder) GetTotalBoardlot() int64 {
 	if m != nil {
 		return m.TotalBoardlot
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetTradedBoardlot() int64 {
+func (m *LocalOrder) GetTradedBoardlot() int64 {
 	if m != nil {
 		return m.TradedBoardlot
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetBuyID() string {
+func (m *LocalOrder) GetBuyID() string {
 	if m != nil {
 		return m.BuyID
 	}
 	return ""
 }
 
-func (m *ReplyTradeOrder) GetStatus() int32 {
+func (m *LocalOrder) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetSellID() string {
+func (m *LocalOrder) GetSellID() string {
 	if m != nil {
 		return m.SellID
 	}
 	return ""
 }
 
-func (m *ReplyTradeOrder) GetTxHash() string {
+func (m *LocalOrder) GetTxHash() []string {
 	if m != nil {
 		return m.TxHash
 	}
-	return ""
+	return nil
 }
 
-func (m *ReplyTradeOrder) GetHeight() int64 {
+func (m *LocalOrder) GetHeight() int64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetKey() string {
+func (m *LocalOrder) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *ReplyTradeOrder) GetBlockTime() int64 {
+func (m *LocalOrder) GetBlockTime() int64 {
 	if m != nil {
 		return m.BlockTime
 	}
 	return 0
 }
 
-func (m *ReplyTradeOrder) GetIsSellOrder() bool {
+func (m *LocalOrder) GetIsSellOrder() bool {
 	if m != nil {
 		return m.IsSellOrder
 	}
 	return false
 }
 
-func (m *ReplyTradeOrder) GetAssetExec() string {
+func (m *LocalOrder) GetAssetExec() string {
 	if m != nil {
 		return m.AssetExec
 	}
 	return ""
 }
 
-type ReplyTradeOrders struct {
-	Orders               []*ReplyTradeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *LocalOrder) GetTxIndex() string {
+	if m != nil {
+		return m.TxIndex
+	}
+	return ""
 }
 
-func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
-func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
-func (*ReplyTradeOrders) ProtoMessage()    {}
-func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{25}
+func (m *LocalOrder) GetIsFinished() bool {
+	if m != nil {
+		return m.IsFinished
+	}
+	return false
 }
 
-func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyTradeOrders.Unmarshal(m, b)
+func (m *LocalOrder) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
 }
-func (m *ReplyTradeOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTradeOrders.Marshal(b, m, deterministic)
+
+func (m *LocalOrder) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
 }
-func (m *ReplyTradeOrders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTradeOrders.Merge(m, src)
+
+// 按交易对分页查询订单, 计价资产为空时表示用coins计价
+// fromKey 第一页为空, 翻页时传上一页返回的最后一条记录的key
+type ReqPairOrders struct {
+	AssetExec            string   `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec            string   `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	IsSell               bool     `protobuf:"varint,5,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	IsFinished           bool     `protobuf:"varint,6,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
+	FromKey              string   `protobuf:"bytes,7,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,9,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ReplyTradeOrders) XXX_Size() int {
-	return xxx_messageInfo_ReplyTradeOrders.Size(m)
+
+func (m *ReqPairOrders) Reset()         { *m = ReqPairOrders{} }
+func (m *ReqPairOrders) String() string { return proto.CompactTextString(m) }
+func (*ReqPairOrders) ProtoMessage()    {}
+func (*ReqPairOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{39}
 }
-func (m *ReplyTradeOrders) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTradeOrders.DiscardUnknown(m)
+
+func (m *ReqPairOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqPairOrders.Unmarshal(m, b)
+}
+func (m *ReqPairOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqPairOrders.Marshal(b, m, deterministic)
+}
+func (m *ReqPairOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqPairOrders.Merge(m, src)
+}
+func (m *ReqPairOrders) XXX_Size() int {
+	return xxx_messageInfo_ReqPairOrders.Size(m)
+}
+func (m *ReqPairOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqPairOrders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTradeOrders proto.InternalMessageInfo
+var xxx_messageInfo_ReqPairOrders proto.InternalMessageInfo
 
-func (m *ReplyTradeOrders) GetOrders() []*ReplyTradeOrder {
+func (m *ReqPairOrders) GetAssetExec() string {
 	if m != nil {
-		return m.Orders
+		return m.AssetExec
 	}
-	return nil
+	return ""
 }
 
-type ReqSellToken struct {
-	Sell                 *TradeForSell `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell,omitempty"`
-	Owner                string        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ReqPairOrders) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
 }
 
-func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
-func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
-func (*ReqSellToken) ProtoMessage()    {}
-func (*ReqSellToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{26}
+func (m *ReqPairOrders) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
 }
 
-func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqSellToken.Unmarshal(m, b)
+func (m *ReqPairOrders) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
 }
-func (m *ReqSellToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqSellToken.Marshal(b, m, deterministic)
+
+func (m *ReqPairOrders) GetIsSell() bool {
+	if m != nil {
+		return m.IsSell
+	}
+	return false
 }
-func (m *ReqSellToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqSellToken.Merge(m, src)
+
+func (m *ReqPairOrders) GetIsFinished() bool {
+	if m != nil {
+		return m.IsFinished
+	}
+	return false
 }
-func (m *ReqSellToken) XXX_Size() int {
-	return xxx_messageInfo_ReqSellToken.Size(m)
+
+func (m *ReqPairOrders) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
 }
-func (m *ReqSellToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqSellToken.DiscardUnknown(m)
+
+func (m *ReqPairOrders) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-var xxx_messageInfo_ReqSellToken proto.InternalMessageInfo
+func (m *ReqPairOrders) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
 
-func (m *ReqSellToken) GetSell() *TradeForSell {
+// 订单簿深度, count 为每一侧返回的价格档位数
+type ReqTradeDepth struct {
+	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTradeDepth) Reset()         { *m = ReqTradeDepth{} }
+func (m *ReqTradeDepth) String() string { return proto.CompactTextString(m) }
+func (*ReqTradeDepth) ProtoMessage()    {}
+func (*ReqTradeDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{40}
+}
+
+func (m *ReqTradeDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTradeDepth.Unmarshal(m, b)
+}
+func (m *ReqTradeDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTradeDepth.Marshal(b, m, deterministic)
+}
+func (m *ReqTradeDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTradeDepth.Merge(m, src)
+}
+func (m *ReqTradeDepth) XXX_Size() int {
+	return xxx_messageInfo_ReqTradeDepth.Size(m)
+}
+func (m *ReqTradeDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTradeDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTradeDepth proto.InternalMessageInfo
+
+func (m *ReqTradeDepth) GetTokenSymbol() string {
 	if m != nil {
-		return m.Sell
+		return m.TokenSymbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ReqSellToken) GetOwner() string {
+func (m *ReqTradeDepth) GetAssetExec() string {
 	if m != nil {
-		return m.Owner
+		return m.AssetExec
 	}
 	return ""
 }
 
-type ReqRevokeSell struct {
-	Revoke               *TradeForRevokeSell `protobuf:"bytes,1,opt,name=revoke,proto3" json:"revoke,omitempty"`
-	Owner                string              `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ReqTradeDepth) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
-func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
-func (*ReqRevokeSell) ProtoMessage()    {}
-func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{27}
+type TradeDepthLevel struct {
+	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Orders               int32    `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqRevokeSell.Unmarshal(m, b)
+func (m *TradeDepthLevel) Reset()         { *m = TradeDepthLevel{} }
+func (m *TradeDepthLevel) String() string { return proto.CompactTextString(m) }
+func (*TradeDepthLevel) ProtoMessage()    {}
+func (*TradeDepthLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{41}
 }
-func (m *ReqRevokeSell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqRevokeSell.Marshal(b, m, deterministic)
+
+func (m *TradeDepthLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeDepthLevel.Unmarshal(m, b)
 }
-func (m *ReqRevokeSell) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqRevokeSell.Merge(m, src)
+func (m *TradeDepthLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeDepthLevel.Marshal(b, m, deterministic)
 }
-func (m *ReqRevokeSell) XXX_Size() int {
-	return xxx_messageInfo_ReqRevokeSell.Size(m)
+func (m *TradeDepthLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeDepthLevel.Merge(m, src)
 }
-func (m *ReqRevokeSell) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqRevokeSell.DiscardUnknown(m)
+func (m *TradeDepthLevel) XXX_Size() int {
+	return xxx_messageInfo_TradeDepthLevel.Size(m)
+}
+func (m *TradeDepthLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeDepthLevel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqRevokeSell proto.InternalMessageInfo
+var xxx_messageInfo_TradeDepthLevel proto.InternalMessageInfo
 
-func (m *ReqRevokeSell) GetRevoke() *TradeForRevokeSell {
+func (m *TradeDepthLevel) GetPrice() int64 {
 	if m != nil {
-		return m.Revoke
+		return m.Price
 	}
-	return nil
+	return 0
 }
 
-func (m *ReqRevokeSell) GetOwner() string {
+func (m *TradeDepthLevel) GetAmount() int64 {
 	if m != nil {
-		return m.Owner
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-type ReqBuyToken struct {
-	Buy                  *TradeForBuy `protobuf:"bytes,1,opt,name=buy,proto3" json:"buy,omitempty"`
-	Buyer                string       `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *TradeDepthLevel) GetOrders() int32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
 }
 
-func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
-func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
-func (*ReqBuyToken) ProtoMessage()    {}
-func (*ReqBuyToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{28}
+type ReplyTradeDepth struct {
+	Sells                []*TradeDepthLevel `protobuf:"bytes,1,rep,name=sells,proto3" json:"sells,omitempty"`
+	Buys                 []*TradeDepthLevel `protobuf:"bytes,2,rep,name=buys,proto3" json:"buys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqBuyToken.Unmarshal(m, b)
+func (m *ReplyTradeDepth) Reset()         { *m = ReplyTradeDepth{} }
+func (m *ReplyTradeDepth) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeDepth) ProtoMessage()    {}
+func (*ReplyTradeDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{42}
 }
-func (m *ReqBuyToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqBuyToken.Marshal(b, m, deterministic)
+
+func (m *ReplyTradeDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeDepth.Unmarshal(m, b)
 }
-func (m *ReqBuyToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqBuyToken.Merge(m, src)
+func (m *ReplyTradeDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeDepth.Marshal(b, m, deterministic)
 }
-func (m *ReqBuyToken) XXX_Size() int {
-	return xxx_messageInfo_ReqBuyToken.Size(m)
+func (m *ReplyTradeDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeDepth.Merge(m, src)
 }
-func (m *ReqBuyToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqBuyToken.DiscardUnknown(m)
+func (m *ReplyTradeDepth) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeDepth.Size(m)
+}
+func (m *ReplyTradeDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeDepth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqBuyToken proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTradeDepth proto.InternalMessageInfo
 
-func (m *ReqBuyToken) GetBuy() *TradeForBuy {
+func (m *ReplyTradeDepth) GetSells() []*TradeDepthLevel {
 	if m != nil {
-		return m.Buy
+		return m.Sells
 	}
 	return nil
 }
 
-func (m *ReqBuyToken) GetBuyer() string {
+func (m *ReplyTradeDepth) GetBuys() []*TradeDepthLevel {
 	if m != nil {
-		return m.Buyer
+		return m.Buys
 	}
-	return ""
+	return nil
 }
 
-type LocalOrder struct {
-	AssetSymbol          string   `protobuf:"bytes,1,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
-	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	AmountPerBoardlot    int64    `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
-	MinBoardlot          int64    `protobuf:"varint,4,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	PricePerBoardlot     int64    `protobuf:"varint,5,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
-	TotalBoardlot        int64    `protobuf:"varint,6,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	TradedBoardlot       int64    `protobuf:"varint,7,opt,name=tradedBoardlot,proto3" json:"tradedBoardlot,omitempty"`
-	BuyID                string   `protobuf:"bytes,8,opt,name=buyID,proto3" json:"buyID,omitempty"`
-	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
-	SellID               string   `protobuf:"bytes,10,opt,name=sellID,proto3" json:"sellID,omitempty"`
-	TxHash               []string `protobuf:"bytes,11,rep,name=txHash,proto3" json:"txHash,omitempty"`
-	Height               int64    `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
-	Key                  string   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
-	BlockTime            int64    `protobuf:"varint,14,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
-	IsSellOrder          bool     `protobuf:"varint,15,opt,name=isSellOrder,proto3" json:"isSellOrder,omitempty"`
-	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
-	TxIndex              string   `protobuf:"bytes,17,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
-	IsFinished           bool     `protobuf:"varint,18,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
+// 成交历史, 指定addr时查询该地址的成交, 否则查询交易对的成交
+// primaryKey 第一页为空, 翻页时传上一页返回的最后一条记录的key
+type ReqTradeFills struct {
+	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,4,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
-func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
-func (*LocalOrder) ProtoMessage()    {}
-func (*LocalOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{29}
+func (m *ReqTradeFills) Reset()         { *m = ReqTradeFills{} }
+func (m *ReqTradeFills) String() string { return proto.CompactTextString(m) }
+func (*ReqTradeFills) ProtoMessage()    {}
+func (*ReqTradeFills) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{43}
 }
 
-func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LocalOrder.Unmarshal(m, b)
+func (m *ReqTradeFills) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTradeFills.Unmarshal(m, b)
 }
-func (m *LocalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LocalOrder.Marshal(b, m, deterministic)
+func (m *ReqTradeFills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTradeFills.Marshal(b, m, deterministic)
 }
-func (m *LocalOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalOrder.Merge(m, src)
+func (m *ReqTradeFills) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTradeFills.Merge(m, src)
 }
-func (m *LocalOrder) XXX_Size() int {
-	return xxx_messageInfo_LocalOrder.Size(m)
+func (m *ReqTradeFills) XXX_Size() int {
+	return xxx_messageInfo_ReqTradeFills.Size(m)
 }
-func (m *LocalOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_LocalOrder.DiscardUnknown(m)
+func (m *ReqTradeFills) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTradeFills.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LocalOrder proto.InternalMessageInfo
+var xxx_messageInfo_ReqTradeFills proto.InternalMessageInfo
 
-func (m *LocalOrder) GetAssetSymbol() string {
+func (m *ReqTradeFills) GetTokenSymbol() string {
 	if m != nil {
-		return m.AssetSymbol
+		return m.TokenSymbol
 	}
 	return ""
 }
 
-func (m *LocalOrder) GetOwner() string {
+func (m *ReqTradeFills) GetAssetExec() string {
 	if m != nil {
-		return m.Owner
+		return m.AssetExec
 	}
 	return ""
 }
 
-func (m *LocalOrder) GetAmountPerBoardlot() int64 {
+func (m *ReqTradeFills) GetAddr() string {
 	if m != nil {
-		return m.AmountPerBoardlot
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalOrder) GetMinBoardlot() int64 {
+func (m *ReqTradeFills) GetPrimaryKey() string {
 	if m != nil {
-		return m.MinBoardlot
+		return m.PrimaryKey
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalOrder) GetPricePerBoardlot() int64 {
+func (m *ReqTradeFills) GetCount() int32 {
 	if m != nil {
-		return m.PricePerBoardlot
+		return m.Count
 	}
 	return 0
 }
 
-func (m *LocalOrder) GetTotalBoardlot() int64 {
+func (m *ReqTradeFills) GetDirection() int32 {
 	if m != nil {
-		return m.TotalBoardlot
+		return m.Direction
 	}
 	return 0
 }
 
-func (m *LocalOrder) GetTradedBoardlot() int64 {
-	if m != nil {
-		return m.TradedBoardlot
-	}
-	return 0
+type ReplyTradeFill struct {
+	Fill                 *TradeFill `protobuf:"bytes,1,opt,name=fill,proto3" json:"fill,omitempty"`
+	Key                  string     `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *LocalOrder) GetBuyID() string {
-	if m != nil {
-		return m.BuyID
-	}
-	return ""
+func (m *ReplyTradeFill) Reset()         { *m = ReplyTradeFill{} }
+func (m *ReplyTradeFill) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeFill) ProtoMessage()    {}
+func (*ReplyTradeFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{44}
 }
 
-func (m *LocalOrder) GetStatus() int32 {
+func (m *ReplyTradeFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeFill.Unmarshal(m, b)
+}
+func (m *ReplyTradeFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeFill.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeFill.Merge(m, src)
+}
+func (m *ReplyTradeFill) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeFill.Size(m)
+}
+func (m *ReplyTradeFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeFill proto.InternalMessageInfo
+
+func (m *ReplyTradeFill) GetFill() *TradeFill {
 	if m != nil {
-		return m.Status
+		return m.Fill
 	}
-	return 0
+	return nil
 }
 
-func (m *LocalOrder) GetSellID() string {
+func (m *ReplyTradeFill) GetKey() string {
 	if m != nil {
-		return m.SellID
+		return m.Key
 	}
 	return ""
 }
 
-func (m *LocalOrder) GetTxHash() []string {
+type ReplyTradeFills struct {
+	Fills                []*ReplyTradeFill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReplyTradeFills) Reset()         { *m = ReplyTradeFills{} }
+func (m *ReplyTradeFills) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeFills) ProtoMessage()    {}
+func (*ReplyTradeFills) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{45}
+}
+
+func (m *ReplyTradeFills) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeFills.Unmarshal(m, b)
+}
+func (m *ReplyTradeFills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeFills.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeFills) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeFills.Merge(m, src)
+}
+func (m *ReplyTradeFills) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeFills.Size(m)
+}
+func (m *ReplyTradeFills) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeFills.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeFills proto.InternalMessageInfo
+
+func (m *ReplyTradeFills) GetFills() []*ReplyTradeFill {
 	if m != nil {
-		return m.TxHash
+		return m.Fills
 	}
 	return nil
 }
 
-func (m *LocalOrder) GetHeight() int64 {
+// 地址的撮合限价单, 翻页方式同 ReqTradeFills
+type ReqTradeLimitOrders struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTradeLimitOrders) Reset()         { *m = ReqTradeLimitOrders{} }
+func (m *ReqTradeLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*ReqTradeLimitOrders) ProtoMessage()    {}
+func (*ReqTradeLimitOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{46}
+}
+
+func (m *ReqTradeLimitOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTradeLimitOrders.Unmarshal(m, b)
+}
+func (m *ReqTradeLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTradeLimitOrders.Marshal(b, m, deterministic)
+}
+func (m *ReqTradeLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTradeLimitOrders.Merge(m, src)
+}
+func (m *ReqTradeLimitOrders) XXX_Size() int {
+	return xxx_messageInfo_ReqTradeLimitOrders.Size(m)
+}
+func (m *ReqTradeLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTradeLimitOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTradeLimitOrders proto.InternalMessageInfo
+
+func (m *ReqTradeLimitOrders) GetAddr() string {
 	if m != nil {
-		return m.Height
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalOrder) GetKey() string {
+func (m *ReqTradeLimitOrders) GetPrimaryKey() string {
 	if m != nil {
-		return m.Key
+		return m.PrimaryKey
 	}
 	return ""
 }
 
-func (m *LocalOrder) GetBlockTime() int64 {
+func (m *ReqTradeLimitOrders) GetCount() int32 {
 	if m != nil {
-		return m.BlockTime
+		return m.Count
 	}
 	return 0
 }
 
-func (m *LocalOrder) GetIsSellOrder() bool {
+func (m *ReqTradeLimitOrders) GetDirection() int32 {
 	if m != nil {
-		return m.IsSellOrder
+		return m.Direction
 	}
-	return false
+	return 0
 }
 
-func (m *LocalOrder) GetAssetExec() string {
+type ReplyTradeLimitOrder struct {
+	Order                *LimitOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Key                  string      `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReplyTradeLimitOrder) Reset()         { *m = ReplyTradeLimitOrder{} }
+func (m *ReplyTradeLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeLimitOrder) ProtoMessage()    {}
+func (*ReplyTradeLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{47}
+}
+
+func (m *ReplyTradeLimitOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeLimitOrder.Unmarshal(m, b)
+}
+func (m *ReplyTradeLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeLimitOrder.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeLimitOrder.Merge(m, src)
+}
+func (m *ReplyTradeLimitOrder) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeLimitOrder.Size(m)
+}
+func (m *ReplyTradeLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeLimitOrder proto.InternalMessageInfo
+
+func (m *ReplyTradeLimitOrder) GetOrder() *LimitOrder {
 	if m != nil {
-		return m.AssetExec
+		return m.Order
 	}
-	return ""
+	return nil
 }
 
-func (m *LocalOrder) GetTxIndex() string {
+func (m *ReplyTradeLimitOrder) GetKey() string {
 	if m != nil {
-		return m.TxIndex
+		return m.Key
 	}
 	return ""
 }
 
-func (m *LocalOrder) GetIsFinished() bool {
+type ReplyTradeLimitOrders struct {
+	Orders               []*ReplyTradeLimitOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ReplyTradeLimitOrders) Reset()         { *m = ReplyTradeLimitOrders{} }
+func (m *ReplyTradeLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeLimitOrders) ProtoMessage()    {}
+func (*ReplyTradeLimitOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{48}
+}
+
+func (m *ReplyTradeLimitOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeLimitOrders.Unmarshal(m, b)
+}
+func (m *ReplyTradeLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeLimitOrders.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeLimitOrders.Merge(m, src)
+}
+func (m *ReplyTradeLimitOrders) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeLimitOrders.Size(m)
+}
+func (m *ReplyTradeLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeLimitOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeLimitOrders proto.InternalMessageInfo
+
+func (m *ReplyTradeLimitOrders) GetOrders() []*ReplyTradeLimitOrder {
 	if m != nil {
-		return m.IsFinished
+		return m.Orders
 	}
-	return false
+	return nil
 }
 
 func init() {
@@ -2593,8 +4072,15 @@ func init() {
 	proto.RegisterType((*TradeForBuyLimit)(nil), "types.TradeForBuyLimit")
 	proto.RegisterType((*TradeForSellMarket)(nil), "types.TradeForSellMarket")
 	proto.RegisterType((*TradeForRevokeBuy)(nil), "types.TradeForRevokeBuy")
+	proto.RegisterType((*TradeForLimitOrder)(nil), "types.TradeForLimitOrder")
+	proto.RegisterType((*TradeForRevokeLimitOrder)(nil), "types.TradeForRevokeLimitOrder")
 	proto.RegisterType((*SellOrder)(nil), "types.SellOrder")
 	proto.RegisterType((*BuyLimitOrder)(nil), "types.BuyLimitOrder")
+	proto.RegisterType((*LimitOrder)(nil), "types.LimitOrder")
+	proto.RegisterType((*TradeBookSide)(nil), "types.TradeBookSide")
+	proto.RegisterType((*TradePriceLevel)(nil), "types.TradePriceLevel")
+	proto.RegisterType((*TradeOrderNode)(nil), "types.TradeOrderNode")
+	proto.RegisterType((*TradeFill)(nil), "types.TradeFill")
 	proto.RegisterType((*ReceiptBuyBase)(nil), "types.ReceiptBuyBase")
 	proto.RegisterType((*ReceiptSellBase)(nil), "types.ReceiptSellBase")
 	proto.RegisterType((*ReceiptTradeBuyMarket)(nil), "types.ReceiptTradeBuyMarket")
@@ -2603,6 +4089,8 @@ func init() {
 	proto.RegisterType((*ReceiptTradeSellLimit)(nil), "types.ReceiptTradeSellLimit")
 	proto.RegisterType((*ReceiptSellMarket)(nil), "types.ReceiptSellMarket")
 	proto.RegisterType((*ReceiptTradeSellRevoke)(nil), "types.ReceiptTradeSellRevoke")
+	proto.RegisterType((*ReceiptTradeLimitOrder)(nil), "types.ReceiptTradeLimitOrder")
+	proto.RegisterType((*ReceiptTradeLimitFill)(nil), "types.ReceiptTradeLimitFill")
 	proto.RegisterType((*ReqAddrAssets)(nil), "types.ReqAddrAssets")
 	proto.RegisterType((*ReqTokenSellOrder)(nil), "types.ReqTokenSellOrder")
 	proto.RegisterType((*ReqTokenBuyOrder)(nil), "types.ReqTokenBuyOrder")
@@ -2616,94 +4104,152 @@ func init() {
 	proto.RegisterType((*ReqRevokeSell)(nil), "types.ReqRevokeSell")
 	proto.RegisterType((*ReqBuyToken)(nil), "types.ReqBuyToken")
 	proto.RegisterType((*LocalOrder)(nil), "types.LocalOrder")
+	proto.RegisterType((*ReqPairOrders)(nil), "types.ReqPairOrders")
+	proto.RegisterType((*ReqTradeDepth)(nil), "types.ReqTradeDepth")
+	proto.RegisterType((*TradeDepthLevel)(nil), "types.TradeDepthLevel")
+	proto.RegisterType((*ReplyTradeDepth)(nil), "types.ReplyTradeDepth")
+	proto.RegisterType((*ReqTradeFills)(nil), "types.ReqTradeFills")
+	proto.RegisterType((*ReplyTradeFill)(nil), "types.ReplyTradeFill")
+	proto.RegisterType((*ReplyTradeFills)(nil), "types.ReplyTradeFills")
+	proto.RegisterType((*ReqTradeLimitOrders)(nil), "types.ReqTradeLimitOrders")
+	proto.RegisterType((*ReplyTradeLimitOrder)(nil), "types.ReplyTradeLimitOrder")
+	proto.RegisterType((*ReplyTradeLimitOrders)(nil), "types.ReplyTradeLimitOrders")
 }
 
 func init() { proto.RegisterFile("trade.proto", fileDescriptor_ee944bd90e8a0312) }
 
 var fileDescriptor_ee944bd90e8a0312 = []byte{
-	// 1306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x6e, 0xe2, 0x38, 0x8d, 0x5f, 0x9a, 0x34, 0x9d, 0xcd, 0x16, 0x6f, 0x85, 0x50, 0x64, 0xad,
-	0xa0, 0xbb, 0x5a, 0x55, 0xa2, 0xab, 0x95, 0x90, 0x40, 0xa0, 0xa6, 0xa5, 0xa4, 0xd0, 0x15, 0x68,
-	0x1a, 0x24, 0xae, 0x4e, 0x3c, 0xdb, 0x5a, 0x75, 0xec, 0xd4, 0x1e, 0x6f, 0xe3, 0xbf, 0x02, 0x07,
-	0x6e, 0x5c, 0x90, 0x38, 0x21, 0xf1, 0x17, 0x90, 0xf8, 0x3b, 0x7b, 0x59, 0x71, 0x41, 0x33, 0x9e,
-	0xd8, 0x63, 0xc7, 0xee, 0x26, 0xd2, 0x1e, 0xba, 0x5d, 0x6e, 0x99, 0x37, 0x6f, 0xbe, 0x79, 0xfe,
-	0xbe, 0xf7, 0x66, 0x9e, 0x1d, 0x68, 0x52, 0xdf, 0xb4, 0xc8, 0xde, 0xd4, 0xf7, 0xa8, 0x87, 0x54,
-	0x1a, 0x4d, 0x49, 0xb0, 0xb3, 0x45, 0x7d, 0xd3, 0x0d, 0xcc, 0x31, 0xb5, 0x3d, 0x37, 0x9e, 0x31,
-	0x5e, 0x55, 0x41, 0x1d, 0x32, 0x4f, 0xf4, 0x14, 0xb4, 0x80, 0x38, 0xce, 0xa9, 0x3d, 0xb1, 0xa9,
-	0x5e, 0xe9, 0x55, 0x76, 0x9b, 0xfb, 0xf7, 0xf6, 0xf8, 0xba, 0x3d, 0xee, 0x70, 0xec, 0xf9, 0x67,
-	0xc4, 0x71, 0x06, 0x6b, 0x38, 0xf5, 0x43, 0xfb, 0xa0, 0x8d, 0xc2, 0xe8, 0xb9, 0xe9, 0x5f, 0x12,
-	0xaa, 0x57, 0xf9, 0x22, 0x94, 0x5b, 0xd4, 0x0f, 0x23, 0xb6, 0x26, 0x71, 0x43, 0x9f, 0x03, 0xf8,
-	0xe4, 0xa5, 0x77, 0x49, 0x18, 0x9c, 0xae, 0xf0, 0x45, 0x0f, 0x72, 0x8b, 0x70, 0xe2, 0x30, 0x58,
-	0xc3, 0x92, 0x3b, 0x7a, 0x06, 0x8d, 0x51, 0x18, 0xc5, 0x41, 0xaa, 0x7c, 0xe9, 0x07, 0x8b, 0xfb,
-	0xf1, 0xe9, 0xc1, 0x1a, 0x4e, 0x5c, 0xd9, 0x9e, 0x2c, 0x68, 0x11, 0x68, 0xbd, 0x70, 0xcf, 0xb3,
-	0xc4, 0x81, 0xed, 0x99, 0xba, 0xa3, 0xcf, 0x40, 0x8b, 0x23, 0xe8, 0x87, 0x91, 0xbe, 0xce, 0xd7,
-	0xea, 0x85, 0xf1, 0x8a, 0x47, 0x4d, 0x9c, 0x51, 0x1b, 0xaa, 0x34, 0xd2, 0x6b, 0xbd, 0xca, 0xae,
-	0x8a, 0xab, 0x34, 0xea, 0xaf, 0x83, 0xfa, 0xd2, 0x74, 0x42, 0x62, 0xfc, 0x5d, 0x85, 0x0d, 0x79,
-	0x5f, 0xd4, 0x83, 0x26, 0xf5, 0x2e, 0x89, 0x7b, 0x16, 0x4d, 0x46, 0x9e, 0xc3, 0xf9, 0xd7, 0xb0,
-	0x6c, 0x42, 0x4f, 0x60, 0xcb, 0x9c, 0x78, 0xa1, 0x4b, 0x7f, 0x20, 0x7e, 0xdf, 0x33, 0x7d, 0xcb,
-	0xf1, 0x62, 0xca, 0x15, 0xbc, 0x38, 0xc1, 0xf0, 0x26, 0xb6, 0x9b, 0xf8, 0x29, 0xdc, 0x4f, 0x36,
-	0xa1, 0xc7, 0xd0, 0x99, 0xfa, 0xf6, 0x98, 0xc8, 0x70, 0x35, 0xee, 0xb6, 0x60, 0x47, 0x0f, 0xa1,
-	0x45, 0x3d, 0x6a, 0x3a, 0x89, 0xa3, 0xca, 0x1d, 0xb3, 0x46, 0xf4, 0x21, 0x68, 0x01, 0x35, 0x7d,
-	0x4a, 0xed, 0x09, 0xe1, 0x1c, 0x2b, 0x38, 0x35, 0xa0, 0x1d, 0x68, 0x04, 0xd4, 0x9b, 0xf2, 0xc9,
-	0x75, 0x3e, 0x99, 0x8c, 0xd9, 0xca, 0xb1, 0xef, 0x5d, 0x5b, 0x2f, 0x42, 0xd7, 0xd2, 0x1b, 0xbd,
-	0xca, 0x6e, 0x03, 0xa7, 0x06, 0x36, 0x6b, 0x06, 0x01, 0xa1, 0x5f, 0xcf, 0xc8, 0x58, 0xd7, 0x38,
-	0x33, 0xa9, 0xc1, 0xf8, 0x06, 0x9a, 0x92, 0xf4, 0x68, 0x1b, 0xea, 0x4c, 0xba, 0x93, 0x23, 0xc1,
-	0xa1, 0x18, 0x31, 0x42, 0x46, 0x22, 0xd0, 0x43, 0x77, 0x4e, 0x9c, 0x6c, 0x32, 0x9e, 0x00, 0x5a,
-	0x4c, 0xbf, 0x32, 0x3c, 0xe3, 0x55, 0x05, 0x3a, 0xf9, 0x94, 0xbb, 0x2b, 0x2a, 0xa6, 0x6c, 0xd7,
-	0xf3, 0x6c, 0x9f, 0xa6, 0x24, 0xa5, 0xf5, 0x82, 0xba, 0xa0, 0x8e, 0xc2, 0x28, 0xe1, 0x28, 0x1e,
-	0x2c, 0x41, 0xf9, 0x23, 0xd8, 0x5a, 0xa8, 0xa0, 0x62, 0x30, 0xe3, 0x1f, 0x05, 0x34, 0xb6, 0xe3,
-	0xf7, 0xbe, 0x45, 0xfc, 0x25, 0x88, 0xd6, 0x61, 0xdd, 0xb4, 0x2c, 0x9f, 0x04, 0x01, 0xdf, 0x58,
-	0xc3, 0xf3, 0x61, 0xb1, 0x04, 0xca, 0x92, 0x12, 0xd4, 0x96, 0x93, 0x40, 0x5d, 0x56, 0x82, 0x7a,
-	0x91, 0x04, 0x06, 0x6c, 0x04, 0x9e, 0x63, 0x25, 0x4e, 0x71, 0xb9, 0x64, 0x6c, 0xd9, 0x62, 0x6b,
-	0xdc, 0x54, 0x6c, 0xda, 0x4d, 0xc5, 0x06, 0xf9, 0x62, 0x4b, 0xf3, 0xbd, 0x99, 0xa9, 0x1f, 0x66,
-	0xa7, 0x26, 0x0d, 0x03, 0x7d, 0x83, 0x1f, 0x67, 0x62, 0xc4, 0xec, 0x17, 0xc4, 0x3e, 0xbf, 0xa0,
-	0x7a, 0x8b, 0xef, 0x23, 0x46, 0xd9, 0x34, 0x6a, 0xe7, 0xd3, 0xe8, 0x75, 0x15, 0x5a, 0xf3, 0xaa,
-	0x79, 0x1f, 0x14, 0xfd, 0x18, 0xda, 0x23, 0x2f, 0x3c, 0xbf, 0xa0, 0x39, 0x4d, 0x73, 0xd6, 0x34,
-	0xf7, 0x1b, 0x72, 0x21, 0xa5, 0xdc, 0x6b, 0x25, 0xdc, 0x43, 0x39, 0xf7, 0xcd, 0x3c, 0xf7, 0xbf,
-	0x29, 0xd0, 0xc6, 0x64, 0x4c, 0xec, 0x29, 0xed, 0x87, 0x51, 0xdf, 0x0c, 0xc8, 0x12, 0xe4, 0x77,
-	0x41, 0xf5, 0xae, 0x5d, 0xe2, 0x0b, 0xea, 0xe3, 0x41, 0x39, 0xf1, 0xda, 0xdb, 0x25, 0x5e, 0xbb,
-	0x15, 0xc4, 0x6b, 0x32, 0xf1, 0xa2, 0x48, 0x20, 0x5f, 0x24, 0x74, 0x36, 0x30, 0x83, 0x8b, 0x79,
-	0xf1, 0xc4, 0x23, 0x49, 0xa8, 0x8d, 0x72, 0xa1, 0x5a, 0x79, 0xa1, 0xfe, 0x55, 0x60, 0x53, 0x08,
-	0xc5, 0x4e, 0xbe, 0x3b, 0xae, 0xd4, 0xed, 0x3f, 0xf4, 0x52, 0xfd, 0x93, 0x6c, 0x69, 0xe5, 0xb2,
-	0x45, 0xa8, 0xdf, 0x2e, 0x51, 0x7f, 0xb3, 0x5c, 0xfd, 0x4e, 0x5e, 0xfd, 0x3e, 0xdc, 0x17, 0xe2,
-	0xf3, 0x2b, 0xb2, 0x9f, 0xf4, 0xcf, 0x8f, 0xa0, 0x36, 0x32, 0x03, 0x22, 0x7a, 0xf4, 0xfb, 0xa2,
-	0x13, 0xcd, 0x56, 0x34, 0xe6, 0x2e, 0xc6, 0x01, 0x74, 0x73, 0x18, 0x71, 0x9f, 0xb2, 0x02, 0xc4,
-	0x62, 0x18, 0xf1, 0x4d, 0xbd, 0x0a, 0xc6, 0x61, 0x16, 0xe3, 0x2c, 0x79, 0x7d, 0x78, 0x9c, 0xc1,
-	0xd8, 0xce, 0x62, 0xcc, 0x73, 0x5e, 0x80, 0x7c, 0x05, 0x5b, 0xd2, 0x84, 0xe0, 0x62, 0x15, 0x80,
-	0x23, 0xd8, 0xce, 0x47, 0x21, 0x1e, 0x65, 0x15, 0x94, 0x5f, 0x2b, 0xd0, 0xc2, 0xe4, 0xea, 0xc0,
-	0xb2, 0xfc, 0x03, 0xa6, 0x55, 0x80, 0x10, 0xd4, 0xd8, 0x45, 0x24, 0x6a, 0x91, 0xff, 0x96, 0x12,
-	0xa7, 0x9a, 0x39, 0xb1, 0xbb, 0xa0, 0xf2, 0x5a, 0xd5, 0x95, 0x9e, 0xc2, 0x12, 0x87, 0x0f, 0x58,
-	0x22, 0x58, 0xb6, 0x4f, 0xf8, 0x7b, 0x99, 0x78, 0x5b, 0x48, 0x0d, 0x6c, 0xcd, 0x98, 0x15, 0x28,
-	0xaf, 0x2f, 0x15, 0xc7, 0x03, 0x76, 0x1b, 0xbe, 0xf0, 0xbd, 0xc9, 0x77, 0x24, 0x12, 0x4d, 0xda,
-	0x7c, 0x68, 0xfc, 0x52, 0x61, 0x4c, 0x5d, 0x0d, 0xf9, 0x99, 0xb0, 0x5a, 0xc7, 0x34, 0x47, 0xac,
-	0x66, 0x10, 0xd3, 0x08, 0x14, 0x39, 0x82, 0x9b, 0xa3, 0x4e, 0x19, 0x50, 0x65, 0x06, 0x8c, 0x9f,
-	0x2b, 0xd0, 0x99, 0x47, 0xd7, 0x0f, 0xa3, 0xdb, 0x15, 0xdc, 0x5f, 0x0a, 0x13, 0x77, 0xea, 0x44,
-	0x2b, 0x44, 0xb6, 0xe2, 0x79, 0x7b, 0xf7, 0x5b, 0x92, 0xb7, 0x72, 0x33, 0x76, 0x40, 0xb9, 0x24,
-	0x91, 0x38, 0x5f, 0xd9, 0xcf, 0x37, 0x34, 0x94, 0x7f, 0xf2, 0xa6, 0x66, 0xea, 0x44, 0xab, 0x64,
-	0xfc, 0xbb, 0x2a, 0xdd, 0x32, 0x57, 0xe5, 0xbb, 0x21, 0xdb, 0x80, 0x75, 0x38, 0xb2, 0x6a, 0x01,
-	0x7a, 0x16, 0x7f, 0xaa, 0x89, 0x47, 0x7a, 0xa5, 0xa7, 0x64, 0x6e, 0x17, 0xd9, 0x17, 0x4b, 0x8e,
-	0xc6, 0x91, 0xd0, 0x7f, 0x5e, 0xb9, 0x81, 0xf8, 0x36, 0x95, 0xc1, 0xe9, 0xca, 0x38, 0x73, 0x4f,
-	0x9c, 0xba, 0x19, 0xaf, 0x15, 0x11, 0x10, 0xbf, 0x22, 0xde, 0x83, 0x23, 0x80, 0x7f, 0x25, 0xcc,
-	0x67, 0x52, 0xce, 0x7a, 0x9b, 0x72, 0x69, 0xe4, 0x78, 0xe3, 0xcb, 0x21, 0xeb, 0xf0, 0xda, 0x71,
-	0xfb, 0x97, 0x18, 0x18, 0x83, 0x76, 0x90, 0x24, 0x07, 0xef, 0xb5, 0x1a, 0x58, 0x36, 0xbd, 0xb1,
-	0xe1, 0xea, 0xe4, 0xa4, 0x0f, 0xd0, 0x1e, 0xd4, 0x3d, 0x39, 0x81, 0xb6, 0xe5, 0x04, 0x4a, 0x1d,
-	0xb1, 0xf0, 0x32, 0x9e, 0xc3, 0x06, 0x26, 0x57, 0x6c, 0x47, 0x7e, 0xc1, 0xa1, 0x4f, 0xa0, 0xc6,
-	0x9e, 0xfe, 0x86, 0xef, 0xa9, 0x98, 0x3b, 0x14, 0xa7, 0x90, 0xf1, 0x13, 0xef, 0x35, 0xa4, 0xaf,
-	0x51, 0x9f, 0x42, 0x3d, 0xfe, 0xba, 0x28, 0x10, 0xcb, 0xbf, 0x9b, 0x62, 0xe1, 0x58, 0x82, 0x7c,
-	0x02, 0x4d, 0x4c, 0xae, 0xfa, 0x61, 0x14, 0xc7, 0xf9, 0x10, 0x94, 0x51, 0x18, 0x09, 0xd0, 0x82,
-	0x2f, 0xb8, 0x98, 0x4d, 0x8b, 0x3c, 0x48, 0xa1, 0xf8, 0xc0, 0xf8, 0xa3, 0x06, 0x70, 0xea, 0x8d,
-	0xcd, 0xf4, 0xd8, 0xe5, 0x9c, 0x66, 0xcb, 0x45, 0x32, 0xfd, 0x5f, 0x2e, 0x2b, 0x97, 0x8b, 0x72,
-	0xfb, 0xca, 0x85, 0x75, 0x64, 0x74, 0x76, 0xe2, 0x5a, 0x64, 0xa6, 0x6f, 0xc5, 0x1d, 0x99, 0x18,
-	0xa2, 0x8f, 0x00, 0xec, 0xe0, 0xd8, 0x76, 0xed, 0xe0, 0x82, 0x58, 0x3a, 0xe2, 0xc0, 0x92, 0x65,
-	0xff, 0x77, 0x05, 0x54, 0x4e, 0x19, 0xfa, 0x12, 0xba, 0x87, 0x3e, 0x31, 0x29, 0xc1, 0xe6, 0x75,
-	0xd2, 0x94, 0x0f, 0x67, 0xa8, 0xa8, 0x50, 0x76, 0x36, 0x85, 0xf1, 0x47, 0x37, 0xb0, 0xcf, 0xdd,
-	0xe1, 0xcc, 0x58, 0x43, 0x5f, 0xc0, 0xbd, 0xec, 0x7a, 0x96, 0xd0, 0x33, 0x54, 0x90, 0xc0, 0x45,
-	0xab, 0x8f, 0x61, 0x3b, 0xbb, 0x3a, 0xae, 0x9e, 0xe1, 0x0c, 0x95, 0x97, 0x55, 0x31, 0x8e, 0xbe,
-	0x10, 0x05, 0x7f, 0xbf, 0x19, 0xce, 0x50, 0xd9, 0xbf, 0x13, 0x45, 0x38, 0xdf, 0xc2, 0xce, 0x22,
-	0x1b, 0xf1, 0x8b, 0x4e, 0x41, 0x4c, 0xe9, 0x64, 0x11, 0xd6, 0x00, 0x1e, 0x14, 0x3d, 0x5b, 0xcc,
-	0x4f, 0xe9, 0xbf, 0x17, 0x05, 0x48, 0xa3, 0x3a, 0xff, 0xa3, 0xe8, 0xe9, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe7, 0xe7, 0x49, 0x74, 0x51, 0x1a, 0x00, 0x00,
+	// 2068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x8f, 0xdc, 0x48,
+	0x15, 0x4f, 0xb7, 0xed, 0xee, 0xf6, 0xeb, 0x99, 0xee, 0x9e, 0x4a, 0x67, 0xf0, 0x06, 0xb4, 0x8c,
+	0xac, 0xc0, 0x7e, 0x45, 0x91, 0x48, 0x58, 0x69, 0x11, 0xb0, 0x28, 0x9d, 0x10, 0x26, 0x30, 0x61,
+	0x83, 0x67, 0xf8, 0xb8, 0xba, 0xdb, 0x95, 0x8c, 0x35, 0xee, 0x76, 0x8f, 0x3f, 0x92, 0xf6, 0x01,
+	0x89, 0x1b, 0x48, 0x9c, 0xb9, 0xc0, 0x81, 0x23, 0xff, 0x00, 0x12, 0x5a, 0xf1, 0x47, 0x70, 0xe7,
+	0x0f, 0xe0, 0xc8, 0x09, 0x89, 0x2b, 0xaa, 0x0f, 0xbb, 0xaa, 0xfc, 0xd1, 0x1f, 0xda, 0x5d, 0x34,
+	0x4c, 0xb8, 0xb9, 0x5e, 0xbd, 0x7a, 0xf5, 0xfc, 0xde, 0xef, 0xbd, 0x7a, 0xf5, 0x6c, 0xe8, 0x27,
+	0x91, 0xeb, 0xe1, 0x7b, 0xcb, 0x28, 0x4c, 0x42, 0x64, 0x24, 0xd9, 0x12, 0xc7, 0xb7, 0x0f, 0x92,
+	0xc8, 0x5d, 0xc4, 0xee, 0x2c, 0xf1, 0xc3, 0x05, 0x9b, 0xb1, 0x7f, 0xa5, 0x83, 0x71, 0x46, 0x38,
+	0xd1, 0x03, 0x30, 0x63, 0x1c, 0x04, 0x27, 0xfe, 0xdc, 0x4f, 0xac, 0xd6, 0x51, 0xeb, 0xdd, 0xfe,
+	0xfd, 0x9b, 0xf7, 0xe8, 0xba, 0x7b, 0x94, 0xe1, 0x49, 0x18, 0x9d, 0xe2, 0x20, 0x38, 0xbe, 0xe1,
+	0x08, 0x3e, 0x74, 0x1f, 0xcc, 0x69, 0x9a, 0x3d, 0x73, 0xa3, 0x0b, 0x9c, 0x58, 0x6d, 0xba, 0x08,
+	0x95, 0x16, 0x4d, 0xd2, 0x8c, 0xac, 0x29, 0xd8, 0xd0, 0xb7, 0x01, 0x22, 0xfc, 0x2a, 0xbc, 0xc0,
+	0x44, 0x9c, 0xa5, 0xd1, 0x45, 0x6f, 0x95, 0x16, 0x39, 0x05, 0xc3, 0xf1, 0x0d, 0x47, 0x62, 0x47,
+	0x1f, 0x42, 0x6f, 0x9a, 0x66, 0x4c, 0x49, 0x83, 0x2e, 0xfd, 0x52, 0x75, 0x3f, 0x3a, 0x7d, 0x7c,
+	0xc3, 0x29, 0x58, 0xc9, 0x9e, 0x44, 0x69, 0xae, 0x68, 0xa7, 0x76, 0xcf, 0xd3, 0x82, 0x81, 0xec,
+	0x29, 0xd8, 0xd1, 0x47, 0x60, 0x32, 0x0d, 0x26, 0x69, 0x66, 0x75, 0xe9, 0x5a, 0xab, 0x56, 0x5f,
+	0xfe, 0xaa, 0x05, 0x33, 0xd9, 0x36, 0x20, 0xfb, 0x7f, 0x12, 0x79, 0x38, 0xb2, 0x7a, 0xb5, 0xdb,
+	0x9e, 0x14, 0x0c, 0x64, 0x5b, 0xc1, 0x8e, 0x9e, 0xc1, 0x88, 0x49, 0x12, 0x1c, 0x96, 0x49, 0x45,
+	0x7c, 0xb5, 0x76, 0x77, 0x45, 0x50, 0x65, 0x29, 0x1a, 0x40, 0x3b, 0xc9, 0x2c, 0xfd, 0xa8, 0xf5,
+	0xae, 0xe1, 0xb4, 0x93, 0x6c, 0xd2, 0x05, 0xe3, 0x95, 0x1b, 0xa4, 0xd8, 0xfe, 0x8d, 0x06, 0x7b,
+	0xb2, 0x0d, 0xd0, 0x11, 0xf4, 0x93, 0xf0, 0x02, 0x2f, 0x4e, 0xb3, 0xf9, 0x34, 0x0c, 0x28, 0x16,
+	0x4c, 0x47, 0x26, 0xa1, 0xbb, 0x70, 0xe0, 0xce, 0xc3, 0x74, 0x91, 0x3c, 0xc7, 0xd1, 0x24, 0x74,
+	0x23, 0x2f, 0x08, 0x99, 0xfb, 0x35, 0xa7, 0x3a, 0x41, 0xe4, 0xcd, 0xfd, 0x45, 0xc1, 0xa7, 0x51,
+	0x3e, 0x99, 0x84, 0xde, 0x87, 0xd1, 0x32, 0xf2, 0x67, 0x58, 0x16, 0xa7, 0x53, 0xb6, 0x0a, 0x1d,
+	0xdd, 0x81, 0xfd, 0x24, 0x4c, 0xdc, 0xa0, 0x60, 0x34, 0x28, 0xa3, 0x4a, 0x44, 0x5f, 0x01, 0x33,
+	0x4e, 0xdc, 0x28, 0x49, 0xfc, 0x39, 0xa6, 0xfe, 0xd6, 0x1c, 0x41, 0x40, 0xb7, 0xa1, 0x17, 0x27,
+	0xe1, 0x92, 0x4e, 0x76, 0xe9, 0x64, 0x31, 0x26, 0x2b, 0x67, 0x51, 0xf8, 0xda, 0x7b, 0x91, 0x2e,
+	0x3c, 0xea, 0xb2, 0x9e, 0x23, 0x08, 0x64, 0xd6, 0x8d, 0x63, 0x9c, 0x7c, 0x7f, 0x85, 0x67, 0xd4,
+	0x1b, 0xa6, 0x23, 0x08, 0x64, 0x96, 0xea, 0x4b, 0x67, 0x81, 0xcd, 0x16, 0x04, 0x62, 0x07, 0x3a,
+	0xe0, 0x76, 0xed, 0x33, 0xbb, 0x4a, 0x24, 0xfb, 0x07, 0xd0, 0x97, 0x60, 0x8c, 0x0e, 0xa1, 0x43,
+	0x60, 0xf8, 0xf4, 0x31, 0xf7, 0x01, 0x1f, 0x11, 0x41, 0x53, 0xfe, 0xa2, 0x8f, 0x16, 0xb9, 0xe1,
+	0x65, 0x92, 0x7d, 0x17, 0x50, 0x35, 0x94, 0x9a, 0xe4, 0xd9, 0x7f, 0x6e, 0xc3, 0xa8, 0x1c, 0x3e,
+	0xd7, 0x05, 0x05, 0xc2, 0x5b, 0x9d, 0xb5, 0xde, 0xea, 0x6e, 0xf0, 0x56, 0xaf, 0xea, 0xad, 0x13,
+	0x61, 0x64, 0x91, 0x3b, 0xd0, 0x18, 0x8c, 0x69, 0x9a, 0x15, 0x36, 0x66, 0x83, 0x2d, 0x5c, 0xf6,
+	0x1e, 0x1c, 0x54, 0xb2, 0x49, 0xbd, 0x30, 0xfb, 0xf7, 0x2d, 0xb1, 0xb3, 0x14, 0xe1, 0x9b, 0x3d,
+	0xa6, 0xd8, 0xa3, 0x5d, 0xb6, 0xc7, 0x21, 0x74, 0xfc, 0xb8, 0x48, 0xca, 0x3d, 0x87, 0x8f, 0x88,
+	0x12, 0xf4, 0xb5, 0xb9, 0x33, 0xd8, 0x80, 0x70, 0x33, 0x27, 0x73, 0xd3, 0xf3, 0x91, 0xfd, 0x4d,
+	0xb0, 0x9a, 0xf2, 0x12, 0xb2, 0xa0, 0x1b, 0x92, 0x87, 0xe2, 0x85, 0xf2, 0xa1, 0xfd, 0x5b, 0x1d,
+	0x4c, 0xb2, 0xd9, 0xb6, 0x6f, 0x62, 0x41, 0xd7, 0xf5, 0xbc, 0x08, 0xc7, 0x31, 0x7f, 0x8f, 0x7c,
+	0x58, 0x8f, 0x4a, 0x6d, 0x4b, 0x54, 0xea, 0xdb, 0xa1, 0xd2, 0xd8, 0x16, 0x95, 0x9d, 0x3a, 0x54,
+	0xda, 0xb0, 0x17, 0x87, 0x81, 0x57, 0x30, 0xb1, 0x0c, 0xa4, 0xd0, 0xd4, 0xfc, 0xd5, 0x5b, 0x97,
+	0xbf, 0xcc, 0x75, 0xf9, 0x0b, 0xca, 0xf9, 0x4b, 0xa4, 0x80, 0xbe, 0x92, 0x52, 0x08, 0x3d, 0x71,
+	0x93, 0x34, 0xb6, 0xf6, 0xe8, 0x09, 0xc1, 0x47, 0x84, 0x7e, 0x8e, 0xfd, 0x97, 0xe7, 0x89, 0xb5,
+	0xcf, 0xbc, 0xcc, 0x46, 0x2a, 0x92, 0x06, 0x6b, 0x23, 0x6b, 0xb8, 0x21, 0xb2, 0x46, 0xd5, 0xc8,
+	0xfa, 0x54, 0x83, 0xfd, 0x3c, 0x11, 0xbd, 0x09, 0x88, 0xf8, 0x3a, 0x0c, 0xa6, 0x61, 0xfa, 0xf2,
+	0x3c, 0x29, 0x61, 0xa2, 0x44, 0x15, 0xe9, 0xa0, 0x27, 0xe7, 0x16, 0xe1, 0x3b, 0xb3, 0xc1, 0x77,
+	0xd0, 0xec, 0xbb, 0xfe, 0x5a, 0xdf, 0xed, 0x6d, 0xf0, 0xdd, 0x7e, 0xd5, 0x77, 0x7f, 0x6f, 0x03,
+	0x6c, 0x13, 0xf2, 0x65, 0x97, 0xb6, 0x37, 0xa4, 0x2b, 0xad, 0xac, 0xe8, 0x18, 0x8c, 0xf0, 0xf5,
+	0x02, 0x47, 0xd4, 0x45, 0xa6, 0xc3, 0x06, 0x52, 0x12, 0x33, 0xea, 0x93, 0x58, 0xa7, 0x3e, 0x89,
+	0x75, 0xe5, 0x24, 0x46, 0x02, 0x0c, 0xaf, 0xf0, 0x2c, 0x4d, 0xb0, 0xc7, 0xa3, 0xaf, 0x18, 0xef,
+	0x6c, 0xee, 0x31, 0x18, 0xfe, 0xc2, 0xc3, 0x2b, 0x6a, 0x6a, 0xcd, 0x61, 0x03, 0xf2, 0x6e, 0xd3,
+	0x20, 0x9c, 0x5d, 0x9c, 0x91, 0x18, 0xde, 0x63, 0x01, 0x5e, 0x10, 0x88, 0xac, 0x64, 0x75, 0xec,
+	0xc6, 0xe7, 0xdc, 0xc2, 0x7c, 0x64, 0x7f, 0x0b, 0xf6, 0x69, 0x72, 0x9d, 0x84, 0xe1, 0xc5, 0xa9,
+	0xef, 0x61, 0x84, 0x40, 0x9f, 0xe2, 0x98, 0x15, 0xec, 0x9a, 0x43, 0x9f, 0xc9, 0x86, 0xaf, 0xc3,
+	0x28, 0xce, 0x4f, 0x19, 0x36, 0xb0, 0xff, 0xd4, 0x82, 0x21, 0x5d, 0xfb, 0x9c, 0xbc, 0xf9, 0x09,
+	0x7e, 0x85, 0x25, 0xa3, 0xb4, 0x64, 0xa3, 0x20, 0xd0, 0x97, 0x11, 0x7e, 0xc5, 0x97, 0xd3, 0x67,
+	0x42, 0x5b, 0xe0, 0x55, 0x1e, 0x36, 0xf4, 0x99, 0xd0, 0xce, 0xb1, 0xeb, 0x71, 0xfb, 0xd3, 0x67,
+	0x42, 0x4b, 0x5c, 0x9f, 0x19, 0xdf, 0x74, 0xe8, 0xb3, 0x64, 0xe4, 0x8e, 0x62, 0xe4, 0x43, 0xe8,
+	0x50, 0x2c, 0xc4, 0xd4, 0xf8, 0x86, 0xc3, 0x47, 0xf6, 0x47, 0x30, 0xa0, 0x8a, 0x52, 0x00, 0xfd,
+	0x38, 0xf4, 0x84, 0x46, 0x0c, 0x41, 0xaa, 0x46, 0x0c, 0x37, 0xf4, 0xd9, 0xfe, 0xb5, 0x06, 0x26,
+	0x3b, 0x7c, 0xfc, 0x20, 0x20, 0x79, 0x76, 0xee, 0x5e, 0xe0, 0xe8, 0x13, 0x05, 0x7f, 0x0a, 0x8d,
+	0xf0, 0x24, 0x32, 0x0f, 0x93, 0xa6, 0xd0, 0xca, 0x40, 0xd5, 0x36, 0x00, 0x55, 0xaf, 0x01, 0x2a,
+	0xb3, 0xb2, 0x51, 0x0f, 0x3d, 0xd5, 0x2a, 0x2c, 0xc6, 0x71, 0xc4, 0x2b, 0x12, 0x36, 0xc8, 0xf3,
+	0x36, 0xbf, 0x45, 0xf0, 0xbc, 0xcd, 0xf3, 0x22, 0xd1, 0xf5, 0x29, 0xc3, 0xbc, 0x49, 0x31, 0x2f,
+	0x93, 0xfe, 0x1b, 0xb0, 0x44, 0x23, 0xd0, 0x62, 0x7c, 0x49, 0xcf, 0x01, 0xc3, 0x21, 0x8f, 0xf6,
+	0x3f, 0x34, 0x18, 0x38, 0x78, 0x86, 0xfd, 0x65, 0x32, 0x49, 0xb3, 0x89, 0x1b, 0xe3, 0x2d, 0x52,
+	0x78, 0x11, 0xd1, 0x6d, 0x39, 0xa2, 0x1b, 0xd3, 0xb7, 0xf9, 0xf9, 0xa6, 0x6f, 0xf3, 0x4a, 0xa4,
+	0x6f, 0x53, 0xce, 0x27, 0xfc, 0xa8, 0x86, 0xf2, 0x51, 0xcd, 0x9d, 0xd0, 0x57, 0x9c, 0x20, 0x1c,
+	0xbd, 0xd7, 0x9c, 0xee, 0xf7, 0xd7, 0xa6, 0xfb, 0xc1, 0x86, 0x74, 0x3f, 0xac, 0xa6, 0xfb, 0xbf,
+	0xea, 0x30, 0xe4, 0x8e, 0x26, 0x70, 0xbb, 0xe6, 0x9e, 0xbe, 0xfa, 0xa5, 0x9b, 0xc0, 0x4f, 0x81,
+	0xb6, 0xfd, 0x12, 0xda, 0x38, 0x7a, 0x06, 0x0d, 0xe8, 0x19, 0x36, 0xa3, 0x67, 0xb4, 0x16, 0x3d,
+	0x07, 0x1b, 0xd0, 0x83, 0xaa, 0xe8, 0x99, 0xc0, 0x2d, 0x0e, 0x1e, 0x76, 0xac, 0x15, 0x4d, 0xa2,
+	0xf7, 0x40, 0x9f, 0xba, 0x31, 0xe6, 0x8d, 0xa8, 0x5b, 0xbc, 0xe1, 0xa1, 0x66, 0x14, 0x87, 0xb2,
+	0xd8, 0x0f, 0x61, 0x5c, 0x92, 0xc1, 0x2e, 0xb0, 0x3b, 0x88, 0xa8, 0xaa, 0xc1, 0xae, 0x2e, 0xbb,
+	0xc8, 0x78, 0xa4, 0xca, 0x38, 0x2d, 0x7a, 0x64, 0xef, 0x2b, 0x32, 0x0e, 0x55, 0x19, 0x79, 0xcc,
+	0x70, 0x21, 0xdf, 0x83, 0x03, 0x69, 0x82, 0xdb, 0x62, 0x17, 0x01, 0x8f, 0xe1, 0xb0, 0xac, 0x05,
+	0x7f, 0x95, 0x5d, 0xa4, 0x04, 0xaa, 0x14, 0xa9, 0x9c, 0xfb, 0x9a, 0x74, 0x12, 0xf7, 0xef, 0x1f,
+	0x70, 0x29, 0x82, 0x81, 0x1f, 0xce, 0x1f, 0x40, 0x77, 0x96, 0x46, 0x11, 0x5e, 0xe4, 0x5d, 0xc1,
+	0x1a, 0xce, 0x9c, 0xc3, 0xfe, 0xae, 0x6a, 0x39, 0xca, 0x42, 0x0f, 0xf0, 0x3b, 0xa0, 0xbf, 0xf0,
+	0x83, 0x80, 0x6f, 0x36, 0x52, 0xba, 0x5e, 0x7e, 0x10, 0x38, 0x74, 0xd6, 0xfe, 0x63, 0x0b, 0xf6,
+	0x1d, 0x7c, 0xf9, 0xd0, 0xf3, 0xa2, 0x87, 0x04, 0x98, 0x31, 0x29, 0x0d, 0x48, 0xed, 0x9f, 0x97,
+	0x0b, 0xe4, 0x59, 0x8a, 0x92, 0xb6, 0x52, 0xb5, 0x8d, 0xc1, 0xa0, 0x89, 0xc9, 0xd2, 0x8e, 0x34,
+	0x12, 0x25, 0x74, 0x40, 0x70, 0xed, 0xf9, 0x11, 0xa6, 0x9d, 0x52, 0xde, 0x33, 0x13, 0x04, 0xb2,
+	0x66, 0x56, 0xdc, 0x7c, 0x0d, 0x87, 0x0d, 0x48, 0xa5, 0xfb, 0x22, 0x0a, 0xe7, 0x3f, 0xc2, 0x19,
+	0x6f, 0x35, 0xe4, 0x43, 0xfb, 0x0f, 0x2d, 0xe2, 0xd6, 0xcb, 0x33, 0x9a, 0x00, 0x77, 0xbb, 0xe4,
+	0xe6, 0x12, 0xdb, 0x8a, 0x44, 0xa1, 0x81, 0x26, 0x6b, 0xb0, 0x5e, 0x6b, 0x61, 0x01, 0x43, 0xb6,
+	0x00, 0xe9, 0x26, 0x8c, 0x72, 0xed, 0x26, 0x69, 0x76, 0xb5, 0x94, 0xfb, 0xb7, 0x46, 0x9c, 0xbb,
+	0x0c, 0xb2, 0x1d, 0x34, 0xdb, 0xf1, 0x70, 0xb9, 0xfe, 0xb7, 0xc0, 0xcf, 0xa5, 0x8c, 0x18, 0x81,
+	0x76, 0x81, 0x33, 0x7e, 0x98, 0x90, 0xc7, 0x2f, 0xb8, 0x07, 0xf0, 0x2f, 0x5a, 0x41, 0x2e, 0x83,
+	0x6c, 0x97, 0x88, 0xf9, 0x5f, 0x75, 0xfd, 0x36, 0x75, 0xc5, 0x9b, 0xe1, 0xf6, 0x63, 0x18, 0xaa,
+	0x5e, 0x8f, 0xd1, 0x87, 0xec, 0xe3, 0x0d, 0x1b, 0x59, 0xad, 0x23, 0x4d, 0x39, 0x8a, 0x65, 0x5e,
+	0x47, 0x62, 0xb4, 0x1f, 0xc3, 0x40, 0xc9, 0x1c, 0x31, 0xff, 0x5a, 0xa5, 0xc8, 0x19, 0xcb, 0x72,
+	0x72, 0x4e, 0x47, 0xb0, 0xd9, 0x9f, 0xea, 0x5c, 0x21, 0x71, 0x25, 0xbd, 0xde, 0x29, 0x88, 0x7e,
+	0x37, 0x2c, 0x23, 0xb1, 0x44, 0xbd, 0x4a, 0x58, 0x14, 0xd7, 0xd5, 0x41, 0xf9, 0xba, 0x7a, 0x04,
+	0x7d, 0xd6, 0xfd, 0x61, 0x1f, 0xcf, 0x86, 0xec, 0x72, 0x2c, 0x91, 0xbe, 0xf0, 0xea, 0x76, 0x54,
+	0x82, 0x4e, 0x8c, 0xee, 0x15, 0x4d, 0x0f, 0x06, 0xc0, 0x43, 0x19, 0x80, 0x82, 0xb1, 0x68, 0x86,
+	0x3c, 0x83, 0x3d, 0x07, 0x5f, 0x12, 0x8d, 0xe9, 0x01, 0x8d, 0xde, 0x01, 0x9d, 0x58, 0x6f, 0xcd,
+	0x17, 0x5a, 0x87, 0x32, 0xd4, 0x43, 0xd0, 0xfe, 0x05, 0xad, 0x95, 0xa4, 0x6f, 0x42, 0xdf, 0x80,
+	0x0e, 0xfb, 0x54, 0x68, 0xb5, 0x6a, 0x3f, 0x4f, 0x0a, 0x56, 0x87, 0x33, 0x36, 0x48, 0x7e, 0x0a,
+	0x7d, 0x07, 0x5f, 0x4e, 0xd2, 0x8c, 0xe9, 0x79, 0x07, 0xb4, 0x69, 0x9a, 0x59, 0xad, 0xa6, 0x6f,
+	0xc2, 0x0e, 0x99, 0x16, 0xcd, 0x8e, 0xb6, 0xd4, 0xec, 0xb0, 0xff, 0xa9, 0x03, 0x9c, 0x84, 0x33,
+	0x57, 0xa4, 0x7d, 0xea, 0x13, 0x35, 0xdc, 0x24, 0xd2, 0xff, 0xc3, 0x6d, 0xe7, 0x70, 0xd3, 0xae,
+	0x60, 0xb8, 0x59, 0xd0, 0x4d, 0x56, 0x4f, 0x69, 0x4f, 0x8a, 0x05, 0x5b, 0x3e, 0x44, 0x6f, 0x03,
+	0xf8, 0xf1, 0x13, 0x7f, 0xe1, 0xc7, 0xe7, 0xd8, 0xa3, 0x91, 0xd6, 0x73, 0x24, 0x8a, 0x1a, 0xa8,
+	0x37, 0x37, 0x04, 0xea, 0xb8, 0x1a, 0xa8, 0xbf, 0x6b, 0xd3, 0xb0, 0x78, 0xee, 0xfa, 0x11, 0x0f,
+	0x53, 0x45, 0xd3, 0x56, 0x59, 0xd3, 0xad, 0x5a, 0xd7, 0x42, 0x23, 0x6d, 0x83, 0x46, 0x7a, 0x45,
+	0xa3, 0xc6, 0x36, 0xb6, 0x6a, 0x89, 0x4e, 0xc5, 0x12, 0x52, 0x55, 0xde, 0x6d, 0xa8, 0xca, 0x7b,
+	0x8d, 0x55, 0xb9, 0x59, 0xaa, 0xca, 0x6d, 0x4c, 0xcd, 0x42, 0xa3, 0xf6, 0x31, 0x5e, 0x26, 0xe7,
+	0x9f, 0xf9, 0x13, 0x63, 0xed, 0xd5, 0xc0, 0xfe, 0x39, 0x0c, 0xc5, 0x1e, 0xeb, 0x3a, 0xd3, 0xa2,
+	0x67, 0xda, 0x6e, 0xe8, 0x24, 0x6b, 0x4a, 0x27, 0xf9, 0x42, 0x3e, 0xbb, 0xd9, 0x1b, 0xdc, 0x05,
+	0x83, 0x84, 0x43, 0x39, 0xfd, 0x96, 0xf6, 0x77, 0x18, 0x13, 0xbd, 0x34, 0xa7, 0x19, 0xb9, 0x33,
+	0xae, 0x63, 0xa6, 0x3c, 0xf6, 0x5f, 0x5a, 0xc2, 0x5a, 0xe4, 0x7a, 0x1a, 0x7f, 0x66, 0x6b, 0xe5,
+	0xf7, 0x58, 0x4d, 0xba, 0xc7, 0xbe, 0x0d, 0xb0, 0x8c, 0xfc, 0xb9, 0x1b, 0x65, 0xc4, 0xc7, 0x0c,
+	0x39, 0x12, 0xa5, 0xe1, 0x6e, 0xaa, 0xb8, 0xb9, 0x53, 0x76, 0xf3, 0x31, 0xaf, 0x94, 0x0a, 0xd5,
+	0xb7, 0xbb, 0x79, 0xe7, 0x09, 0xa2, 0x5d, 0x24, 0x08, 0xfb, 0x63, 0xd9, 0xe0, 0xcc, 0x08, 0x1f,
+	0x80, 0xf1, 0xc2, 0x17, 0x06, 0xbf, 0x55, 0x39, 0xef, 0xa8, 0x40, 0xc6, 0x63, 0xff, 0x12, 0x6e,
+	0xe6, 0x26, 0x14, 0x9d, 0x82, 0xfa, 0x0b, 0xbd, 0x6a, 0x88, 0x76, 0xb3, 0x21, 0xb6, 0xbf, 0x85,
+	0xda, 0x3f, 0x81, 0xb1, 0xd0, 0x4b, 0x28, 0x80, 0xde, 0x01, 0x83, 0x22, 0xaa, 0xb9, 0xed, 0xc1,
+	0xe6, 0x6b, 0x2c, 0x72, 0x02, 0xb7, 0xea, 0x44, 0xc6, 0xe8, 0x41, 0xa9, 0x10, 0xf8, 0x72, 0xc5,
+	0x30, 0x92, 0x78, 0xce, 0x7a, 0xff, 0x6f, 0x3a, 0x18, 0xf4, 0x6c, 0x40, 0x1f, 0xc3, 0xf8, 0x51,
+	0x84, 0xdd, 0x04, 0x3b, 0xee, 0xeb, 0xa2, 0xd5, 0x73, 0xb6, 0x42, 0x75, 0x15, 0xc1, 0xed, 0x21,
+	0x27, 0xfe, 0x74, 0x11, 0xfb, 0x2f, 0x17, 0x67, 0x2b, 0xfb, 0x06, 0xfa, 0x0e, 0xdc, 0x54, 0xd7,
+	0x93, 0x93, 0x7b, 0x85, 0x6a, 0x4e, 0xea, 0xba, 0xd5, 0x4f, 0xe0, 0x50, 0x5d, 0xcd, 0xca, 0x84,
+	0xb3, 0x15, 0x6a, 0xae, 0x1f, 0xea, 0xe5, 0x58, 0x15, 0x2d, 0xe8, 0x6b, 0x9f, 0xad, 0x50, 0xd3,
+	0x8f, 0x5d, 0x75, 0x72, 0x7e, 0x08, 0xb7, 0xab, 0xd6, 0x60, 0xed, 0xb3, 0x1a, 0x9d, 0xc4, 0x64,
+	0x9d, 0xac, 0x63, 0x78, 0xab, 0xee, 0xdd, 0x98, 0x7d, 0x1a, 0x7f, 0xfc, 0xda, 0x4a, 0x2b, 0xe1,
+	0xd1, 0x1a, 0xad, 0xc4, 0x64, 0x9d, 0xac, 0x9f, 0xc1, 0x51, 0x9d, 0x56, 0x8a, 0xc4, 0x4d, 0xff,
+	0x85, 0xd5, 0xc8, 0x9d, 0x76, 0xe8, 0x7f, 0x80, 0x0f, 0xfe, 0x33, 0x00, 0xea, 0xa4, 0xd9, 0x79,
+	0x30, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2724,6 +4270,8 @@ type TradeClient interface {
 	CreateRawTradeBuyLimitTx(ctx context.Context, in *TradeForBuyLimit, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(ctx context.Context, in *TradeForSellMarket, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeForRevokeBuy, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	CreateRawTradeLimitOrderTx(ctx context.Context, in *TradeForLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	CreateRawTradeRevokeLimitOrderTx(ctx context.Context, in *TradeForRevokeLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error)
 }
 
 type tradeClient struct {
@@ -2788,6 +4336,24 @@ func (c *tradeClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeFo
 	return out, nil
 }
 
+func (c *tradeClient) CreateRawTradeLimitOrderTx(ctx context.Context, in *TradeForLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.trade/CreateRawTradeLimitOrderTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeClient) CreateRawTradeRevokeLimitOrderTx(ctx context.Context, in *TradeForRevokeLimitOrder, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.trade/CreateRawTradeRevokeLimitOrderTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradeServer is the server API for Trade service.
 type TradeServer interface {
 	CreateRawTradeSellTx(context.Context, *TradeForSell) (*types.UnsignTx, error)
@@ -2796,6 +4362,8 @@ type TradeServer interface {
 	CreateRawTradeBuyLimitTx(context.Context, *TradeForBuyLimit) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(context.Context, *TradeForSellMarket) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(context.Context, *TradeForRevokeBuy) (*types.UnsignTx, error)
+	CreateRawTradeLimitOrderTx(context.Context, *TradeForLimitOrder) (*types.UnsignTx, error)
+	CreateRawTradeRevokeLimitOrderTx(context.Context, *TradeForRevokeLimitOrder) (*types.UnsignTx, error)
 }
 
 func RegisterTradeServer(s *grpc.Server, srv TradeServer) {
@@ -2910,6 +4478,42 @@ func _Trade_CreateRawTradeRevokeBuyTx_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trade_CreateRawTradeLimitOrderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeForLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServer).CreateRawTradeLimitOrderTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.trade/CreateRawTradeLimitOrderTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServer).CreateRawTradeLimitOrderTx(ctx, req.(*TradeForLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trade_CreateRawTradeRevokeLimitOrderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeForRevokeLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServer).CreateRawTradeRevokeLimitOrderTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.trade/CreateRawTradeRevokeLimitOrderTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServer).CreateRawTradeRevokeLimitOrderTx(ctx, req.(*TradeForRevokeLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Trade_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.trade",
 	HandlerType: (*TradeServer)(nil),
@@ -2938,6 +4542,14 @@ var _Trade_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRawTradeRevokeBuyTx",
 			Handler:    _Trade_CreateRawTradeRevokeBuyTx_Handler,
 		},
+		{
+			MethodName: "CreateRawTradeLimitOrderTx",
+			Handler:    _Trade_CreateRawTradeLimitOrderTx_Handler,
+		},
+		{
+			MethodName: "CreateRawTradeRevokeLimitOrderTx",
+			Handler:    _Trade_CreateRawTradeRevokeLimitOrderTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trade.proto",
@@ -13,6 +13,8 @@ type TradeSellTx struct {
 	TotalBoardlot     int64  `json:"totalBoardlot"`
 	Fee               int64  `json:"fee"`
 	AssetExec         string `json:"assetExec"`
+	PriceExec         string `json:"priceExec"`
+	PriceSymbol       string `json:"priceSymbol"`
 }
 
 //TradeBuyTx :info for buy order to speficied order
@@ -37,6 +39,8 @@ type TradeBuyLimitTx struct {
 	TotalBoardlot     int64  `json:"totalBoardlot"`
 	Fee               int64  `json:"fee"`
 	AssetExec         string `json:"assetExec"`
+	PriceExec         string `json:"priceExec"`
+	PriceSymbol       string `json:"priceSymbol"`
 }
 
 //TradeSellMarketTx :用于向指定买单出售token的信息
@@ -51,3 +55,19 @@ type TradeRevokeBuyTx struct {
 	BuyID string `json:"buyID,"`
 	Fee   int64  `json:"fee"`
 }
+
+//TradeLimitOrderTx :撮合模式的限价单
+type TradeLimitOrderTx struct {
+	TokenSymbol string `json:"tokenSymbol"`
+	AssetExec   string `json:"assetExec"`
+	IsSell      bool   `json:"isSell"`
+	Price       int64  `json:"price"`
+	Amount      int64  `json:"amount"`
+	Fee         int64  `json:"fee"`
+}
+
+//TradeRevokeLimitOrderTx :撤销撮合模式的限价单
+type TradeRevokeLimitOrderTx struct {
+	OrderID string `json:"orderID"`
+	Fee     int64  `json:"fee"`
+}
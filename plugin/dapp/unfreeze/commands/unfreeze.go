@@ -30,6 +30,8 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(createCmd())
 	cmd.AddCommand(withdrawCmd())
 	cmd.AddCommand(terminateCmd())
+	cmd.AddCommand(reassignCmd())
+	cmd.AddCommand(acceptReassignCmd())
 	cmd.AddCommand(showCmd())
 	cmd.AddCommand(queryWithdrawCmd())
 	cmd.AddCommand(listUnfreezeCmd())
@@ -44,6 +46,8 @@ func createCmd() *cobra.Command {
 
 	cmd.AddCommand(fixAmountCmd())
 	cmd.AddCommand(leftCmd())
+	cmd.AddCommand(linearCmd())
+	cmd.AddCommand(tranchesCmd())
 	return cmd
 }
 
@@ -63,6 +67,8 @@ func createFlag(cmd *cobra.Command) *cobra.Command {
 	cmd.PersistentFlags().Int64P("start_ts", "", 0, "effect, UTC timestamp")
 	//cmd.MarkFlagRequired("start_ts")
 
+	cmd.PersistentFlags().Int64P("cliff", "", 0, "cliff in second after start, nothing unfreeze before it")
+
 	return cmd
 }
 
@@ -79,7 +85,11 @@ func getCreateFlags(cmd *cobra.Command) (*pty.UnfreezeCreate, error) {
 	symbol, _ := cmd.Flags().GetString("asset_symbol")
 	total, _ := cmd.Flags().GetFloat64("total")
 	startTs, _ := cmd.Flags().GetInt64("start_ts")
+	cliff, _ := cmd.Flags().GetInt64("cliff")
 
+	if cliff < 0 {
+		return nil, types.ErrInvalidParam
+	}
 	if err := checkAmount(total); err != nil {
 		return nil, types.ErrAmount
 	}
@@ -92,6 +102,7 @@ func getCreateFlags(cmd *cobra.Command) (*pty.UnfreezeCreate, error) {
 		TotalCount:  totalInt64,
 		Beneficiary: beneficiary,
 		Means:       "",
+		Cliff:       cliff,
 	}
 	return unfreeze, nil
 }
@@ -199,6 +210,93 @@ func left(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func linearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "linear",
+		Short: "create linear means unfreeze construct",
+		Run:   linear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("duration", "", 0, "duration in second to unfreeze all")
+	cmd.MarkFlagRequired("duration")
+	return cmd
+}
+
+func linear(cmd *cobra.Command, args []string) {
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	duration, _ := cmd.Flags().GetInt64("duration")
+	if duration <= 0 {
+		fmt.Fprintf(os.Stderr, "duration must be positive integer")
+		return
+	}
+	create.Means = pty.LinearX
+	create.MeansOpt = &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: duration}}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func tranchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tranches",
+		Short: "create tranches means unfreeze construct",
+		Run:   tranches,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().StringP("tranches", "", "", "tranche list, UTC timestamp:amount separated by comma, sum of amount must be total")
+	cmd.MarkFlagRequired("tranches")
+	return cmd
+}
+
+func tranches(cmd *cobra.Command, args []string) {
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	list, _ := cmd.Flags().GetString("tranches")
+	opt := &pty.Tranches{}
+	for _, item := range strings.Split(list, ",") {
+		var ts int64
+		var amount float64
+		if _, err = fmt.Sscanf(strings.TrimSpace(item), "%d:%f", &ts, &amount); err != nil {
+			fmt.Fprintln(os.Stderr, "bad tranche", item)
+			return
+		}
+		if err = checkAmount(amount); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		amountInt64 := int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+		opt.Tranches = append(opt.Tranches, &pty.Tranche{Time: ts, Amount: amountInt64})
+	}
+	create.Means = pty.TranchesX
+	create.MeansOpt = &pty.UnfreezeCreate_Tranches{Tranches: opt}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func withdrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw",
@@ -223,6 +321,33 @@ func terminateCmd() *cobra.Command {
 	return cmd
 }
 
+func reassignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reassign",
+		Short: "propose new beneficiary of construct, empty to cancel",
+		Run:   reassign,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("beneficiary", "b", "", "address of new beneficiary")
+
+	return cmd
+}
+
+func acceptReassignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept_reassign",
+		Short: "beneficiary accept new beneficiary of construct",
+		Run:   acceptReassign,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("beneficiary", "b", "", "address of new beneficiary")
+	cmd.MarkFlagRequired("beneficiary")
+
+	return cmd
+}
+
 func showCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show",
@@ -275,6 +400,36 @@ func terminate(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func reassign(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_ReassignUnfreeze,
+		Payload:    types.MustPBToJSON(&pty.UnfreezeReassign{UnfreezeID: id, NewBeneficiary: beneficiary}),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func acceptReassign(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     types.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_AcceptReassignUnfreeze,
+		Payload:    types.MustPBToJSON(&pty.UnfreezeAcceptReassign{UnfreezeID: id, NewBeneficiary: beneficiary}),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func queryWithdraw(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	paraName, _ := cmd.Flags().GetString("paraName")
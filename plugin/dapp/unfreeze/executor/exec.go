@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
@@ -119,6 +120,63 @@ func (u *Unfreeze) Exec_Terminate(payload *pty.UnfreezeTerminate, tx *types.Tran
 	return mergeReceipt(receipt, receipt1)
 }
 
+// Exec_Reassign 执行发币人提议更换收币人
+func (u *Unfreeze) Exec_Reassign(payload *pty.UnfreezeReassign, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeVestingX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if payload.NewBeneficiary != "" {
+		if err := address.CheckAddress(payload.NewBeneficiary); err != nil {
+			return nil, err
+		}
+	}
+	unfreeze, err := loadUnfreeze(unfreezeIDFromHex(payload.UnfreezeID), u.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	if tx.From() != unfreeze.Initiator {
+		uflog.Error("unfreeze reassign no privilege", "initiator", unfreeze.Initiator, "from", tx.From())
+		return nil, pty.ErrNoPrivilege
+	}
+	if unfreeze.Remaining <= 0 {
+		return nil, pty.ErrUnfreezeEmptied
+	}
+	if payload.NewBeneficiary == unfreeze.Beneficiary {
+		return nil, types.ErrInvalidParam
+	}
+	if payload.NewBeneficiary == "" && unfreeze.PendingBeneficiary == "" {
+		return nil, pty.ErrUnfreezeReassign
+	}
+
+	unfreezeOld := *unfreeze
+	unfreeze.PendingBeneficiary = payload.NewBeneficiary
+	return u.save(&unfreezeOld, unfreeze, pty.TyLogReassignUnfreeze)
+}
+
+// Exec_AcceptReassign 执行原收币人同意更换收币人, 未提取的已解冻部分归新收币人
+func (u *Unfreeze) Exec_AcceptReassign(payload *pty.UnfreezeAcceptReassign, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !types.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeVestingX) {
+		return nil, types.ErrActionNotSupport
+	}
+	unfreeze, err := loadUnfreeze(unfreezeIDFromHex(payload.UnfreezeID), u.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	if tx.From() != unfreeze.Beneficiary {
+		uflog.Error("unfreeze accept reassign no privilege", "beneficiary", unfreeze.Beneficiary, "from", tx.From())
+		return nil, pty.ErrNoPrivilege
+	}
+	if unfreeze.PendingBeneficiary == "" || unfreeze.PendingBeneficiary != payload.NewBeneficiary {
+		uflog.Error("unfreeze accept reassign", "pending", unfreeze.PendingBeneficiary, "new", payload.NewBeneficiary)
+		return nil, pty.ErrUnfreezeReassign
+	}
+
+	unfreezeOld := *unfreeze
+	unfreeze.Beneficiary = unfreeze.PendingBeneficiary
+	unfreeze.PendingBeneficiary = ""
+	return u.save(&unfreezeOld, unfreeze, pty.TyLogAcceptReassignUnfreeze)
+}
+
 func (u *Unfreeze) newEntity(payload *pty.UnfreezeCreate, tx *types.Transaction) (*pty.Unfreeze, error) {
 	id := unfreezeID(tx.Hash())
 	unfreeze := &pty.Unfreeze{
@@ -161,6 +219,20 @@ func (u *Unfreeze) create(unfreeze *pty.Unfreeze) (*types.Receipt, error) {
 		KV: []*types.KeyValue{{Key: k, Value: v}}, Logs: []*types.ReceiptLog{receiptLog}}, nil
 }
 
+// 保存解冻状态
+func (u *Unfreeze) save(prev, unfreeze *pty.Unfreeze, ty int32) (*types.Receipt, error) {
+	k := []byte(unfreeze.UnfreezeID)
+	v := types.Encode(unfreeze)
+	err := u.GetStateDB().Set(k, v)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptLog := getUnfreezeLog(prev, unfreeze, ty)
+	return &types.Receipt{Ty: types.ExecOk,
+		KV: []*types.KeyValue{{Key: k, Value: v}}, Logs: []*types.ReceiptLog{receiptLog}}, nil
+}
+
 func mergeReceipt(r1 *types.Receipt, r2 *types.Receipt) (*types.Receipt, error) {
 	r1.Logs = append(r1.Logs, r2.Logs...)
 	r1.KV = append(r1.KV, r2.KV...)
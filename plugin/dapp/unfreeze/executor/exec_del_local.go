@@ -20,7 +20,7 @@ func (u *Unfreeze) execDelLocal(receiptData *types.ReceiptData, index int) (*typ
 	txIndex := dapp.HeightIndexStr(u.GetHeight(), int64(index))
 	for _, log := range receiptData.Logs {
 		switch log.Ty {
-		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze:
+		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze, uf.TyLogReassignUnfreeze, uf.TyLogAcceptReassignUnfreeze:
 			var receipt uf.ReceiptUnfreeze
 			err := types.Decode(log.Log, &receipt)
 			if err != nil {
@@ -62,3 +62,13 @@ func (u *Unfreeze) ExecDelLocal_Withdraw(payload *uf.UnfreezeWithdraw, tx *types
 func (u *Unfreeze) ExecDelLocal_Terminate(payload *uf.UnfreezeTerminate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return u.execDelLocal(receiptData, index)
 }
+
+// ExecDelLocal_Reassign 本地撤销执行更换收币人提议
+func (u *Unfreeze) ExecDelLocal_Reassign(payload *uf.UnfreezeReassign, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execDelLocal(receiptData, index)
+}
+
+// ExecDelLocal_AcceptReassign 本地撤销执行同意更换收币人
+func (u *Unfreeze) ExecDelLocal_AcceptReassign(payload *uf.UnfreezeAcceptReassign, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execDelLocal(receiptData, index)
+}
@@ -20,7 +20,7 @@ func (u *Unfreeze) execLocal(receiptData *types.ReceiptData, index int) (*types.
 
 	for _, log := range receiptData.Logs {
 		switch log.Ty {
-		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze:
+		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze, uf.TyLogReassignUnfreeze, uf.TyLogAcceptReassignUnfreeze:
 			var receipt uf.ReceiptUnfreeze
 			err := types.Decode(log.Log, &receipt)
 			if err != nil {
@@ -72,3 +72,13 @@ func (u *Unfreeze) ExecLocal_Withdraw(payload *uf.UnfreezeWithdraw, tx *types.Tr
 func (u *Unfreeze) ExecLocal_Terminate(payload *uf.UnfreezeTerminate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return u.execLocal(receiptData, index)
 }
+
+// ExecLocal_Reassign 本地执行更换收币人提议
+func (u *Unfreeze) ExecLocal_Reassign(payload *uf.UnfreezeReassign, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execLocal(receiptData, index)
+}
+
+// ExecLocal_AcceptReassign 本地执行同意更换收币人
+func (u *Unfreeze) ExecLocal_AcceptReassign(payload *uf.UnfreezeAcceptReassign, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execLocal(receiptData, index)
+}
@@ -280,3 +280,96 @@ func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error
 	tx.Sign(int32(signType), privKey)
 	return tx, nil
 }
+
+func TestUnfreezeReassign(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	execAddr := address.ExecAddress(pty.UnfreezeX)
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc, _ := account.NewAccountDB(AssetExecPara, Symbol, kvdb)
+	acc.SaveExecAccount(execAddr, &types.Account{Addr: string(Nodes[0]), Balance: 100000})
+
+	height := types.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeVestingX)
+	exec := newUnfreeze()
+	exec.SetStateDB(kvdb)
+	exec.SetLocalDB(kvdb)
+	run := func(tx *types.Transaction, priv string, blockTime int64) (*types.Receipt, error) {
+		tx, err := signTx(tx, priv)
+		assert.Nil(t, err)
+		exec.SetEnv(height, blockTime, 0)
+		receipt, err := exec.Exec(tx, 1)
+		if err != nil {
+			return nil, err
+		}
+		_, err = exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+		assert.Nil(t, err)
+		return receipt, nil
+	}
+	list := func(beneficiary string) int {
+		reply, err := exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: beneficiary}))
+		if err == types.ErrNotFound {
+			return 0
+		}
+		assert.Nil(t, err)
+		return len(reply.(*pty.ReplyUnfreezes).Unfreeze)
+	}
+
+	//锁定期50秒, 100秒内线性解冻
+	createTx, err := pty.CreateUnfreezeCreateTx("", &pty.UnfreezeCreate{
+		StartTime:   10000,
+		AssetExec:   AssetExecPara,
+		AssetSymbol: Symbol,
+		TotalCount:  10000,
+		Beneficiary: string(Nodes[1]),
+		Means:       pty.LinearX,
+		MeansOpt:    &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: 100}},
+		Cliff:       50,
+	})
+	assert.Nil(t, err)
+	_, err = run(createTx, PrivKeyA, 10000)
+	assert.Nil(t, err)
+	id := hex.EncodeToString(createTx.Hash())
+
+	unfreeze, err := loadUnfreeze(unfreezeIDFromHex(id), kvdb)
+	assert.Nil(t, err)
+	available, err := getWithdrawAvailable(unfreeze, 10049)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), available)
+	available, err = getWithdrawAvailable(unfreeze, 10050)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5000), available)
+
+	reassign := func(priv, beneficiary string) error {
+		tx, err := pty.CreateUnfreezeReassignTx("", &pty.UnfreezeReassign{UnfreezeID: id, NewBeneficiary: beneficiary})
+		assert.Nil(t, err)
+		_, err = run(tx, priv, 10060)
+		return err
+	}
+	accept := func(priv, beneficiary string) error {
+		tx, err := pty.CreateUnfreezeAcceptReassignTx("", &pty.UnfreezeAcceptReassign{UnfreezeID: id, NewBeneficiary: beneficiary})
+		assert.Nil(t, err)
+		_, err = run(tx, priv, 10060)
+		return err
+	}
+
+	//只有发币人可以提议, 只有原收币人可以同意
+	assert.Equal(t, pty.ErrNoPrivilege, reassign(PrivKeyB, string(Nodes[2])))
+	assert.Equal(t, pty.ErrUnfreezeReassign, accept(PrivKeyB, string(Nodes[2])))
+	assert.Nil(t, reassign(PrivKeyA, string(Nodes[2])))
+	assert.Equal(t, pty.ErrNoPrivilege, accept(PrivKeyC, string(Nodes[2])))
+	assert.Equal(t, pty.ErrUnfreezeReassign, accept(PrivKeyB, string(Nodes[3])))
+	assert.Equal(t, 1, list(string(Nodes[1])))
+	assert.Nil(t, accept(PrivKeyB, string(Nodes[2])))
+	assert.Equal(t, 0, list(string(Nodes[1])))
+	assert.Equal(t, 1, list(string(Nodes[2])))
+
+	//新收币人提取已解冻部分
+	withdrawTx, err := pty.CreateUnfreezeWithdrawTx("", &pty.UnfreezeWithdraw{UnfreezeID: id})
+	assert.Nil(t, err)
+	_, err = run(withdrawTx, PrivKeyB, 10060)
+	assert.Equal(t, pty.ErrNoPrivilege, err)
+	_, err = run(withdrawTx, PrivKeyC, 10060)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6000), acc.LoadExecAccount(string(Nodes[2]), execAddr).Balance)
+	assert.Equal(t, int64(4000), acc.LoadExecAccount(string(Nodes[0]), execAddr).Frozen)
+}
@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"math/big"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
 )
@@ -16,6 +18,22 @@ type Means interface {
 }
 
 func newMeans(means string, height int64) (Means, error) {
+	if types.IsDappFork(height, pty.UnfreezeX, pty.ForkUnfreezeVestingX) {
+		var m Means
+		switch means {
+		case pty.FixAmountX:
+			m = &fixAmountV2{}
+		case pty.LeftProportionX:
+			m = &leftProportionV2{}
+		case pty.LinearX:
+			m = &linear{}
+		case pty.TranchesX:
+			m = &tranches{}
+		default:
+			return nil, types.ErrNotSupport
+		}
+		return &cliff{Means: m}, nil
+	}
 	if types.IsDappFork(height, pty.UnfreezeX, "ForkTerminatePart") {
 		if means == "FixAmount" {
 			return &fixAmountV2{}, nil
@@ -158,3 +176,105 @@ func (opt *leftProportionV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int6
 	}
 	return int64(frozen), nil
 }
+
+// cliff 锁定期内不解冻, 锁定期结束后按具体解冻方式计算
+type cliff struct {
+	Means
+}
+
+func (opt *cliff) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	if from.Cliff < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.Cliff = from.Cliff
+	return opt.Means.setOpt(unfreeze, from)
+}
+
+func (opt *cliff) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	if !unfreeze.Terminated && now < unfreeze.StartTime+unfreeze.Cliff {
+		return unfreeze.Remaining, nil
+	}
+	return opt.Means.calcFrozen(unfreeze, now)
+}
+
+// linear 从开始时间起在duration内按区块时间连续解冻
+type linear struct {
+}
+
+func (opt *linear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Duration <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_Linear{Linear: o}
+	return unfreeze, nil
+}
+
+func (opt *linear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	elapsed := now - unfreeze.StartTime
+	if elapsed <= 0 {
+		return unfreeze.TotalCount, nil
+	}
+	if elapsed >= means.Duration {
+		return 0, nil
+	}
+	// TotalCount * elapsed 可能超出int64
+	unfreezeAmount := new(big.Int).Mul(big.NewInt(unfreeze.TotalCount), big.NewInt(elapsed))
+	unfreezeAmount.Div(unfreezeAmount, big.NewInt(means.Duration))
+	return unfreeze.TotalCount - unfreezeAmount.Int64(), nil
+}
+
+// tranches 按时间表在指定时间解冻指定数额
+type tranches struct {
+}
+
+func (opt *tranches) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetTranches()
+	if o == nil || len(o.Tranches) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	var total, last int64
+	for i, t := range o.Tranches {
+		if t.Amount <= 0 || (i > 0 && t.Time <= last) {
+			return nil, types.ErrInvalidParam
+		}
+		if total+t.Amount < total {
+			return nil, types.ErrInvalidParam
+		}
+		total += t.Amount
+		last = t.Time
+	}
+	if total != unfreeze.TotalCount {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_Tranches{Tranches: o}
+	return unfreeze, nil
+}
+
+func (opt *tranches) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetTranches()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated {
+		return 0, nil
+	}
+	frozen := unfreeze.TotalCount
+	for _, t := range means.Tranches {
+		if t.Time > now {
+			break
+		}
+		frozen -= t.Amount
+	}
+	return frozen, nil
+}
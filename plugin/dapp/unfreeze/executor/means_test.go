@@ -180,3 +180,89 @@ func TestFixV1(t *testing.T) {
 		})
 	}
 }
+
+func TestLinear(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	m, err := newMeans(pty.LinearX, types.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeVestingX))
+	assert.Nil(t, err)
+
+	cases := []struct {
+		now    int64
+		cliff  int64
+		total  int64
+		expect int64
+	}{
+		{9999, 0, 10000, 10000},
+		{10000, 0, 10000, 10000},
+		{10001, 0, 10000, 9900},
+		{10050, 0, 10000, 5000},
+		{10100, 0, 10000, 0},
+		{10200, 0, 10000, 0},
+		{10049, 50, 10000, 10000},
+		{10050, 50, 10000, 5000},
+		{10050, 0, 9e18, 4.5e18},
+	}
+	for _, c := range cases {
+		create := &pty.UnfreezeCreate{
+			Cliff:    c.cliff,
+			MeansOpt: &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: 100}},
+		}
+		u := &pty.Unfreeze{TotalCount: c.total, Remaining: c.total, Means: pty.LinearX, StartTime: 10000}
+		u, err = m.setOpt(u, create)
+		assert.Nil(t, err)
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f, "now %d cliff %d", c.now, c.cliff)
+	}
+
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 100}, &pty.UnfreezeCreate{
+		MeansOpt: &pty.UnfreezeCreate_Linear{Linear: &pty.Linear{Duration: 0}}})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
+
+func TestTranches(t *testing.T) {
+	types.SetTitleOnlyForTest("chain33")
+	m, err := newMeans(pty.TranchesX, types.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeVestingX))
+	assert.Nil(t, err)
+
+	tranches := func(list ...int64) *pty.UnfreezeCreate {
+		opt := &pty.Tranches{}
+		for i := 0; i+1 < len(list); i += 2 {
+			opt.Tranches = append(opt.Tranches, &pty.Tranche{Time: list[i], Amount: list[i+1]})
+		}
+		return &pty.UnfreezeCreate{MeansOpt: &pty.UnfreezeCreate_Tranches{Tranches: opt}}
+	}
+
+	//数额之和必须等于总额, 时间必须递增
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 100}, tranches(10, 50, 20, 40))
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 100}, tranches(20, 50, 20, 50))
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 100}, tranches(10, 0, 20, 100))
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = m.setOpt(&pty.Unfreeze{TotalCount: 100}, tranches())
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	u, err := m.setOpt(&pty.Unfreeze{TotalCount: 100, Remaining: 100, StartTime: 5}, tranches(10, 30, 20, 50, 30, 20))
+	assert.Nil(t, err)
+	cases := []struct {
+		now    int64
+		expect int64
+	}{
+		{9, 100},
+		{10, 70},
+		{19, 70},
+		{20, 20},
+		{30, 0},
+	}
+	for _, c := range cases {
+		f, err := m.calcFrozen(u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expect, f, "now %d", c.now)
+	}
+
+	u.Terminated = true
+	f, err := m.calcFrozen(u, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), f)
+}
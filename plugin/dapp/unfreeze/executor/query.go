@@ -53,7 +53,7 @@ func QueryWithdraw(stateDB dbm.KV, id string) (types.Message, error) {
 }
 
 func getWithdrawAvailable(unfreeze *pty.Unfreeze, calcTime int64) (int64, error) {
-	means, err := newMeans(unfreeze.Means, 1500000)
+	means, err := newMeans(unfreeze.Means, types.MaxHeight)
 	if err != nil {
 		return 0, err
 	}
@@ -129,22 +129,28 @@ func fmtLocalUnfreeze(rows []*table.Row) (*pty.ReplyUnfreezes, error) {
 			return nil, types.ErrDecode
 		}
 		v := &pty.ReplyUnfreeze{
-			UnfreezeID:  r.Unfreeze.UnfreezeID,
-			StartTime:   r.Unfreeze.StartTime,
-			AssetExec:   r.Unfreeze.AssetExec,
-			AssetSymbol: r.Unfreeze.AssetSymbol,
-			TotalCount:  r.Unfreeze.TotalCount,
-			Initiator:   r.Unfreeze.Initiator,
-			Beneficiary: r.Unfreeze.Beneficiary,
-			Remaining:   r.Unfreeze.Remaining,
-			Means:       r.Unfreeze.Means,
-			Terminated:  r.Unfreeze.Terminated,
-			Key:         r.TxIndex,
+			UnfreezeID:         r.Unfreeze.UnfreezeID,
+			StartTime:          r.Unfreeze.StartTime,
+			AssetExec:          r.Unfreeze.AssetExec,
+			AssetSymbol:        r.Unfreeze.AssetSymbol,
+			TotalCount:         r.Unfreeze.TotalCount,
+			Initiator:          r.Unfreeze.Initiator,
+			Beneficiary:        r.Unfreeze.Beneficiary,
+			Remaining:          r.Unfreeze.Remaining,
+			Means:              r.Unfreeze.Means,
+			Terminated:         r.Unfreeze.Terminated,
+			Key:                r.TxIndex,
+			Cliff:              r.Unfreeze.Cliff,
+			PendingBeneficiary: r.Unfreeze.PendingBeneficiary,
 		}
 		if v.Means == pty.FixAmountX {
 			v.MeansOpt = &pty.ReplyUnfreeze_FixAmount{FixAmount: r.Unfreeze.GetFixAmount()}
 		} else if v.Means == pty.LeftProportionX {
 			v.MeansOpt = &pty.ReplyUnfreeze_LeftProportion{LeftProportion: r.Unfreeze.GetLeftProportion()}
+		} else if v.Means == pty.LinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_Linear{Linear: r.Unfreeze.GetLinear()}
+		} else if v.Means == pty.TranchesX {
+			v.MeansOpt = &pty.ReplyUnfreeze_Tranches{Tranches: r.Unfreeze.GetTranches()}
 		}
 		results.Unfreeze = append(results.Unfreeze, v)
 	}
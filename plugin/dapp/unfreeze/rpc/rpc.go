@@ -96,3 +96,29 @@ func (c *Jrpc) CreateRawUnfreezeTerminate(param *pty.UnfreezeTerminate, result *
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawUnfreezeReassign 发币人提议更换收币人
+func (c *Jrpc) CreateRawUnfreezeReassign(param *pty.UnfreezeReassign, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(pty.UnfreezeX), "Reassign", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawUnfreezeAcceptReassign 收币人同意更换收币人
+func (c *Jrpc) CreateRawUnfreezeAcceptReassign(param *pty.UnfreezeAcceptReassign, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(pty.UnfreezeX), "AcceptReassign", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
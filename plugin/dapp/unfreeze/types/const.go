@@ -9,11 +9,15 @@ const (
 	UnfreezeActionCreate = iota + 1
 	UnfreezeActionWithdraw
 	UnfreezeActionTerminate
+	UnfreezeActionReassign
+	UnfreezeActionAcceptReassign
 
 	//log for unfreeze
-	TyLogCreateUnfreeze    = 2001 // TODO 修改具体编号
-	TyLogWithdrawUnfreeze  = 2002
-	TyLogTerminateUnfreeze = 2003
+	TyLogCreateUnfreeze         = 2001 // TODO 修改具体编号
+	TyLogWithdrawUnfreeze       = 2002
+	TyLogTerminateUnfreeze      = 2003
+	TyLogReassignUnfreeze       = 2004
+	TyLogAcceptReassignUnfreeze = 2005
 )
 
 const (
@@ -23,6 +27,10 @@ const (
 	Action_WithdrawUnfreeze = "withdrawUnfreeze"
 	// Action_TerminateUnfreeze Action 名字
 	Action_TerminateUnfreeze = "terminateUnfreeze"
+	// Action_ReassignUnfreeze Action 名字
+	Action_ReassignUnfreeze = "reassignUnfreeze"
+	// Action_AcceptReassignUnfreeze Action 名字
+	Action_AcceptReassignUnfreeze = "acceptReassignUnfreeze"
 )
 
 const (
@@ -41,8 +49,11 @@ var (
 
 	FixAmountX      = "FixAmount"
 	LeftProportionX = "LeftProportion"
-	SupportMeans    = []string{"FixAmount", "LeftProportion"}
+	LinearX         = "Linear"
+	TranchesX       = "Tranches"
+	SupportMeans    = []string{"FixAmount", "LeftProportion", "Linear", "Tranches"}
 
-	ForkTerminatePartX = "ForkTerminatePart"
-	ForkUnfreezeIDX    = "ForkUnfreezeIDX"
+	ForkTerminatePartX   = "ForkTerminatePart"
+	ForkUnfreezeIDX      = "ForkUnfreezeIDX"
+	ForkUnfreezeVestingX = "ForkUnfreezeVesting"
 )
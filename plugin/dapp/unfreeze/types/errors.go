@@ -17,4 +17,6 @@ var (
 	ErrNoPrivilege = errors.New("ErrNoPrivilege")
 	// ErrTerminated 已经被取消过了
 	ErrTerminated = errors.New("ErrTerminated")
+	// ErrUnfreezeReassign 没有待确认的收币人变更或变更地址不一致
+	ErrUnfreezeReassign = errors.New("ErrUnfreezeReassign")
 )
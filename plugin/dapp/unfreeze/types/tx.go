@@ -19,6 +19,9 @@ type parseUnfreezeCreate struct {
 	Means          string          `protobuf:"bytes,6,opt,name=means,proto3" json:"means,omitempty"`
 	FixAmount      *FixAmount      `json:"fixAmount,omitempty"`
 	LeftProportion *LeftProportion `json:"leftProportion,omitempty"`
+	Linear         *Linear         `json:"linear,omitempty"`
+	Tranches       *Tranches       `json:"tranches,omitempty"`
+	Cliff          int64           `json:"cliff,omitempty"`
 }
 
 // UnmarshalJSON 解析UnfreezeCreate
@@ -32,6 +35,10 @@ func (m *UnfreezeCreate) UnmarshalJSON(v []byte) error {
 		m.MeansOpt = &UnfreezeCreate_FixAmount{FixAmount: c.FixAmount}
 	} else if c.Means == LeftProportionX && c.LeftProportion != nil {
 		m.MeansOpt = &UnfreezeCreate_LeftProportion{LeftProportion: c.LeftProportion}
+	} else if c.Means == LinearX && c.Linear != nil {
+		m.MeansOpt = &UnfreezeCreate_Linear{Linear: c.Linear}
+	} else if c.Means == TranchesX && c.Tranches != nil {
+		m.MeansOpt = &UnfreezeCreate_Tranches{Tranches: c.Tranches}
 	} else {
 		return types.ErrInvalidParam
 	}
@@ -39,5 +46,6 @@ func (m *UnfreezeCreate) UnmarshalJSON(v []byte) error {
 	m.AssetSymbol, m.AssetExec = c.AssetSymbol, c.AssetExec
 	m.TotalCount, m.Beneficiary = c.TotalCount, c.Beneficiary
 	m.Means = c.Means
+	m.Cliff = c.Cliff
 	return nil
 }
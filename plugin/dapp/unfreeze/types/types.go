@@ -27,6 +27,7 @@ func init() {
 	types.RegisterDappFork(name, "Enable", 0)
 	types.RegisterDappFork(name, ForkTerminatePartX, 1298600)
 	types.RegisterDappFork(name, ForkUnfreezeIDX, 1450000)
+	types.RegisterDappFork(name, ForkUnfreezeVestingX, types.MaxHeight)
 }
 
 //getRealExecName
@@ -54,9 +55,11 @@ func (u *UnfreezeType) GetName() string {
 // GetLogMap 获得日志类型列表
 func (u *UnfreezeType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{
-		TyLogCreateUnfreeze:    {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogCreateUnfreeze"},
-		TyLogWithdrawUnfreeze:  {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogWithdrawUnfreeze"},
-		TyLogTerminateUnfreeze: {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogTerminateUnfreeze"},
+		TyLogCreateUnfreeze:         {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogCreateUnfreeze"},
+		TyLogWithdrawUnfreeze:       {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogWithdrawUnfreeze"},
+		TyLogTerminateUnfreeze:      {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogTerminateUnfreeze"},
+		TyLogReassignUnfreeze:       {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogReassignUnfreeze"},
+		TyLogAcceptReassignUnfreeze: {Ty: reflect.TypeOf(ReceiptUnfreeze{}), Name: "LogAcceptReassignUnfreeze"},
 	}
 }
 
@@ -68,9 +71,11 @@ func (u *UnfreezeType) GetPayload() types.Message {
 // GetTypeMap 获得Action 方法列表
 func (u *UnfreezeType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Create":    UnfreezeActionCreate,
-		"Withdraw":  UnfreezeActionWithdraw,
-		"Terminate": UnfreezeActionTerminate,
+		"Create":         UnfreezeActionCreate,
+		"Withdraw":       UnfreezeActionWithdraw,
+		"Terminate":      UnfreezeActionTerminate,
+		"Reassign":       UnfreezeActionReassign,
+		"AcceptReassign": UnfreezeActionAcceptReassign,
 	}
 }
 
@@ -101,6 +106,22 @@ func (u *UnfreezeType) CreateTx(action string, message json.RawMessage) (*types.
 			return nil, types.ErrInvalidParam
 		}
 		return u.RPC_UnfreezeTerminateTx(&param)
+	} else if action == Action_ReassignUnfreeze {
+		var param UnfreezeReassign
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return u.RPC_UnfreezeReassignTx(&param)
+	} else if action == Action_AcceptReassignUnfreeze {
+		var param UnfreezeAcceptReassign
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return u.RPC_UnfreezeAcceptReassignTx(&param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -196,6 +217,64 @@ func CreateUnfreezeTerminateTx(title string, parm *UnfreezeTerminate) (*types.Tr
 	return tx, nil
 }
 
+// RPC_UnfreezeReassignTx 创建更换收币人提议入口
+func (u *UnfreezeType) RPC_UnfreezeReassignTx(parm *UnfreezeReassign) (*types.Transaction, error) {
+	return CreateUnfreezeReassignTx(types.GetParaName(), parm)
+}
+
+// CreateUnfreezeReassignTx 创建更换收币人提议
+func CreateUnfreezeReassignTx(title string, parm *UnfreezeReassign) (*types.Transaction, error) {
+	if parm == nil {
+		tlog.Error("RPC_UnfreezeReassignTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+	v := &UnfreezeReassign{
+		UnfreezeID:     parm.UnfreezeID,
+		NewBeneficiary: parm.NewBeneficiary,
+	}
+	reassign := &UnfreezeAction{
+		Ty:    UnfreezeActionReassign,
+		Value: &UnfreezeAction_Reassign{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(getRealExecName(title)),
+		Payload: types.Encode(reassign),
+		Nonce:   rand.New(rand.NewSource(time.Now().UnixNano())).Int63(),
+		To:      address.ExecAddress(getRealExecName(types.GetParaName())),
+	}
+	tx.SetRealFee(types.GInt("MinFee"))
+	return tx, nil
+}
+
+// RPC_UnfreezeAcceptReassignTx 创建同意更换收币人入口
+func (u *UnfreezeType) RPC_UnfreezeAcceptReassignTx(parm *UnfreezeAcceptReassign) (*types.Transaction, error) {
+	return CreateUnfreezeAcceptReassignTx(types.GetParaName(), parm)
+}
+
+// CreateUnfreezeAcceptReassignTx 创建同意更换收币人
+func CreateUnfreezeAcceptReassignTx(title string, parm *UnfreezeAcceptReassign) (*types.Transaction, error) {
+	if parm == nil || parm.NewBeneficiary == "" {
+		tlog.Error("RPC_UnfreezeAcceptReassignTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+	v := &UnfreezeAcceptReassign{
+		UnfreezeID:     parm.UnfreezeID,
+		NewBeneficiary: parm.NewBeneficiary,
+	}
+	accept := &UnfreezeAction{
+		Ty:    UnfreezeActionAcceptReassign,
+		Value: &UnfreezeAction_AcceptReassign{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(getRealExecName(title)),
+		Payload: types.Encode(accept),
+		Nonce:   rand.New(rand.NewSource(time.Now().UnixNano())).Int63(),
+		To:      address.ExecAddress(getRealExecName(types.GetParaName())),
+	}
+	tx.SetRealFee(types.GInt("MinFee"))
+	return tx, nil
+}
+
 func supportMeans(means string) bool {
 	for _, m := range SupportMeans {
 		if m == means {
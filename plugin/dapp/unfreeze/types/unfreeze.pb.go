@@ -45,11 +45,17 @@ type Unfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*Unfreeze_FixAmount
 	//	*Unfreeze_LeftProportion
-	MeansOpt             isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
-	Terminated           bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	//	*Unfreeze_Linear
+	//	*Unfreeze_Tranches
+	MeansOpt   isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
+	Terminated bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	//开始时间之后的锁定期(秒), 锁定期内不解冻
+	Cliff int64 `protobuf:"varint,15,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	//发币人提议的新收币人, 需原收币人同意
+	PendingBeneficiary   string   `protobuf:"bytes,16,opt,name=pendingBeneficiary,proto3" json:"pendingBeneficiary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Unfreeze) Reset()         { *m = Unfreeze{} }
@@ -152,10 +158,22 @@ type Unfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type Unfreeze_Linear struct {
+	Linear *Linear `protobuf:"bytes,13,opt,name=linear,proto3,oneof"`
+}
+
+type Unfreeze_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,14,opt,name=tranches,proto3,oneof"`
+}
+
 func (*Unfreeze_FixAmount) isUnfreeze_MeansOpt() {}
 
 func (*Unfreeze_LeftProportion) isUnfreeze_MeansOpt() {}
 
+func (*Unfreeze_Linear) isUnfreeze_MeansOpt() {}
+
+func (*Unfreeze_Tranches) isUnfreeze_MeansOpt() {}
+
 func (m *Unfreeze) GetMeansOpt() isUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -177,6 +195,20 @@ func (m *Unfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *Unfreeze) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *Unfreeze) GetTranches() *Tranches {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
 func (m *Unfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -184,11 +216,27 @@ func (m *Unfreeze) GetTerminated() bool {
 	return false
 }
 
+func (m *Unfreeze) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *Unfreeze) GetPendingBeneficiary() string {
+	if m != nil {
+		return m.PendingBeneficiary
+	}
+	return ""
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Unfreeze) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Unfreeze_OneofMarshaler, _Unfreeze_OneofUnmarshaler, _Unfreeze_OneofSizer, []interface{}{
 		(*Unfreeze_FixAmount)(nil),
 		(*Unfreeze_LeftProportion)(nil),
+		(*Unfreeze_Linear)(nil),
+		(*Unfreeze_Tranches)(nil),
 	}
 }
 
@@ -206,6 +254,16 @@ func _Unfreeze_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LeftProportion); err != nil {
 			return err
 		}
+	case *Unfreeze_Linear:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Linear); err != nil {
+			return err
+		}
+	case *Unfreeze_Tranches:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Tranches); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Unfreeze.MeansOpt has unexpected type %T", x)
@@ -232,6 +290,22 @@ func _Unfreeze_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.MeansOpt = &Unfreeze_LeftProportion{msg}
 		return true, err
+	case 13: // meansOpt.linear
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Linear)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &Unfreeze_Linear{msg}
+		return true, err
+	case 14: // meansOpt.tranches
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Tranches)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &Unfreeze_Tranches{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -251,6 +325,16 @@ func _Unfreeze_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Unfreeze_Linear:
+		s := proto.Size(x.Linear)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Unfreeze_Tranches:
+		s := proto.Size(x.Tranches)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -354,12 +438,141 @@ func (m *LeftProportion) GetTenThousandth() int64 {
 	return 0
 }
 
+// 按区块时间在duration秒内连续线性解冻
+type Linear struct {
+	Duration             int64    `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Linear) Reset()         { *m = Linear{} }
+func (m *Linear) String() string { return proto.CompactTextString(m) }
+func (*Linear) ProtoMessage()    {}
+func (*Linear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{3}
+}
+
+func (m *Linear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Linear.Unmarshal(m, b)
+}
+func (m *Linear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Linear.Marshal(b, m, deterministic)
+}
+func (m *Linear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Linear.Merge(m, src)
+}
+func (m *Linear) XXX_Size() int {
+	return xxx_messageInfo_Linear.Size(m)
+}
+func (m *Linear) XXX_DiscardUnknown() {
+	xxx_messageInfo_Linear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Linear proto.InternalMessageInfo
+
+func (m *Linear) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// 按时间表分批解冻
+type Tranche struct {
+	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tranche) Reset()         { *m = Tranche{} }
+func (m *Tranche) String() string { return proto.CompactTextString(m) }
+func (*Tranche) ProtoMessage()    {}
+func (*Tranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{4}
+}
+
+func (m *Tranche) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tranche.Unmarshal(m, b)
+}
+func (m *Tranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tranche.Marshal(b, m, deterministic)
+}
+func (m *Tranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tranche.Merge(m, src)
+}
+func (m *Tranche) XXX_Size() int {
+	return xxx_messageInfo_Tranche.Size(m)
+}
+func (m *Tranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tranche proto.InternalMessageInfo
+
+func (m *Tranche) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Tranche) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type Tranches struct {
+	Tranches             []*Tranche `protobuf:"bytes,1,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Tranches) Reset()         { *m = Tranches{} }
+func (m *Tranches) String() string { return proto.CompactTextString(m) }
+func (*Tranches) ProtoMessage()    {}
+func (*Tranches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{5}
+}
+
+func (m *Tranches) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tranches.Unmarshal(m, b)
+}
+func (m *Tranches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tranches.Marshal(b, m, deterministic)
+}
+func (m *Tranches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tranches.Merge(m, src)
+}
+func (m *Tranches) XXX_Size() int {
+	return xxx_messageInfo_Tranches.Size(m)
+}
+func (m *Tranches) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tranches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tranches proto.InternalMessageInfo
+
+func (m *Tranches) GetTranches() []*Tranche {
+	if m != nil {
+		return m.Tranches
+	}
+	return nil
+}
+
 // message for execs.unfreeze
 type UnfreezeAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*UnfreezeAction_Create
 	//	*UnfreezeAction_Withdraw
 	//	*UnfreezeAction_Terminate
+	//	*UnfreezeAction_Reassign
+	//	*UnfreezeAction_AcceptReassign
 	Value                isUnfreezeAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -371,7 +584,7 @@ func (m *UnfreezeAction) Reset()         { *m = UnfreezeAction{} }
 func (m *UnfreezeAction) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeAction) ProtoMessage()    {}
 func (*UnfreezeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{3}
+	return fileDescriptor_6caa0554cb0b9167, []int{6}
 }
 
 func (m *UnfreezeAction) XXX_Unmarshal(b []byte) error {
@@ -408,12 +621,24 @@ type UnfreezeAction_Terminate struct {
 	Terminate *UnfreezeTerminate `protobuf:"bytes,3,opt,name=terminate,proto3,oneof"`
 }
 
+type UnfreezeAction_Reassign struct {
+	Reassign *UnfreezeReassign `protobuf:"bytes,5,opt,name=reassign,proto3,oneof"`
+}
+
+type UnfreezeAction_AcceptReassign struct {
+	AcceptReassign *UnfreezeAcceptReassign `protobuf:"bytes,6,opt,name=acceptReassign,proto3,oneof"`
+}
+
 func (*UnfreezeAction_Create) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Withdraw) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Terminate) isUnfreezeAction_Value() {}
 
+func (*UnfreezeAction_Reassign) isUnfreezeAction_Value() {}
+
+func (*UnfreezeAction_AcceptReassign) isUnfreezeAction_Value() {}
+
 func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
 	if m != nil {
 		return m.Value
@@ -442,6 +667,20 @@ func (m *UnfreezeAction) GetTerminate() *UnfreezeTerminate {
 	return nil
 }
 
+func (m *UnfreezeAction) GetReassign() *UnfreezeReassign {
+	if x, ok := m.GetValue().(*UnfreezeAction_Reassign); ok {
+		return x.Reassign
+	}
+	return nil
+}
+
+func (m *UnfreezeAction) GetAcceptReassign() *UnfreezeAcceptReassign {
+	if x, ok := m.GetValue().(*UnfreezeAction_AcceptReassign); ok {
+		return x.AcceptReassign
+	}
+	return nil
+}
+
 func (m *UnfreezeAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -455,6 +694,8 @@ func (*UnfreezeAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer
 		(*UnfreezeAction_Create)(nil),
 		(*UnfreezeAction_Withdraw)(nil),
 		(*UnfreezeAction_Terminate)(nil),
+		(*UnfreezeAction_Reassign)(nil),
+		(*UnfreezeAction_AcceptReassign)(nil),
 	}
 }
 
@@ -477,6 +718,16 @@ func _UnfreezeAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Terminate); err != nil {
 			return err
 		}
+	case *UnfreezeAction_Reassign:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Reassign); err != nil {
+			return err
+		}
+	case *UnfreezeAction_AcceptReassign:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AcceptReassign); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("UnfreezeAction.Value has unexpected type %T", x)
@@ -511,6 +762,22 @@ func _UnfreezeAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Value = &UnfreezeAction_Terminate{msg}
 		return true, err
+	case 5: // value.reassign
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeReassign)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_Reassign{msg}
+		return true, err
+	case 6: // value.acceptReassign
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnfreezeAcceptReassign)
+		err := b.DecodeMessage(msg)
+		m.Value = &UnfreezeAction_AcceptReassign{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -535,6 +802,16 @@ func _UnfreezeAction_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *UnfreezeAction_Reassign:
+		s := proto.Size(x.Reassign)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeAction_AcceptReassign:
+		s := proto.Size(x.AcceptReassign)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -553,7 +830,10 @@ type UnfreezeCreate struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*UnfreezeCreate_FixAmount
 	//	*UnfreezeCreate_LeftProportion
+	//	*UnfreezeCreate_Linear
+	//	*UnfreezeCreate_Tranches
 	MeansOpt             isUnfreezeCreate_MeansOpt `protobuf_oneof:"meansOpt"`
+	Cliff                int64                     `protobuf:"varint,11,opt,name=cliff,proto3" json:"cliff,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -563,7 +843,7 @@ func (m *UnfreezeCreate) Reset()         { *m = UnfreezeCreate{} }
 func (m *UnfreezeCreate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeCreate) ProtoMessage()    {}
 func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{4}
+	return fileDescriptor_6caa0554cb0b9167, []int{7}
 }
 
 func (m *UnfreezeCreate) XXX_Unmarshal(b []byte) error {
@@ -638,10 +918,22 @@ type UnfreezeCreate_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,8,opt,name=leftProportion,proto3,oneof"`
 }
 
+type UnfreezeCreate_Linear struct {
+	Linear *Linear `protobuf:"bytes,9,opt,name=linear,proto3,oneof"`
+}
+
+type UnfreezeCreate_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,10,opt,name=tranches,proto3,oneof"`
+}
+
 func (*UnfreezeCreate_FixAmount) isUnfreezeCreate_MeansOpt() {}
 
 func (*UnfreezeCreate_LeftProportion) isUnfreezeCreate_MeansOpt() {}
 
+func (*UnfreezeCreate_Linear) isUnfreezeCreate_MeansOpt() {}
+
+func (*UnfreezeCreate_Tranches) isUnfreezeCreate_MeansOpt() {}
+
 func (m *UnfreezeCreate) GetMeansOpt() isUnfreezeCreate_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -663,11 +955,34 @@ func (m *UnfreezeCreate) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *UnfreezeCreate) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *UnfreezeCreate) GetTranches() *Tranches {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (m *UnfreezeCreate) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*UnfreezeCreate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _UnfreezeCreate_OneofMarshaler, _UnfreezeCreate_OneofUnmarshaler, _UnfreezeCreate_OneofSizer, []interface{}{
 		(*UnfreezeCreate_FixAmount)(nil),
 		(*UnfreezeCreate_LeftProportion)(nil),
+		(*UnfreezeCreate_Linear)(nil),
+		(*UnfreezeCreate_Tranches)(nil),
 	}
 }
 
@@ -685,6 +1000,16 @@ func _UnfreezeCreate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LeftProportion); err != nil {
 			return err
 		}
+	case *UnfreezeCreate_Linear:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Linear); err != nil {
+			return err
+		}
+	case *UnfreezeCreate_Tranches:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Tranches); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("UnfreezeCreate.MeansOpt has unexpected type %T", x)
@@ -711,6 +1036,22 @@ func _UnfreezeCreate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.MeansOpt = &UnfreezeCreate_LeftProportion{msg}
 		return true, err
+	case 9: // meansOpt.linear
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Linear)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &UnfreezeCreate_Linear{msg}
+		return true, err
+	case 10: // meansOpt.tranches
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Tranches)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &UnfreezeCreate_Tranches{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -730,6 +1071,16 @@ func _UnfreezeCreate_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *UnfreezeCreate_Linear:
+		s := proto.Size(x.Linear)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *UnfreezeCreate_Tranches:
+		s := proto.Size(x.Tranches)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -748,7 +1099,7 @@ func (m *UnfreezeWithdraw) Reset()         { *m = UnfreezeWithdraw{} }
 func (m *UnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeWithdraw) ProtoMessage()    {}
 func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{5}
+	return fileDescriptor_6caa0554cb0b9167, []int{8}
 }
 
 func (m *UnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -787,7 +1138,7 @@ func (m *UnfreezeTerminate) Reset()         { *m = UnfreezeTerminate{} }
 func (m *UnfreezeTerminate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeTerminate) ProtoMessage()    {}
 func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{6}
+	return fileDescriptor_6caa0554cb0b9167, []int{9}
 }
 
 func (m *UnfreezeTerminate) XXX_Unmarshal(b []byte) error {
@@ -815,6 +1166,102 @@ func (m *UnfreezeTerminate) GetUnfreezeID() string {
 	return ""
 }
 
+// 发币人提议更换收币人, newBeneficiary为空表示取消提议
+type UnfreezeReassign struct {
+	UnfreezeID           string   `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	NewBeneficiary       string   `protobuf:"bytes,2,opt,name=newBeneficiary,proto3" json:"newBeneficiary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeReassign) Reset()         { *m = UnfreezeReassign{} }
+func (m *UnfreezeReassign) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeReassign) ProtoMessage()    {}
+func (*UnfreezeReassign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{10}
+}
+
+func (m *UnfreezeReassign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeReassign.Unmarshal(m, b)
+}
+func (m *UnfreezeReassign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeReassign.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeReassign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeReassign.Merge(m, src)
+}
+func (m *UnfreezeReassign) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeReassign.Size(m)
+}
+func (m *UnfreezeReassign) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeReassign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeReassign proto.InternalMessageInfo
+
+func (m *UnfreezeReassign) GetUnfreezeID() string {
+	if m != nil {
+		return m.UnfreezeID
+	}
+	return ""
+}
+
+func (m *UnfreezeReassign) GetNewBeneficiary() string {
+	if m != nil {
+		return m.NewBeneficiary
+	}
+	return ""
+}
+
+// 原收币人同意更换收币人
+type UnfreezeAcceptReassign struct {
+	UnfreezeID           string   `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	NewBeneficiary       string   `protobuf:"bytes,2,opt,name=newBeneficiary,proto3" json:"newBeneficiary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeAcceptReassign) Reset()         { *m = UnfreezeAcceptReassign{} }
+func (m *UnfreezeAcceptReassign) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeAcceptReassign) ProtoMessage()    {}
+func (*UnfreezeAcceptReassign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{11}
+}
+
+func (m *UnfreezeAcceptReassign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeAcceptReassign.Unmarshal(m, b)
+}
+func (m *UnfreezeAcceptReassign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeAcceptReassign.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeAcceptReassign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeAcceptReassign.Merge(m, src)
+}
+func (m *UnfreezeAcceptReassign) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeAcceptReassign.Size(m)
+}
+func (m *UnfreezeAcceptReassign) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeAcceptReassign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeAcceptReassign proto.InternalMessageInfo
+
+func (m *UnfreezeAcceptReassign) GetUnfreezeID() string {
+	if m != nil {
+		return m.UnfreezeID
+	}
+	return ""
+}
+
+func (m *UnfreezeAcceptReassign) GetNewBeneficiary() string {
+	if m != nil {
+		return m.NewBeneficiary
+	}
+	return ""
+}
+
 // receipt
 type ReceiptUnfreeze struct {
 	Prev                 *Unfreeze `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
@@ -828,7 +1275,7 @@ func (m *ReceiptUnfreeze) Reset()         { *m = ReceiptUnfreeze{} }
 func (m *ReceiptUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReceiptUnfreeze) ProtoMessage()    {}
 func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{7}
+	return fileDescriptor_6caa0554cb0b9167, []int{12}
 }
 
 func (m *ReceiptUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -875,7 +1322,7 @@ func (m *LocalUnfreeze) Reset()         { *m = LocalUnfreeze{} }
 func (m *LocalUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*LocalUnfreeze) ProtoMessage()    {}
 func (*LocalUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{8}
+	return fileDescriptor_6caa0554cb0b9167, []int{13}
 }
 
 func (m *LocalUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1370,7 @@ func (m *ReplyQueryUnfreezeWithdraw) Reset()         { *m = ReplyQueryUnfreezeWi
 func (m *ReplyQueryUnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*ReplyQueryUnfreezeWithdraw) ProtoMessage()    {}
 func (*ReplyQueryUnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{9}
+	return fileDescriptor_6caa0554cb0b9167, []int{14}
 }
 
 func (m *ReplyQueryUnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -973,7 +1420,7 @@ func (m *ReqUnfreezes) Reset()         { *m = ReqUnfreezes{} }
 func (m *ReqUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReqUnfreezes) ProtoMessage()    {}
 func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{10}
+	return fileDescriptor_6caa0554cb0b9167, []int{15}
 }
 
 func (m *ReqUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -1050,9 +1497,13 @@ type ReplyUnfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*ReplyUnfreeze_FixAmount
 	//	*ReplyUnfreeze_LeftProportion
+	//	*ReplyUnfreeze_Linear
+	//	*ReplyUnfreeze_Tranches
 	MeansOpt             isReplyUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated           bool                     `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	Key                  string                   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
+	Cliff                int64                    `protobuf:"varint,16,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	PendingBeneficiary   string                   `protobuf:"bytes,17,opt,name=pendingBeneficiary,proto3" json:"pendingBeneficiary,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1062,7 +1513,7 @@ func (m *ReplyUnfreeze) Reset()         { *m = ReplyUnfreeze{} }
 func (m *ReplyUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreeze) ProtoMessage()    {}
 func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{11}
+	return fileDescriptor_6caa0554cb0b9167, []int{16}
 }
 
 func (m *ReplyUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -1158,10 +1609,22 @@ type ReplyUnfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type ReplyUnfreeze_Linear struct {
+	Linear *Linear `protobuf:"bytes,14,opt,name=linear,proto3,oneof"`
+}
+
+type ReplyUnfreeze_Tranches struct {
+	Tranches *Tranches `protobuf:"bytes,15,opt,name=tranches,proto3,oneof"`
+}
+
 func (*ReplyUnfreeze_FixAmount) isReplyUnfreeze_MeansOpt() {}
 
 func (*ReplyUnfreeze_LeftProportion) isReplyUnfreeze_MeansOpt() {}
 
+func (*ReplyUnfreeze_Linear) isReplyUnfreeze_MeansOpt() {}
+
+func (*ReplyUnfreeze_Tranches) isReplyUnfreeze_MeansOpt() {}
+
 func (m *ReplyUnfreeze) GetMeansOpt() isReplyUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -1183,6 +1646,20 @@ func (m *ReplyUnfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *ReplyUnfreeze) GetLinear() *Linear {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_Linear); ok {
+		return x.Linear
+	}
+	return nil
+}
+
+func (m *ReplyUnfreeze) GetTranches() *Tranches {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_Tranches); ok {
+		return x.Tranches
+	}
+	return nil
+}
+
 func (m *ReplyUnfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -1197,11 +1674,27 @@ func (m *ReplyUnfreeze) GetKey() string {
 	return ""
 }
 
+func (m *ReplyUnfreeze) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *ReplyUnfreeze) GetPendingBeneficiary() string {
+	if m != nil {
+		return m.PendingBeneficiary
+	}
+	return ""
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ReplyUnfreeze) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ReplyUnfreeze_OneofMarshaler, _ReplyUnfreeze_OneofUnmarshaler, _ReplyUnfreeze_OneofSizer, []interface{}{
 		(*ReplyUnfreeze_FixAmount)(nil),
 		(*ReplyUnfreeze_LeftProportion)(nil),
+		(*ReplyUnfreeze_Linear)(nil),
+		(*ReplyUnfreeze_Tranches)(nil),
 	}
 }
 
@@ -1219,6 +1712,16 @@ func _ReplyUnfreeze_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.LeftProportion); err != nil {
 			return err
 		}
+	case *ReplyUnfreeze_Linear:
+		b.EncodeVarint(14<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Linear); err != nil {
+			return err
+		}
+	case *ReplyUnfreeze_Tranches:
+		b.EncodeVarint(15<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Tranches); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ReplyUnfreeze.MeansOpt has unexpected type %T", x)
@@ -1245,6 +1748,22 @@ func _ReplyUnfreeze_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		err := b.DecodeMessage(msg)
 		m.MeansOpt = &ReplyUnfreeze_LeftProportion{msg}
 		return true, err
+	case 14: // meansOpt.linear
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Linear)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &ReplyUnfreeze_Linear{msg}
+		return true, err
+	case 15: // meansOpt.tranches
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Tranches)
+		err := b.DecodeMessage(msg)
+		m.MeansOpt = &ReplyUnfreeze_Tranches{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1264,6 +1783,16 @@ func _ReplyUnfreeze_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ReplyUnfreeze_Linear:
+		s := proto.Size(x.Linear)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ReplyUnfreeze_Tranches:
+		s := proto.Size(x.Tranches)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1282,7 +1811,7 @@ func (m *ReplyUnfreezes) Reset()         { *m = ReplyUnfreezes{} }
 func (m *ReplyUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreezes) ProtoMessage()    {}
 func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{12}
+	return fileDescriptor_6caa0554cb0b9167, []int{17}
 }
 
 func (m *ReplyUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -1314,10 +1843,15 @@ func init() {
 	proto.RegisterType((*Unfreeze)(nil), "types.Unfreeze")
 	proto.RegisterType((*FixAmount)(nil), "types.FixAmount")
 	proto.RegisterType((*LeftProportion)(nil), "types.LeftProportion")
+	proto.RegisterType((*Linear)(nil), "types.Linear")
+	proto.RegisterType((*Tranche)(nil), "types.Tranche")
+	proto.RegisterType((*Tranches)(nil), "types.Tranches")
 	proto.RegisterType((*UnfreezeAction)(nil), "types.UnfreezeAction")
 	proto.RegisterType((*UnfreezeCreate)(nil), "types.UnfreezeCreate")
 	proto.RegisterType((*UnfreezeWithdraw)(nil), "types.UnfreezeWithdraw")
 	proto.RegisterType((*UnfreezeTerminate)(nil), "types.UnfreezeTerminate")
+	proto.RegisterType((*UnfreezeReassign)(nil), "types.UnfreezeReassign")
+	proto.RegisterType((*UnfreezeAcceptReassign)(nil), "types.UnfreezeAcceptReassign")
 	proto.RegisterType((*ReceiptUnfreeze)(nil), "types.ReceiptUnfreeze")
 	proto.RegisterType((*LocalUnfreeze)(nil), "types.LocalUnfreeze")
 	proto.RegisterType((*ReplyQueryUnfreezeWithdraw)(nil), "types.ReplyQueryUnfreezeWithdraw")
@@ -1329,55 +1863,69 @@ func init() {
 func init() { proto.RegisterFile("unfreeze.proto", fileDescriptor_6caa0554cb0b9167) }
 
 var fileDescriptor_6caa0554cb0b9167 = []byte{
-	// 755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0x13, 0x4b,
-	0x10, 0xf5, 0x78, 0x3c, 0x7e, 0x94, 0x63, 0xc7, 0xb7, 0x6f, 0xee, 0x65, 0x14, 0x21, 0x64, 0x06,
-	0x16, 0x46, 0x48, 0x01, 0x39, 0x20, 0x21, 0xb1, 0x40, 0x49, 0x78, 0x38, 0x22, 0xe2, 0x31, 0x09,
-	0xb0, 0x6e, 0x8f, 0xcb, 0x49, 0x8b, 0x79, 0xa5, 0xdd, 0x4e, 0x3c, 0x7c, 0x04, 0x5f, 0xc0, 0x9f,
-	0xb0, 0x66, 0xc5, 0x8e, 0x2f, 0x42, 0xd3, 0xf3, 0x1e, 0x27, 0x58, 0x61, 0x87, 0xc4, 0xce, 0x75,
-	0xaa, 0xea, 0x74, 0x75, 0xd5, 0xa9, 0x1e, 0x43, 0x77, 0xee, 0x4e, 0x39, 0xe2, 0x27, 0xdc, 0xf2,
-	0xb9, 0x27, 0x3c, 0xa2, 0x89, 0xc0, 0xc7, 0xd9, 0xe6, 0x9a, 0xe5, 0x39, 0x8e, 0xe7, 0x46, 0xa0,
-	0xf1, 0x55, 0x85, 0xe6, 0xbb, 0x38, 0x8e, 0xdc, 0x00, 0x48, 0x72, 0xf6, 0x9f, 0xea, 0x4a, 0x5f,
-	0x19, 0xb4, 0xcc, 0x1c, 0x42, 0xae, 0x43, 0x6b, 0x26, 0x28, 0x17, 0x47, 0xcc, 0x41, 0xbd, 0xda,
-	0x57, 0x06, 0xaa, 0x99, 0x01, 0xa1, 0x97, 0xce, 0x66, 0x28, 0x9e, 0x2d, 0xd0, 0xd2, 0x55, 0x99,
-	0x9c, 0x01, 0xa4, 0x0f, 0x6d, 0x69, 0x1c, 0x06, 0xce, 0xd8, 0xb3, 0xf5, 0x9a, 0xf4, 0xe7, 0xa1,
-	0xf0, 0x74, 0xe1, 0x09, 0x6a, 0xef, 0x79, 0x73, 0x57, 0xe8, 0x9a, 0xa4, 0xcf, 0x21, 0x21, 0x3f,
-	0x73, 0x99, 0x60, 0x54, 0x78, 0x5c, 0xaf, 0x47, 0xfc, 0x29, 0x10, 0xf2, 0x8f, 0xd1, 0xc5, 0x29,
-	0xb3, 0x18, 0xe5, 0x81, 0xde, 0x88, 0xf8, 0x73, 0x50, 0x98, 0xcf, 0xd1, 0xa1, 0xcc, 0x65, 0xee,
-	0xb1, 0xde, 0x8c, 0xaa, 0x4f, 0x01, 0xb2, 0x01, 0x9a, 0x83, 0xd4, 0x9d, 0xe9, 0x2d, 0x99, 0x19,
-	0x19, 0xe4, 0x3e, 0xb4, 0xa6, 0x6c, 0xb1, 0xe3, 0xc8, 0x92, 0xa0, 0xaf, 0x0c, 0xda, 0xc3, 0xde,
-	0x96, 0xec, 0xe3, 0xd6, 0xf3, 0x04, 0x1f, 0x55, 0xcc, 0x2c, 0x88, 0x3c, 0x81, 0xae, 0x8d, 0x53,
-	0xf1, 0x86, 0x7b, 0xbe, 0xc7, 0x05, 0xf3, 0x5c, 0xbd, 0x2d, 0xd3, 0xfe, 0x8b, 0xd3, 0x0e, 0x0a,
-	0xce, 0x51, 0xc5, 0x2c, 0x85, 0xcb, 0x36, 0x20, 0x77, 0x98, 0x4b, 0x05, 0x4e, 0xf4, 0xb5, 0xbe,
-	0x32, 0x68, 0x9a, 0x39, 0x64, 0x17, 0xa0, 0x29, 0x6b, 0x7b, 0xed, 0x0b, 0xe3, 0x31, 0xb4, 0xd2,
-	0x32, 0xc8, 0xff, 0x50, 0xf7, 0x91, 0x33, 0x6f, 0x22, 0x27, 0xa7, 0x9a, 0xb1, 0x15, 0xe2, 0x34,
-	0xba, 0x40, 0x34, 0xb2, 0xd8, 0x32, 0x5e, 0x41, 0xb7, 0x58, 0xcc, 0xa5, 0x0c, 0xb7, 0xa1, 0x23,
-	0xd0, 0x3d, 0x3a, 0xf1, 0xe6, 0x33, 0xea, 0x4e, 0xc4, 0x49, 0x4c, 0x54, 0x04, 0x8d, 0xef, 0x0a,
-	0x74, 0x13, 0x29, 0xed, 0x58, 0x92, 0xf0, 0x1e, 0xd4, 0x2d, 0x8e, 0x54, 0xa0, 0x24, 0xcc, 0x9a,
-	0x90, 0x84, 0xed, 0x49, 0xe7, 0xa8, 0x62, 0xc6, 0x61, 0xe4, 0x21, 0x34, 0xcf, 0x99, 0x38, 0x99,
-	0x70, 0x7a, 0x2e, 0x0f, 0x69, 0x0f, 0xaf, 0x95, 0x52, 0x3e, 0xc4, 0xee, 0x51, 0xc5, 0x4c, 0x43,
-	0xc9, 0x23, 0x68, 0xa5, 0x1d, 0x92, 0xd2, 0x6b, 0x0f, 0xf5, 0x52, 0xde, 0x51, 0xe2, 0x0f, 0xc7,
-	0x95, 0x06, 0x93, 0x2e, 0x54, 0x45, 0x20, 0xd5, 0xa8, 0x99, 0x55, 0x11, 0xec, 0x36, 0x40, 0x3b,
-	0xa3, 0xf6, 0x1c, 0x8d, 0x6f, 0xd5, 0xec, 0x36, 0x51, 0x99, 0x45, 0xf9, 0x2b, 0xbf, 0x94, 0x7f,
-	0x75, 0x85, 0xfc, 0xd5, 0x55, 0xf2, 0xaf, 0x2d, 0xc9, 0xbf, 0x24, 0x70, 0x6d, 0x59, 0xe0, 0xa9,
-	0x84, 0xeb, 0x97, 0x4a, 0xb8, 0xf1, 0x7b, 0x12, 0x6e, 0x5e, 0x49, 0xc2, 0x05, 0x89, 0x0e, 0xa1,
-	0x57, 0x1e, 0xdd, 0xaa, 0x77, 0xc6, 0xd8, 0x86, 0x7f, 0x96, 0xc6, 0xb6, 0x32, 0x89, 0xc2, 0xba,
-	0x89, 0x16, 0x32, 0x5f, 0xa4, 0xef, 0xd9, 0x2d, 0xa8, 0xf9, 0x1c, 0xcf, 0x62, 0xf1, 0xad, 0x97,
-	0x14, 0x61, 0x4a, 0x27, 0xb9, 0x03, 0x0d, 0x6b, 0xce, 0x39, 0xc6, 0xfb, 0x71, 0x41, 0x5c, 0xe2,
-	0x37, 0xde, 0x43, 0xe7, 0xc0, 0xb3, 0xa8, 0x9d, 0x1e, 0x70, 0x17, 0x9a, 0x49, 0x05, 0x97, 0x1d,
-	0x92, 0x06, 0x10, 0x1d, 0x1a, 0x62, 0xb1, 0xef, 0x4e, 0x70, 0x11, 0xcb, 0x23, 0x31, 0x8d, 0x29,
-	0x6c, 0x9a, 0xe8, 0xdb, 0xc1, 0xdb, 0x39, 0xf2, 0xe0, 0xaa, 0xdd, 0x22, 0x03, 0x58, 0xa7, 0x67,
-	0x94, 0xd9, 0x74, 0x6c, 0xe3, 0x4e, 0x7e, 0xd1, 0xcb, 0xb0, 0xf1, 0x45, 0x81, 0x35, 0x13, 0x4f,
-	0x93, 0x13, 0x66, 0xa1, 0x66, 0x27, 0x8c, 0xa3, 0x5c, 0x56, 0xc9, 0xac, 0x99, 0x19, 0x10, 0xea,
-	0xc9, 0x4a, 0xe9, 0x34, 0x33, 0x32, 0xc2, 0x6b, 0x4c, 0xb9, 0xe7, 0xbc, 0xc4, 0x20, 0x56, 0x71,
-	0x62, 0x16, 0x1f, 0xe8, 0xda, 0x8a, 0x07, 0x7a, 0x59, 0xbf, 0xc6, 0x0f, 0x15, 0x3a, 0xb2, 0x0f,
-	0x7f, 0x3f, 0x48, 0x7f, 0xd0, 0x07, 0x89, 0xf4, 0x40, 0xfd, 0x88, 0x81, 0xde, 0x91, 0x65, 0x86,
-	0x3f, 0x0b, 0xfb, 0xbf, 0x0b, 0xdd, 0xc2, 0x4c, 0xc3, 0x2b, 0xe4, 0x97, 0x46, 0x1d, 0xb4, 0x87,
-	0x1b, 0x71, 0x29, 0x85, 0xc0, 0x6c, 0x73, 0x86, 0x9f, 0x95, 0x2c, 0x85, 0x1c, 0xc0, 0xbf, 0x2f,
-	0x50, 0x2c, 0x6d, 0x49, 0x2f, 0xe5, 0x38, 0x3d, 0x14, 0x9c, 0xb9, 0xc7, 0x9b, 0x37, 0xf3, 0xac,
-	0x17, 0xae, 0x96, 0x51, 0x21, 0x0f, 0xa0, 0x53, 0x70, 0x5d, 0xc0, 0x53, 0x5e, 0x69, 0xa3, 0x32,
-	0xae, 0xcb, 0x3f, 0x4f, 0xdb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x37, 0x34, 0x48, 0x63,
-	0x09, 0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xdb, 0x6e, 0x23, 0x45,
+	0x13, 0xf6, 0xf8, 0x38, 0x2e, 0xc7, 0x63, 0x6f, 0xff, 0xfb, 0x2f, 0xad, 0x08, 0x90, 0x19, 0x56,
+	0x60, 0x40, 0x04, 0xe4, 0x65, 0x11, 0x12, 0x17, 0x28, 0x59, 0x60, 0xb3, 0x22, 0xe2, 0x30, 0x1b,
+	0x40, 0xe2, 0x8a, 0xce, 0xb8, 0x9c, 0xb4, 0x18, 0xf7, 0xcc, 0xf6, 0xb4, 0x93, 0x98, 0x87, 0xe0,
+	0x09, 0x78, 0x0b, 0x1e, 0x83, 0x27, 0xe0, 0x2d, 0x78, 0x04, 0xd4, 0x3d, 0xe7, 0xb1, 0x83, 0xc3,
+	0xc2, 0x05, 0x17, 0xdc, 0xb9, 0xbe, 0x3a, 0x75, 0x57, 0x57, 0x7d, 0x53, 0x06, 0x67, 0x25, 0x16,
+	0x12, 0xf1, 0x47, 0x3c, 0x88, 0x64, 0xa8, 0x42, 0xd2, 0x51, 0xeb, 0x08, 0xe3, 0xfd, 0x3d, 0x3f,
+	0x5c, 0x2e, 0x43, 0x91, 0x80, 0xee, 0x6f, 0x6d, 0xb0, 0xbf, 0x4e, 0xed, 0xc8, 0xcb, 0x00, 0x99,
+	0xcf, 0x93, 0x8f, 0xa9, 0x35, 0xb1, 0xa6, 0x7d, 0xaf, 0x84, 0x90, 0x17, 0xa1, 0x1f, 0x2b, 0x26,
+	0xd5, 0x29, 0x5f, 0x22, 0x6d, 0x4e, 0xac, 0x69, 0xcb, 0x2b, 0x00, 0xad, 0x65, 0x71, 0x8c, 0xea,
+	0x93, 0x6b, 0xf4, 0x69, 0xcb, 0x38, 0x17, 0x00, 0x99, 0xc0, 0xc0, 0x08, 0x4f, 0xd7, 0xcb, 0xb3,
+	0x30, 0xa0, 0x6d, 0xa3, 0x2f, 0x43, 0x3a, 0xbb, 0x0a, 0x15, 0x0b, 0x1e, 0x85, 0x2b, 0xa1, 0x68,
+	0xc7, 0x84, 0x2f, 0x21, 0x3a, 0x3e, 0x17, 0x5c, 0x71, 0xa6, 0x42, 0x49, 0xbb, 0x49, 0xfc, 0x1c,
+	0xd0, 0xf1, 0xcf, 0x50, 0xe0, 0x82, 0xfb, 0x9c, 0xc9, 0x35, 0xed, 0x25, 0xf1, 0x4b, 0x90, 0xf6,
+	0x97, 0xb8, 0x64, 0x5c, 0x70, 0x71, 0x4e, 0xed, 0xe4, 0xf4, 0x39, 0x40, 0xee, 0x42, 0x67, 0x89,
+	0x4c, 0xc4, 0xb4, 0x6f, 0x3c, 0x13, 0x81, 0xbc, 0x0b, 0xfd, 0x05, 0xbf, 0x3e, 0x5c, 0x9a, 0x23,
+	0xc1, 0xc4, 0x9a, 0x0e, 0x66, 0xe3, 0x03, 0x53, 0xc7, 0x83, 0x4f, 0x33, 0xfc, 0xb8, 0xe1, 0x15,
+	0x46, 0xe4, 0x23, 0x70, 0x02, 0x5c, 0xa8, 0x2f, 0x65, 0x18, 0x85, 0x52, 0xf1, 0x50, 0xd0, 0x81,
+	0x71, 0xfb, 0x7f, 0xea, 0x76, 0x52, 0x51, 0x1e, 0x37, 0xbc, 0x9a, 0x39, 0x79, 0x1d, 0xba, 0x01,
+	0x17, 0xc8, 0x24, 0x1d, 0x1a, 0xc7, 0x61, 0xe6, 0x68, 0xc0, 0xe3, 0x86, 0x97, 0xaa, 0xc9, 0xdb,
+	0x60, 0x2b, 0xc9, 0x84, 0x7f, 0x81, 0x31, 0x75, 0x8c, 0xe9, 0x28, 0x35, 0x3d, 0x4d, 0xe1, 0xe3,
+	0x86, 0x97, 0x9b, 0x98, 0xf2, 0xa2, 0x5c, 0x72, 0xc1, 0x14, 0xce, 0xe9, 0xde, 0xc4, 0x9a, 0xda,
+	0x5e, 0x09, 0xd1, 0x05, 0xf0, 0x03, 0xbe, 0x58, 0xd0, 0x91, 0x29, 0x4d, 0x22, 0x90, 0x03, 0x20,
+	0x11, 0x8a, 0x39, 0x17, 0xe7, 0x47, 0xa5, 0xea, 0x8e, 0x4d, 0x8d, 0xb6, 0x68, 0x8e, 0x00, 0x6c,
+	0x53, 0xb9, 0x2f, 0x22, 0xe5, 0x7e, 0x08, 0xfd, 0xbc, 0x48, 0xe4, 0x1e, 0x74, 0x23, 0x94, 0x3c,
+	0x9c, 0x9b, 0xbe, 0x6a, 0x79, 0xa9, 0xa4, 0x71, 0x96, 0x94, 0x37, 0x69, 0xa8, 0x54, 0x72, 0x3f,
+	0x07, 0xa7, 0x5a, 0xaa, 0x1b, 0x23, 0xdc, 0x87, 0xa1, 0x42, 0x71, 0x7a, 0x11, 0xae, 0x62, 0x26,
+	0xe6, 0xea, 0x22, 0x0d, 0x54, 0x05, 0xdd, 0xfb, 0xd0, 0x4d, 0x2a, 0x48, 0xf6, 0xc1, 0x9e, 0xaf,
+	0x24, 0x33, 0x6f, 0x93, 0x44, 0xca, 0x65, 0xf7, 0x21, 0xf4, 0xd2, 0xe2, 0x11, 0x02, 0x6d, 0xa5,
+	0xfb, 0x3c, 0x31, 0x31, 0xbf, 0x6f, 0x3c, 0xec, 0xfb, 0x60, 0x67, 0x35, 0x27, 0x6f, 0x96, 0x9e,
+	0xc5, 0x9a, 0xb4, 0xa6, 0x83, 0x99, 0x53, 0x7d, 0x96, 0xe2, 0x4d, 0xdc, 0x5f, 0x9b, 0xe0, 0x64,
+	0xd3, 0x77, 0xe8, 0x9b, 0x5b, 0xbe, 0x03, 0x5d, 0x5f, 0x22, 0x53, 0x49, 0xe2, 0xa2, 0x6f, 0x32,
+	0xb3, 0x47, 0x46, 0xa9, 0xdb, 0x20, 0x31, 0x23, 0x0f, 0xc1, 0xbe, 0xe2, 0xea, 0x62, 0x2e, 0xd9,
+	0x95, 0x39, 0xd5, 0x60, 0xf6, 0x42, 0xcd, 0xe5, 0xdb, 0x54, 0xad, 0xdb, 0x21, 0x33, 0x25, 0x1f,
+	0x40, 0x3f, 0x7f, 0x7c, 0x33, 0xad, 0x83, 0x19, 0xad, 0xf9, 0x9d, 0x66, 0x7a, 0xdd, 0xe1, 0xb9,
+	0xb1, 0x4e, 0x28, 0x91, 0xc5, 0x31, 0x3f, 0x17, 0xb4, 0xb3, 0x35, 0xa1, 0x97, 0xaa, 0x75, 0xc2,
+	0xcc, 0x94, 0x3c, 0x06, 0x87, 0xf9, 0x3e, 0x46, 0x2a, 0xd3, 0x9a, 0x19, 0x1e, 0xcc, 0x5e, 0xaa,
+	0x39, 0x1f, 0x56, 0x8c, 0xf4, 0x80, 0x54, 0xdd, 0x88, 0x03, 0x4d, 0xb5, 0x36, 0x04, 0xd2, 0xf1,
+	0x9a, 0x6a, 0x7d, 0xd4, 0x83, 0xce, 0x25, 0x0b, 0x56, 0xe8, 0xfe, 0xd2, 0x02, 0xa7, 0x5a, 0xa6,
+	0x2a, 0x63, 0x59, 0x7f, 0xca, 0x58, 0xcd, 0x1d, 0x8c, 0xd5, 0xda, 0xc5, 0x58, 0xed, 0x0d, 0xc6,
+	0xaa, 0x71, 0x52, 0x67, 0x93, 0x93, 0x72, 0xd6, 0xe9, 0xde, 0xc8, 0x3a, 0xbd, 0xe7, 0x63, 0x1d,
+	0xfb, 0x79, 0x59, 0xa7, 0x7f, 0x7b, 0xd6, 0x81, 0xdd, 0xac, 0x93, 0xb3, 0xca, 0xa0, 0xc4, 0x2a,
+	0x15, 0x96, 0x98, 0xc1, 0xb8, 0xde, 0xa8, 0xbb, 0x3e, 0x44, 0xee, 0x03, 0xb8, 0xb3, 0xd1, 0xa4,
+	0x3b, 0x9d, 0xbe, 0x2b, 0x12, 0xe5, 0xbd, 0xb4, 0xc3, 0x87, 0xbc, 0x06, 0x8e, 0xc0, 0xab, 0x32,
+	0xf5, 0x25, 0x6d, 0x52, 0x43, 0xdd, 0xef, 0xe1, 0xde, 0xf6, 0xfe, 0xfd, 0xc7, 0x32, 0x30, 0x18,
+	0x79, 0xe8, 0x23, 0x8f, 0x54, 0xfe, 0xb9, 0x7e, 0x15, 0xda, 0x91, 0xc4, 0x4b, 0x6a, 0x55, 0x9e,
+	0x21, 0xbf, 0xa3, 0x51, 0x92, 0x37, 0xa0, 0xe7, 0xaf, 0xa4, 0xc4, 0x94, 0xb3, 0xb6, 0xd8, 0x65,
+	0x7a, 0xf7, 0x1b, 0x18, 0x9e, 0x84, 0x3e, 0x0b, 0xf2, 0x04, 0x6f, 0x81, 0x9d, 0x9d, 0xf4, 0xa6,
+	0x24, 0xb9, 0x01, 0xa1, 0xd0, 0x53, 0xd7, 0x4f, 0xc4, 0x1c, 0xaf, 0xd3, 0x1b, 0x64, 0xa2, 0xbb,
+	0x80, 0x7d, 0x0f, 0xa3, 0x60, 0xfd, 0xd5, 0x0a, 0xe5, 0xfa, 0xaf, 0xbe, 0x35, 0x99, 0xc2, 0x88,
+	0x5d, 0x32, 0x1e, 0xb0, 0xb3, 0x00, 0x0f, 0xcb, 0xe4, 0x5b, 0x87, 0xdd, 0x9f, 0x2d, 0xd8, 0xf3,
+	0xf0, 0x59, 0x96, 0x21, 0xd6, 0xf3, 0x3d, 0xe7, 0x12, 0xfd, 0x9c, 0xea, 0x3b, 0x5e, 0x01, 0x98,
+	0xd6, 0xcc, 0xc3, 0x75, 0xbc, 0x44, 0xd0, 0xd7, 0x58, 0xc8, 0x70, 0xf9, 0x19, 0xae, 0xd3, 0x89,
+	0xcf, 0xc4, 0xea, 0xfe, 0xd1, 0xde, 0xb1, 0x7f, 0x6c, 0xce, 0xba, 0xfb, 0x7b, 0x1b, 0x86, 0xa6,
+	0x0e, 0xff, 0xed, 0x5b, 0xff, 0xce, 0x7d, 0xcb, 0xb9, 0x3d, 0xf3, 0x8d, 0xfe, 0xfe, 0xbe, 0x35,
+	0x86, 0xd6, 0x0f, 0xb8, 0x36, 0x4b, 0x5e, 0xdf, 0xd3, 0x3f, 0x0b, 0xae, 0x1c, 0xef, 0xde, 0xc0,
+	0xee, 0xdc, 0x6a, 0x03, 0x3b, 0x02, 0xa7, 0xd2, 0x71, 0xba, 0xc0, 0xe5, 0x91, 0xd6, 0xdb, 0xc9,
+	0xdd, 0xf4, 0x12, 0x15, 0xc3, 0x62, 0xae, 0x67, 0x3f, 0x59, 0x85, 0x0b, 0x39, 0x81, 0xff, 0x3d,
+	0x46, 0xb5, 0x31, 0xc3, 0xe3, 0x3c, 0xc6, 0xb3, 0xa7, 0x4a, 0x72, 0x71, 0xbe, 0xff, 0x4a, 0x39,
+	0xea, 0xd6, 0xc1, 0x77, 0x1b, 0xe4, 0x3d, 0x18, 0x56, 0x54, 0x5b, 0xe2, 0xd4, 0x09, 0xc7, 0x6d,
+	0x9c, 0x75, 0xcd, 0x3f, 0x97, 0x07, 0x7f, 0x0c, 0x00, 0xb6, 0xdf, 0x1e, 0x99, 0xe0, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -18,6 +18,7 @@ import (
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/consensus/tendermint/lite"
 	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
 	vt "github.com/33cn/plugin/plugin/dapp/valnode/types"
 	"github.com/spf13/cobra"
@@ -40,6 +41,10 @@ func ValCmd() *cobra.Command {
 		IsSyncCmd(),
 		GetBlockInfoCmd(),
 		GetNodeInfoCmd(),
+		GetPendingCmd(),
+		GetJailedCmd(),
+		GetLightBlockCmd(),
+		VerifyHeaderCmd(),
 		AddNodeCmd(),
 		CreateCmd(),
 	)
@@ -95,6 +100,70 @@ func parseNodeInfo(arg interface{}) (interface{}, error) {
 	return result.Validators, nil
 }
 
+// GetPendingCmd get validator changes not yet in effect
+func GetPendingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Get tendermint validator changes waiting for their effective height",
+		Run:   getPending,
+	}
+	return cmd
+}
+
+func getPending(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "valnode.GetPendingValidators", nil, &res)
+	ctx.SetResultCb(parsePending)
+	ctx.Run()
+}
+
+func parsePending(arg interface{}) (interface{}, error) {
+	var result vt.PendingValidators
+	res := arg.(*string)
+	data, err := hex.DecodeString(*res)
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(data, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Pending, nil
+}
+
+// GetJailedCmd get validators jailed by committed evidence
+func GetJailedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jailed",
+		Short: "Get tendermint validators jailed by committed evidence",
+		Run:   getJailed,
+	}
+	return cmd
+}
+
+func getJailed(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "valnode.GetJailedValidators", nil, &res)
+	ctx.SetResultCb(parseJailed)
+	ctx.Run()
+}
+
+func parseJailed(arg interface{}) (interface{}, error) {
+	var result vt.JailedValidators
+	res := arg.(*string)
+	data, err := hex.DecodeString(*res)
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(data, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Jailed, nil
+}
+
 // GetBlockInfoCmd get block info
 func GetBlockInfoCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -128,11 +197,103 @@ func getBlockInfo(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
-// AddNodeCmd add validator node
+// GetLightBlockCmd get the header at a height with its commit and validators
+func GetLightBlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "light",
+		Short: "Get hex encoded tendermint light block, the trusted input of verify",
+		Run:   getLightBlock,
+	}
+	addGetBlockInfoFlags(cmd)
+	return cmd
+}
+
+func getLightBlock(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	req := &vt.ReqBlockInfo{
+		Height: height,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "valnode.GetLightBlock", req, &res)
+	ctx.Run()
+}
+
+// VerifyHeaderCmd verify a header from a trusted light block without replaying blocks
+func VerifyHeaderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify tendermint header at a height from a trusted light block",
+		Run:   verifyHeader,
+	}
+	addVerifyHeaderFlags(cmd)
+	return cmd
+}
+
+func addVerifyHeaderFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("trusted", "r", "", "hex encoded trusted light block")
+	cmd.MarkFlagRequired("trusted")
+	cmd.Flags().Int64P("height", "t", 0, "height of the header to verify")
+	cmd.MarkFlagRequired("height")
+}
+
+func verifyHeader(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	trustedHex, _ := cmd.Flags().GetString("trusted")
+	height, _ := cmd.Flags().GetInt64("height")
+
+	var trusted vt.LightBlock
+	if err := decodeHex(trustedHex, &trusted); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var transitions vt.LightBlocks
+	if height > trusted.GetHeader().GetHeight()+1 {
+		var res string
+		req := &vt.ReqValidatorTransitions{From: trusted.GetHeader().GetHeight(), To: height - 1}
+		if err = rpc.Call("valnode.GetValidatorTransitions", req, &res); err == nil {
+			err = decodeHex(res, &transitions)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+	var target vt.LightBlock
+	var res string
+	if err = rpc.Call("valnode.GetLightBlock", &vt.ReqBlockInfo{Height: height}, &res); err == nil {
+		err = decodeHex(res, &target)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	if err = lite.VerifyHeader(&trusted, transitions.Items, &target); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Printf("header at height %d verified, hash %X\n", height, target.Commit.BlockID.Hash)
+}
+
+func decodeHex(data string, msg types.Message) error {
+	b, err := hex.DecodeString(data)
+	if err != nil {
+		return err
+	}
+	return types.Decode(b, msg)
+}
+
+// AddNodeCmd add validator node, change its power or remove it with power 0
 func AddNodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add",
-		Short: "Add tendermint validator node",
+		Short: "Add tendermint validator node, change its power or remove it (power 0)",
 		Run:   addNode,
 	}
 	addNodeFlags(cmd)
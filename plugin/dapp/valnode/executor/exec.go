@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"encoding/hex"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
@@ -18,5 +20,13 @@ func (val *ValNode) Exec_Node(node *pty.ValNode, tx *types.Transaction, index in
 // Exec_BlockInfo method
 func (val *ValNode) Exec_BlockInfo(blockInfo *pty.TendermintBlockInfo, tx *types.Transaction, index int) (*types.Receipt, error) {
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: nil, Logs: nil}
+	// record the validators jailed by the evidence committed in this block
+	for _, jailed := range blockInfo.GetState().GetJailedValidators() {
+		if jailed.Height != val.GetHeight() {
+			continue
+		}
+		clog.Info("jail validator", "address", hex.EncodeToString(jailed.Address), "power", jailed.Power, "evidence-height", jailed.EvidenceHeight)
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pty.TyLogValNodeJail, Log: types.Encode(jailed)})
+	}
 	return receipt, nil
 }
@@ -39,8 +39,10 @@ func (val *ValNode) Query_GetValNodeByHeight(in *pty.ReqNodeInfo) (types.Message
 
 // Query_GetBlockInfoByHeight method
 func (val *ValNode) Query_GetBlockInfoByHeight(in *pty.ReqBlockInfo) (types.Message, error) {
-	height := in.GetHeight()
+	return val.getBlockInfo(in.GetHeight())
+}
 
+func (val *ValNode) getBlockInfo(height int64) (*pty.TendermintBlockInfo, error) {
 	if height <= 0 {
 		return nil, types.ErrInvalidParam
 	}
@@ -60,3 +62,57 @@ func (val *ValNode) Query_GetBlockInfoByHeight(in *pty.ReqBlockInfo) (types.Mess
 	}
 	return reply, nil
 }
+
+// Query_GetLightBlock method
+func (val *ValNode) Query_GetLightBlock(in *pty.ReqBlockInfo) (types.Message, error) {
+	blockInfo, err := val.getBlockInfo(in.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return lightBlock(blockInfo)
+}
+
+// the header of block info is signed by the validators before the block was applied
+func lightBlock(blockInfo *pty.TendermintBlockInfo) (*pty.LightBlock, error) {
+	if blockInfo.GetBlock().GetHeader() == nil || blockInfo.GetSeenCommit() == nil || blockInfo.GetState() == nil {
+		return nil, types.ErrNotFound
+	}
+	return &pty.LightBlock{
+		Header:     blockInfo.Block.Header,
+		Commit:     blockInfo.SeenCommit,
+		Validators: blockInfo.State.LastValidators,
+	}, nil
+}
+
+// Query_GetValidatorTransitions returns the light blocks of the heights in (from, to]
+// where the validator set changed, in ascending height order
+func (val *ValNode) Query_GetValidatorTransitions(in *pty.ReqValidatorTransitions) (types.Message, error) {
+	if in.GetFrom() <= 0 || in.GetTo() <= in.GetFrom() {
+		return nil, types.ErrInvalidParam
+	}
+	var items []*pty.LightBlock
+	height := in.GetTo()
+	for height > in.GetFrom() {
+		blockInfo, err := val.getBlockInfo(height)
+		if err != nil {
+			return nil, err
+		}
+		changed := blockInfo.GetState().GetLastHeightValidatorsChanged()
+		if changed <= in.GetFrom() || changed > height {
+			break
+		}
+		if changed != height {
+			blockInfo, err = val.getBlockInfo(changed)
+			if err != nil {
+				return nil, err
+			}
+		}
+		lb, err := lightBlock(blockInfo)
+		if err != nil {
+			return nil, err
+		}
+		items = append([]*pty.LightBlock{lb}, items...)
+		height = changed - 1
+	}
+	return &pty.LightBlocks{Items: items}, nil
+}
@@ -7,8 +7,10 @@ package rpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/consensus/tendermint/lite"
 	vt "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
 
@@ -55,3 +57,138 @@ func (c *Jrpc) GetNodeInfo(req *types.ReqNil, result *interface{}) error {
 	*result = hex.EncodeToString(types.Encode(data))
 	return nil
 }
+
+// GetPendingValidators query the validator changes waiting for their effective height
+func (c *channelClient) GetPendingValidators(ctx context.Context, req *types.ReqNil) (*vt.PendingValidators, error) {
+	data, err := c.QueryConsensusFunc("tendermint", "PendingValidators", &types.ReqNil{})
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*vt.PendingValidators); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+// GetPendingValidators query the validator changes waiting for their effective height
+func (c *Jrpc) GetPendingValidators(req *types.ReqNil, result *interface{}) error {
+	data, err := c.cli.GetPendingValidators(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(types.Encode(data))
+	return nil
+}
+
+// GetJailedValidators query the validators jailed by committed evidence
+func (c *channelClient) GetJailedValidators(ctx context.Context, req *types.ReqNil) (*vt.JailedValidators, error) {
+	data, err := c.QueryConsensusFunc("tendermint", "JailedValidators", &types.ReqNil{})
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*vt.JailedValidators); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+// GetJailedValidators query the validators jailed by committed evidence
+func (c *Jrpc) GetJailedValidators(req *types.ReqNil, result *interface{}) error {
+	data, err := c.cli.GetJailedValidators(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(types.Encode(data))
+	return nil
+}
+
+// GetLightBlock query the header at a height with its commit and the validators that signed it
+func (c *channelClient) GetLightBlock(ctx context.Context, req *vt.ReqBlockInfo) (*vt.LightBlock, error) {
+	data, err := c.Query(vt.ValNodeX, "GetLightBlock", req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*vt.LightBlock); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+// GetLightBlock query the header at a height with its commit and the validators that signed it
+func (c *Jrpc) GetLightBlock(req *vt.ReqBlockInfo, result *interface{}) error {
+	data, err := c.cli.GetLightBlock(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(types.Encode(data))
+	return nil
+}
+
+// GetValidatorTransitions query the light blocks where the validator set changed
+func (c *channelClient) GetValidatorTransitions(ctx context.Context, req *vt.ReqValidatorTransitions) (*vt.LightBlocks, error) {
+	data, err := c.Query(vt.ValNodeX, "GetValidatorTransitions", req)
+	if err != nil {
+		return nil, err
+	}
+	if resp, ok := data.(*vt.LightBlocks); ok {
+		return resp, nil
+	}
+	return nil, types.ErrDecode
+}
+
+// GetValidatorTransitions query the light blocks where the validator set changed
+func (c *Jrpc) GetValidatorTransitions(req *vt.ReqValidatorTransitions, result *interface{}) error {
+	data, err := c.cli.GetValidatorTransitions(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(types.Encode(data))
+	return nil
+}
+
+// VerifyLightBlock verify the target header from a trusted one and the validator set transitions
+func (c *channelClient) VerifyLightBlock(ctx context.Context, req *vt.ReqVerifyLightBlock) (*types.Reply, error) {
+	err := lite.VerifyHeader(req.GetTrusted(), req.GetTransitions(), req.GetTarget())
+	if err != nil {
+		return &types.Reply{IsOk: false, Msg: []byte(err.Error())}, nil
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
+// VerifyLightBlock verify the target header from a trusted one and the validator set transitions
+func (c *Jrpc) VerifyLightBlock(req *ReqVerifyLightBlock, result *interface{}) error {
+	if req == nil {
+		return types.ErrInvalidParam
+	}
+	in := &vt.ReqVerifyLightBlock{Trusted: &vt.LightBlock{}, Target: &vt.LightBlock{}}
+	if err := decodeHex(req.Trusted, in.Trusted); err != nil {
+		return err
+	}
+	if err := decodeHex(req.Target, in.Target); err != nil {
+		return err
+	}
+	if req.Transitions != "" {
+		transitions := &vt.LightBlocks{}
+		if err := decodeHex(req.Transitions, transitions); err != nil {
+			return err
+		}
+		in.Transitions = transitions.Items
+	}
+	reply, err := c.cli.VerifyLightBlock(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	if !reply.IsOk {
+		return errors.New(string(reply.Msg))
+	}
+	*result = true
+	return nil
+}
+
+func decodeHex(data string, msg types.Message) error {
+	b, err := hex.DecodeString(data)
+	if err != nil {
+		return types.ErrInvalidParam
+	}
+	return types.Decode(b, msg)
+}
@@ -92,3 +92,94 @@ func TestJrpc_GetNodeInfo(t *testing.T) {
 	assert.Nil(t, err)
 	assert.EqualValues(t, hex.EncodeToString(types.Encode(set)), result)
 }
+
+func TestChannelClient_GetPendingValidators(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newGrpc(api)
+	client.Init("valnode", nil, nil, nil)
+	req := &types.ReqNil{}
+	pending := &vt.PendingValidators{
+		Pending: []*vt.PendingValidator{{PubKey: []byte("bbb"), Power: 0, Height: 10, EffectiveHeight: 21}},
+	}
+	api.On("QueryConsensusFunc", "tendermint", "PendingValidators", req).Return(pending, nil)
+	result, err := client.GetPendingValidators(context.Background(), req)
+	assert.Nil(t, err)
+	assert.EqualValues(t, pending, result)
+}
+
+func TestJrpc_GetPendingValidators(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	J := newJrpc(api)
+	req := &types.ReqNil{}
+	var result interface{}
+	pending := &vt.PendingValidators{
+		Pending: []*vt.PendingValidator{{PubKey: []byte("bbb"), Power: 20, Height: 10, EffectiveHeight: 11}},
+	}
+	api.On("QueryConsensusFunc", "tendermint", "PendingValidators", req).Return(pending, nil)
+	err := J.GetPendingValidators(req, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, hex.EncodeToString(types.Encode(pending)), result)
+}
+
+func TestChannelClient_GetJailedValidators(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	client := newGrpc(api)
+	client.Init("valnode", nil, nil, nil)
+	req := &types.ReqNil{}
+	jailed := &vt.JailedValidators{
+		Jailed: []*vt.JailedValidator{{PubKey: []byte("bbb"), Address: []byte("ccc"), Power: 10, Height: 12, EvidenceHeight: 11}},
+	}
+	api.On("QueryConsensusFunc", "tendermint", "JailedValidators", req).Return(jailed, nil)
+	result, err := client.GetJailedValidators(context.Background(), req)
+	assert.Nil(t, err)
+	assert.EqualValues(t, jailed, result)
+}
+
+func TestJrpc_GetJailedValidators(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	J := newJrpc(api)
+	req := &types.ReqNil{}
+	var result interface{}
+	jailed := &vt.JailedValidators{
+		Jailed: []*vt.JailedValidator{{PubKey: []byte("bbb"), Address: []byte("ccc"), Power: 10, Height: 12, EvidenceHeight: 11}},
+	}
+	api.On("QueryConsensusFunc", "tendermint", "JailedValidators", req).Return(jailed, nil)
+	err := J.GetJailedValidators(req, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, hex.EncodeToString(types.Encode(jailed)), result)
+}
+
+func TestJrpc_GetLightBlock(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	J := newJrpc(api)
+	req := &vt.ReqBlockInfo{Height: 10}
+	var result interface{}
+	lb := &vt.LightBlock{Header: &vt.TendermintBlockHeader{Height: 10}, Commit: &vt.TendermintCommit{}}
+	api.On("Query", vt.ValNodeX, "GetLightBlock", req).Return(lb, nil)
+	err := J.GetLightBlock(req, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, hex.EncodeToString(types.Encode(lb)), result)
+}
+
+func TestJrpc_GetValidatorTransitions(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	J := newJrpc(api)
+	req := &vt.ReqValidatorTransitions{From: 1, To: 20}
+	var result interface{}
+	lbs := &vt.LightBlocks{Items: []*vt.LightBlock{{Header: &vt.TendermintBlockHeader{Height: 5}}}}
+	api.On("Query", vt.ValNodeX, "GetValidatorTransitions", req).Return(lbs, nil)
+	err := J.GetValidatorTransitions(req, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, hex.EncodeToString(types.Encode(lbs)), result)
+}
+
+func TestJrpc_VerifyLightBlock(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	J := newJrpc(api)
+	var result interface{}
+	lb := hex.EncodeToString(types.Encode(&vt.LightBlock{Header: &vt.TendermintBlockHeader{Height: 10}}))
+	err := J.VerifyLightBlock(&ReqVerifyLightBlock{Trusted: lb, Target: lb}, &result)
+	assert.NotNil(t, err)
+	err = J.VerifyLightBlock(&ReqVerifyLightBlock{Trusted: "zz", Target: lb}, &result)
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
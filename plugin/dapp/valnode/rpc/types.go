@@ -19,6 +19,13 @@ type Grpc struct {
 	*channelClient
 }
 
+// ReqVerifyLightBlock jrpc request, light blocks are hex encoded as returned by GetLightBlock and GetValidatorTransitions
+type ReqVerifyLightBlock struct {
+	Trusted     string `json:"trusted"`
+	Transitions string `json:"transitions"`
+	Target      string `json:"target"`
+}
+
 type channelClient struct {
 	types.ChannelClient
 }
@@ -9,3 +9,19 @@ const (
 	ValNodeActionUpdate    = 1
 	ValNodeActionBlockInfo = 2
 )
+
+// fork of the validator set derivation of tendermint
+const (
+	// ForkValidatorDelay validator updates take effect after the update and unbonding delays
+	ForkValidatorDelay = "ForkValidatorDelay"
+	// ForkValidatorJail validators proven byzantine by committed evidence are jailed
+	ForkValidatorJail = "ForkValidatorJail"
+	// ForkValidatorStep validator changes are applied in steps below 1/3 of the voting power
+	ForkValidatorStep = "ForkValidatorStep"
+)
+
+//valnode log
+const (
+	// TyLogValNodeJail log for validator jailed by committed evidence
+	TyLogValNodeJail = 1201
+)
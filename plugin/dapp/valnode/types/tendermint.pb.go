@@ -674,29 +674,253 @@ func (m *ValidatorSet) GetProposer() *Validator {
 	return nil
 }
 
+// validator change waiting for its effective height
+type PendingValidator struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=Power,proto3" json:"Power,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	EffectiveHeight      int64    `protobuf:"varint,4,opt,name=EffectiveHeight,proto3" json:"EffectiveHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingValidator) Reset()         { *m = PendingValidator{} }
+func (m *PendingValidator) String() string { return proto.CompactTextString(m) }
+func (*PendingValidator) ProtoMessage()    {}
+func (*PendingValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{12}
+}
+
+func (m *PendingValidator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingValidator.Unmarshal(m, b)
+}
+func (m *PendingValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingValidator.Marshal(b, m, deterministic)
+}
+func (m *PendingValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidator.Merge(m, src)
+}
+func (m *PendingValidator) XXX_Size() int {
+	return xxx_messageInfo_PendingValidator.Size(m)
+}
+func (m *PendingValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidator proto.InternalMessageInfo
+
+func (m *PendingValidator) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PendingValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *PendingValidator) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingValidator) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+type PendingValidators struct {
+	Pending              []*PendingValidator `protobuf:"bytes,1,rep,name=Pending,proto3" json:"Pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PendingValidators) Reset()         { *m = PendingValidators{} }
+func (m *PendingValidators) String() string { return proto.CompactTextString(m) }
+func (*PendingValidators) ProtoMessage()    {}
+func (*PendingValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{13}
+}
+
+func (m *PendingValidators) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingValidators.Unmarshal(m, b)
+}
+func (m *PendingValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingValidators.Marshal(b, m, deterministic)
+}
+func (m *PendingValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidators.Merge(m, src)
+}
+func (m *PendingValidators) XXX_Size() int {
+	return xxx_messageInfo_PendingValidators.Size(m)
+}
+func (m *PendingValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidators proto.InternalMessageInfo
+
+func (m *PendingValidators) GetPending() []*PendingValidator {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+// validator jailed by committed evidence of byzantine behaviour
+type JailedValidator struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	Address              []byte   `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Power                int64    `protobuf:"varint,3,opt,name=Power,proto3" json:"Power,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	EvidenceHeight       int64    `protobuf:"varint,5,opt,name=EvidenceHeight,proto3" json:"EvidenceHeight,omitempty"`
+	EvidenceHash         []byte   `protobuf:"bytes,6,opt,name=EvidenceHash,proto3" json:"EvidenceHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JailedValidator) Reset()         { *m = JailedValidator{} }
+func (m *JailedValidator) String() string { return proto.CompactTextString(m) }
+func (*JailedValidator) ProtoMessage()    {}
+func (*JailedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{14}
+}
+
+func (m *JailedValidator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JailedValidator.Unmarshal(m, b)
+}
+func (m *JailedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JailedValidator.Marshal(b, m, deterministic)
+}
+func (m *JailedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailedValidator.Merge(m, src)
+}
+func (m *JailedValidator) XXX_Size() int {
+	return xxx_messageInfo_JailedValidator.Size(m)
+}
+func (m *JailedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailedValidator proto.InternalMessageInfo
+
+func (m *JailedValidator) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *JailedValidator) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *JailedValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *JailedValidator) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailedValidator) GetEvidenceHeight() int64 {
+	if m != nil {
+		return m.EvidenceHeight
+	}
+	return 0
+}
+
+func (m *JailedValidator) GetEvidenceHash() []byte {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return nil
+}
+
+type JailedValidators struct {
+	Jailed               []*JailedValidator `protobuf:"bytes,1,rep,name=Jailed,proto3" json:"Jailed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *JailedValidators) Reset()         { *m = JailedValidators{} }
+func (m *JailedValidators) String() string { return proto.CompactTextString(m) }
+func (*JailedValidators) ProtoMessage()    {}
+func (*JailedValidators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{15}
+}
+
+func (m *JailedValidators) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JailedValidators.Unmarshal(m, b)
+}
+func (m *JailedValidators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JailedValidators.Marshal(b, m, deterministic)
+}
+func (m *JailedValidators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailedValidators.Merge(m, src)
+}
+func (m *JailedValidators) XXX_Size() int {
+	return xxx_messageInfo_JailedValidators.Size(m)
+}
+func (m *JailedValidators) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailedValidators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailedValidators proto.InternalMessageInfo
+
+func (m *JailedValidators) GetJailed() []*JailedValidator {
+	if m != nil {
+		return m.Jailed
+	}
+	return nil
+}
+
 type State struct {
-	ChainID                          string           `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
-	LastBlockHeight                  int64            `protobuf:"varint,2,opt,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
-	LastBlockTotalTx                 int64            `protobuf:"varint,3,opt,name=LastBlockTotalTx,proto3" json:"LastBlockTotalTx,omitempty"`
-	LastBlockID                      *BlockID         `protobuf:"bytes,4,opt,name=LastBlockID,proto3" json:"LastBlockID,omitempty"`
-	LastBlockTime                    int64            `protobuf:"varint,5,opt,name=LastBlockTime,proto3" json:"LastBlockTime,omitempty"`
-	Validators                       *ValidatorSet    `protobuf:"bytes,6,opt,name=Validators,proto3" json:"Validators,omitempty"`
-	LastValidators                   *ValidatorSet    `protobuf:"bytes,7,opt,name=LastValidators,proto3" json:"LastValidators,omitempty"`
-	LastHeightValidatorsChanged      int64            `protobuf:"varint,8,opt,name=LastHeightValidatorsChanged,proto3" json:"LastHeightValidatorsChanged,omitempty"`
-	ConsensusParams                  *ConsensusParams `protobuf:"bytes,9,opt,name=ConsensusParams,proto3" json:"ConsensusParams,omitempty"`
-	LastHeightConsensusParamsChanged int64            `protobuf:"varint,10,opt,name=LastHeightConsensusParamsChanged,proto3" json:"LastHeightConsensusParamsChanged,omitempty"`
-	LastResultsHash                  []byte           `protobuf:"bytes,11,opt,name=LastResultsHash,proto3" json:"LastResultsHash,omitempty"`
-	AppHash                          []byte           `protobuf:"bytes,12,opt,name=AppHash,proto3" json:"AppHash,omitempty"`
-	XXX_NoUnkeyedLiteral             struct{}         `json:"-"`
-	XXX_unrecognized                 []byte           `json:"-"`
-	XXX_sizecache                    int32            `json:"-"`
+	ChainID                          string              `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	LastBlockHeight                  int64               `protobuf:"varint,2,opt,name=LastBlockHeight,proto3" json:"LastBlockHeight,omitempty"`
+	LastBlockTotalTx                 int64               `protobuf:"varint,3,opt,name=LastBlockTotalTx,proto3" json:"LastBlockTotalTx,omitempty"`
+	LastBlockID                      *BlockID            `protobuf:"bytes,4,opt,name=LastBlockID,proto3" json:"LastBlockID,omitempty"`
+	LastBlockTime                    int64               `protobuf:"varint,5,opt,name=LastBlockTime,proto3" json:"LastBlockTime,omitempty"`
+	Validators                       *ValidatorSet       `protobuf:"bytes,6,opt,name=Validators,proto3" json:"Validators,omitempty"`
+	LastValidators                   *ValidatorSet       `protobuf:"bytes,7,opt,name=LastValidators,proto3" json:"LastValidators,omitempty"`
+	LastHeightValidatorsChanged      int64               `protobuf:"varint,8,opt,name=LastHeightValidatorsChanged,proto3" json:"LastHeightValidatorsChanged,omitempty"`
+	ConsensusParams                  *ConsensusParams    `protobuf:"bytes,9,opt,name=ConsensusParams,proto3" json:"ConsensusParams,omitempty"`
+	LastHeightConsensusParamsChanged int64               `protobuf:"varint,10,opt,name=LastHeightConsensusParamsChanged,proto3" json:"LastHeightConsensusParamsChanged,omitempty"`
+	LastResultsHash                  []byte              `protobuf:"bytes,11,opt,name=LastResultsHash,proto3" json:"LastResultsHash,omitempty"`
+	AppHash                          []byte              `protobuf:"bytes,12,opt,name=AppHash,proto3" json:"AppHash,omitempty"`
+	PendingValidators                []*PendingValidator `protobuf:"bytes,13,rep,name=PendingValidators,proto3" json:"PendingValidators,omitempty"`
+	JailedValidators                 []*JailedValidator  `protobuf:"bytes,14,rep,name=JailedValidators,proto3" json:"JailedValidators,omitempty"`
+	XXX_NoUnkeyedLiteral             struct{}            `json:"-"`
+	XXX_unrecognized                 []byte              `json:"-"`
+	XXX_sizecache                    int32               `json:"-"`
 }
 
 func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{12}
+	return fileDescriptor_04f926c8da23c367, []int{16}
 }
 
 func (m *State) XXX_Unmarshal(b []byte) error {
@@ -801,6 +1025,20 @@ func (m *State) GetAppHash() []byte {
 	return nil
 }
 
+func (m *State) GetPendingValidators() []*PendingValidator {
+	if m != nil {
+		return m.PendingValidators
+	}
+	return nil
+}
+
+func (m *State) GetJailedValidators() []*JailedValidator {
+	if m != nil {
+		return m.JailedValidators
+	}
+	return nil
+}
+
 type DuplicateVoteEvidence struct {
 	PubKey               string   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 	VoteA                *Vote    `protobuf:"bytes,2,opt,name=voteA,proto3" json:"voteA,omitempty"`
@@ -814,7 +1052,7 @@ func (m *DuplicateVoteEvidence) Reset()         { *m = DuplicateVoteEvidence{} }
 func (m *DuplicateVoteEvidence) String() string { return proto.CompactTextString(m) }
 func (*DuplicateVoteEvidence) ProtoMessage()    {}
 func (*DuplicateVoteEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{13}
+	return fileDescriptor_04f926c8da23c367, []int{17}
 }
 
 func (m *DuplicateVoteEvidence) XXX_Unmarshal(b []byte) error {
@@ -868,7 +1106,7 @@ func (m *EvidenceEnvelope) Reset()         { *m = EvidenceEnvelope{} }
 func (m *EvidenceEnvelope) String() string { return proto.CompactTextString(m) }
 func (*EvidenceEnvelope) ProtoMessage()    {}
 func (*EvidenceEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{14}
+	return fileDescriptor_04f926c8da23c367, []int{18}
 }
 
 func (m *EvidenceEnvelope) XXX_Unmarshal(b []byte) error {
@@ -914,7 +1152,7 @@ func (m *EvidenceData) Reset()         { *m = EvidenceData{} }
 func (m *EvidenceData) String() string { return proto.CompactTextString(m) }
 func (*EvidenceData) ProtoMessage()    {}
 func (*EvidenceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{15}
+	return fileDescriptor_04f926c8da23c367, []int{19}
 }
 
 func (m *EvidenceData) XXX_Unmarshal(b []byte) error {
@@ -965,7 +1203,7 @@ func (m *TendermintBlockHeader) Reset()         { *m = TendermintBlockHeader{} }
 func (m *TendermintBlockHeader) String() string { return proto.CompactTextString(m) }
 func (*TendermintBlockHeader) ProtoMessage()    {}
 func (*TendermintBlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{16}
+	return fileDescriptor_04f926c8da23c367, []int{20}
 }
 
 func (m *TendermintBlockHeader) XXX_Unmarshal(b []byte) error {
@@ -1092,7 +1330,7 @@ func (m *TendermintBlock) Reset()         { *m = TendermintBlock{} }
 func (m *TendermintBlock) String() string { return proto.CompactTextString(m) }
 func (*TendermintBlock) ProtoMessage()    {}
 func (*TendermintBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{17}
+	return fileDescriptor_04f926c8da23c367, []int{21}
 }
 
 func (m *TendermintBlock) XXX_Unmarshal(b []byte) error {
@@ -1148,6 +1386,101 @@ func (m *TendermintBlock) GetProposerAddr() []byte {
 	return nil
 }
 
+// block header with the commit that proves it and the validator set that signed it
+type LightBlock struct {
+	Header               *TendermintBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Commit               *TendermintCommit      `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Validators           *ValidatorSet          `protobuf:"bytes,3,opt,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *LightBlock) Reset()         { *m = LightBlock{} }
+func (m *LightBlock) String() string { return proto.CompactTextString(m) }
+func (*LightBlock) ProtoMessage()    {}
+func (*LightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{22}
+}
+
+func (m *LightBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightBlock.Unmarshal(m, b)
+}
+func (m *LightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightBlock.Marshal(b, m, deterministic)
+}
+func (m *LightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlock.Merge(m, src)
+}
+func (m *LightBlock) XXX_Size() int {
+	return xxx_messageInfo_LightBlock.Size(m)
+}
+func (m *LightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlock proto.InternalMessageInfo
+
+func (m *LightBlock) GetHeader() *TendermintBlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *LightBlock) GetCommit() *TendermintCommit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *LightBlock) GetValidators() *ValidatorSet {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type LightBlocks struct {
+	Items                []*LightBlock `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LightBlocks) Reset()         { *m = LightBlocks{} }
+func (m *LightBlocks) String() string { return proto.CompactTextString(m) }
+func (*LightBlocks) ProtoMessage()    {}
+func (*LightBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04f926c8da23c367, []int{23}
+}
+
+func (m *LightBlocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightBlocks.Unmarshal(m, b)
+}
+func (m *LightBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightBlocks.Marshal(b, m, deterministic)
+}
+func (m *LightBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlocks.Merge(m, src)
+}
+func (m *LightBlocks) XXX_Size() int {
+	return xxx_messageInfo_LightBlocks.Size(m)
+}
+func (m *LightBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlocks proto.InternalMessageInfo
+
+func (m *LightBlocks) GetItems() []*LightBlock {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 type Proposal struct {
 	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
@@ -1165,7 +1498,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{18}
+	return fileDescriptor_04f926c8da23c367, []int{24}
 }
 
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
@@ -1250,7 +1583,7 @@ func (m *NewRoundStepMsg) Reset()         { *m = NewRoundStepMsg{} }
 func (m *NewRoundStepMsg) String() string { return proto.CompactTextString(m) }
 func (*NewRoundStepMsg) ProtoMessage()    {}
 func (*NewRoundStepMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{19}
+	return fileDescriptor_04f926c8da23c367, []int{25}
 }
 
 func (m *NewRoundStepMsg) XXX_Unmarshal(b []byte) error {
@@ -1317,7 +1650,7 @@ func (m *CommitStepMsg) Reset()         { *m = CommitStepMsg{} }
 func (m *CommitStepMsg) String() string { return proto.CompactTextString(m) }
 func (*CommitStepMsg) ProtoMessage()    {}
 func (*CommitStepMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{20}
+	return fileDescriptor_04f926c8da23c367, []int{26}
 }
 
 func (m *CommitStepMsg) XXX_Unmarshal(b []byte) error {
@@ -1358,7 +1691,7 @@ func (m *ProposalPOLMsg) Reset()         { *m = ProposalPOLMsg{} }
 func (m *ProposalPOLMsg) String() string { return proto.CompactTextString(m) }
 func (*ProposalPOLMsg) ProtoMessage()    {}
 func (*ProposalPOLMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{21}
+	return fileDescriptor_04f926c8da23c367, []int{27}
 }
 
 func (m *ProposalPOLMsg) XXX_Unmarshal(b []byte) error {
@@ -1414,7 +1747,7 @@ func (m *HasVoteMsg) Reset()         { *m = HasVoteMsg{} }
 func (m *HasVoteMsg) String() string { return proto.CompactTextString(m) }
 func (*HasVoteMsg) ProtoMessage()    {}
 func (*HasVoteMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{22}
+	return fileDescriptor_04f926c8da23c367, []int{28}
 }
 
 func (m *HasVoteMsg) XXX_Unmarshal(b []byte) error {
@@ -1477,7 +1810,7 @@ func (m *VoteSetMaj23Msg) Reset()         { *m = VoteSetMaj23Msg{} }
 func (m *VoteSetMaj23Msg) String() string { return proto.CompactTextString(m) }
 func (*VoteSetMaj23Msg) ProtoMessage()    {}
 func (*VoteSetMaj23Msg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{23}
+	return fileDescriptor_04f926c8da23c367, []int{29}
 }
 
 func (m *VoteSetMaj23Msg) XXX_Unmarshal(b []byte) error {
@@ -1541,7 +1874,7 @@ func (m *VoteSetBitsMsg) Reset()         { *m = VoteSetBitsMsg{} }
 func (m *VoteSetBitsMsg) String() string { return proto.CompactTextString(m) }
 func (*VoteSetBitsMsg) ProtoMessage()    {}
 func (*VoteSetBitsMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{24}
+	return fileDescriptor_04f926c8da23c367, []int{30}
 }
 
 func (m *VoteSetBitsMsg) XXX_Unmarshal(b []byte) error {
@@ -1613,7 +1946,7 @@ func (m *Heartbeat) Reset()         { *m = Heartbeat{} }
 func (m *Heartbeat) String() string { return proto.CompactTextString(m) }
 func (*Heartbeat) ProtoMessage()    {}
 func (*Heartbeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{25}
+	return fileDescriptor_04f926c8da23c367, []int{31}
 }
 
 func (m *Heartbeat) XXX_Unmarshal(b []byte) error {
@@ -1687,7 +2020,7 @@ func (m *IsHealthy) Reset()         { *m = IsHealthy{} }
 func (m *IsHealthy) String() string { return proto.CompactTextString(m) }
 func (*IsHealthy) ProtoMessage()    {}
 func (*IsHealthy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04f926c8da23c367, []int{26}
+	return fileDescriptor_04f926c8da23c367, []int{32}
 }
 
 func (m *IsHealthy) XXX_Unmarshal(b []byte) error {
@@ -1728,12 +2061,18 @@ func init() {
 	proto.RegisterType((*ConsensusParams)(nil), "types.ConsensusParams")
 	proto.RegisterType((*Validator)(nil), "types.Validator")
 	proto.RegisterType((*ValidatorSet)(nil), "types.ValidatorSet")
+	proto.RegisterType((*PendingValidator)(nil), "types.PendingValidator")
+	proto.RegisterType((*PendingValidators)(nil), "types.PendingValidators")
+	proto.RegisterType((*JailedValidator)(nil), "types.JailedValidator")
+	proto.RegisterType((*JailedValidators)(nil), "types.JailedValidators")
 	proto.RegisterType((*State)(nil), "types.State")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "types.DuplicateVoteEvidence")
 	proto.RegisterType((*EvidenceEnvelope)(nil), "types.EvidenceEnvelope")
 	proto.RegisterType((*EvidenceData)(nil), "types.EvidenceData")
 	proto.RegisterType((*TendermintBlockHeader)(nil), "types.TendermintBlockHeader")
 	proto.RegisterType((*TendermintBlock)(nil), "types.TendermintBlock")
+	proto.RegisterType((*LightBlock)(nil), "types.LightBlock")
+	proto.RegisterType((*LightBlocks)(nil), "types.LightBlocks")
 	proto.RegisterType((*Proposal)(nil), "types.Proposal")
 	proto.RegisterType((*NewRoundStepMsg)(nil), "types.NewRoundStepMsg")
 	proto.RegisterType((*CommitStepMsg)(nil), "types.CommitStepMsg")
@@ -1748,97 +2087,109 @@ func init() {
 func init() { proto.RegisterFile("tendermint.proto", fileDescriptor_04f926c8da23c367) }
 
 var fileDescriptor_04f926c8da23c367 = []byte{
-	// 1472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x73, 0x1b, 0xc5,
-	0x12, 0xaf, 0xb5, 0x2c, 0xd9, 0x6a, 0xc9, 0x7f, 0xde, 0xe4, 0x39, 0xd1, 0xcb, 0xf3, 0xab, 0xd2,
-	0x9b, 0x0a, 0x20, 0x92, 0x94, 0x93, 0xb2, 0x53, 0xc5, 0x21, 0x84, 0x8a, 0x65, 0xa7, 0x62, 0x83,
-	0x9d, 0xa8, 0x46, 0xaa, 0x70, 0x1e, 0x4b, 0x83, 0xb4, 0x20, 0xed, 0x2e, 0x3b, 0x23, 0x45, 0xa6,
-	0x8a, 0x0b, 0x07, 0xee, 0x7c, 0x10, 0x4e, 0x1c, 0xf8, 0x08, 0x7c, 0x02, 0x8e, 0xf0, 0x45, 0xb8,
-	0x50, 0xd3, 0x33, 0xb3, 0xff, 0xa4, 0x28, 0x40, 0x51, 0xdc, 0xd4, 0x3d, 0xbf, 0x99, 0xde, 0xee,
-	0xfe, 0x75, 0x4f, 0x8f, 0x60, 0x57, 0x89, 0x60, 0x20, 0xe2, 0x89, 0x1f, 0xa8, 0x83, 0x28, 0x0e,
-	0x55, 0x48, 0xca, 0xea, 0x3a, 0x12, 0xf2, 0xf6, 0xbf, 0x54, 0xcc, 0x03, 0xc9, 0xfb, 0xca, 0x0f,
-	0x03, 0xb3, 0x42, 0xff, 0x07, 0x1b, 0xed, 0x71, 0xd8, 0xff, 0xe2, 0xfc, 0x94, 0x10, 0x58, 0x3f,
-	0xe3, 0x72, 0xd4, 0xf0, 0x9a, 0x5e, 0xab, 0xce, 0xf0, 0x37, 0xfd, 0x08, 0x48, 0x2f, 0x39, 0xac,
-	0xed, 0xab, 0xe3, 0x38, 0xe6, 0xd7, 0x1a, 0xd9, 0xf6, 0x95, 0x44, 0x64, 0x99, 0xe1, 0x6f, 0xf2,
-	0x6f, 0x28, 0x3f, 0x1b, 0x8b, 0x89, 0x6c, 0xac, 0x35, 0x4b, 0xad, 0x75, 0x66, 0x04, 0xfa, 0xcd,
-	0x1a, 0xac, 0xbf, 0x0a, 0x95, 0x20, 0x77, 0x61, 0xf7, 0x15, 0x1f, 0xfb, 0x03, 0xae, 0xc2, 0xf8,
-	0x78, 0x30, 0x88, 0x85, 0x94, 0xd6, 0xd0, 0x82, 0x9e, 0xbc, 0x0b, 0xdb, 0x89, 0xee, 0x3c, 0x18,
-	0x88, 0x79, 0x63, 0x0d, 0x0d, 0x15, 0xb4, 0xe4, 0x26, 0x54, 0xce, 0x84, 0x3f, 0x1c, 0xa9, 0x46,
-	0xa9, 0xe9, 0xb5, 0x4a, 0xcc, 0x4a, 0xfa, 0x53, 0x58, 0x38, 0x0d, 0x06, 0x8d, 0x75, 0xdc, 0x66,
-	0x04, 0xb2, 0x0f, 0xd5, 0x9e, 0x3f, 0x11, 0x52, 0xf1, 0x49, 0xd4, 0x28, 0xe3, 0x86, 0x54, 0xa1,
-	0x5d, 0xea, 0x5d, 0x47, 0xa2, 0x51, 0x69, 0x7a, 0xad, 0x2d, 0x86, 0xbf, 0x49, 0x2b, 0x89, 0x4d,
-	0x63, 0xa3, 0xe9, 0xb5, 0x6a, 0x87, 0xdb, 0x07, 0x18, 0xc7, 0x03, 0xab, 0x65, 0x49, 0xe8, 0xf6,
-	0xa1, 0xda, 0xf5, 0x87, 0x01, 0x57, 0xd3, 0x58, 0x34, 0x36, 0xd1, 0xad, 0x54, 0x41, 0x7d, 0xd8,
-	0x4d, 0x83, 0x78, 0x12, 0x4e, 0x26, 0xbe, 0xca, 0x9e, 0xed, 0xad, 0x3e, 0xfb, 0x1e, 0x40, 0x27,
-	0x16, 0x7d, 0xdc, 0x66, 0xa2, 0x5b, 0x3b, 0xac, 0x59, 0xb0, 0x0e, 0x2d, 0xcb, 0x2c, 0xd3, 0x6f,
-	0xd7, 0xe0, 0x46, 0x26, 0x61, 0x78, 0x44, 0xf0, 0x59, 0x48, 0x3e, 0x00, 0xe8, 0x0a, 0x11, 0x18,
-	0xe3, 0xd6, 0xe2, 0x2d, 0x7b, 0x48, 0xf1, 0xdb, 0x58, 0x06, 0xaa, 0x37, 0x5e, 0x70, 0x69, 0x57,
-	0x30, 0x0f, 0xab, 0x36, 0xa6, 0x50, 0x42, 0xa1, 0xdc, 0x55, 0x5c, 0x09, 0xcc, 0x4d, 0xed, 0xb0,
-	0x6e, 0xf7, 0xa0, 0x8e, 0x99, 0x25, 0x72, 0x0f, 0x36, 0x3b, 0x71, 0x18, 0x85, 0x92, 0x8f, 0x31,
-	0x57, 0xb5, 0xc3, 0x1d, 0x0b, 0x73, 0x6a, 0x96, 0x00, 0xc8, 0x7d, 0x28, 0x5f, 0x69, 0x7f, 0x30,
-	0x77, 0xb5, 0xc3, 0x9b, 0x0b, 0x1f, 0x81, 0xde, 0x32, 0x03, 0xa2, 0x9f, 0x42, 0x15, 0xe5, 0xae,
-	0xff, 0x95, 0x20, 0xb7, 0x61, 0xf3, 0x92, 0xcf, 0xdb, 0xd7, 0x4a, 0x38, 0xce, 0x26, 0xb2, 0x26,
-	0xd1, 0x25, 0x9f, 0xf7, 0xe6, 0xd2, 0x92, 0xcc, 0x4a, 0x56, 0xff, 0x9c, 0x4b, 0x47, 0x2e, 0x23,
-	0xd1, 0x0f, 0xa1, 0xd2, 0x9b, 0xff, 0xc1, 0x53, 0xf5, 0xee, 0xb5, 0xdc, 0xee, 0x27, 0x50, 0xc3,
-	0xcf, 0x7a, 0x1e, 0x4a, 0xe9, 0x47, 0xe4, 0x00, 0x08, 0x8a, 0x1d, 0x1e, 0x2b, 0x7d, 0x66, 0xf6,
-	0xb0, 0x25, 0x2b, 0xb4, 0x05, 0xdb, 0xcf, 0x66, 0xfe, 0x40, 0x04, 0x7d, 0xd1, 0xe1, 0x31, 0x9f,
-	0x38, 0x43, 0xc7, 0x43, 0x81, 0xbb, 0x8c, 0xa1, 0xe3, 0xa1, 0xa0, 0xbf, 0x78, 0xb0, 0x73, 0x12,
-	0x06, 0x52, 0x04, 0x72, 0x2a, 0x2d, 0xf6, 0x20, 0x13, 0x13, 0xcb, 0x81, 0xdd, 0x2c, 0xeb, 0xb4,
-	0x9e, 0x65, 0xc2, 0xf6, 0x8e, 0x73, 0xd5, 0xe6, 0x7d, 0xcb, 0x85, 0x1c, 0x95, 0xcc, 0xc5, 0xe1,
-	0x51, 0xce, 0x27, 0x9b, 0x6f, 0x92, 0x3d, 0xd8, 0xac, 0xb0, 0x9c, 0xeb, 0x4f, 0x8a, 0xae, 0x58,
-	0x06, 0xec, 0xd9, 0x8d, 0xf9, 0x45, 0x56, 0x00, 0xd3, 0x29, 0x54, 0x93, 0x6e, 0x40, 0x1a, 0xb0,
-	0x91, 0xef, 0x29, 0x4e, 0xd4, 0xe1, 0xe9, 0x4c, 0xaf, 0x3e, 0x11, 0xd7, 0xe8, 0x42, 0x9d, 0x59,
-	0x89, 0x34, 0xa1, 0xf6, 0x2a, 0x54, 0x7e, 0x30, 0xec, 0x84, 0xaf, 0x45, 0x6c, 0x53, 0x9c, 0x55,
-	0xe9, 0x26, 0x72, 0xdc, 0xef, 0x4f, 0x27, 0xf8, 0x59, 0x25, 0x66, 0x04, 0x1a, 0x40, 0x3d, 0x31,
-	0xdb, 0x15, 0x8a, 0x3c, 0x04, 0x48, 0x64, 0x6d, 0xbc, 0x94, 0x89, 0x69, 0xb2, 0xc0, 0x32, 0x18,
-	0x72, 0xdf, 0x71, 0x5e, 0xc4, 0x36, 0xac, 0x8b, 0xf8, 0x04, 0x41, 0x7f, 0x5e, 0xb7, 0x65, 0xa4,
-	0x7d, 0x3c, 0x19, 0x71, 0x3f, 0xb0, 0x0d, 0xa3, 0xca, 0x9c, 0x48, 0x5a, 0xb0, 0xa3, 0xeb, 0x0e,
-	0x83, 0x6b, 0xfb, 0xa1, 0x21, 0x5d, 0x51, 0xad, 0x9b, 0x70, 0xa2, 0xea, 0x85, 0x8a, 0x8f, 0x7b,
-	0x73, 0xeb, 0xfa, 0x82, 0x9e, 0x3c, 0x84, 0x5a, 0xa2, 0x3b, 0x3f, 0xb5, 0xc9, 0x29, 0x36, 0xa9,
-	0x2c, 0x84, 0xdc, 0x81, 0xad, 0xf4, 0x14, 0x7f, 0x22, 0x6c, 0x93, 0xcd, 0x2b, 0xc9, 0x51, 0x2e,
-	0x62, 0x15, 0x3c, 0xf6, 0x46, 0x31, 0x02, 0x5d, 0xa1, 0x72, 0x41, 0x7b, 0x0c, 0xdb, 0xfa, 0x94,
-	0xcc, 0xc6, 0x8d, 0x37, 0x6f, 0x2c, 0x40, 0xc9, 0x53, 0xf8, 0xaf, 0xd6, 0x98, 0x18, 0xa4, 0xfa,
-	0x93, 0x11, 0x0f, 0x86, 0x62, 0x80, 0xed, 0xba, 0xc4, 0x56, 0x41, 0xc8, 0xd3, 0x85, 0x5a, 0x6a,
-	0x54, 0x73, 0x4d, 0xa8, 0xb0, 0xca, 0x16, 0x4a, 0xef, 0x63, 0x68, 0xa6, 0x06, 0x0a, 0x8b, 0xee,
-	0x43, 0x00, 0x3f, 0xe4, 0xad, 0x38, 0x97, 0x6f, 0x26, 0xe4, 0x74, 0xac, 0x24, 0x5e, 0xd9, 0x35,
-	0x24, 0x77, 0x51, 0x8d, 0x75, 0x11, 0x45, 0x88, 0xa8, 0xdb, 0xba, 0x30, 0x22, 0x9d, 0xc2, 0xde,
-	0xe9, 0x34, 0x1a, 0xfb, 0x7d, 0xae, 0x84, 0xbe, 0x44, 0x5c, 0x75, 0xe9, 0x82, 0x89, 0x4c, 0xc1,
-	0x18, 0x96, 0x59, 0x89, 0xfc, 0x1f, 0xca, 0xb3, 0x50, 0x89, 0x63, 0xcb, 0xd9, 0xdc, 0x05, 0x64,
-	0x56, 0x1c, 0xa4, 0x6d, 0x3b, 0xc0, 0x22, 0xa4, 0x4d, 0xdb, 0xb0, 0xeb, 0x2c, 0x3d, 0x0b, 0x66,
-	0x62, 0x1c, 0x46, 0xd8, 0x46, 0x35, 0xf0, 0x05, 0x9f, 0x08, 0x6b, 0x33, 0x91, 0xf5, 0xad, 0x3c,
-	0xe0, 0x8a, 0xdb, 0xe2, 0xc5, 0xdf, 0xf4, 0x04, 0xea, 0xee, 0x8c, 0x53, 0xae, 0x38, 0x39, 0x82,
-	0x4d, 0x61, 0x65, 0x5b, 0x80, 0xb7, 0x0a, 0x2d, 0xc4, 0x99, 0x62, 0x09, 0x90, 0xfe, 0x58, 0x82,
-	0xbd, 0xc2, 0xcd, 0x71, 0x26, 0xf8, 0x40, 0x60, 0x2f, 0xe9, 0xe7, 0xeb, 0xcc, 0x8a, 0x3a, 0x34,
-	0xa3, 0x6c, 0x79, 0x59, 0x49, 0x77, 0x8a, 0x18, 0xc7, 0x0d, 0x53, 0x4a, 0x46, 0xd0, 0x9f, 0xae,
-	0x74, 0x11, 0x98, 0xf6, 0x81, 0xbf, 0xf5, 0x09, 0xc1, 0x74, 0xa2, 0xef, 0x1a, 0x53, 0x1a, 0x56,
-	0xd2, 0xb5, 0x36, 0xce, 0xd4, 0x5a, 0x65, 0x79, 0xad, 0x65, 0x20, 0x18, 0x34, 0x53, 0xa8, 0xa6,
-	0x14, 0x4a, 0x2c, 0x91, 0xf5, 0xf8, 0x34, 0x4e, 0xee, 0x61, 0x4c, 0xbe, 0x99, 0x48, 0x0a, 0x5a,
-	0x8d, 0x9b, 0x25, 0x54, 0x47, 0x5c, 0xd5, 0xe0, 0xf2, 0x5a, 0x5d, 0xd7, 0x7d, 0xc7, 0x44, 0x84,
-	0x01, 0xc2, 0xf2, 0x4a, 0x1d, 0x37, 0x6e, 0xb9, 0x66, 0xd8, 0xe8, 0x44, 0xcd, 0xd7, 0x71, 0x81,
-	0xaf, 0x86, 0x8d, 0x45, 0x35, 0xa1, 0x50, 0x77, 0x19, 0x42, 0xd8, 0x16, 0xc2, 0x72, 0x3a, 0xfa,
-	0x9b, 0x07, 0x3b, 0x85, 0xcc, 0x91, 0x47, 0x3a, 0x33, 0x3a, 0x7b, 0xf6, 0x56, 0xdb, 0x5f, 0x3e,
-	0x1b, 0x98, 0x0c, 0x33, 0x8b, 0x25, 0x77, 0xa0, 0xa4, 0xe6, 0x6e, 0xa2, 0x72, 0xf7, 0x55, 0x2f,
-	0x9d, 0x90, 0x99, 0x5e, 0x26, 0x0f, 0x32, 0xf4, 0x2a, 0xe5, 0x9a, 0x4e, 0x96, 0x85, 0x29, 0xb5,
-	0xf4, 0xc4, 0x94, 0x06, 0xda, 0xf6, 0xcd, 0x37, 0x4f, 0x4c, 0xe3, 0xec, 0xc4, 0x54, 0x8f, 0x6c,
-	0xdf, 0xd7, 0xd7, 0x17, 0x72, 0xa4, 0xce, 0x72, 0x3a, 0xfa, 0xab, 0x97, 0x8e, 0x4c, 0x19, 0x42,
-	0x7a, 0xcb, 0x09, 0x69, 0x26, 0x1a, 0x4b, 0xc8, 0x7d, 0xa8, 0xaa, 0x64, 0xfe, 0x35, 0x54, 0x4d,
-	0x15, 0x9a, 0x50, 0x9d, 0x97, 0x17, 0xd9, 0xb1, 0x39, 0x91, 0xc9, 0x01, 0x40, 0xe7, 0xe5, 0x85,
-	0x63, 0x67, 0x79, 0x29, 0x3b, 0x33, 0x08, 0x6d, 0x49, 0x26, 0xd3, 0x70, 0xc5, 0x4c, 0xc3, 0x89,
-	0x42, 0xaf, 0xe2, 0x88, 0x36, 0xd2, 0x19, 0xde, 0x30, 0xab, 0x89, 0x82, 0xfe, 0xe0, 0xc1, 0xce,
-	0x0b, 0xf1, 0x1a, 0x0d, 0x77, 0x95, 0x88, 0x2e, 0xe5, 0xf0, 0x4f, 0xfa, 0x49, 0x60, 0x5d, 0x2a,
-	0x61, 0x5c, 0x2c, 0x33, 0xfc, 0x4d, 0x1e, 0xc1, 0x9e, 0x14, 0xfd, 0x30, 0x18, 0xc8, 0xae, 0x1f,
-	0xf4, 0x45, 0x57, 0xf1, 0x58, 0xf5, 0x5c, 0x75, 0x96, 0xd9, 0xf2, 0x45, 0x47, 0x5c, 0x9b, 0x2a,
-	0xb4, 0x54, 0x46, 0x7c, 0x51, 0x4d, 0xdf, 0x83, 0x2d, 0x23, 0xbe, 0xe5, 0x93, 0xe9, 0x77, 0x1e,
-	0x6c, 0xbb, 0xfc, 0x75, 0x5e, 0x5e, 0xac, 0xf2, 0xee, 0x2e, 0xec, 0x46, 0x29, 0x92, 0x65, 0x1c,
-	0x5d, 0xd0, 0x93, 0xc7, 0x50, 0xcb, 0xe8, 0x2c, 0x4f, 0xff, 0xb3, 0x58, 0x05, 0xf6, 0x01, 0xc7,
-	0xb2, 0x68, 0x3a, 0x00, 0x38, 0xe3, 0x52, 0xb7, 0xe9, 0xbf, 0x14, 0x6c, 0x6d, 0xc4, 0x05, 0x5b,
-	0xff, 0xd6, 0x48, 0x1f, 0x5f, 0x6d, 0xf6, 0xf9, 0x85, 0x02, 0xfd, 0x1a, 0x76, 0xb4, 0x89, 0xae,
-	0x50, 0x97, 0xfc, 0xf3, 0xc3, 0xa3, 0xbf, 0xc7, 0x54, 0x0b, 0x36, 0xae, 0x56, 0x0e, 0x28, 0x6e,
-	0x99, 0x7e, 0xef, 0xc1, 0xb6, 0xb5, 0xaf, 0x9f, 0xab, 0xff, 0xb0, 0x79, 0xf2, 0xc0, 0xdc, 0x8d,
-	0xd2, 0x56, 0xcf, 0x8a, 0xd4, 0x18, 0x1c, 0xfd, 0xc9, 0x83, 0xea, 0x99, 0xe0, 0xb1, 0xba, 0x12,
-	0x1c, 0xb9, 0x30, 0x7b, 0xc3, 0xeb, 0x79, 0xb6, 0xe4, 0xf5, 0x3c, 0x5b, 0xfa, 0x7a, 0x9e, 0x2d,
-	0xbc, 0x9e, 0x47, 0xb9, 0xd7, 0x73, 0xd1, 0xfd, 0xf5, 0xac, 0xfb, 0xb7, 0x61, 0x53, 0x8a, 0x2f,
-	0xa7, 0xd8, 0x06, 0x4d, 0x11, 0x24, 0xf2, 0xea, 0x7a, 0xa7, 0xef, 0x43, 0xf5, 0x5c, 0x9e, 0x09,
-	0x3e, 0x56, 0xa3, 0x6b, 0x0d, 0xf5, 0x9d, 0x80, 0x1e, 0x6c, 0xb2, 0x54, 0x71, 0x55, 0xc1, 0xff,
-	0x24, 0x8e, 0x7e, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x20, 0x41, 0x55, 0xc1, 0x10, 0x00, 0x00,
+	// 1661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x73, 0x1b, 0x35,
+	0x18, 0x9f, 0x8d, 0x1f, 0x89, 0x3f, 0x3b, 0x8f, 0xaa, 0x4d, 0x59, 0x4a, 0x99, 0x09, 0x9a, 0xd2,
+	0x9a, 0xb6, 0x93, 0x96, 0xa4, 0x03, 0x87, 0x52, 0xa6, 0x79, 0xd1, 0xa4, 0x24, 0xad, 0x47, 0xf6,
+	0x94, 0xb3, 0x62, 0xab, 0xf6, 0x82, 0xbd, 0xbb, 0xac, 0x64, 0x37, 0x61, 0x86, 0x4b, 0x0f, 0xdc,
+	0xf9, 0x2f, 0xb8, 0x70, 0xe2, 0xc0, 0x89, 0x33, 0x7f, 0x05, 0x0c, 0xff, 0x07, 0x17, 0x46, 0xaf,
+	0x5d, 0xed, 0xda, 0x49, 0xca, 0x63, 0xb8, 0xed, 0xf7, 0xd3, 0x4f, 0xfa, 0xf4, 0x3d, 0x25, 0x2d,
+	0xac, 0x08, 0x16, 0xf6, 0x58, 0x32, 0x0a, 0x42, 0xb1, 0x1e, 0x27, 0x91, 0x88, 0x50, 0x45, 0x9c,
+	0xc6, 0x8c, 0x5f, 0xbb, 0x24, 0x12, 0x1a, 0x72, 0xda, 0x15, 0x41, 0x14, 0xea, 0x11, 0xfc, 0x2e,
+	0xcc, 0x6f, 0x0f, 0xa3, 0xee, 0x57, 0x07, 0xbb, 0x08, 0x41, 0x79, 0x9f, 0xf2, 0x81, 0xef, 0xad,
+	0x79, 0xcd, 0x06, 0x51, 0xdf, 0xf8, 0x53, 0x40, 0x9d, 0x74, 0xb1, 0xed, 0x40, 0x6c, 0x25, 0x09,
+	0x3d, 0x95, 0xcc, 0xed, 0x40, 0x70, 0xc5, 0xac, 0x10, 0xf5, 0x8d, 0xae, 0x40, 0x65, 0x6f, 0xc8,
+	0x46, 0xdc, 0x9f, 0x5b, 0x2b, 0x35, 0xcb, 0x44, 0x0b, 0xf8, 0xf5, 0x1c, 0x94, 0x5f, 0x44, 0x82,
+	0xa1, 0xdb, 0xb0, 0xf2, 0x82, 0x0e, 0x83, 0x1e, 0x15, 0x51, 0xb2, 0xd5, 0xeb, 0x25, 0x8c, 0x73,
+	0xa3, 0x68, 0x0a, 0x47, 0x37, 0x61, 0x29, 0xc5, 0x0e, 0xc2, 0x1e, 0x3b, 0xf1, 0xe7, 0x94, 0xa2,
+	0x02, 0x8a, 0xae, 0x42, 0x75, 0x9f, 0x05, 0xfd, 0x81, 0xf0, 0x4b, 0x6b, 0x5e, 0xb3, 0x44, 0x8c,
+	0x24, 0xb7, 0x42, 0xa2, 0x71, 0xd8, 0xf3, 0xcb, 0x6a, 0x9a, 0x16, 0xd0, 0x75, 0xa8, 0x75, 0x82,
+	0x11, 0xe3, 0x82, 0x8e, 0x62, 0xbf, 0xa2, 0x26, 0x64, 0x80, 0x34, 0xa9, 0x73, 0x1a, 0x33, 0xbf,
+	0xba, 0xe6, 0x35, 0x17, 0x89, 0xfa, 0x46, 0xcd, 0xd4, 0x37, 0xfe, 0xfc, 0x9a, 0xd7, 0xac, 0x6f,
+	0x2c, 0xad, 0x2b, 0x3f, 0xae, 0x1b, 0x94, 0xa4, 0xae, 0xbb, 0x0e, 0xb5, 0x76, 0xd0, 0x0f, 0xa9,
+	0x18, 0x27, 0xcc, 0x5f, 0x50, 0x66, 0x65, 0x00, 0x0e, 0x60, 0x25, 0x73, 0xe2, 0x4e, 0x34, 0x1a,
+	0x05, 0xc2, 0x5d, 0xdb, 0x3b, 0x7f, 0xed, 0x3b, 0x00, 0xad, 0x84, 0x75, 0xd5, 0x34, 0xed, 0xdd,
+	0xfa, 0x46, 0xdd, 0x90, 0xa5, 0x6b, 0x89, 0x33, 0x8c, 0xbf, 0x9b, 0x83, 0xcb, 0x4e, 0xc0, 0xd4,
+	0x12, 0xe1, 0xcb, 0x08, 0x7d, 0x0c, 0xd0, 0x66, 0x2c, 0xd4, 0xca, 0x8d, 0xc6, 0xb7, 0xcc, 0x22,
+	0xc5, 0xbd, 0x11, 0x87, 0x2a, 0x27, 0x1e, 0x52, 0x6e, 0x46, 0xfc, 0xb9, 0x0b, 0x26, 0x66, 0x54,
+	0x84, 0xa1, 0xd2, 0x16, 0x54, 0x30, 0x15, 0x9b, 0xfa, 0x46, 0xc3, 0xcc, 0x51, 0x18, 0xd1, 0x43,
+	0xe8, 0x0e, 0x2c, 0xb4, 0x92, 0x28, 0x8e, 0x38, 0x1d, 0xaa, 0x58, 0xd5, 0x37, 0x96, 0x0d, 0xcd,
+	0xc2, 0x24, 0x25, 0xa0, 0xbb, 0x50, 0x39, 0x96, 0xf6, 0xa8, 0xd8, 0xd5, 0x37, 0xae, 0x4e, 0x6d,
+	0x42, 0x59, 0x4b, 0x34, 0x09, 0x7f, 0x01, 0x35, 0x25, 0xb7, 0x83, 0x6f, 0x18, 0xba, 0x06, 0x0b,
+	0x47, 0xf4, 0x64, 0xfb, 0x54, 0x30, 0x9b, 0xb3, 0xa9, 0x2c, 0x93, 0xe8, 0x88, 0x9e, 0x74, 0x4e,
+	0xb8, 0x49, 0x32, 0x23, 0x19, 0xfc, 0x09, 0xe5, 0x36, 0xb9, 0xb4, 0x84, 0x3f, 0x81, 0x6a, 0xe7,
+	0xe4, 0x0d, 0x57, 0x7d, 0x42, 0xf5, 0xaa, 0xd9, 0xec, 0x47, 0x50, 0x57, 0xdb, 0x7a, 0x12, 0x71,
+	0x1e, 0xc4, 0x68, 0x1d, 0x90, 0x12, 0x5b, 0x34, 0x11, 0x72, 0x4d, 0x77, 0xb1, 0x19, 0x23, 0xb8,
+	0x09, 0x4b, 0x7b, 0x93, 0xa0, 0xc7, 0xc2, 0x2e, 0x6b, 0xd1, 0x84, 0x8e, 0xac, 0xa2, 0xad, 0x3e,
+	0xf3, 0xbd, 0x54, 0xd1, 0x56, 0x9f, 0xe1, 0xdf, 0x3c, 0x58, 0xde, 0x89, 0x42, 0xce, 0x42, 0x3e,
+	0xe6, 0x86, 0xbb, 0xee, 0xf8, 0xc4, 0xe4, 0xc0, 0x8a, 0x9b, 0x75, 0x12, 0x27, 0x8e, 0xdb, 0xde,
+	0xb7, 0xa6, 0x9a, 0xb8, 0x2f, 0x5a, 0x97, 0x2b, 0x90, 0x58, 0x3f, 0x3c, 0xc8, 0xd9, 0x64, 0xe2,
+	0x8d, 0xdc, 0x85, 0xf5, 0x08, 0xc9, 0x99, 0xfe, 0xa8, 0x68, 0x8a, 0xc9, 0x80, 0x55, 0x33, 0x31,
+	0x3f, 0x48, 0x0a, 0x64, 0x3c, 0x86, 0x5a, 0xda, 0x0d, 0x90, 0x0f, 0xf3, 0xf9, 0x9e, 0x62, 0x45,
+	0xe9, 0x9e, 0xd6, 0xf8, 0xf8, 0x73, 0x76, 0xaa, 0x4c, 0x68, 0x10, 0x23, 0xa1, 0x35, 0xa8, 0xbf,
+	0x88, 0x44, 0x10, 0xf6, 0x5b, 0xd1, 0x2b, 0x96, 0x98, 0x10, 0xbb, 0x90, 0x6c, 0x22, 0x5b, 0xdd,
+	0xee, 0x78, 0xa4, 0xb6, 0x55, 0x22, 0x5a, 0xc0, 0x21, 0x34, 0x52, 0xb5, 0x6d, 0x26, 0xd0, 0x7d,
+	0x80, 0x54, 0x96, 0xca, 0x4b, 0x8e, 0x4f, 0xd3, 0x01, 0xe2, 0x70, 0xd0, 0x5d, 0x9b, 0xf3, 0x2c,
+	0x31, 0x6e, 0x9d, 0xe6, 0xa7, 0x0c, 0xfc, 0xda, 0x83, 0x95, 0x16, 0x0b, 0x7b, 0x41, 0xd8, 0xcf,
+	0xcc, 0xcd, 0x8c, 0xf2, 0x72, 0x46, 0x5d, 0x81, 0x8a, 0x36, 0x47, 0xe7, 0x9c, 0x16, 0xce, 0xec,
+	0x92, 0x4d, 0x58, 0xde, 0x7b, 0xf9, 0x92, 0x75, 0x45, 0x30, 0x61, 0x86, 0xa0, 0x4d, 0x2d, 0xc2,
+	0xf8, 0x33, 0xb8, 0x54, 0xdc, 0x03, 0x47, 0x1f, 0xc2, 0xbc, 0x01, 0x8d, 0xd9, 0xb6, 0x2b, 0x14,
+	0xa9, 0xc4, 0xf2, 0xf0, 0x2f, 0x1e, 0x2c, 0x3f, 0xa5, 0xc1, 0x90, 0xf5, 0x2e, 0xb6, 0xc5, 0x09,
+	0xe9, 0x5c, 0x3e, 0xa4, 0xa9, 0x95, 0xa5, 0xd9, 0x56, 0x96, 0x73, 0x56, 0xde, 0xcc, 0xd2, 0xcc,
+	0x8c, 0xeb, 0xd6, 0x5f, 0x40, 0x11, 0x86, 0x46, 0x8a, 0xc8, 0x43, 0xb0, 0xaa, 0x94, 0xe6, 0x30,
+	0xbc, 0x0d, 0x2b, 0x85, 0xed, 0xcb, 0x9a, 0xaa, 0x6a, 0xcc, 0x78, 0xc1, 0xb6, 0xa5, 0x02, 0x91,
+	0x18, 0x16, 0xfe, 0xa3, 0x62, 0xfa, 0xa2, 0xb4, 0x70, 0x67, 0x40, 0x83, 0xd0, 0x9c, 0x00, 0x35,
+	0x62, 0x45, 0x19, 0x19, 0xd9, 0x48, 0x55, 0xb5, 0x98, 0x4d, 0xeb, 0x88, 0x16, 0x61, 0x79, 0xaa,
+	0xa6, 0x50, 0x27, 0x12, 0x74, 0xd8, 0x39, 0x31, 0x6e, 0x99, 0xc2, 0xd1, 0x7d, 0xa8, 0xa7, 0xd8,
+	0xc1, 0xae, 0x5f, 0x9e, 0x79, 0xea, 0xb8, 0x14, 0x74, 0x03, 0x16, 0xb3, 0x55, 0x82, 0x11, 0x33,
+	0xae, 0xcb, 0x83, 0x68, 0x33, 0x57, 0x02, 0x55, 0xb5, 0xec, 0xe5, 0x62, 0x4a, 0xb7, 0x99, 0xc8,
+	0x55, 0xc1, 0x43, 0x58, 0x92, 0xab, 0x38, 0x13, 0xe7, 0xcf, 0x9e, 0x58, 0xa0, 0xa2, 0xc7, 0xf0,
+	0x8e, 0x44, 0xb4, 0x0f, 0x32, 0x7c, 0x67, 0x40, 0xc3, 0x3e, 0xeb, 0xa9, 0xf3, 0xb7, 0x44, 0xce,
+	0xa3, 0xa0, 0xc7, 0x53, 0xcd, 0xd1, 0xaf, 0xe5, 0x4e, 0x95, 0xc2, 0x28, 0x29, 0xd2, 0xd1, 0x53,
+	0x58, 0xcb, 0x14, 0x14, 0x06, 0xed, 0x46, 0x40, 0x6d, 0xe4, 0x42, 0x9e, 0x8d, 0x37, 0x61, 0x7c,
+	0x3c, 0x14, 0x5c, 0xa5, 0x5f, 0x5d, 0xa5, 0x5f, 0x11, 0x56, 0x55, 0x11, 0xc7, 0x8a, 0xd1, 0x30,
+	0x55, 0xa1, 0x45, 0xb4, 0x37, 0xa3, 0x46, 0xfd, 0xc5, 0xf3, 0x0b, 0x73, 0x7a, 0x06, 0x9a, 0x91,
+	0xe2, 0xfe, 0xd2, 0xb9, 0x89, 0x3d, 0xc5, 0xc7, 0x63, 0x58, 0xdd, 0x1d, 0xc7, 0xc3, 0xa0, 0x4b,
+	0x05, 0x93, 0x17, 0x14, 0x5b, 0x43, 0xb2, 0x46, 0xe3, 0xac, 0xd6, 0x6b, 0xc4, 0x48, 0xe8, 0x3d,
+	0xa8, 0x4c, 0x22, 0xc1, 0xb6, 0x4c, 0x3f, 0xcc, 0x5d, 0x6e, 0xf4, 0x88, 0xa5, 0x6c, 0xfb, 0xa5,
+	0x33, 0x28, 0xdb, 0xb2, 0x3a, 0xad, 0xa6, 0xbd, 0x70, 0xc2, 0x86, 0x51, 0xac, 0x8e, 0x68, 0x49,
+	0x7c, 0x46, 0x47, 0xcc, 0xe8, 0x4c, 0x65, 0x79, 0xe3, 0xeb, 0x51, 0x41, 0x4d, 0x7b, 0x51, 0xdf,
+	0x78, 0x27, 0xeb, 0x02, 0xbb, 0x54, 0x50, 0xb4, 0x09, 0x0b, 0xcc, 0xc8, 0x85, 0x2e, 0x57, 0x54,
+	0x45, 0x52, 0x22, 0xfe, 0xb9, 0x04, 0xab, 0x85, 0x5b, 0xc9, 0x3e, 0xa3, 0x3d, 0xa6, 0xce, 0xa9,
+	0x6e, 0xbe, 0xe4, 0x8d, 0x28, 0x5d, 0x33, 0x70, 0x2b, 0xdd, 0x48, 0xb2, 0xd9, 0x25, 0xea, 0x2a,
+	0x6b, 0x9a, 0x9d, 0x12, 0xe4, 0xd6, 0x85, 0xac, 0x47, 0xdd, 0xea, 0xd4, 0xb7, 0x5c, 0x21, 0x1c,
+	0x8f, 0xe4, 0x3d, 0x46, 0x57, 0xa9, 0x91, 0x64, 0xd9, 0x0f, 0x9d, 0xb2, 0xaf, 0xce, 0x2e, 0x7b,
+	0x87, 0xa2, 0x9c, 0xa6, 0x7b, 0x86, 0xae, 0xca, 0x12, 0x49, 0x65, 0xd9, 0x4e, 0x87, 0xe9, 0x1d,
+	0x4f, 0xe5, 0xa1, 0xbe, 0xed, 0x16, 0x50, 0xc9, 0x9b, 0xa4, 0x19, 0xa1, 0x78, 0x35, 0xcd, 0xcb,
+	0xa3, 0xb2, 0xc5, 0x74, 0x6d, 0x51, 0x28, 0x1a, 0x28, 0x5a, 0x1e, 0x94, 0x7e, 0xa3, 0x71, 0xec,
+	0x14, 0x86, 0x15, 0x65, 0xe9, 0x0c, 0x0b, 0xa5, 0xa3, 0x0b, 0xa3, 0x08, 0xcb, 0x06, 0xcf, 0xdc,
+	0x06, 0xbf, 0xa8, 0x1b, 0xbc, 0x8b, 0xe1, 0x3f, 0x3d, 0x58, 0x2e, 0x44, 0x0e, 0x3d, 0x90, 0x91,
+	0x91, 0xd1, 0x33, 0x37, 0xa6, 0xeb, 0xb3, 0xef, 0x9d, 0x3a, 0xc2, 0xc4, 0x70, 0xd1, 0x0d, 0x28,
+	0x89, 0x13, 0x7b, 0x5b, 0xb7, 0x77, 0xa1, 0x4e, 0xf6, 0xfa, 0x22, 0x72, 0x18, 0xdd, 0x73, 0xd2,
+	0xab, 0x94, 0xeb, 0x7f, 0x6e, 0x16, 0x66, 0xa9, 0x25, 0x6f, 0xe3, 0x99, 0xa3, 0x4d, 0x0b, 0x3f,
+	0xfb, 0x36, 0x3e, 0x74, 0x6f, 0xe3, 0x8d, 0xd8, 0xdc, 0x29, 0xe4, 0x39, 0xaa, 0x72, 0xa4, 0x41,
+	0x72, 0x18, 0xfe, 0xc1, 0x03, 0x38, 0x94, 0x59, 0xf7, 0x6f, 0x0c, 0xbf, 0x07, 0xd5, 0xee, 0x1b,
+	0xbd, 0x15, 0x0c, 0x4d, 0x1e, 0x1f, 0x59, 0x4e, 0x14, 0xbc, 0x90, 0x3f, 0x3e, 0x32, 0x1a, 0xfe,
+	0x08, 0xea, 0xd9, 0x4e, 0x39, 0xba, 0x05, 0x95, 0x40, 0xc8, 0xb7, 0xa7, 0xae, 0xd1, 0x4b, 0x66,
+	0x7a, 0x46, 0x21, 0x7a, 0x1c, 0xff, 0xee, 0x65, 0x2f, 0x0e, 0xa7, 0xe6, 0xbc, 0xd9, 0x35, 0xa7,
+	0x1f, 0x04, 0x5a, 0x90, 0x4f, 0x3c, 0x91, 0x3e, 0x1f, 0x75, 0x35, 0x66, 0x80, 0xac, 0x99, 0xd6,
+	0xf3, 0x43, 0xf7, 0xd5, 0x99, 0xca, 0x68, 0x1d, 0xa0, 0xf5, 0xfc, 0xd0, 0x16, 0x60, 0x65, 0x66,
+	0x01, 0x3a, 0x0c, 0xa9, 0x89, 0xa7, 0x8f, 0x49, 0x7d, 0x0f, 0xc9, 0x00, 0x39, 0xaa, 0x5e, 0x38,
+	0x03, 0x99, 0xc4, 0xf3, 0x7a, 0x34, 0x05, 0xf0, 0x4f, 0x1e, 0x2c, 0x3f, 0x63, 0xaf, 0x94, 0xe2,
+	0xb6, 0x60, 0xf1, 0x11, 0xef, 0xff, 0x4d, 0x3b, 0x11, 0x94, 0xb9, 0x60, 0xda, 0xc4, 0x0a, 0x51,
+	0xdf, 0xe8, 0x01, 0xac, 0x72, 0xd6, 0x8d, 0xc2, 0x1e, 0x6f, 0x07, 0x61, 0x97, 0xb5, 0x05, 0x4d,
+	0x44, 0xc7, 0x36, 0xa0, 0x0a, 0x99, 0x3d, 0x68, 0x6b, 0xd3, 0xc4, 0x5b, 0x69, 0xaa, 0x28, 0x7e,
+	0x11, 0xc6, 0xb7, 0x60, 0x51, 0x8b, 0x17, 0x6c, 0x19, 0x7f, 0xef, 0xc1, 0x92, 0x8d, 0x5f, 0xeb,
+	0xf9, 0xe1, 0x79, 0xd6, 0xdd, 0x86, 0x95, 0x38, 0x63, 0x12, 0xc7, 0xd0, 0x29, 0x1c, 0x3d, 0x84,
+	0xba, 0x83, 0x99, 0x24, 0x7c, 0x7b, 0x3a, 0xdf, 0xcd, 0xff, 0x0f, 0xe2, 0xb2, 0x71, 0x0f, 0x60,
+	0x9f, 0x72, 0x79, 0x12, 0xfd, 0x23, 0x67, 0x4b, 0x25, 0xd6, 0xd9, 0xf2, 0x5b, 0x32, 0x03, 0xf5,
+	0xd3, 0xc3, 0xfc, 0xbd, 0x50, 0x02, 0xfe, 0x16, 0x96, 0xa5, 0x8a, 0x36, 0x13, 0x47, 0xf4, 0xcb,
+	0x8d, 0xcd, 0xff, 0x46, 0x55, 0x13, 0xe6, 0x8f, 0xcf, 0xbd, 0x0e, 0xda, 0x61, 0xfc, 0xa3, 0x07,
+	0x4b, 0x46, 0xbf, 0xfc, 0xdb, 0xf3, 0x3f, 0xab, 0x47, 0xf7, 0xf4, 0xf1, 0xcf, 0xfd, 0xca, 0x45,
+	0xa1, 0xd1, 0x3c, 0xfc, 0xab, 0x07, 0xb5, 0x7d, 0x46, 0x13, 0x71, 0xcc, 0xa8, 0xca, 0x85, 0xc9,
+	0x19, 0x3f, 0x9f, 0x26, 0x33, 0x7e, 0x3e, 0x4d, 0x66, 0xfe, 0x7c, 0x9a, 0x4c, 0xfd, 0x7c, 0x1a,
+	0xe4, 0x9e, 0x55, 0x45, 0xf3, 0xcb, 0xae, 0xf9, 0xd7, 0x60, 0x81, 0xb3, 0xaf, 0xc7, 0xaa, 0xd3,
+	0xeb, 0x22, 0x48, 0xe5, 0xf3, 0xeb, 0x1d, 0x7f, 0x00, 0xb5, 0x03, 0xbe, 0xcf, 0xe8, 0x50, 0x0c,
+	0x4e, 0x25, 0x35, 0xb0, 0x82, 0xb2, 0x60, 0x81, 0x64, 0xc0, 0x71, 0x55, 0xfd, 0xd2, 0xdb, 0xfc,
+	0x2b, 0x00, 0x00, 0xff, 0xff, 0x58, 0x22, 0x0d, 0x1d, 0x00, 0x14, 0x00, 0x00,
 }
@@ -5,6 +5,8 @@
 package types
 
 import (
+	"reflect"
+
 	"github.com/33cn/chain33/types"
 )
 
@@ -15,6 +17,9 @@ func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(ValNodeX))
 	types.RegistorExecutor(ValNodeX, NewType())
 	types.RegisterDappFork(ValNodeX, "Enable", 0)
+	types.RegisterDappFork(ValNodeX, ForkValidatorDelay, types.MaxHeight)
+	types.RegisterDappFork(ValNodeX, ForkValidatorJail, types.MaxHeight)
+	types.RegisterDappFork(ValNodeX, ForkValidatorStep, types.MaxHeight)
 }
 
 // GetExecName get exec name
@@ -54,5 +59,7 @@ func (t *ValNodeType) GetTypeMap() map[string]int32 {
 
 // GetLogMap method
 func (t *ValNodeType) GetLogMap() map[int64]*types.LogInfo {
-	return map[int64]*types.LogInfo{}
+	return map[int64]*types.LogInfo{
+		TyLogValNodeJail: {Ty: reflect.TypeOf(JailedValidator{}), Name: "LogValNodeJail"},
+	}
 }
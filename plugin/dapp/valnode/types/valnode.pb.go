@@ -342,39 +342,155 @@ func (m *ReqBlockInfo) GetHeight() int64 {
 	return 0
 }
 
+type ReqValidatorTransitions struct {
+	From                 int64    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqValidatorTransitions) Reset()         { *m = ReqValidatorTransitions{} }
+func (m *ReqValidatorTransitions) String() string { return proto.CompactTextString(m) }
+func (*ReqValidatorTransitions) ProtoMessage()    {}
+func (*ReqValidatorTransitions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e9a3523ca7e0ea, []int{5}
+}
+
+func (m *ReqValidatorTransitions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqValidatorTransitions.Unmarshal(m, b)
+}
+func (m *ReqValidatorTransitions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqValidatorTransitions.Marshal(b, m, deterministic)
+}
+func (m *ReqValidatorTransitions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqValidatorTransitions.Merge(m, src)
+}
+func (m *ReqValidatorTransitions) XXX_Size() int {
+	return xxx_messageInfo_ReqValidatorTransitions.Size(m)
+}
+func (m *ReqValidatorTransitions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqValidatorTransitions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqValidatorTransitions proto.InternalMessageInfo
+
+func (m *ReqValidatorTransitions) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *ReqValidatorTransitions) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type ReqVerifyLightBlock struct {
+	Trusted              *LightBlock   `protobuf:"bytes,1,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Transitions          []*LightBlock `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	Target               *LightBlock   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReqVerifyLightBlock) Reset()         { *m = ReqVerifyLightBlock{} }
+func (m *ReqVerifyLightBlock) String() string { return proto.CompactTextString(m) }
+func (*ReqVerifyLightBlock) ProtoMessage()    {}
+func (*ReqVerifyLightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e9a3523ca7e0ea, []int{6}
+}
+
+func (m *ReqVerifyLightBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqVerifyLightBlock.Unmarshal(m, b)
+}
+func (m *ReqVerifyLightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqVerifyLightBlock.Marshal(b, m, deterministic)
+}
+func (m *ReqVerifyLightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqVerifyLightBlock.Merge(m, src)
+}
+func (m *ReqVerifyLightBlock) XXX_Size() int {
+	return xxx_messageInfo_ReqVerifyLightBlock.Size(m)
+}
+func (m *ReqVerifyLightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqVerifyLightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqVerifyLightBlock proto.InternalMessageInfo
+
+func (m *ReqVerifyLightBlock) GetTrusted() *LightBlock {
+	if m != nil {
+		return m.Trusted
+	}
+	return nil
+}
+
+func (m *ReqVerifyLightBlock) GetTransitions() []*LightBlock {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+func (m *ReqVerifyLightBlock) GetTarget() *LightBlock {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValNode)(nil), "types.ValNode")
 	proto.RegisterType((*ValNodes)(nil), "types.ValNodes")
 	proto.RegisterType((*ValNodeAction)(nil), "types.ValNodeAction")
 	proto.RegisterType((*ReqNodeInfo)(nil), "types.ReqNodeInfo")
 	proto.RegisterType((*ReqBlockInfo)(nil), "types.ReqBlockInfo")
+	proto.RegisterType((*ReqValidatorTransitions)(nil), "types.ReqValidatorTransitions")
+	proto.RegisterType((*ReqVerifyLightBlock)(nil), "types.ReqVerifyLightBlock")
 }
 
 func init() { proto.RegisterFile("valnode.proto", fileDescriptor_38e9a3523ca7e0ea) }
 
 var fileDescriptor_38e9a3523ca7e0ea = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x6a, 0xf2, 0x40,
-	0x14, 0x4d, 0xcc, 0x97, 0xf8, 0xf5, 0x46, 0x45, 0xa6, 0x45, 0x42, 0x56, 0x61, 0xb0, 0x25, 0x50,
-	0x90, 0xa2, 0x8b, 0x42, 0x77, 0x75, 0x53, 0xa5, 0xd0, 0xc5, 0x28, 0xdd, 0xc7, 0xe4, 0xb6, 0x06,
-	0xe3, 0x4c, 0x4c, 0x46, 0x4b, 0x5e, 0xa1, 0x4f, 0x5d, 0x32, 0x99, 0x46, 0x68, 0xe9, 0xf2, 0xfc,
-	0xe5, 0x9c, 0xdc, 0x81, 0xfe, 0x29, 0xca, 0xb8, 0x48, 0x70, 0x92, 0x17, 0x42, 0x0a, 0x62, 0xcb,
-	0x2a, 0xc7, 0xd2, 0xef, 0xc5, 0x62, 0xbf, 0x17, 0xbc, 0x21, 0xfd, 0xa1, 0x44, 0x9e, 0x60, 0xb1,
-	0x4f, 0xb9, 0x6c, 0x18, 0x7a, 0x0f, 0xdd, 0xd7, 0x28, 0x7b, 0x11, 0x09, 0x92, 0x11, 0x38, 0xf9,
-	0x71, 0xf3, 0x8c, 0x95, 0x67, 0x06, 0x66, 0xd8, 0x63, 0x1a, 0x91, 0x2b, 0xb0, 0x73, 0xf1, 0x81,
-	0x85, 0xd7, 0x09, 0xcc, 0xd0, 0x62, 0x0d, 0xa0, 0x77, 0xf0, 0x5f, 0x07, 0x4b, 0x32, 0x06, 0xbb,
-	0x6e, 0x2e, 0x3d, 0x33, 0xb0, 0x42, 0x77, 0x3a, 0x98, 0xa8, 0xee, 0x89, 0xd6, 0x59, 0x23, 0xd2,
-	0x4f, 0x13, 0xfa, 0x9a, 0x7a, 0x8c, 0x65, 0x2a, 0x38, 0x19, 0xc3, 0xbf, 0x5a, 0x52, 0x7d, 0xbf,
-	0x62, 0x0b, 0x83, 0x29, 0x95, 0x3c, 0xc0, 0xc5, 0x26, 0x13, 0xf1, 0x6e, 0xc9, 0xdf, 0x84, 0xda,
-	0xe0, 0x4e, 0x7d, 0x6d, 0x5d, 0xb7, 0xbf, 0x33, 0xff, 0x76, 0x2c, 0x0c, 0x76, 0xb6, 0x93, 0x01,
-	0x74, 0xd6, 0x95, 0x67, 0x05, 0x66, 0x68, 0xb3, 0xce, 0xba, 0x9a, 0x77, 0xc1, 0x3e, 0x45, 0xd9,
-	0x11, 0xe9, 0x35, 0xb8, 0x0c, 0x0f, 0x75, 0x8f, 0xf2, 0x8d, 0xc0, 0xd9, 0x62, 0xfa, 0xbe, 0x95,
-	0x6a, 0x8b, 0xc5, 0x34, 0xa2, 0x37, 0xd0, 0x63, 0x78, 0x68, 0x3f, 0xfe, 0x97, 0x6f, 0xba, 0x83,
-	0xae, 0x3e, 0x3f, 0xb9, 0x05, 0x67, 0x59, 0xae, 0x2a, 0x1e, 0x93, 0xbe, 0x5e, 0x59, 0x17, 0xa5,
-	0x99, 0x3f, 0xd4, 0x70, 0x59, 0x2e, 0x30, 0xca, 0xe4, 0xb6, 0xa2, 0x06, 0x99, 0x81, 0xfb, 0x84,
-	0xb2, 0x9d, 0xf1, 0x23, 0x71, 0x79, 0xbe, 0x48, 0x9a, 0x44, 0x52, 0x14, 0x2b, 0x94, 0xd4, 0xd8,
-	0x38, 0xea, 0xe9, 0x66, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0xc8, 0x21, 0x55, 0xf2, 0x01,
-	0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xc1, 0x6e, 0xda, 0x40,
+	0x10, 0xb5, 0x71, 0x80, 0x76, 0x80, 0x28, 0x5d, 0xa2, 0x60, 0xf9, 0x50, 0xa1, 0x55, 0x5a, 0x51,
+	0x45, 0x42, 0x15, 0x39, 0x54, 0xad, 0xd4, 0x56, 0xc9, 0x05, 0x68, 0xab, 0xb6, 0xda, 0xa0, 0xdc,
+	0x0d, 0x1e, 0xc0, 0xaa, 0xf1, 0x82, 0x3d, 0xa4, 0xf2, 0x2f, 0xf4, 0x33, 0xfa, 0x1b, 0xfd, 0xb9,
+	0x6a, 0x97, 0x8d, 0x8d, 0x30, 0xb9, 0x79, 0xf6, 0xbd, 0x37, 0x6f, 0xf6, 0xed, 0x18, 0x5a, 0x0f,
+	0x7e, 0x14, 0xcb, 0x00, 0xfb, 0xeb, 0x44, 0x92, 0x64, 0x55, 0xca, 0xd6, 0x98, 0x7a, 0xcd, 0x99,
+	0x5c, 0xad, 0x64, 0xbc, 0x3b, 0xf4, 0xce, 0x08, 0xe3, 0x00, 0x93, 0x55, 0x18, 0xd3, 0xee, 0x84,
+	0xbf, 0x83, 0xfa, 0xbd, 0x1f, 0x7d, 0x97, 0x01, 0xb2, 0x0b, 0xa8, 0xad, 0xb7, 0xd3, 0xaf, 0x98,
+	0xb9, 0x76, 0xd7, 0xee, 0x35, 0x85, 0xa9, 0xd8, 0x39, 0x54, 0xd7, 0xf2, 0x37, 0x26, 0x6e, 0xa5,
+	0x6b, 0xf7, 0x1c, 0xb1, 0x2b, 0xf8, 0x5b, 0x78, 0x66, 0x84, 0x29, 0xbb, 0x84, 0xaa, 0x72, 0x4e,
+	0x5d, 0xbb, 0xeb, 0xf4, 0x1a, 0x83, 0xd3, 0xbe, 0xf6, 0xee, 0x1b, 0x5c, 0xec, 0x40, 0xfe, 0xc7,
+	0x86, 0x96, 0x39, 0xba, 0x99, 0x51, 0x28, 0x63, 0x76, 0x09, 0x27, 0x0a, 0xd2, 0x7e, 0x25, 0xd9,
+	0xc8, 0x12, 0x1a, 0x65, 0x1f, 0xe0, 0xf9, 0x34, 0x92, 0xb3, 0x5f, 0xe3, 0x78, 0x2e, 0xf5, 0x0c,
+	0x8d, 0x81, 0x67, 0xa8, 0x93, 0xfc, 0x3a, 0xb7, 0x8f, 0x8c, 0x91, 0x25, 0x0a, 0x3a, 0x3b, 0x85,
+	0xca, 0x24, 0x73, 0x9d, 0xae, 0xdd, 0xab, 0x8a, 0xca, 0x24, 0xbb, 0xad, 0x43, 0xf5, 0xc1, 0x8f,
+	0xb6, 0xc8, 0x5f, 0x41, 0x43, 0xe0, 0x46, 0xf9, 0x68, 0xde, 0x05, 0xd4, 0x96, 0x18, 0x2e, 0x96,
+	0xa4, 0x67, 0x71, 0x84, 0xa9, 0xf8, 0x6b, 0x68, 0x0a, 0xdc, 0xe4, 0xcd, 0x9f, 0xe4, 0x7d, 0x84,
+	0x8e, 0xc0, 0xcd, 0xbd, 0x1f, 0x85, 0x81, 0x4f, 0x32, 0x99, 0x24, 0x7e, 0x9c, 0x86, 0xea, 0x8e,
+	0x29, 0x63, 0x70, 0x32, 0x4f, 0xe4, 0xca, 0x08, 0xf4, 0xb7, 0x1a, 0x8b, 0xa4, 0xc9, 0xb3, 0x42,
+	0x92, 0xff, 0xb5, 0xa1, 0xad, 0xf4, 0x98, 0x84, 0xf3, 0xec, 0x9b, 0xea, 0xa8, 0x2d, 0xd9, 0x15,
+	0xd4, 0x29, 0xd9, 0xa6, 0x84, 0x81, 0xc9, 0xe8, 0x85, 0xb9, 0x78, 0xc1, 0x11, 0x8f, 0x0c, 0x76,
+	0x0d, 0x0d, 0x2a, 0x7c, 0xdd, 0x4a, 0xd7, 0x39, 0x2e, 0xd8, 0x67, 0xb1, 0x37, 0x50, 0x23, 0x3f,
+	0x59, 0x20, 0xe9, 0x90, 0x8e, 0xf2, 0x0d, 0x61, 0xf0, 0xcf, 0x81, 0xba, 0xd9, 0x31, 0x76, 0x05,
+	0xb5, 0x71, 0x7a, 0x97, 0xc5, 0x33, 0xd6, 0x32, 0x02, 0x95, 0x66, 0x18, 0x79, 0x67, 0xa6, 0x1c,
+	0xa7, 0x23, 0xf4, 0x23, 0x5a, 0x66, 0xdc, 0x52, 0x83, 0x0d, 0x91, 0xf2, 0xac, 0x0f, 0x14, 0xed,
+	0xe2, 0xd9, 0x77, 0xe1, 0xdd, 0x21, 0x71, 0x8b, 0xdd, 0xc0, 0xf9, 0x10, 0xe9, 0x27, 0xc6, 0x41,
+	0x18, 0x2f, 0x72, 0x2c, 0x3d, 0x54, 0xbb, 0xa6, 0x2c, 0x11, 0xb9, 0xc5, 0x3e, 0x43, 0x7b, 0x88,
+	0xf4, 0xc5, 0x0f, 0x23, 0x0c, 0x9e, 0xee, 0xd0, 0x31, 0xe5, 0x21, 0x8f, 0x5b, 0xec, 0x3d, 0xb4,
+	0x86, 0x48, 0x7b, 0xef, 0xd1, 0x2e, 0xa4, 0xf9, 0x4e, 0x78, 0xe5, 0xc8, 0xb8, 0xc5, 0x7e, 0x40,
+	0x67, 0x88, 0x74, 0x74, 0x21, 0x5e, 0x16, 0x4d, 0x8e, 0xe1, 0x1e, 0x2b, 0xf5, 0x53, 0xb3, 0x7c,
+	0x82, 0xb3, 0xd2, 0x7a, 0x78, 0x7b, 0x9d, 0x0e, 0x30, 0xaf, 0x99, 0x63, 0xeb, 0x28, 0xe3, 0xd6,
+	0xb4, 0xa6, 0xff, 0xf7, 0xeb, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xb0, 0xa3, 0xad, 0x27,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +507,11 @@ const _ = grpc.SupportPackageIsVersion4
 type ValnodeClient interface {
 	IsSync(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*IsHealthy, error)
 	GetNodeInfo(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*ValidatorSet, error)
+	GetPendingValidators(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*PendingValidators, error)
+	GetJailedValidators(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*JailedValidators, error)
+	GetLightBlock(ctx context.Context, in *ReqBlockInfo, opts ...grpc.CallOption) (*LightBlock, error)
+	GetValidatorTransitions(ctx context.Context, in *ReqValidatorTransitions, opts ...grpc.CallOption) (*LightBlocks, error)
+	VerifyLightBlock(ctx context.Context, in *ReqVerifyLightBlock, opts ...grpc.CallOption) (*types.Reply, error)
 }
 
 type valnodeClient struct {
@@ -419,10 +540,60 @@ func (c *valnodeClient) GetNodeInfo(ctx context.Context, in *types.ReqNil, opts
 	return out, nil
 }
 
+func (c *valnodeClient) GetPendingValidators(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*PendingValidators, error) {
+	out := new(PendingValidators)
+	err := c.cc.Invoke(ctx, "/types.valnode/GetPendingValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valnodeClient) GetJailedValidators(ctx context.Context, in *types.ReqNil, opts ...grpc.CallOption) (*JailedValidators, error) {
+	out := new(JailedValidators)
+	err := c.cc.Invoke(ctx, "/types.valnode/GetJailedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valnodeClient) GetLightBlock(ctx context.Context, in *ReqBlockInfo, opts ...grpc.CallOption) (*LightBlock, error) {
+	out := new(LightBlock)
+	err := c.cc.Invoke(ctx, "/types.valnode/GetLightBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valnodeClient) GetValidatorTransitions(ctx context.Context, in *ReqValidatorTransitions, opts ...grpc.CallOption) (*LightBlocks, error) {
+	out := new(LightBlocks)
+	err := c.cc.Invoke(ctx, "/types.valnode/GetValidatorTransitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valnodeClient) VerifyLightBlock(ctx context.Context, in *ReqVerifyLightBlock, opts ...grpc.CallOption) (*types.Reply, error) {
+	out := new(types.Reply)
+	err := c.cc.Invoke(ctx, "/types.valnode/VerifyLightBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ValnodeServer is the server API for Valnode service.
 type ValnodeServer interface {
 	IsSync(context.Context, *types.ReqNil) (*IsHealthy, error)
 	GetNodeInfo(context.Context, *types.ReqNil) (*ValidatorSet, error)
+	GetPendingValidators(context.Context, *types.ReqNil) (*PendingValidators, error)
+	GetJailedValidators(context.Context, *types.ReqNil) (*JailedValidators, error)
+	GetLightBlock(context.Context, *ReqBlockInfo) (*LightBlock, error)
+	GetValidatorTransitions(context.Context, *ReqValidatorTransitions) (*LightBlocks, error)
+	VerifyLightBlock(context.Context, *ReqVerifyLightBlock) (*types.Reply, error)
 }
 
 func RegisterValnodeServer(s *grpc.Server, srv ValnodeServer) {
@@ -465,6 +636,96 @@ func _Valnode_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Valnode_GetPendingValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValnodeServer).GetPendingValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.valnode/GetPendingValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValnodeServer).GetPendingValidators(ctx, req.(*types.ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valnode_GetJailedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValnodeServer).GetJailedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.valnode/GetJailedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValnodeServer).GetJailedValidators(ctx, req.(*types.ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valnode_GetLightBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqBlockInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValnodeServer).GetLightBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.valnode/GetLightBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValnodeServer).GetLightBlock(ctx, req.(*ReqBlockInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valnode_GetValidatorTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqValidatorTransitions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValnodeServer).GetValidatorTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.valnode/GetValidatorTransitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValnodeServer).GetValidatorTransitions(ctx, req.(*ReqValidatorTransitions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Valnode_VerifyLightBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqVerifyLightBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValnodeServer).VerifyLightBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.valnode/VerifyLightBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValnodeServer).VerifyLightBlock(ctx, req.(*ReqVerifyLightBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Valnode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.valnode",
 	HandlerType: (*ValnodeServer)(nil),
@@ -477,6 +738,26 @@ var _Valnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodeInfo",
 			Handler:    _Valnode_GetNodeInfo_Handler,
 		},
+		{
+			MethodName: "GetPendingValidators",
+			Handler:    _Valnode_GetPendingValidators_Handler,
+		},
+		{
+			MethodName: "GetJailedValidators",
+			Handler:    _Valnode_GetJailedValidators_Handler,
+		},
+		{
+			MethodName: "GetLightBlock",
+			Handler:    _Valnode_GetLightBlock_Handler,
+		},
+		{
+			MethodName: "GetValidatorTransitions",
+			Handler:    _Valnode_GetValidatorTransitions_Handler,
+		},
+		{
+			MethodName: "VerifyLightBlock",
+			Handler:    _Valnode_VerifyLightBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "valnode.proto",
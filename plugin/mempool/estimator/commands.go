@@ -0,0 +1,49 @@
+package estimator
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/spf13/cobra"
+)
+
+// Cmd 手续费估算命令行
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee",
+		Short: "Transaction fee estimation",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		EstimateCmd(),
+	)
+	return cmd
+}
+
+// EstimateCmd 估算手续费
+func EstimateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Estimate the fee rate (per KB) to get a tx packed within target blocks",
+		Run:   estimate,
+	}
+	cmd.Flags().IntSliceP("targets", "t", nil, "target blocks, default 1,3,6")
+	cmd.Flags().IntSliceP("confidences", "c", nil, "confidence percents, default 50,80,95")
+	cmd.Flags().Int64P("size", "s", 0, "tx size in bytes, to convert the fee rate to fee")
+	return cmd
+}
+
+func estimate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	targets, _ := cmd.Flags().GetIntSlice("targets")
+	confidences, _ := cmd.Flags().GetIntSlice("confidences")
+	size, _ := cmd.Flags().GetInt64("size")
+	params := &ReqEstimateFee{TxSize: size}
+	for _, target := range targets {
+		params.Targets = append(params.Targets, int64(target))
+	}
+	for _, confidence := range confidences {
+		params.Confidences = append(params.Confidences, int64(confidence))
+	}
+	var res ReplyEstimateFee
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, EstimatorX+".EstimateFee", params, &res)
+	ctx.Run()
+}
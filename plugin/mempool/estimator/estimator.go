@@ -0,0 +1,201 @@
+package estimator
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// 默认的目标区块数和置信度(百分比)
+var (
+	defaultTargets     = []int64{1, 3, 6}
+	defaultConfidences = []int64{50, 80, 95}
+)
+
+// FeeRate 交易的手续费率,和mempool GetProperFee的计算方式一致:每KB的手续费
+func FeeRate(tx *types.Transaction) int64 {
+	return tx.Fee / int64(proto.Size(tx)/1000+1)
+}
+
+// blockFee 一个区块打包交易的最低手续费率,区块中没有付手续费的交易时为-1,不参与统计
+type blockFee struct {
+	height  int64
+	hash    []byte
+	minRate int64
+}
+
+// Estimator 根据最近区块打包交易的手续费率和当前mempool中的交易估算手续费率
+type Estimator struct {
+	mtx    sync.Mutex
+	blocks []*blockFee
+	size   int
+	minFee int64
+}
+
+// New 创建估算器, size为保留的最近区块数, minFee为最低手续费率
+func New(size int, minFee int64) *Estimator {
+	return &Estimator{size: size, minFee: minFee}
+}
+
+// Size 保留的最近区块数
+func (e *Estimator) Size() int {
+	return e.size
+}
+
+// LastBlock 返回最后加入的区块高度和哈希, 没有区块时高度为-1
+func (e *Estimator) LastBlock() (int64, []byte) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if len(e.blocks) == 0 {
+		return -1, nil
+	}
+	last := e.blocks[len(e.blocks)-1]
+	return last.height, last.hash
+}
+
+// Reset 清空历史记录,用于区块回滚
+func (e *Estimator) Reset() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.blocks = nil
+}
+
+// AddBlock 加入新打包的区块
+// 区块不能接在最后一个区块之后时(回滚或者跳过了区块)返回false, 不做修改
+func (e *Estimator) AddBlock(block *types.Block) bool {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if len(e.blocks) > 0 {
+		last := e.blocks[len(e.blocks)-1]
+		if block.Height != last.height+1 || !bytes.Equal(block.ParentHash, last.hash) {
+			return false
+		}
+	}
+	// 区块未满时也按实际打包的最低费率统计,不能当作0拉低估算
+	bf := &blockFee{height: block.Height, hash: block.Hash(), minRate: -1}
+	for _, tx := range block.Txs {
+		// 没有手续费的是挖矿等系统交易,不参与统计
+		if tx.Fee == 0 {
+			continue
+		}
+		if rate := FeeRate(tx); bf.minRate < 0 || rate < bf.minRate {
+			bf.minRate = rate
+		}
+	}
+	e.blocks = append(e.blocks, bf)
+	if len(e.blocks) > e.size {
+		e.blocks = e.blocks[len(e.blocks)-e.size:]
+	}
+	return true
+}
+
+// Estimate 估算在target个区块内以confidence的把握被打包所需的手续费率
+// 取历史估算和当前mempool排队估算的较大者:
+// 历史估算: 最近每连续target个区块中最低的打包费率, 按置信度取这些值的百分位数
+// 排队估算: 要排在mempool中前target个区块能容纳的交易之内需要的费率
+func (e *Estimator) Estimate(queue []*types.Transaction, maxTxNumber int64, req *ReqEstimateFee) (*ReplyEstimateFee, error) {
+	targets := req.Targets
+	if len(targets) == 0 {
+		targets = defaultTargets
+	}
+	confidences := req.Confidences
+	if len(confidences) == 0 {
+		confidences = defaultConfidences
+	}
+	for _, target := range targets {
+		if target <= 0 || target > int64(e.size) {
+			return nil, types.ErrInvalidParam
+		}
+	}
+	for _, confidence := range confidences {
+		if confidence <= 0 || confidence > 100 {
+			return nil, types.ErrInvalidParam
+		}
+	}
+	if req.TxSize < 0 {
+		return nil, types.ErrInvalidParam
+	}
+
+	rates := make([]int64, 0, len(queue))
+	for _, tx := range queue {
+		rates = append(rates, FeeRate(tx))
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i] > rates[j] })
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	reply := &ReplyEstimateFee{
+		Height:    -1,
+		Blocks:    int64(len(e.blocks)),
+		QueueSize: int64(len(queue)),
+	}
+	if len(e.blocks) > 0 {
+		reply.Height = e.blocks[len(e.blocks)-1].height
+	}
+	for _, target := range targets {
+		queueRate := int64(0)
+		if pos := target * maxTxNumber; pos > 0 && int64(len(rates)) >= pos {
+			// 同费率的交易先到先打包,需要高于排在最后的交易
+			queueRate = rates[pos-1] + 1
+		}
+		minRates := e.windowMinRates(int(target))
+		for _, confidence := range confidences {
+			rate := e.minFee
+			if r := percentile(minRates, confidence); r > rate {
+				rate = r
+			}
+			if queueRate > rate {
+				rate = queueRate
+			}
+			reply.Estimates = append(reply.Estimates, &FeeEstimate{
+				Target:     target,
+				Confidence: confidence,
+				FeeRate:    rate,
+				Fee:        rate * (req.TxSize/1000 + 1),
+			})
+		}
+	}
+	return reply, nil
+}
+
+// windowMinRates 每连续target个区块中最低的打包费率,区块数不足target时把全部区块作为一个窗口
+// 没有付手续费交易的区块不参与统计,窗口内都是这样的区块时跳过这个窗口
+func (e *Estimator) windowMinRates(target int) []int64 {
+	if len(e.blocks) == 0 {
+		return nil
+	}
+	if target > len(e.blocks) {
+		target = len(e.blocks)
+	}
+	var mins []int64
+	for i := 0; i+target <= len(e.blocks); i++ {
+		min := int64(-1)
+		for _, bf := range e.blocks[i : i+target] {
+			if bf.minRate >= 0 && (min < 0 || bf.minRate < min) {
+				min = bf.minRate
+			}
+		}
+		if min >= 0 {
+			mins = append(mins, min)
+		}
+	}
+	return mins
+}
+
+// percentile 从小到大排序后取confidence百分位的值,置信度越高费率越高
+func percentile(values []int64, confidence int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]int64, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	index := (int64(len(sorted))*confidence+99)/100 - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
@@ -0,0 +1,105 @@
+package estimator
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTx(fee int64) *types.Transaction {
+	return &types.Transaction{Execer: []byte("coins"), Payload: []byte("transfer"), Fee: fee, To: "1MTAzWSmGLkNyEFeD2cUaTYn5ZuoVBp6bS"}
+}
+
+// newBlock 打包fees对应的交易, 第一笔是没有手续费的挖矿交易
+func newBlock(parent *types.Block, fees ...int64) *types.Block {
+	block := &types.Block{Txs: []*types.Transaction{newTx(0)}}
+	if parent != nil {
+		block.Height = parent.Height + 1
+		block.ParentHash = parent.Hash()
+	}
+	for _, fee := range fees {
+		block.Txs = append(block.Txs, newTx(fee))
+	}
+	return block
+}
+
+func TestAddBlock(t *testing.T) {
+	e := New(3, 100000)
+	height, _ := e.LastBlock()
+	assert.Equal(t, int64(-1), height)
+
+	var blocks []*types.Block
+	var parent *types.Block
+	for i := 0; i < 5; i++ {
+		parent = newBlock(parent, 200000)
+		blocks = append(blocks, parent)
+		assert.True(t, e.AddBlock(parent))
+	}
+	assert.Equal(t, 3, len(e.blocks))
+	height, hash := e.LastBlock()
+	assert.Equal(t, int64(4), height)
+	assert.Equal(t, blocks[4].Hash(), hash)
+
+	// 不连续的区块
+	assert.False(t, e.AddBlock(blocks[2]))
+	fork := newBlock(blocks[3], 300000)
+	fork.ParentHash = []byte("fork")
+	assert.False(t, e.AddBlock(fork))
+	e.Reset()
+	assert.True(t, e.AddBlock(fork))
+}
+
+func TestEstimate(t *testing.T) {
+	e := New(10, 100000)
+
+	// 没有历史也没有排队时取最低手续费
+	reply, err := e.Estimate(nil, 2, &ReqEstimateFee{TxSize: 1500})
+	assert.Nil(t, err)
+	assert.Equal(t, 9, len(reply.Estimates))
+	assert.Equal(t, int64(-1), reply.Height)
+	for _, est := range reply.Estimates {
+		assert.Equal(t, int64(100000), est.FeeRate)
+		assert.Equal(t, int64(200000), est.Fee)
+	}
+
+	// 区块打包的最低费率依次是 1e6,2e6,...,1e7, 第5个区块只有挖矿交易不参与统计
+	var parent *types.Block
+	for i := int64(1); i <= 10; i++ {
+		if i == 5 {
+			parent = newBlock(parent)
+		} else {
+			parent = newBlock(parent, i*1000000, 20000000)
+		}
+		assert.True(t, e.AddBlock(parent))
+	}
+	req := &ReqEstimateFee{Targets: []int64{1, 3}, Confidences: []int64{50, 90}}
+	reply, err = e.Estimate(nil, 3, req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), reply.Height)
+	assert.Equal(t, int64(10), reply.Blocks)
+	rates := make([]int64, 0, len(reply.Estimates))
+	for _, est := range reply.Estimates {
+		rates = append(rates, est.FeeRate)
+	}
+	// 1个区块: 1e6,2e6,3e6,4e6,6e6,...,1e7 取50%和90%
+	// 3个区块: 窗口最小值 1e6,2e6,3e6,4e6,6e6,6e6,7e6,8e6 取50%和90%
+	assert.Equal(t, []int64{6000000, 10000000, 4000000, 8000000}, rates)
+
+	// mempool排在前面的交易超过目标区块的容量时,需要比最后一笔能打包的交易出价高
+	var queue []*types.Transaction
+	for i := int64(1); i <= 4; i++ {
+		queue = append(queue, newTx(i*10000000))
+	}
+	reply, err = e.Estimate(queue, 3, req)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), reply.QueueSize)
+	assert.Equal(t, int64(20000001), reply.Estimates[0].FeeRate)
+	assert.Equal(t, int64(20000001), reply.Estimates[1].FeeRate)
+	assert.Equal(t, int64(4000000), reply.Estimates[2].FeeRate)
+
+	_, err = e.Estimate(nil, 3, &ReqEstimateFee{Targets: []int64{11}})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = e.Estimate(nil, 3, &ReqEstimateFee{Confidences: []int64{101}})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
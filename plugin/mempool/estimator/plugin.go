@@ -0,0 +1,10 @@
+package estimator
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/plugin/plugin/rpcplugin"
+)
+
+func init() {
+	pluginmgr.Register(&rpcplugin.Plugin{Name: EstimatorX, Cmd: Cmd, RPC: Init})
+}
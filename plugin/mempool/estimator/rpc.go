@@ -0,0 +1,108 @@
+package estimator
+
+import (
+	"bytes"
+	"sync"
+
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+)
+
+// 参与统计的最近区块数
+const historySize = 128
+
+// Jrpc 对外提供服务的RPC接口
+type Jrpc struct {
+	cli *channelClient
+}
+
+type channelClient struct {
+	rpctypes.ChannelClient
+	// 同步区块时加锁,避免并发请求重复拉取
+	mtx       sync.Mutex
+	estimator *Estimator
+}
+
+// Init 注册 rpc 接口
+func Init(name string, s rpctypes.RPCServer) {
+	cli := &channelClient{estimator: New(historySize, types.GInt("MinFee"))}
+	cli.Init(name, s, &Jrpc{cli: cli}, nil)
+}
+
+// EstimateFee 估算交易在指定区块数内被打包所需的手续费率
+func (c *Jrpc) EstimateFee(param *ReqEstimateFee, result *interface{}) error {
+	if param == nil {
+		param = &ReqEstimateFee{}
+	}
+	reply, err := c.cli.EstimateFee(param)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// EstimateFee 先同步最近的区块, 再结合mempool中的交易估算
+func (c *channelClient) EstimateFee(param *ReqEstimateFee) (*ReplyEstimateFee, error) {
+	height, err := c.syncBlocks()
+	if err != nil {
+		return nil, err
+	}
+	txs, err := c.GetMempool()
+	if err != nil {
+		return nil, err
+	}
+	return c.estimator.Estimate(txs.GetTxs(), types.GetP(height+1).MaxTxNumber, param)
+}
+
+// syncBlocks 把上次同步之后的区块加入估算器,发生回滚时重新拉取整个窗口
+func (c *channelClient) syncBlocks() (int64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	header, err := c.GetLastHeader()
+	if err != nil {
+		return 0, err
+	}
+	synced, err := c.addBlocks(header)
+	if err != nil {
+		return 0, err
+	}
+	if !synced {
+		c.estimator.Reset()
+		if _, err = c.addBlocks(header); err != nil {
+			return 0, err
+		}
+	}
+	return header.Height, nil
+}
+
+func (c *channelClient) addBlocks(header *types.Header) (bool, error) {
+	height := header.Height
+	start := height - int64(c.estimator.Size()) + 1
+	if start < 0 {
+		start = 0
+	}
+	last, hash := c.estimator.LastBlock()
+	// 回滚了或者落后超过整个窗口
+	if last > height || (last >= 0 && last < start-1) {
+		return false, nil
+	}
+	// 同一高度回滚到了另一个区块, 之后加入的区块由AddBlock检查父区块哈希
+	if last == height {
+		return bytes.Equal(hash, header.Hash), nil
+	}
+	if last >= start {
+		start = last + 1
+	}
+	details, err := c.GetBlocks(&types.ReqBlocks{Start: start, End: height})
+	if err != nil {
+		return false, err
+	}
+	for _, detail := range details.GetItems() {
+		block := detail.GetBlock()
+		if !c.estimator.AddBlock(block) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,49 @@
+package estimator
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncBlocks(t *testing.T) {
+	api := new(mocks.QueueProtocolAPI)
+	cli := &channelClient{ChannelClient: rpctypes.ChannelClient{QueueProtocolAPI: api}, estimator: New(10, 100000)}
+	chain := func(blocks ...*types.Block) {
+		last := blocks[len(blocks)-1]
+		api.On("GetLastHeader").Return(&types.Header{Height: last.Height, Hash: last.Hash()}, nil).Once()
+		api.On("GetBlocks", mock.Anything).Return(func(req *types.ReqBlocks) *types.BlockDetails {
+			details := &types.BlockDetails{}
+			for _, block := range blocks[req.Start : req.End+1] {
+				details.Items = append(details.Items, &types.BlockDetail{Block: block})
+			}
+			return details
+		}, nil).Once()
+	}
+
+	var blocks []*types.Block
+	var parent *types.Block
+	for i := 0; i < 5; i++ {
+		parent = newBlock(parent, 200000)
+		blocks = append(blocks, parent)
+	}
+	chain(blocks...)
+	height, err := cli.syncBlocks()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), height)
+
+	// 回滚到同一高度的另一个区块
+	fork := newBlock(blocks[3], 300000)
+	fork.BlockTime = 1
+	chain(append(blocks[:4:4], fork)...)
+	_, err = cli.syncBlocks()
+	assert.Nil(t, err)
+	last, hash := cli.estimator.LastBlock()
+	assert.Equal(t, int64(4), last)
+	assert.Equal(t, fork.Hash(), hash)
+	assert.Equal(t, int64(300000), cli.estimator.blocks[len(cli.estimator.blocks)-1].minRate)
+}
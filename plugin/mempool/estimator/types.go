@@ -0,0 +1,32 @@
+package estimator
+
+// EstimatorX 插件名称,也是rpc接口的前缀
+const EstimatorX = "feeestimator"
+
+// ReqEstimateFee 手续费估算请求
+type ReqEstimateFee struct {
+	// 目标打包区块数, 默认 1,3,6
+	Targets []int64 `json:"targets"`
+	// 置信度百分比, 默认 50,80,95
+	Confidences []int64 `json:"confidences"`
+	// 交易字节数, 用于把费率换算成手续费
+	TxSize int64 `json:"txSize"`
+}
+
+// FeeEstimate 一个目标区块数和置信度的估算结果
+type FeeEstimate struct {
+	Target     int64 `json:"target"`
+	Confidence int64 `json:"confidence"`
+	FeeRate    int64 `json:"feeRate"`
+	Fee        int64 `json:"fee"`
+}
+
+// ReplyEstimateFee 手续费估算结果
+type ReplyEstimateFee struct {
+	// 参与统计的最后区块高度
+	Height int64 `json:"height"`
+	// 参与统计的区块数
+	Blocks    int64          `json:"blocks"`
+	QueueSize int64          `json:"queueSize"`
+	Estimates []*FeeEstimate `json:"estimates"`
+}
@@ -1,7 +1,9 @@
 package init
 
 import (
-	_ "github.com/33cn/plugin/plugin/mempool/para"  //auto gen
-	_ "github.com/33cn/plugin/plugin/mempool/price" //auto gen
-	_ "github.com/33cn/plugin/plugin/mempool/score" //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/estimator" //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/para"      //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/price"     //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/rbf"       //auto gen
+	_ "github.com/33cn/plugin/plugin/mempool/score"     //auto gen
 )
@@ -0,0 +1,244 @@
+package rbf
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// ErrReplaceFeeTooLow 替换交易的手续费没有高出足够的比例
+var ErrReplaceFeeTooLow = errors.New("ErrReplaceFeeTooLow")
+
+// Queue 手续费率队列模式(费率=手续费/交易字节数),账户按待打包交易的费率排序,
+// 同一账户的交易按nonce顺序打包,同一账户同一nonce的交易可以加价替换
+type Queue struct {
+	txMap     map[string]*feeItem
+	accMap    map[string][]*feeItem
+	count     int64
+	subConfig subConfig
+	// 被替换的交易,不计入交易数也不会被遍历,只等待mempool删除它的账户等索引
+	replaced map[string]*feeItem
+	// onReplace 通知mempool删除被替换的交易
+	onReplace func(hash string)
+}
+
+type feeItem struct {
+	*mempool.Item
+	hash string
+	from string
+	size int64
+}
+
+func newFeeItem(item *mempool.Item) *feeItem {
+	return &feeItem{
+		Item: item,
+		hash: string(item.Value.Hash()),
+		from: item.Value.From(),
+		size: int64(proto.Size(item.Value)),
+	}
+}
+
+// better 费率高者优先,同费率则时间早优先
+func (item *feeItem) better(it *feeItem) bool {
+	l := item.Value.Fee * it.size
+	r := it.Value.Fee * item.size
+	if l != r {
+		return l > r
+	}
+	if item.EnterTime != it.EnterTime {
+		return item.EnterTime < it.EnterTime
+	}
+	return item.hash < it.hash
+}
+
+// NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	if subcfg.ReplaceFeePercent <= 0 {
+		subcfg.ReplaceFeePercent = defaultReplaceFeePercent
+	}
+	return &Queue{
+		txMap:     make(map[string]*feeItem),
+		accMap:    make(map[string][]*feeItem),
+		subConfig: subcfg,
+		replaced:  make(map[string]*feeItem),
+	}
+}
+
+//Exist 是否存在,被替换的交易在mempool删除之前仍然存在
+func (cache *Queue) Exist(hash string) bool {
+	if _, ok := cache.txMap[hash]; ok {
+		return true
+	}
+	_, ok := cache.replaced[hash]
+	return ok
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
+	if item, ok := cache.txMap[hash]; ok {
+		return item.Item, nil
+	}
+	if item, ok := cache.replaced[hash]; ok {
+		return item.Item, nil
+	}
+	return nil, types.ErrNotFound
+}
+
+//Size 数据总数,不包括被替换的交易
+func (cache *Queue) Size() int {
+	return len(cache.txMap)
+}
+
+//Push 加入数据到队列,同一账户同一nonce的交易手续费足够高时替换原交易
+func (cache *Queue) Push(item *mempool.Item) error {
+	it := newFeeItem(item)
+	if cache.Exist(it.hash) {
+		return types.ErrTxExist
+	}
+	txs := cache.accMap[it.from]
+	i := searchNonce(txs, it.Value.Nonce)
+	if i < len(txs) && txs[i].Value.Nonce == it.Value.Nonce {
+		old := txs[i]
+		if it.Value.Fee*100 < old.Value.Fee*(100+cache.subConfig.ReplaceFeePercent) {
+			return ErrReplaceFeeTooLow
+		}
+		// 被替换的交易从队列中移除,由mempool删除时连同账户索引一起清理
+		delete(cache.txMap, old.hash)
+		cache.replaced[old.hash] = old
+		txs[i] = it
+		cache.txMap[it.hash] = it
+		if cache.onReplace != nil {
+			cache.onReplace(old.hash)
+		}
+		return nil
+	}
+	if cache.count >= cache.subConfig.PoolCacheSize {
+		tail := cache.worstTail()
+		if tail == nil || !it.better(tail) {
+			return types.ErrMemFull
+		}
+		if err := cache.Remove(tail.hash); err != nil {
+			return err
+		}
+		txs = cache.accMap[it.from]
+		i = searchNonce(txs, it.Value.Nonce)
+	}
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = it
+	cache.accMap[it.from] = txs
+	cache.txMap[it.hash] = it
+	cache.count++
+	return nil
+}
+
+//Remove 删除数据
+func (cache *Queue) Remove(hash string) error {
+	if _, ok := cache.replaced[hash]; ok {
+		delete(cache.replaced, hash)
+		return nil
+	}
+	item, ok := cache.txMap[hash]
+	if !ok {
+		return types.ErrNotFound
+	}
+	delete(cache.txMap, hash)
+	txs := cache.accMap[item.from]
+	for i, it := range txs {
+		if it == item {
+			txs = append(txs[:i], txs[i+1:]...)
+			break
+		}
+	}
+	if len(txs) == 0 {
+		delete(cache.accMap, item.from)
+	} else {
+		cache.accMap[item.from] = txs
+	}
+	cache.count--
+	return nil
+}
+
+//Walk 按账户费率从高到低遍历,同一账户按nonce顺序;count为0时遍历全部
+func (cache *Queue) Walk(count int, cb func(tx *mempool.Item) bool) {
+	h := make(accountHeap, 0, len(cache.accMap))
+	for _, txs := range cache.accMap {
+		h = append(h, &accountTxs{txs: txs})
+	}
+	heap.Init(&h)
+	i := 0
+	for h.Len() > 0 {
+		acc := h[0]
+		if !cb(acc.txs[acc.index].Item) {
+			return
+		}
+		i++
+		if i == count {
+			return
+		}
+		acc.index++
+		if acc.index < len(acc.txs) {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+}
+
+// worstTail 每个账户nonce最大的交易中费率最低的一个,淘汰它不会打乱账户的nonce顺序
+func (cache *Queue) worstTail() *feeItem {
+	var tail *feeItem
+	for _, txs := range cache.accMap {
+		last := txs[len(txs)-1]
+		if tail == nil || tail.better(last) {
+			tail = last
+		}
+	}
+	return tail
+}
+
+// GetProperFee 获取合适的手续费率,取前100的平均手续费率
+func (cache *Queue) GetProperFee() int64 {
+	var sumFeeRate int64
+	if cache.count == 0 {
+		return cache.subConfig.ProperFee
+	}
+	i := 0
+	cache.Walk(100, func(item *mempool.Item) bool {
+		txSize := proto.Size(item.Value)
+		sumFeeRate += item.Value.Fee / int64(txSize/1000+1)
+		i++
+		return true
+	})
+	return sumFeeRate / int64(i)
+}
+
+func searchNonce(txs []*feeItem, nonce int64) int {
+	return sort.Search(len(txs), func(i int) bool { return txs[i].Value.Nonce >= nonce })
+}
+
+type accountTxs struct {
+	txs   []*feeItem
+	index int
+}
+
+// accountHeap 以账户当前待打包交易的费率排序
+type accountHeap []*accountTxs
+
+func (h accountHeap) Len() int { return len(h) }
+func (h accountHeap) Less(i, j int) bool {
+	return h[i].txs[h[i].index].better(h[j].txs[h[j].index])
+}
+func (h accountHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *accountHeap) Push(x interface{}) { *h = append(*h, x.(*accountTxs)) }
+func (h *accountHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
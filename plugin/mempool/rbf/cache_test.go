@@ -0,0 +1,134 @@
+package rbf
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/33cn/chain33/system/crypto/init"
+)
+
+// 固定私钥,签名长度固定,交易大小和费率不会随机变化
+var (
+	c, _  = crypto.New(types.GetSignName("", types.SECP256K1))
+	privA = mustPrivKey("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	privB = mustPrivKey("4257D8692EF7FE13C68B65D6A52F03933DB2FA5CE8FAF210B5B8B80C721CED01")
+)
+
+func mustPrivKey(key string) crypto.PrivKey {
+	bkey, err := common.FromHex(key)
+	if err != nil {
+		panic(err)
+	}
+	priv, err := c.PrivKeyFromBytes(bkey)
+	if err != nil {
+		panic(err)
+	}
+	return priv
+}
+
+func initEnv(size int64) *Queue {
+	if size == 0 {
+		size = 100
+	}
+	_, sub := types.InitCfg("chain33.test.toml")
+	var subcfg subConfig
+	types.MustDecode(sub.Mempool["rbf"], &subcfg)
+	subcfg.PoolCacheSize = size
+	return NewQueue(subcfg)
+}
+
+func newItem(priv crypto.PrivKey, nonce, fee int64) *drivers.Item {
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("transfer"), Fee: fee, Nonce: nonce, To: "1MTAzWSmGLkNyEFeD2cUaTYn5ZuoVBp6bS"}
+	tx.Sign(types.SECP256K1, priv)
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+}
+
+func walk(cache *Queue, count int) (items []*drivers.Item) {
+	cache.Walk(count, func(item *drivers.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+func TestNonceOrder(t *testing.T) {
+	cache := initEnv(0)
+	a3 := newItem(privA, 3, 1000000)
+	a1 := newItem(privA, 1, 100000)
+	a2 := newItem(privA, 2, 5000000)
+	b1 := newItem(privB, 1, 300000)
+	b2 := newItem(privB, 2, 200000)
+	for _, item := range []*drivers.Item{a3, a1, a2, b1, b2} {
+		assert.Nil(t, cache.Push(item))
+	}
+	assert.Equal(t, types.ErrTxExist, cache.Push(a1))
+	assert.Equal(t, 5, cache.Size())
+
+	// a1 has the lowest fee rate and holds back the rest of the account
+	assert.Equal(t, []*drivers.Item{b1, b2, a1, a2, a3}, walk(cache, 0))
+	assert.Equal(t, []*drivers.Item{b1, b2}, walk(cache, 2))
+
+	assert.Nil(t, cache.Remove(string(a1.Value.Hash())))
+	assert.Equal(t, []*drivers.Item{a2, a3, b1, b2}, walk(cache, 0))
+	assert.Equal(t, types.ErrNotFound, cache.Remove(string(a1.Value.Hash())))
+}
+
+func TestReplaceByFee(t *testing.T) {
+	cache := initEnv(0)
+	a1 := newItem(privA, 1, 100000)
+	a2 := newItem(privA, 2, 100000)
+	assert.Nil(t, cache.Push(a1))
+	assert.Nil(t, cache.Push(a2))
+
+	// fee must be higher by replaceFeePercent
+	assert.Equal(t, ErrReplaceFeeTooLow, cache.Push(newItem(privA, 1, 109999)))
+	var removed []string
+	cache.onReplace = func(hash string) { removed = append(removed, hash) }
+	replace := newItem(privA, 1, 110000)
+	assert.Nil(t, cache.Push(replace))
+	assert.Equal(t, []*drivers.Item{replace, a2}, walk(cache, 2))
+
+	// the replaced tx is neither counted nor walked, the mempool is told to remove it with the rest of its indexes
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, []*drivers.Item{replace, a2}, walk(cache, 0))
+	assert.Equal(t, []string{string(a1.Value.Hash())}, removed)
+	assert.True(t, cache.Exist(string(a1.Value.Hash())))
+	assert.Equal(t, types.ErrTxExist, cache.Push(a1))
+	assert.Nil(t, cache.Remove(string(a1.Value.Hash())))
+	assert.False(t, cache.Exist(string(a1.Value.Hash())))
+	assert.Equal(t, 2, cache.Size())
+}
+
+func TestMemFull(t *testing.T) {
+	cache := initEnv(2)
+	a1 := newItem(privA, 1, 500000)
+	a2 := newItem(privA, 2, 100000)
+	assert.Nil(t, cache.Push(a1))
+	assert.Nil(t, cache.Push(a2))
+	assert.Equal(t, types.ErrMemFull, cache.Push(newItem(privB, 1, 100000)))
+
+	// the lowest fee rate account tail is evicted
+	b1 := newItem(privB, 1, 300000)
+	assert.Nil(t, cache.Push(b1))
+	assert.False(t, cache.Exist(string(a2.Value.Hash())))
+	assert.Equal(t, []*drivers.Item{a1, b1}, walk(cache, 0))
+
+	// replacing doesn't need room
+	a1r := newItem(privA, 1, 600000)
+	assert.Nil(t, cache.Push(a1r))
+	assert.Equal(t, []*drivers.Item{a1r, b1}, walk(cache, 0))
+	assert.Equal(t, 2, cache.Size())
+}
+
+func TestGetProperFee(t *testing.T) {
+	cache := initEnv(0)
+	assert.Equal(t, cache.subConfig.ProperFee, cache.GetProperFee())
+	cache.Push(newItem(privA, 1, 100000))
+	cache.Push(newItem(privB, 1, 300000))
+	assert.Equal(t, int64(200000), cache.GetProperFee())
+}
@@ -0,0 +1,46 @@
+package rbf
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+//--------------------------------------------------------------------------------
+// Module Mempool
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	// 替换同一账户同一nonce的交易时, 新交易手续费至少要高出的百分比
+	ReplaceFeePercent int64 `json:"replaceFeePercent"`
+}
+
+const defaultReplaceFeePercent = 10
+
+func init() {
+	drivers.Reg("rbf", New)
+}
+
+//New 创建rbf cache 结构的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFee
+	}
+	if subcfg.ReplaceFeePercent <= 0 {
+		subcfg.ReplaceFeePercent = defaultReplaceFeePercent
+	}
+	q := NewQueue(subcfg)
+	// 被替换的交易要经过mempool删除才能清理账户等索引,Push时mempool持有锁,所以异步删除
+	q.onReplace = func(hash string) {
+		go c.RemoveTxs(&types.TxHashList{Hashes: [][]byte{[]byte(hash)}})
+	}
+	c.SetQueueCache(q)
+	return c
+}
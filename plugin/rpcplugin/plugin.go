@@ -0,0 +1,51 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rpcplugin 只提供rpc和命令行的插件, 如mempool和store模块的查询接口
+package rpcplugin
+
+import (
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	wcom "github.com/33cn/chain33/wallet/common"
+	"github.com/spf13/cobra"
+)
+
+// Plugin 实现pluginmgr.Plugin, 执行器名为空, 不会被当成执行器
+type Plugin struct {
+	Name string
+	RPC  func(name string, s rpctypes.RPCServer)
+	Cmd  func() *cobra.Command
+}
+
+// GetName 插件名, 也是rpc的服务名
+func (p *Plugin) GetName() string {
+	return p.Name
+}
+
+// GetExecutorName 没有执行器
+func (p *Plugin) GetExecutorName() string {
+	return ""
+}
+
+// InitExec 没有执行器, 不需要初始化
+func (p *Plugin) InitExec(sub map[string][]byte) {
+}
+
+// InitWallet 没有钱包插件, 不需要初始化
+func (p *Plugin) InitWallet(wallet wcom.WalletOperate, sub map[string][]byte) {
+}
+
+// AddCmd add Command for plugin cli
+func (p *Plugin) AddCmd(rootCmd *cobra.Command) {
+	if p.Cmd != nil {
+		rootCmd.AddCommand(p.Cmd())
+	}
+}
+
+// AddRPC 以插件名注册rpc服务
+func (p *Plugin) AddRPC(s rpctypes.RPCServer) {
+	if p.RPC != nil {
+		p.RPC(p.Name, s)
+	}
+}
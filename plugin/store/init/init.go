@@ -4,5 +4,6 @@ import (
 	_ "github.com/33cn/plugin/plugin/store/kvdb"       //auto gen
 	_ "github.com/33cn/plugin/plugin/store/kvmvcc"     //auto gen
 	_ "github.com/33cn/plugin/plugin/store/kvmvccmavl" //auto gen
+	_ "github.com/33cn/plugin/plugin/store/migrate"    //auto gen
 	_ "github.com/33cn/plugin/plugin/store/mpt"        //auto gen
 )
@@ -6,6 +6,7 @@ package kvdb
 
 import (
 	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/queue"
@@ -30,16 +31,27 @@ func init() {
 	drivers.Reg("kvdb", New)
 }
 
+// lastSetKey 保存最后写入的区块状态变化, kvdb的状态hash由它计算, 导出快照时用来校验区块头.
+// 它写在单独的metadb中, 不改变状态数据库的内容
+var lastSetKey = []byte("kvdb-last-storeset")
+
 // KVStore implementation
 type KVStore struct {
 	*drivers.BaseStore
-	cache map[string]map[string]*types.KeyValue
+	cache  map[string]map[string]*types.KeyValue
+	sets   map[string]*types.StoreSet
+	metadb dbm.DB
 }
 
 // New KVStore module
 func New(cfg *types.Store, sub []byte) queue.Module {
 	bs := drivers.NewBaseStore(cfg)
-	kvs := &KVStore{bs, make(map[string]map[string]*types.KeyValue)}
+	kvs := &KVStore{
+		BaseStore: bs,
+		cache:     make(map[string]map[string]*types.KeyValue),
+		sets:      make(map[string]*types.StoreSet),
+		metadb:    dbm.NewDB("kvdb-meta", cfg.Driver, cfg.DbPath, 16),
+	}
 	bs.SetChild(kvs)
 	return kvs
 }
@@ -47,6 +59,7 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 // Close KVStore module
 func (kvs *KVStore) Close() {
 	kvs.BaseStore.Close()
+	kvs.metadb.Close()
 	klog.Info("store kvdb closed")
 }
 
@@ -57,7 +70,7 @@ func (kvs *KVStore) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
 	for _, kv := range datas.KV {
 		kvmap[string(kv.Key)] = kv
 	}
-	kvs.save(kvmap)
+	kvs.save(kvmap, datas)
 	return hash, nil
 }
 
@@ -98,6 +111,7 @@ func (kvs *KVStore) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 		kvmap[string(kv.Key)] = kv
 	}
 	kvs.cache[string(hash)] = kvmap
+	kvs.sets[string(hash)] = datas
 	if len(kvs.cache) > 100 {
 		klog.Error("too many items in cache")
 	}
@@ -116,8 +130,9 @@ func (kvs *KVStore) Commit(req *types.ReqHash) ([]byte, error) {
 		delete(kvs.cache, string(req.Hash))
 		return req.Hash, nil
 	}
-	kvs.save(kvmap)
+	kvs.save(kvmap, kvs.sets[string(req.Hash)])
 	delete(kvs.cache, string(req.Hash))
+	delete(kvs.sets, string(req.Hash))
 	return req.Hash, nil
 }
 
@@ -141,14 +156,18 @@ func (kvs *KVStore) Rollback(req *types.ReqHash) ([]byte, error) {
 		return nil, types.ErrHashNotFound
 	}
 	delete(kvs.cache, string(req.Hash))
+	delete(kvs.sets, string(req.Hash))
 	return req.Hash, nil
 }
 
-// IterateRangeByStateHash method
+// IterateRangeByStateHash kvdb只保存最新的状态, 忽略statehash遍历数据库中的kv
 func (kvs *KVStore) IterateRangeByStateHash(statehash []byte, start []byte, end []byte, ascending bool, fn func(key, value []byte) bool) {
-	panic("empty")
-	//TODO:
-	//kvs.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, fn)
+	var direction int32
+	if ascending {
+		direction = 1
+	}
+	listhelper := dbm.NewListHelper(kvs.GetDB())
+	listhelper.IteratorCallback(start, end, 0, direction, fn)
 }
 
 // ProcEvent handles supported events
@@ -165,7 +184,44 @@ func (kvs *KVStore) Del(req *types.StoreDel) ([]byte, error) {
 	return nil, nil
 }
 
-func (kvs *KVStore) save(kvmap map[string]*types.KeyValue) {
+// ImportState 导入状态快照, kvdb的状态hash不能由状态计算, 直接使用快照的状态hash
+func (kvs *KVStore) ImportState(prevHash, stateHash []byte, height int64, kvset []*types.KeyValue) error {
+	kvmap := make(map[string]*types.KeyValue)
+	for _, kv := range kvset {
+		kvmap[string(kv.Key)] = kv
+	}
+	kvs.save(kvmap, &types.StoreSet{StateHash: prevHash, KV: kvset, Height: height})
+	return nil
+}
+
+// BlockKV 返回height高度区块的状态变化, 最后写入的不是这个高度时区块没有状态变化
+func (kvs *KVStore) BlockKV(height int64) ([]*types.KeyValue, error) {
+	value, err := kvs.metadb.Get(lastSetKey)
+	if err == dbm.ErrNotFoundInDb {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var set types.StoreSet
+	if err = types.Decode(value, &set); err != nil {
+		return nil, err
+	}
+	if set.Height != height {
+		return nil, nil
+	}
+	return set.KV, nil
+}
+
+// BlockStateHash 和MemSet一样由父状态hash和区块的状态变化计算状态hash
+func (kvs *KVStore) BlockStateHash(set *types.StoreSet) []byte {
+	if len(set.KV) == 0 {
+		return set.StateHash
+	}
+	return calcHash(set)
+}
+
+func (kvs *KVStore) save(kvmap map[string]*types.KeyValue, set *types.StoreSet) {
 	storeBatch := kvs.GetDB().NewBatch(true)
 	for _, kv := range kvmap {
 		if kv.Value == nil {
@@ -175,6 +231,10 @@ func (kvs *KVStore) save(kvmap map[string]*types.KeyValue) {
 		}
 	}
 	storeBatch.Write()
+	// 状态先落盘, 中途退出时metadb里是上一个区块, 导出快照时校验失败而不会导出错误的状态
+	if set != nil {
+		kvs.metadb.Set(lastSetKey, types.Encode(set))
+	}
 }
 
 func calcHash(datas proto.Message) []byte {
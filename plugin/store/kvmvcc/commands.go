@@ -0,0 +1,93 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kvmvccdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/spf13/cobra"
+)
+
+// Cmd kvmvcc store 命令行
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kvmvcc",
+		Short: "Historical state of kvmvcc and kvmvccmavl store",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		GetAtHeightCmd(),
+		KeyVersionsCmd(),
+	)
+	return cmd
+}
+
+// GetAtHeightCmd 查询key在某个高度的值
+func GetAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the values of state keys at a block height",
+		Run:   getAtHeight,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	cmd.Flags().StringSliceP("keys", "k", nil, "state keys, separated by comma")
+	cmd.MarkFlagRequired("keys")
+	return cmd
+}
+
+func getAtHeight(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	keys, _ := cmd.Flags().GetStringSlice("keys")
+	var res KeysAtHeightResult
+	call(rpcLaddr, "kvmvcc.GetKeysAtHeight", &ReqGetKeysAtHeight{Height: height, Keys: keys}, &res)
+}
+
+// KeyVersionsCmd 列出key的历史版本
+func KeyVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "List the heights at which a state key was changed, with its values",
+		Run:   listKeyVersions,
+	}
+	cmd.Flags().StringP("key", "k", "", "state key")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("height", "t", -1, "start height, -1 for the latest or the first version")
+	cmd.Flags().Int32P("count", "c", 20, "max count of versions")
+	cmd.Flags().Int32P("direction", "d", 0, "0 for descending, 1 for ascending")
+	return cmd
+}
+
+func listKeyVersions(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	key, _ := cmd.Flags().GetString("key")
+	height, _ := cmd.Flags().GetInt64("height")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	var res KeyVersionsResult
+	call(rpcLaddr, "kvmvcc.ListKeyVersions", &ReqListKeyVersions{Key: key, Height: height, Count: count, Direction: direction}, &res)
+}
+
+func call(rpcLaddr string, method string, param interface{}, res interface{}) {
+	client, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if err = client.Call(method, param, res); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := json.MarshalIndent(res, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
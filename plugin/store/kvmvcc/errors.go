@@ -9,4 +9,6 @@ import "errors"
 var (
 	//ErrStateHashLost means err happened when query with StateHash
 	ErrStateHashLost = errors.New("ErrStateHashLost")
+	//ErrHeightPruned means the history of the height has been pruned
+	ErrHeightPruned = errors.New("ErrHeightPruned")
 )
@@ -0,0 +1,176 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kvmvccdb
+
+import (
+	"bytes"
+	"strconv"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	stypes "github.com/33cn/plugin/plugin/store/types"
+)
+
+const (
+	// 单次最多列出的版本数
+	maxVersionCount = 1000
+	versionLen      = 20
+)
+
+var (
+	//同common/db中的mvcc相关的定义保持一致
+	mvccMetaVersionKeyList = []byte(".-mvcc-.m.versionkl.")
+)
+
+// HistoryReader 按高度读取mvcc中的历史版本, kvmvcc和kvmvccmavl共用
+type HistoryReader struct {
+	db   dbm.DB
+	mvcc dbm.MVCC
+	// 裁剪之后可以查询的最低高度, 不裁剪时为nil
+	prunedHeight func() int64
+}
+
+// NewHistoryReader new history reader
+func NewHistoryReader(db dbm.DB, mvcc dbm.MVCC, prunedHeight func() int64) *HistoryReader {
+	return &HistoryReader{db: db, mvcc: mvcc, prunedHeight: prunedHeight}
+}
+
+// Range 返回可以查询的高度范围, 从快照导入的数据库从快照高度开始
+func (h *HistoryReader) Range() (*HistoryRange, error) {
+	last, err := h.mvcc.GetMaxVersion()
+	if err != nil {
+		return nil, types.ErrHeightNotExist
+	}
+	it := h.db.Iterator(mvccMetaVersionKeyList, nil, false)
+	defer it.Close()
+	if !it.Rewind() {
+		return nil, types.ErrHeightNotExist
+	}
+	first, err := parseVersion(it.Key(), len(mvccMetaVersionKeyList))
+	if err != nil {
+		return nil, err
+	}
+	if h.prunedHeight != nil {
+		if pruned := h.prunedHeight(); pruned > first {
+			first = pruned
+		}
+	}
+	return &HistoryRange{First: first, Last: last}, nil
+}
+
+// GetAtHeight 读取key在某个高度的值, 高度已经被裁剪时返回ErrHeightPruned
+func (h *HistoryReader) GetAtHeight(req *ReqGetAtHeight) (*ValuesAtHeight, error) {
+	rng, err := h.Range()
+	if err != nil {
+		return nil, err
+	}
+	if req.Height > rng.Last {
+		return nil, types.ErrHeightNotExist
+	}
+	if req.Height < rng.First {
+		klog.Info("kvmvcc history height pruned", "height", req.Height, "first", rng.First)
+		return nil, ErrHeightPruned
+	}
+	hash, err := h.mvcc.GetVersionHash(req.Height)
+	if err != nil {
+		return nil, types.ErrHeightNotExist
+	}
+	reply := &ValuesAtHeight{Height: req.Height, StateHash: hash, Values: make([][]byte, len(req.Keys)), Range: rng}
+	for i, key := range req.Keys {
+		value, err := h.mvcc.GetV(key, req.Height)
+		if err == types.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		reply.Values[i] = value
+	}
+	return reply, nil
+}
+
+// GetKeyVersions 列出key的历史版本, 每个版本是key在这个高度被修改后的值
+func (h *HistoryReader) GetKeyVersions(req *ReqGetKeyVersions) (*KeyVersions, error) {
+	if len(req.Key) == 0 || (req.Direction != dbm.ListDESC && req.Direction != dbm.ListASC) {
+		return nil, types.ErrInvalidParam
+	}
+	rng, err := h.Range()
+	if err != nil {
+		return nil, err
+	}
+	count := req.Count
+	if count <= 0 || count > maxVersionCount {
+		count = maxVersionCount
+	}
+	prefix := dbm.GetKeyPerfix(req.Key)
+	desc := req.Direction == dbm.ListDESC
+	it := h.db.Iterator(prefix, nil, desc)
+	defer it.Close()
+	if req.Height < 0 {
+		it.Rewind()
+	} else {
+		seek, _ := dbm.GetKey(req.Key, req.Height)
+		// 降序时定位到不大于height的版本
+		if !it.Seek(seek) || (desc && !bytes.Equal(it.Key(), seek)) {
+			if desc {
+				it.Next()
+			}
+		}
+	}
+	reply := &KeyVersions{Key: req.Key, Range: rng}
+	for ; it.Valid() && int32(len(reply.Versions)) < count; it.Next() {
+		// 前缀相同的其它key
+		height, err := parseVersion(it.Key(), len(prefix))
+		if err != nil {
+			continue
+		}
+		version := &KeyVersion{Height: height, Value: it.ValueCopy()}
+		version.StateHash, _ = h.mvcc.GetVersionHash(height)
+		reply.Versions = append(reply.Versions, version)
+	}
+	return reply, nil
+}
+
+// ProcEvent 处理按高度查询的事件, 不是历史查询的事件返回false
+func (h *HistoryReader) ProcEvent(client queue.Client, msg *queue.Message) bool {
+	switch msg.Ty {
+	case stypes.EventStoreGetAtHeight:
+		req, ok := msg.GetData().(*ReqGetAtHeight)
+		if !ok {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetAtHeightReply, types.ErrInvalidParam))
+			return true
+		}
+		reply, err := h.GetAtHeight(req)
+		if err != nil {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetAtHeightReply, err))
+			return true
+		}
+		msg.Reply(client.NewMessage("", stypes.EventStoreGetAtHeightReply, reply))
+		return true
+	case stypes.EventStoreGetKeyVersions:
+		req, ok := msg.GetData().(*ReqGetKeyVersions)
+		if !ok {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetKeyVersionsReply, types.ErrInvalidParam))
+			return true
+		}
+		reply, err := h.GetKeyVersions(req)
+		if err != nil {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetKeyVersionsReply, err))
+			return true
+		}
+		msg.Reply(client.NewMessage("", stypes.EventStoreGetKeyVersionsReply, reply))
+		return true
+	}
+	return false
+}
+
+// parseVersion 解析key中prefix之后的版本号, 版本号固定20位
+func parseVersion(key []byte, prefixLen int) (int64, error) {
+	if len(key) != prefixLen+versionLen {
+		return 0, types.ErrSize
+	}
+	return strconv.ParseInt(string(key[prefixLen:]), 10, 64)
+}
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: history.proto
+
+package kvmvccdb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// ReqGetAtHeight 查询key在某个高度的值
+type ReqGetAtHeight struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGetAtHeight) Reset()         { *m = ReqGetAtHeight{} }
+func (m *ReqGetAtHeight) String() string { return proto.CompactTextString(m) }
+func (*ReqGetAtHeight) ProtoMessage()    {}
+func (*ReqGetAtHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{0}
+}
+
+func (m *ReqGetAtHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGetAtHeight.Unmarshal(m, b)
+}
+func (m *ReqGetAtHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGetAtHeight.Marshal(b, m, deterministic)
+}
+func (m *ReqGetAtHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGetAtHeight.Merge(m, src)
+}
+func (m *ReqGetAtHeight) XXX_Size() int {
+	return xxx_messageInfo_ReqGetAtHeight.Size(m)
+}
+func (m *ReqGetAtHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGetAtHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGetAtHeight proto.InternalMessageInfo
+
+func (m *ReqGetAtHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqGetAtHeight) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// ValuesAtHeight 高度对应的状态hash和key的值, key不存在时值为空
+type ValuesAtHeight struct {
+	Height               int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash            []byte        `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Values               [][]byte      `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Range                *HistoryRange `protobuf:"bytes,4,opt,name=range,proto3" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValuesAtHeight) Reset()         { *m = ValuesAtHeight{} }
+func (m *ValuesAtHeight) String() string { return proto.CompactTextString(m) }
+func (*ValuesAtHeight) ProtoMessage()    {}
+func (*ValuesAtHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{1}
+}
+
+func (m *ValuesAtHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValuesAtHeight.Unmarshal(m, b)
+}
+func (m *ValuesAtHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValuesAtHeight.Marshal(b, m, deterministic)
+}
+func (m *ValuesAtHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValuesAtHeight.Merge(m, src)
+}
+func (m *ValuesAtHeight) XXX_Size() int {
+	return xxx_messageInfo_ValuesAtHeight.Size(m)
+}
+func (m *ValuesAtHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValuesAtHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValuesAtHeight proto.InternalMessageInfo
+
+func (m *ValuesAtHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValuesAtHeight) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ValuesAtHeight) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ValuesAtHeight) GetRange() *HistoryRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+// ReqGetKeyVersions 从height开始列出key的历史版本, direction为0时降序, 为1时升序
+// height小于0时降序从最新版本开始, 升序从最早版本开始
+type ReqGetKeyVersions struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGetKeyVersions) Reset()         { *m = ReqGetKeyVersions{} }
+func (m *ReqGetKeyVersions) String() string { return proto.CompactTextString(m) }
+func (*ReqGetKeyVersions) ProtoMessage()    {}
+func (*ReqGetKeyVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{2}
+}
+
+func (m *ReqGetKeyVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGetKeyVersions.Unmarshal(m, b)
+}
+func (m *ReqGetKeyVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGetKeyVersions.Marshal(b, m, deterministic)
+}
+func (m *ReqGetKeyVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGetKeyVersions.Merge(m, src)
+}
+func (m *ReqGetKeyVersions) XXX_Size() int {
+	return xxx_messageInfo_ReqGetKeyVersions.Size(m)
+}
+func (m *ReqGetKeyVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGetKeyVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGetKeyVersions proto.InternalMessageInfo
+
+func (m *ReqGetKeyVersions) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ReqGetKeyVersions) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqGetKeyVersions) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqGetKeyVersions) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+// KeyVersion key在某个高度被修改后的值
+type KeyVersion struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash            []byte   `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyVersion) Reset()         { *m = KeyVersion{} }
+func (m *KeyVersion) String() string { return proto.CompactTextString(m) }
+func (*KeyVersion) ProtoMessage()    {}
+func (*KeyVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{3}
+}
+
+func (m *KeyVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyVersion.Unmarshal(m, b)
+}
+func (m *KeyVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyVersion.Marshal(b, m, deterministic)
+}
+func (m *KeyVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyVersion.Merge(m, src)
+}
+func (m *KeyVersion) XXX_Size() int {
+	return xxx_messageInfo_KeyVersion.Size(m)
+}
+func (m *KeyVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyVersion proto.InternalMessageInfo
+
+func (m *KeyVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *KeyVersion) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *KeyVersion) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// KeyVersions key的历史版本, first之前的版本可能已经被裁剪
+type KeyVersions struct {
+	Key                  []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Versions             []*KeyVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	Range                *HistoryRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *KeyVersions) Reset()         { *m = KeyVersions{} }
+func (m *KeyVersions) String() string { return proto.CompactTextString(m) }
+func (*KeyVersions) ProtoMessage()    {}
+func (*KeyVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{4}
+}
+
+func (m *KeyVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyVersions.Unmarshal(m, b)
+}
+func (m *KeyVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyVersions.Marshal(b, m, deterministic)
+}
+func (m *KeyVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyVersions.Merge(m, src)
+}
+func (m *KeyVersions) XXX_Size() int {
+	return xxx_messageInfo_KeyVersions.Size(m)
+}
+func (m *KeyVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyVersions proto.InternalMessageInfo
+
+func (m *KeyVersions) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyVersions) GetVersions() []*KeyVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *KeyVersions) GetRange() *HistoryRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+// HistoryRange 可以按高度查询的范围, first之前的高度没有数据或者已经被裁剪
+type HistoryRange struct {
+	First                int64    `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	Last                 int64    `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryRange) Reset()         { *m = HistoryRange{} }
+func (m *HistoryRange) String() string { return proto.CompactTextString(m) }
+func (*HistoryRange) ProtoMessage()    {}
+func (*HistoryRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_454388b49b309873, []int{5}
+}
+
+func (m *HistoryRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryRange.Unmarshal(m, b)
+}
+func (m *HistoryRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryRange.Marshal(b, m, deterministic)
+}
+func (m *HistoryRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRange.Merge(m, src)
+}
+func (m *HistoryRange) XXX_Size() int {
+	return xxx_messageInfo_HistoryRange.Size(m)
+}
+func (m *HistoryRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRange proto.InternalMessageInfo
+
+func (m *HistoryRange) GetFirst() int64 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *HistoryRange) GetLast() int64 {
+	if m != nil {
+		return m.Last
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReqGetAtHeight)(nil), "kvmvccdb.ReqGetAtHeight")
+	proto.RegisterType((*ValuesAtHeight)(nil), "kvmvccdb.ValuesAtHeight")
+	proto.RegisterType((*ReqGetKeyVersions)(nil), "kvmvccdb.ReqGetKeyVersions")
+	proto.RegisterType((*KeyVersion)(nil), "kvmvccdb.KeyVersion")
+	proto.RegisterType((*KeyVersions)(nil), "kvmvccdb.KeyVersions")
+	proto.RegisterType((*HistoryRange)(nil), "kvmvccdb.HistoryRange")
+}
+
+func init() { proto.RegisterFile("history.proto", fileDescriptor_454388b49b309873) }
+
+var fileDescriptor_454388b49b309873 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x95, 0xeb, 0xa6, 0x2a, 0xd7, 0x50, 0x81, 0x55, 0x55, 0x1e, 0x18, 0xa2, 0x4c, 0x19, 0x50,
+	0x85, 0xca, 0xc2, 0xc0, 0xc2, 0x44, 0x25, 0x36, 0x0f, 0x15, 0x6b, 0x9a, 0x9a, 0xc6, 0x4a, 0x89,
+	0xa9, 0xed, 0x46, 0xca, 0xc4, 0x0f, 0xf0, 0xd1, 0xc8, 0x76, 0x82, 0xcb, 0x02, 0x88, 0xed, 0xbd,
+	0xb3, 0xcf, 0xf7, 0xde, 0xf3, 0xc1, 0x79, 0x29, 0xb4, 0x91, 0xaa, 0x5d, 0xbc, 0x29, 0x69, 0x24,
+	0x19, 0x57, 0xcd, 0x6b, 0x53, 0x14, 0xdb, 0x4d, 0x7a, 0x0f, 0x53, 0xc6, 0x0f, 0x8f, 0xdc, 0x3c,
+	0x98, 0x15, 0x17, 0xbb, 0xd2, 0x90, 0x39, 0x8c, 0x4a, 0x87, 0x28, 0x4a, 0x50, 0x86, 0x59, 0xc7,
+	0x08, 0x81, 0x61, 0xc5, 0x5b, 0x4d, 0x07, 0x09, 0xce, 0x62, 0xe6, 0x70, 0xfa, 0x81, 0x60, 0xba,
+	0xce, 0xf7, 0x47, 0xae, 0x7f, 0x6d, 0xbf, 0x82, 0x33, 0x6d, 0x72, 0xc3, 0x57, 0xb9, 0x2e, 0xe9,
+	0x20, 0x41, 0x59, 0xcc, 0x42, 0xc1, 0x76, 0x35, 0xee, 0x1d, 0x8a, 0xdd, 0xf3, 0x1d, 0x23, 0xd7,
+	0x10, 0xa9, 0xbc, 0xde, 0x71, 0x3a, 0x4c, 0x50, 0x36, 0x59, 0xce, 0x17, 0xbd, 0xf0, 0xc5, 0xca,
+	0x1b, 0x62, 0xf6, 0x94, 0xf9, 0x4b, 0xe9, 0x01, 0x2e, 0xbd, 0x99, 0x27, 0xde, 0xae, 0xb9, 0xd2,
+	0x42, 0xd6, 0x9a, 0x5c, 0x00, 0xae, 0x78, 0xeb, 0xd4, 0xc4, 0xcc, 0xc2, 0x13, 0x89, 0x83, 0x6f,
+	0x12, 0x67, 0x10, 0x15, 0xf2, 0x58, 0x1b, 0x8a, 0x13, 0x94, 0x45, 0xcc, 0x13, 0x2b, 0x7c, 0x2b,
+	0x14, 0x2f, 0x8c, 0x90, 0xb5, 0x93, 0x11, 0xb1, 0x50, 0x48, 0x9f, 0x01, 0xc2, 0xb0, 0x7f, 0x9a,
+	0x9f, 0x41, 0xe4, 0xec, 0xba, 0xb9, 0x31, 0xf3, 0x24, 0x7d, 0x87, 0xc9, 0xcf, 0x36, 0x6e, 0x60,
+	0xdc, 0x74, 0xa7, 0xee, 0x53, 0x26, 0xcb, 0x59, 0x88, 0x27, 0xb4, 0xb2, 0xaf, 0x5b, 0x21, 0x4d,
+	0xfc, 0x97, 0x34, 0xef, 0x20, 0x3e, 0x2d, 0x5b, 0x99, 0x2f, 0x42, 0xe9, 0xde, 0x9b, 0x27, 0x76,
+	0x2d, 0xf6, 0xb9, 0xee, 0xa3, 0x74, 0x78, 0x33, 0x72, 0x5b, 0x76, 0xfb, 0x39, 0x00, 0x5a, 0xa6,
+	0x7b, 0x83, 0x76, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,100 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kvmvccdb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	store := New(newStoreCfg(dir), nil).(*KVMVCCStore)
+	defer store.Close()
+	history := NewHistoryReader(store.GetDB(), store.mvcc, nil)
+
+	_, err = history.Range()
+	assert.Equal(t, types.ErrHeightNotExist, err)
+
+	// k1每个高度都修改, k2只在偶数高度修改, 前缀相同的k1.x不影响k1的版本
+	hash := drivers.EmptyRoot[:]
+	var hashes [][]byte
+	for i := int64(0); i < 10; i++ {
+		kvs := []*types.KeyValue{
+			{Key: []byte("k1"), Value: []byte(fmt.Sprintf("v1-%d", i))},
+			{Key: []byte("k1.x"), Value: []byte(fmt.Sprintf("x-%d", i))},
+		}
+		if i%2 == 0 {
+			kvs = append(kvs, &types.KeyValue{Key: []byte("k2"), Value: []byte(fmt.Sprintf("v2-%d", i))})
+		}
+		hash, err = store.MemSet(&types.StoreSet{StateHash: hash, KV: kvs, Height: i}, true)
+		require.Nil(t, err)
+		_, err = store.Commit(&types.ReqHash{Hash: hash})
+		require.Nil(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	rng, err := history.Range()
+	require.Nil(t, err)
+	assert.Equal(t, &HistoryRange{First: 0, Last: 9}, rng)
+
+	values, err := history.GetAtHeight(&ReqGetAtHeight{Height: 5, Keys: [][]byte{[]byte("k1"), []byte("k2"), []byte("k3")}})
+	require.Nil(t, err)
+	assert.Equal(t, hashes[5], values.StateHash)
+	assert.Equal(t, []byte("v1-5"), values.Values[0])
+	assert.Equal(t, []byte("v2-4"), values.Values[1])
+	assert.Nil(t, values.Values[2])
+	_, err = history.GetAtHeight(&ReqGetAtHeight{Height: 10, Keys: [][]byte{[]byte("k1")}})
+	assert.Equal(t, types.ErrHeightNotExist, err)
+
+	// 降序从最新版本开始
+	versions, err := history.GetKeyVersions(&ReqGetKeyVersions{Key: []byte("k2"), Height: -1, Count: 2, Direction: dbm.ListDESC})
+	require.Nil(t, err)
+	require.Len(t, versions.Versions, 2)
+	assert.Equal(t, int64(8), versions.Versions[0].Height)
+	assert.Equal(t, hashes[8], versions.Versions[0].StateHash)
+	assert.Equal(t, []byte("v2-6"), versions.Versions[1].Value)
+	// 降序从不大于height的版本开始
+	versions, err = history.GetKeyVersions(&ReqGetKeyVersions{Key: []byte("k2"), Height: 5, Direction: dbm.ListDESC})
+	require.Nil(t, err)
+	require.Len(t, versions.Versions, 3)
+	assert.Equal(t, int64(4), versions.Versions[0].Height)
+	assert.Equal(t, int64(0), versions.Versions[2].Height)
+	// 升序从不小于height的版本开始
+	versions, err = history.GetKeyVersions(&ReqGetKeyVersions{Key: []byte("k2"), Height: 5, Direction: dbm.ListASC})
+	require.Nil(t, err)
+	require.Len(t, versions.Versions, 2)
+	assert.Equal(t, int64(6), versions.Versions[0].Height)
+	versions, err = history.GetKeyVersions(&ReqGetKeyVersions{Key: []byte("k1"), Height: -1, Direction: dbm.ListASC})
+	require.Nil(t, err)
+	assert.Len(t, versions.Versions, 10)
+	_, err = history.GetKeyVersions(&ReqGetKeyVersions{Key: []byte("k1"), Direction: 2})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 回滚的区块不能再查询
+	_, err = store.Del(&types.StoreDel{StateHash: hashes[9], Height: 9})
+	require.Nil(t, err)
+	_, err = history.GetAtHeight(&ReqGetAtHeight{Height: 9, Keys: [][]byte{[]byte("k1")}})
+	assert.Equal(t, types.ErrHeightNotExist, err)
+
+	// 裁剪之后更低的高度返回ErrHeightPruned
+	history = NewHistoryReader(store.GetDB(), store.mvcc, func() int64 { return 3 })
+	_, err = history.GetAtHeight(&ReqGetAtHeight{Height: 2, Keys: [][]byte{[]byte("k1")}})
+	assert.Equal(t, ErrHeightPruned, err)
+	values, err = history.GetAtHeight(&ReqGetAtHeight{Height: 3, Keys: [][]byte{[]byte("k1")}})
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v1-3"), values.Values[0])
+	assert.Equal(t, &HistoryRange{First: 3, Last: 8}, values.Range)
+}
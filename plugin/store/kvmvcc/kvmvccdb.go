@@ -9,9 +9,11 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/rpcplugin"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -29,6 +31,8 @@ func DisableLog() {
 
 func init() {
 	drivers.Reg("kvmvcc", New)
+	// 只提供按高度查询历史状态的rpc和命令行
+	pluginmgr.Register(&rpcplugin.Plugin{Name: "kvmvcc", Cmd: Cmd, RPC: InitRPC})
 }
 
 // KVMVCCStore provide kvmvcc store interface implementation
@@ -199,6 +203,10 @@ func (mvccs *KVMVCCStore) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
+	// kvmvcc不裁剪, 可以查询所有高度
+	if NewHistoryReader(mvccs.GetDB(), mvccs.mvcc, nil).ProcEvent(mvccs.GetQueueClient(), msg) {
+		return
+	}
 	msg.ReplyErr("KVStore", types.ErrActionNotSupport)
 }
 
@@ -215,6 +223,21 @@ func (mvccs *KVMVCCStore) Del(req *types.StoreDel) ([]byte, error) {
 	return req.StateHash, nil
 }
 
+// ImportState 导入状态快照
+func (mvccs *KVMVCCStore) ImportState(prevHash, stateHash []byte, height int64, kvs []*types.KeyValue) error {
+	return ImportState(mvccs.GetDB(), mvccs.mvcc, prevHash, stateHash, height, kvs)
+}
+
+// BlockStateHash 和MemSet一样由父状态hash和区块的状态变化计算状态hash
+func (mvccs *KVMVCCStore) BlockStateHash(set *types.StoreSet) []byte {
+	return BlockStateHash(set)
+}
+
+// BlockKV 恢复height高度区块的状态变化
+func (mvccs *KVMVCCStore) BlockKV(height int64) ([]*types.KeyValue, error) {
+	return BlockKV(mvccs.GetDB(), mvccs.mvcc, height)
+}
+
 func (mvccs *KVMVCCStore) saveKVSets(kvset []*types.KeyValue, sync bool) {
 	if len(kvset) == 0 {
 		return
@@ -0,0 +1,154 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kvmvccdb
+
+import (
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	stypes "github.com/33cn/plugin/plugin/store/types"
+)
+
+// ReqGetKeysAtHeight 查询key在某个高度的值的rpc参数
+type ReqGetKeysAtHeight struct {
+	Height int64    `json:"height"`
+	Keys   []string `json:"keys"`
+}
+
+// KeysAtHeightResult 高度对应的状态hash和key的值, 值为hex编码, key不存在时为空
+type KeysAtHeightResult struct {
+	Height      int64    `json:"height"`
+	StateHash   string   `json:"stateHash"`
+	Values      []string `json:"values"`
+	FirstHeight int64    `json:"firstHeight"`
+	LastHeight  int64    `json:"lastHeight"`
+}
+
+// ReqListKeyVersions 列出key的历史版本的rpc参数, height为-1时从最新或者最早的版本开始
+type ReqListKeyVersions struct {
+	Key       string `json:"key"`
+	Height    int64  `json:"height"`
+	Count     int32  `json:"count"`
+	Direction int32  `json:"direction"`
+}
+
+// KeyVersionResult key在某个高度被修改后的值
+type KeyVersionResult struct {
+	Height    int64  `json:"height"`
+	StateHash string `json:"stateHash"`
+	Value     string `json:"value"`
+}
+
+// KeyVersionsResult key的历史版本, firstHeight之前的版本可能已经被裁剪
+type KeyVersionsResult struct {
+	Key         string              `json:"key"`
+	Versions    []*KeyVersionResult `json:"versions"`
+	FirstHeight int64               `json:"firstHeight"`
+	LastHeight  int64               `json:"lastHeight"`
+}
+
+// Jrpc 历史状态查询的rpc接口
+type Jrpc struct {
+	cli *channelClient
+}
+
+type channelClient struct {
+	rpctypes.ChannelClient
+	qclient queue.Client
+}
+
+// InitRPC 注册 rpc 接口
+func InitRPC(name string, s rpctypes.RPCServer) {
+	cli := &channelClient{qclient: s.GetQueueClient()}
+	cli.Init(name, s, &Jrpc{cli: cli}, nil)
+}
+
+// GetKeysAtHeight 获取key在某个高度的值, 高度已经被裁剪时返回ErrHeightPruned
+func (c *Jrpc) GetKeysAtHeight(param *ReqGetKeysAtHeight, result *interface{}) error {
+	if param == nil || len(param.Keys) == 0 || param.Height < 0 {
+		return types.ErrInvalidParam
+	}
+	req := &ReqGetAtHeight{Height: param.Height}
+	for _, key := range param.Keys {
+		req.Keys = append(req.Keys, []byte(key))
+	}
+	reply, err := c.cli.GetAtHeight(req)
+	if err != nil {
+		return err
+	}
+	res := &KeysAtHeightResult{
+		Height:      reply.Height,
+		StateHash:   common.ToHex(reply.StateHash),
+		FirstHeight: reply.Range.First,
+		LastHeight:  reply.Range.Last,
+	}
+	for _, value := range reply.Values {
+		res.Values = append(res.Values, common.ToHex(value))
+	}
+	*result = res
+	return nil
+}
+
+// ListKeyVersions 列出key的历史版本, direction为0时降序, 为1时升序
+func (c *Jrpc) ListKeyVersions(param *ReqListKeyVersions, result *interface{}) error {
+	if param == nil || param.Key == "" || (param.Direction != dbm.ListDESC && param.Direction != dbm.ListASC) {
+		return types.ErrInvalidParam
+	}
+	reply, err := c.cli.GetKeyVersions(&ReqGetKeyVersions{Key: []byte(param.Key), Height: param.Height,
+		Count: param.Count, Direction: param.Direction})
+	if err != nil {
+		return err
+	}
+	res := &KeyVersionsResult{Key: param.Key, FirstHeight: reply.Range.First, LastHeight: reply.Range.Last}
+	for _, v := range reply.Versions {
+		res.Versions = append(res.Versions, &KeyVersionResult{Height: v.Height, StateHash: common.ToHex(v.StateHash), Value: common.ToHex(v.Value)})
+	}
+	*result = res
+	return nil
+}
+
+// GetAtHeight 通过store模块查询, store不是kvmvcc或者kvmvccmavl时返回不支持
+func (c *channelClient) GetAtHeight(req *ReqGetAtHeight) (*ValuesAtHeight, error) {
+	data, err := c.send(stypes.EventStoreGetAtHeight, req)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := data.(*ValuesAtHeight); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetKeyVersions 通过store模块查询, store不是kvmvcc或者kvmvccmavl时返回不支持
+func (c *channelClient) GetKeyVersions(req *ReqGetKeyVersions) (*KeyVersions, error) {
+	data, err := c.send(stypes.EventStoreGetKeyVersions, req)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := data.(*KeyVersions); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+func (c *channelClient) send(ty int64, req interface{}) (interface{}, error) {
+	msg := c.qclient.NewMessage("store", ty, req)
+	err := c.qclient.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.qclient.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := resp.GetData().(*types.Reply); ok {
+		return nil, errors.New(string(reply.GetMsg()))
+	}
+	return resp.GetData(), nil
+}
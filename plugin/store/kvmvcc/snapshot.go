@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kvmvccdb
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// ImportState 导入状态快照, 数据写入快照高度的版本并绑定到快照的状态hash, kvmvcc和kvmvccmavl共用
+// 快照之前的状态不导入, 只记录父状态的版本, 后续区块可以接着快照高度执行
+func ImportState(db dbm.DB, mvcc dbm.MVCC, prevHash, stateHash []byte, height int64, kvs []*types.KeyValue) error {
+	if height > 0 {
+		if _, err := mvcc.GetVersionHash(height - 1); err == types.ErrNotFound {
+			if err = mvcc.SetVersion(prevHash, height-1); err != nil {
+				return err
+			}
+		}
+	}
+	kvlist, err := mvcc.AddMVCC(kvs, stateHash, prevHash, height)
+	if err != nil {
+		return err
+	}
+	if len(kvlist) == 0 {
+		return nil
+	}
+	batch := db.NewBatch(true)
+	for _, kv := range kvlist {
+		if kv.Value == nil {
+			batch.Delete(kv.Key)
+		} else {
+			batch.Set(kv.Key, kv.Value)
+		}
+	}
+	return batch.Write()
+}
+
+// BlockStateHash 和MemSet一样由父状态hash和区块的状态变化计算状态hash
+func BlockStateHash(set *types.StoreSet) []byte {
+	return calcHash(set)
+}
+
+// BlockKV 由height版本修改的key列表和这个版本的值恢复区块的状态变化, 被删除的key值为nil
+func BlockKV(db dbm.DB, mvcc dbm.MVCC, height int64) ([]*types.KeyValue, error) {
+	list, ok := mvcc.(interface {
+		GetDelKVList(version int64) ([]*types.KeyValue, error)
+	})
+	if !ok {
+		return nil, types.ErrNotSupport
+	}
+	keys, err := list.GetDelKVList(height)
+	if err != nil {
+		return nil, err
+	}
+	kvs := make([]*types.KeyValue, len(keys))
+	for i, kv := range keys {
+		key, err := dbm.GetKey(kv.Key, height)
+		if err != nil {
+			return nil, err
+		}
+		value, err := db.Get(key)
+		if err != nil && err != dbm.ErrNotFoundInDb {
+			return nil, err
+		}
+		kvs[i] = &types.KeyValue{Key: kv.Key, Value: value}
+	}
+	return kvs, nil
+}
@@ -76,6 +76,7 @@ type subKVMVCCConfig struct {
 	EnableMVCCIter  bool  `json:"enableMVCCIter"`
 	EnableMavlPrune bool  `json:"enableMavlPrune"`
 	PruneHeight     int32 `json:"pruneHeight"`
+	RetainHeight    int32 `json:"retainHeight"`
 }
 
 type subMavlConfig struct {
@@ -97,6 +98,8 @@ type subConfig struct {
 	EnableMVCC       bool  `json:"enableMVCC"`
 	EnableMavlPrune  bool  `json:"enableMavlPrune"`
 	PruneHeight      int32 `json:"pruneHeight"`
+	// 裁剪时保留最近retainHeight个高度的历史版本, 小于pruneHeight时使用pruneHeight
+	RetainHeight int32 `json:"retainHeight"`
 	// 是否使能内存树
 	EnableMemTree bool `json:"enableMemTree"`
 	// 是否使能内存树中叶子节点
@@ -116,6 +119,7 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 		subKVMVCCcfg.EnableMVCCIter = subcfg.EnableMVCCIter
 		subKVMVCCcfg.EnableMavlPrune = subcfg.EnableMavlPrune
 		subKVMVCCcfg.PruneHeight = subcfg.PruneHeight
+		subKVMVCCcfg.RetainHeight = subcfg.RetainHeight
 
 		subMavlcfg.EnableMavlPrefix = subcfg.EnableMavlPrefix
 		subMavlcfg.EnableMVCC = subcfg.EnableMVCC
@@ -273,6 +277,9 @@ func (kvmMavls *KVmMavlStore) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
+	if kvmMavls.KVMVCCStore.History().ProcEvent(kvmMavls.GetQueueClient(), msg) {
+		return
+	}
 	msg.ReplyErr("KVmMavlStore", types.ErrActionNotSupport)
 }
 
@@ -350,6 +357,20 @@ func (kvmMavls *KVmMavlStore) Del(req *types.StoreDel) ([]byte, error) {
 	return hash, err
 }
 
+// ImportState 导入状态快照, 分叉高度之前mavl树的节点由快照直接导入, 这里只导入kvmvcc
+func (kvmMavls *KVmMavlStore) ImportState(prevHash, stateHash []byte, height int64, kvs []*types.KeyValue) error {
+	if err := kvmMavls.KVMVCCStore.ImportState(prevHash, stateHash, height, kvs); err != nil {
+		return err
+	}
+	kvmMavls.cache.Add(string(stateHash), height)
+	return nil
+}
+
+// IsMavlState 分叉高度之前状态hash是mavl树根
+func (kvmMavls *KVmMavlStore) IsMavlState(height int64) bool {
+	return height < kvmvccMavlFork
+}
+
 // DelMavl 数据库中mavl数据清除
 // 达到kvmvccMavlFork + 100000 后触发清除
 func DelMavl(db dbm.DB) {
@@ -21,6 +21,7 @@ import (
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
+	kvmvccdb "github.com/33cn/plugin/plugin/store/kvmvcc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -676,6 +677,59 @@ func TestPruning(t *testing.T) {
 	}
 }
 
+func TestPruningHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	os.RemoveAll(dir)       //删除已存在目录
+	storeCfg := newStoreCfg(dir)
+	store := New(storeCfg, nil).(*KVmMavlStore)
+	assert.NotNil(t, store)
+
+	kvmvccStore := NewKVMVCC(&subKVMVCCConfig{}, store.GetDB())
+	SetPruneHeight(10)
+	defer SetPruneHeight(0)
+	// 保留的历史高度比裁剪间隔长
+	SetRetainHeight(20)
+	defer SetRetainHeight(0)
+
+	key := []byte("mavl-coins-bty-exec")
+	datas := &types.StoreSet{StateHash: drivers.EmptyRoot[:]}
+	for i := 0; i < 100; i++ {
+		datas.Height = int64(i)
+		datas.KV = []*types.KeyValue{{Key: key, Value: []byte(fmt.Sprintf("v%d", i))}}
+		hash, err := kvmvccStore.MemSet(datas, nil, true)
+		require.NoError(t, err)
+		_, err = kvmvccStore.Commit(&types.ReqHash{Hash: hash})
+		require.NoError(t, err)
+		datas.StateHash = hash
+	}
+	history := kvmvccStore.History()
+	rng, err := history.Range()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), rng.First)
+
+	pruningMVCC(store.GetDB(), 99)
+	rng, err = history.Range()
+	require.NoError(t, err)
+	assert.Equal(t, &kvmvccdb.HistoryRange{First: 79, Last: 99}, rng)
+	_, err = history.GetAtHeight(&kvmvccdb.ReqGetAtHeight{Height: 78, Keys: [][]byte{key}})
+	assert.Equal(t, kvmvccdb.ErrHeightPruned, err)
+	for i := int64(79); i < 100; i++ {
+		values, err := history.GetAtHeight(&kvmvccdb.ReqGetAtHeight{Height: i, Keys: [][]byte{key}})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("v%d", i)), values.Values[0])
+	}
+	// 裁剪之后保留的最早版本是可以查询的最低高度的值
+	versions, err := history.GetKeyVersions(&kvmvccdb.ReqGetKeyVersions{Key: key, Height: -1, Direction: dbm.ListASC})
+	require.NoError(t, err)
+	assert.Equal(t, int64(79), versions.Versions[0].Height)
+
+	// 裁剪高度保存在数据库中, 不会降低
+	setPrunedHeight(store.GetDB(), 50)
+	assert.Equal(t, int64(79), getPrunedHeight(store.GetDB()))
+}
+
 func TestGetKeyVersion(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
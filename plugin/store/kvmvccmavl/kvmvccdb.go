@@ -16,6 +16,7 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
+	kvmvccdb "github.com/33cn/plugin/plugin/store/kvmvcc"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -31,7 +32,9 @@ var (
 	// 是否开启裁剪
 	enablePrune bool
 	// 每个10000裁剪一次
-	pruneHeight  = 10000
+	pruneHeight = 10000
+	// 裁剪时保留的历史高度数, 为0时等于pruneHeight
+	retainHeight int
 	pruningState int32
 	batch        dbm.Batch
 )
@@ -41,6 +44,8 @@ var (
 	mvccPrefix = []byte(".-mvcc-.")
 	//mvccMeta               = append(mvccPrefix, []byte("m.")...)
 	mvccData = append(mvccPrefix, []byte("d.")...)
+	// 裁剪之后可以按高度查询的最低高度
+	prunedHeightKey = append(append([]byte{}, mvccPrefix...), []byte("--prunedHeight--")...)
 	//mvccLast               = append(mvccPrefix, []byte("l.")...)
 	//mvccMetaVersion        = append(mvccMeta, []byte("version.")...)
 	//mvccMetaVersionKeyList = append(mvccMeta, []byte("versionkl.")...)
@@ -73,6 +78,7 @@ func NewKVMVCC(sub *subKVMVCCConfig, db dbm.DB) *KVMVCCStore {
 	}
 	EnablePrune(sub.EnableMavlPrune)
 	SetPruneHeight(int(sub.PruneHeight))
+	SetRetainHeight(int(sub.RetainHeight))
 	return kvs
 }
 
@@ -231,6 +237,13 @@ func (mvccs *KVMVCCStore) IterateRangeByStateHash(statehash []byte, start []byte
 	listhelper.IteratorCallback(start, end, 0, 1, fn)
 }
 
+// History 按高度查询历史版本, 裁剪过的高度返回ErrHeightPruned
+func (mvccs *KVMVCCStore) History() *kvmvccdb.HistoryReader {
+	return kvmvccdb.NewHistoryReader(mvccs.db, mvccs.mvcc, func() int64 {
+		return getPrunedHeight(mvccs.db)
+	})
+}
+
 // ProcEvent handles supported events
 func (mvccs *KVMVCCStore) ProcEvent(msg queue.Message) {
 	msg.ReplyErr("KVStore", types.ErrActionNotSupport)
@@ -249,6 +262,21 @@ func (mvccs *KVMVCCStore) Del(req *types.StoreDel) ([]byte, error) {
 	return req.StateHash, nil
 }
 
+// ImportState 导入状态快照
+func (mvccs *KVMVCCStore) ImportState(prevHash, stateHash []byte, height int64, kvs []*types.KeyValue) error {
+	return kvmvccdb.ImportState(mvccs.db, mvccs.mvcc, prevHash, stateHash, height, kvs)
+}
+
+// BlockStateHash 和MemSet一样由父状态hash和区块的状态变化计算状态hash
+func (mvccs *KVMVCCStore) BlockStateHash(set *types.StoreSet) []byte {
+	return kvmvccdb.BlockStateHash(set)
+}
+
+// BlockKV 恢复height高度区块的状态变化
+func (mvccs *KVMVCCStore) BlockKV(height int64) ([]*types.KeyValue, error) {
+	return kvmvccdb.BlockKV(mvccs.db, mvccs.mvcc, height)
+}
+
 func (mvccs *KVMVCCStore) saveKVSets(kvset []*types.KeyValue, sync bool) {
 	if len(kvset) == 0 {
 		return
@@ -329,6 +357,40 @@ func SetPruneHeight(height int) {
 	pruneHeight = height
 }
 
+// SetRetainHeight 设置裁剪时保留的历史高度数
+func SetRetainHeight(height int) {
+	retainHeight = height
+}
+
+func getRetainHeight() int64 {
+	if retainHeight < pruneHeight {
+		return int64(pruneHeight)
+	}
+	return int64(retainHeight)
+}
+
+func getPrunedHeight(db dbm.DB) int64 {
+	value, err := db.Get(prunedHeightKey)
+	if err != nil || len(value) == 0 {
+		return 0
+	}
+	var height types.Int64
+	if err = types.Decode(value, &height); err != nil {
+		return 0
+	}
+	return height.Data
+}
+
+// setPrunedHeight 裁剪开始前记录, 查询更低的高度时返回已经裁剪
+func setPrunedHeight(db dbm.DB, height int64) {
+	if height <= getPrunedHeight(db) {
+		return
+	}
+	if err := db.Set(prunedHeightKey, types.Encode(&types.Int64{Data: height})); err != nil {
+		kmlog.Error("setPrunedHeight", "height", height, "err", err)
+	}
+}
+
 func pruning(db dbm.DB, height int64) {
 	defer wg.Done()
 	pruningMVCC(db, height)
@@ -337,6 +399,7 @@ func pruning(db dbm.DB, height int64) {
 func pruningMVCC(db dbm.DB, height int64) {
 	setPruning(pruningStateStart)
 	defer setPruning(pruningStateEnd)
+	setPrunedHeight(db, height-getRetainHeight())
 	start := time.Now()
 	pruningFirst(db, height)
 	end := time.Now()
@@ -365,7 +428,7 @@ func pruningFirst(db dbm.DB, curHeight int64) {
 		}
 
 		if curHeight < height+levelPruningHeight &&
-			curHeight >= height+int64(pruneHeight) {
+			curHeight >= height+getRetainHeight() {
 			mp[string(key)] = append(mp[string(key)], height)
 			count++
 		}
@@ -390,7 +453,7 @@ func deleteOldKV(mp map[string][]int64, curHeight int64, batch dbm.Batch) {
 	for key, vals := range mp {
 		if len(vals) > 1 && vals[1] != vals[0] { //防止相同高度时候出现的误删除
 			for _, val := range vals[1:] { //从第二个开始判断
-				if curHeight >= val+int64(pruneHeight) {
+				if curHeight >= val+getRetainHeight() {
 					batch.Delete(genKeyVersion([]byte(key), val)) // 删除老版本key
 					if batch.ValueSize() > batchDataSize {
 						dbm.MustWrite(batch)
@@ -0,0 +1,483 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/store/snapshot"
+)
+
+var (
+	// ErrVerifyFailed 目标store和源store的状态不一致
+	ErrVerifyFailed = errors.New("ErrVerifyFailed")
+	// ErrMigrateNotReady 到达切换高度时迁移没有开始或者已经失败, 不能切换到目标store
+	ErrMigrateNotReady = errors.New("ErrMigrateNotReady")
+)
+
+var (
+	statusKey     = []byte("migrate-status")
+	baseHeightKey = []byte("migrate-base-height")
+	basePrevKey   = []byte("migrate-base-prev")
+	baseHashKey   = []byte("migrate-base-hash")
+	hashPrefix    = []byte("migrate-hash-")
+	journalPrefix = []byte("migrate-journal-")
+)
+
+const (
+	opSet = 1
+	opDel = 2
+
+	batchSize = 1000
+)
+
+func hashKey(hash []byte) []byte {
+	return append(append([]byte{}, hashPrefix...), hash...)
+}
+
+func journalKey(seq int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", journalPrefix, seq))
+}
+
+// loadJournal 返回日志中第一条和下一条的序号, 重放过的日志已经删除
+func loadJournal(db dbm.DB) (int64, int64) {
+	first, next := int64(-1), int64(0)
+	it := db.Iterator(journalPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		seq, err := strconv.ParseInt(string(it.Key()[len(journalPrefix):]), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		if first < 0 {
+			first = seq
+		}
+		next = seq + 1
+	}
+	if first < 0 {
+		first = next
+	}
+	return first, next
+}
+
+func loadStatus(db dbm.DB) int32 {
+	value, err := db.Get(statusKey)
+	if err != nil || len(value) == 0 {
+		return statusNone
+	}
+	var status types.Int64
+	if err = types.Decode(value, &status); err != nil {
+		panic(err)
+	}
+	return int32(status.Data)
+}
+
+func loadInt64(db dbm.DB, key []byte) int64 {
+	value, err := db.Get(key)
+	if err != nil || len(value) == 0 {
+		return 0
+	}
+	var data types.Int64
+	types.Decode(value, &data)
+	return data.Data
+}
+
+func (m *Store) setInt64(key []byte, data int64) {
+	if err := m.GetDB().Set(key, types.Encode(&types.Int64{Data: data})); err != nil {
+		panic(err)
+	}
+}
+
+func (m *Store) setBytes(key, value []byte) {
+	if err := m.GetDB().Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+// loadBase 重启时恢复基准区块和日志的位置
+func (m *Store) loadBase() {
+	db := m.GetDB()
+	m.baseHeight = loadInt64(db, baseHeightKey)
+	m.basePrev, _ = db.Get(basePrevKey)
+	m.baseHash, _ = db.Get(baseHashKey)
+	m.journalFirst, m.journalSeq = loadJournal(db)
+}
+
+// copied 基准状态已经复制到目标store
+func (m *Store) copied() bool {
+	return m.baseHash != nil && m.getHash(m.baseHash) != nil
+}
+
+// setStatus 调用者持有m.mtx
+func (m *Store) setStatus(status int32) {
+	if m.status == status {
+		return
+	}
+	mlog.Info("store migrate status", "from", m.status, "to", status)
+	m.status = status
+	m.setInt64(statusKey, int64(status))
+}
+
+// fail 迁移失败, 不再写入目标store, 调用者持有m.mtx
+func (m *Store) fail(msg string, err error) {
+	mlog.Error("store migrate failed", "msg", msg, "err", err)
+	m.setStatus(statusFailed)
+}
+
+// dualWrite 目标store追上之后新的区块同时写入两个store
+func (m *Store) dualWrite() bool {
+	return m.status == statusSynced || m.status == statusVerified
+}
+
+// getHash 源状态hash对应的目标状态hash
+func (m *Store) getHash(hash []byte) []byte {
+	value, err := m.GetDB().Get(hashKey(hash))
+	if err != nil || len(value) == 0 {
+		return nil
+	}
+	return value
+}
+
+func (m *Store) setHash(from, to []byte) {
+	if err := m.GetDB().Set(hashKey(from), to); err != nil {
+		panic(err)
+	}
+}
+
+func (m *Store) appendJournal(op byte, data types.Message) {
+	value := append([]byte{op}, types.Encode(data)...)
+	if err := m.GetDB().Set(journalKey(m.journalSeq), value); err != nil {
+		panic(err)
+	}
+	m.journalSeq++
+}
+
+// committed 源store提交了一个区块, 调用者持有m.mtx
+// hash是源状态hash, target是MemSet时写入目标store的状态hash
+func (m *Store) committed(datas *types.StoreSet, hash, target []byte) {
+	m.lastPrev, m.lastHash, m.lastHeight = datas.StateHash, hash, datas.Height
+	switch m.status {
+	case statusNone:
+		if datas.Height+m.confirmations >= m.switchHeight {
+			m.fail("not enough blocks before the switch height", types.ErrInvalidParam)
+			return
+		}
+		m.basePrev, m.baseHash, m.baseHeight = datas.StateHash, hash, datas.Height
+		m.journalFirst, m.journalSeq = 0, 0
+		m.setInt64(baseHeightKey, m.baseHeight)
+		m.setBytes(basePrevKey, m.basePrev)
+		m.setBytes(baseHashKey, m.baseHash)
+		m.setStatus(statusBuilding)
+		mlog.Info("store migrate base", "height", m.baseHeight, "hash", common.ToHex(hash))
+	case statusBuilding:
+		m.appendJournal(opSet, &types.KeyValue{Key: hash, Value: types.Encode(datas)})
+		if !m.copying && datas.Height >= m.baseHeight+m.confirmations {
+			m.copying = true
+			m.wg.Add(1)
+			go m.build()
+		}
+	case statusSynced, statusVerified:
+		var err error
+		if target == nil {
+			target, err = m.to.Set(m.toTarget(datas), true)
+		} else {
+			_, err = m.to.Commit(&types.ReqHash{Hash: target})
+		}
+		if err != nil {
+			m.fail("target commit", err)
+			return
+		}
+		m.setHash(hash, target)
+		// 重启时还没有校验
+		if m.status == statusSynced && !m.verifying {
+			m.verifying = true
+			m.wg.Add(1)
+			go func() {
+				defer m.wg.Done()
+				m.verify()
+			}()
+		}
+	}
+}
+
+// deleted 源store回滚了一个区块, 调用者持有m.mtx
+func (m *Store) deleted(req *types.StoreDel) {
+	switch m.status {
+	case statusBuilding:
+		if req.Height > m.baseHeight {
+			m.appendJournal(opDel, req)
+			return
+		}
+		if m.copying || m.copied() {
+			m.fail("rollback below the base block", types.ErrInvalidParam)
+			return
+		}
+		// 还在等待确认, 在下一个提交的区块重新选择基准
+		clearDB(m.GetDB())
+		m.baseHash, m.baseHeight = nil, 0
+		m.status = statusNone
+		mlog.Info("store migrate base rollback", "height", req.Height)
+	case statusSynced, statusVerified:
+		if req.Height <= m.baseHeight {
+			m.fail("rollback below the base block", types.ErrInvalidParam)
+			return
+		}
+		target := m.getHash(req.StateHash)
+		if target == nil {
+			return
+		}
+		if _, err := m.to.Del(&types.StoreDel{StateHash: target, Height: req.Height}); err != nil {
+			m.fail("target del", err)
+			return
+		}
+		if err := m.GetDB().Delete(hashKey(req.StateHash)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// lockUntilFirst 在持有m.mtx时开始遍历最新状态, 读到第一个kv之后释放m.mtx
+// kvmvcc只能遍历最新的状态, 遍历开始之后新提交的区块不影响遍历的结果
+func (m *Store) lockUntilFirst(iterate func(fn func(key, value []byte) bool), fn func(key, value []byte) bool) {
+	var once sync.Once
+	unlock := func() { once.Do(m.mtx.Unlock) }
+	m.mtx.Lock()
+	defer unlock()
+	iterate(func(key, value []byte) bool {
+		unlock()
+		return fn(key, value)
+	})
+}
+
+func (m *Store) build() {
+	defer m.wg.Done()
+	var err error
+	// 重启之前已经复制完成时接着重放日志
+	if !m.copied() {
+		err = m.copyBase()
+	}
+	if err == nil {
+		err = m.replay()
+	}
+	if err == nil {
+		m.verify()
+		return
+	}
+	// 节点关闭时复制或者重放没有完成, 重启后继续
+	if err == types.ErrIsClosed {
+		return
+	}
+	m.mtx.Lock()
+	m.fail("build target store", err)
+	m.mtx.Unlock()
+}
+
+// copyBase 把基准区块的状态复制到目标store
+func (m *Store) copyBase() error {
+	mlog.Info("store migrate copy base state", "height", m.baseHeight)
+	builder := snapshot.NewStateBuilder(m.to, m.basePrev, m.baseHash, m.baseHeight)
+	var keys [][]byte
+	var err error
+	var count int
+	flush := func() {
+		if err != nil || len(keys) == 0 {
+			return
+		}
+		values := m.from.Get(&types.StoreGet{StateHash: m.baseHash, Keys: keys})
+		var kvs []*types.KeyValue
+		for i, value := range values {
+			// 基准区块之后新增的key
+			if len(value) == 0 {
+				continue
+			}
+			kvs = append(kvs, &types.KeyValue{Key: keys[i], Value: value})
+		}
+		keys = nil
+		if len(kvs) > 0 {
+			err = builder.Write(kvs)
+			count += len(kvs)
+		}
+	}
+	m.lockUntilFirst(func(fn func(key, value []byte) bool) {
+		m.from.IterateRangeByStateHash(m.lastHash, nil, nil, true, fn)
+	}, func(key, value []byte) bool {
+		if err != nil || m.isQuit() {
+			return true
+		}
+		keys = append(keys, common.CopyBytes(key))
+		if len(keys) >= batchSize {
+			flush()
+		}
+		return err != nil
+	})
+	flush()
+	if err != nil {
+		return err
+	}
+	if m.isQuit() {
+		return types.ErrIsClosed
+	}
+	hash, err := builder.Finish()
+	if err != nil {
+		return err
+	}
+	m.mtx.Lock()
+	m.setHash(m.baseHash, hash)
+	m.mtx.Unlock()
+	mlog.Info("store migrate copy base state done", "height", m.baseHeight, "count", count, "hash", common.ToHex(hash))
+	return nil
+}
+
+// replay 按顺序把复制期间的区块写入目标store, 日志为空时目标store已经追上
+func (m *Store) replay() error {
+	seq := m.journalFirst
+	for !m.isQuit() {
+		m.mtx.Lock()
+		if m.status != statusBuilding {
+			m.mtx.Unlock()
+			return nil
+		}
+		end := seq + batchSize
+		if end > m.journalSeq {
+			end = m.journalSeq
+		}
+		for ; seq < end; seq++ {
+			if err := m.replayOne(seq); err != nil {
+				m.mtx.Unlock()
+				return err
+			}
+		}
+		if seq == m.journalSeq {
+			m.setStatus(statusSynced)
+			m.verifying = true
+			m.mtx.Unlock()
+			mlog.Info("store migrate synced", "height", m.lastHeight)
+			return nil
+		}
+		m.mtx.Unlock()
+	}
+	return types.ErrIsClosed
+}
+
+func (m *Store) replayOne(seq int64) error {
+	key := journalKey(seq)
+	value, err := m.GetDB().Get(key)
+	if err != nil || len(value) == 0 {
+		return types.ErrNotFound
+	}
+	switch value[0] {
+	case opSet:
+		var kv types.KeyValue
+		var datas types.StoreSet
+		if err = types.Decode(value[1:], &kv); err != nil {
+			return err
+		}
+		if err = types.Decode(kv.Value, &datas); err != nil {
+			return err
+		}
+		hash, err := m.to.Set(m.toTarget(&datas), true)
+		if err != nil {
+			return err
+		}
+		m.setHash(kv.Key, hash)
+	case opDel:
+		var req types.StoreDel
+		if err = types.Decode(value[1:], &req); err != nil {
+			return err
+		}
+		if target := m.getHash(req.StateHash); target != nil {
+			if _, err = m.to.Del(&types.StoreDel{StateHash: target, Height: req.Height}); err != nil {
+				return err
+			}
+			if err = m.GetDB().Delete(hashKey(req.StateHash)); err != nil {
+				return err
+			}
+		}
+	}
+	return m.GetDB().Delete(key)
+}
+
+// verify 逐个key比较两个store的最新状态, 先遍历源store, 再遍历目标store
+func (m *Store) verify() {
+	mlog.Info("store migrate verify")
+	count, err := m.compare(true)
+	if err == nil {
+		count, err = m.compare(false)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err == types.ErrIsClosed {
+		return
+	}
+	if err != nil {
+		m.fail("verify", err)
+		return
+	}
+	if m.status == statusSynced {
+		m.setStatus(statusVerified)
+		mlog.Info("store migrate verified", "count", count, "height", m.lastHeight)
+	}
+}
+
+// compare 遍历一个store的最新状态, 同一批key在两个store对应的状态中读出的值必须相同
+func (m *Store) compare(fromSide bool) (int, error) {
+	var from, to []byte
+	var keys [][]byte
+	var err error
+	var count int
+	check := func() {
+		if err != nil || len(keys) == 0 {
+			return
+		}
+		fromValues := m.from.Get(&types.StoreGet{StateHash: from, Keys: keys})
+		toValues := m.to.Get(&types.StoreGet{StateHash: to, Keys: keys})
+		for i := range keys {
+			if !bytes.Equal(fromValues[i], toValues[i]) {
+				mlog.Error("store migrate verify", "key", string(keys[i]), "from", common.ToHex(fromValues[i]), "to", common.ToHex(toValues[i]))
+				err = ErrVerifyFailed
+				return
+			}
+		}
+		count += len(keys)
+		keys = nil
+	}
+	m.lockUntilFirst(func(fn func(key, value []byte) bool) {
+		from = m.lastHash
+		to = m.getHash(from)
+		if to == nil {
+			err = types.ErrHashNotFound
+			return
+		}
+		if fromSide {
+			m.from.IterateRangeByStateHash(from, nil, nil, true, fn)
+		} else {
+			m.to.IterateRangeByStateHash(to, nil, nil, true, fn)
+		}
+	}, func(key, value []byte) bool {
+		if err != nil || m.isQuit() {
+			return true
+		}
+		if len(value) == 0 {
+			return false
+		}
+		keys = append(keys, common.CopyBytes(key))
+		if len(keys) >= batchSize {
+			check()
+		}
+		return err != nil
+	})
+	check()
+	if err == nil && m.isQuit() {
+		err = types.ErrIsClosed
+	}
+	return count, err
+}
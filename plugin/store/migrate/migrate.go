@@ -0,0 +1,473 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package migrate 节点正常出块的同时在后台把状态迁移到另一种store, 到达切换高度后使用新的store
+package migrate
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbm "github.com/33cn/chain33/common/db"
+	clog "github.com/33cn/chain33/common/log"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+/*
+迁移过程:
+1. 启动后第一个提交的区块作为基准, 之后提交和删除的区块按顺序记录到日志中
+2. 基准区块有足够的确认数后, 在后台把基准状态复制到目标store
+3. 按顺序把日志重放到目标store, 追上之后新的区块同时写入两个store
+4. 逐个key比较源store和目标store的状态, 一致后等待切换高度
+5. 切换高度及之后的区块只写入目标store, 区块的状态hash是目标store的状态hash
+
+源状态hash和目标状态hash的对应关系保存在迁移数据库中, 切换之前的区块回滚时同时回滚两个store
+复制没有完成时节点重启, 清空目标store重新复制基准状态, 日志保留; 复制完成之后重启接着重放日志
+到达切换高度时迁移没有开始或者已经失败, 区块执行返回错误, 查询继续使用源store;
+还在复制或者校验时最多等待switchWait秒, 超时同样返回错误, 校验完成后区块重新执行时再切换
+*/
+
+var mlog = log.New("module", "store.migrate")
+
+const (
+	statusNone     = 0 // 还没有开始
+	statusBuilding = 1 // 正在复制状态, 新的区块记录到日志
+	statusSynced   = 2 // 目标store已经追上, 新的区块同时写入两个store
+	statusVerified = 3 // 两个store状态一致, 等待切换高度
+	statusSwitched = 4 // 已经切换到目标store
+	statusFailed   = 5 // 迁移失败, 不能切换, 删除迁移数据目录后重新开始
+
+	defaultConfirmations = 100
+	defaultSwitchWait    = 60
+	cacheSize            = 2048
+)
+
+// SetLogLevel set log level
+func SetLogLevel(level string) {
+	clog.SetLogLevel(level)
+}
+
+// DisableLog disable log output
+func DisableLog() {
+	mlog.SetHandler(log.DiscardHandler())
+}
+
+func init() {
+	drivers.Reg("migrate", New)
+}
+
+type subConfig struct {
+	// 源store, 使用store配置中的数据目录
+	From    string          `json:"from"`
+	FromSub json.RawMessage `json:"fromSub"`
+	// 目标store, 数据目录是store数据目录加上"-"和目标store名字
+	To    string          `json:"to"`
+	ToSub json.RawMessage `json:"toSub"`
+	// 从这个高度开始区块的状态hash是目标store的状态hash
+	SwitchHeight int64 `json:"switchHeight"`
+	// 基准区块需要的确认数, 复制开始后不能回滚到基准区块之前
+	Confirmations int64 `json:"confirmations"`
+	// 到达切换高度时还在复制或者校验, 最多等待的秒数
+	SwitchWait int64 `json:"switchWait"`
+}
+
+// Store 迁移store, 包装源store和目标store
+type Store struct {
+	*drivers.BaseStore
+	from          drivers.SubStore
+	to            drivers.SubStore
+	fromModule    queue.Module
+	toModule      queue.Module
+	switchHeight  int64
+	confirmations int64
+	switchWait    time.Duration
+
+	mtx        sync.Mutex
+	status     int32
+	pending    map[string]*pendingSet
+	lastPrev   []byte
+	lastHash   []byte
+	lastHeight int64
+	// 基准区块, 复制开始后不再改变
+	basePrev   []byte
+	baseHash   []byte
+	baseHeight int64
+	copying    bool
+	verifying  bool
+	// 日志中第一条没有重放的序号和下一条的序号
+	journalFirst int64
+	journalSeq   int64
+
+	// 切换之后区分状态hash属于源store还是目标store
+	switched int32
+	cache    *lru.Cache
+	quit     int32
+	wg       sync.WaitGroup
+}
+
+// pendingSet MemSet之后等待Commit的区块
+type pendingSet struct {
+	datas *types.StoreSet
+	// 目标store中的状态hash, 同时写入两个store时有效
+	hash []byte
+	// 切换之后只写入目标store
+	target bool
+}
+
+// New new migrate store module
+func New(cfg *types.Store, sub []byte) queue.Module {
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.From == "" || subcfg.To == "" || subcfg.From == subcfg.To || subcfg.SwitchHeight <= 0 {
+		panic("store migrate: from, to and switchHeight must be set")
+	}
+	// kvdb只有最新的状态, 不能按状态hash读取历史状态进行校验
+	if subcfg.From == "kvdb" || subcfg.To == "kvdb" {
+		panic("store migrate: kvdb is not supported")
+	}
+	checkMVCCIter(subcfg.From, subcfg.FromSub)
+	checkMVCCIter(subcfg.To, subcfg.ToSub)
+	if subcfg.Confirmations <= 0 {
+		subcfg.Confirmations = defaultConfirmations
+	}
+	if subcfg.SwitchWait <= 0 {
+		subcfg.SwitchWait = defaultSwitchWait
+	}
+
+	metaCfg := *cfg
+	metaCfg.DbPath = cfg.DbPath + "-migrate"
+	toCfg := *cfg
+	toCfg.DbPath = cfg.DbPath + "-" + subcfg.To
+	bs := drivers.NewBaseStore(&metaCfg)
+	status := loadStatus(bs.GetDB())
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	m := &Store{
+		BaseStore:     bs,
+		switchHeight:  subcfg.SwitchHeight,
+		confirmations: subcfg.Confirmations,
+		switchWait:    time.Duration(subcfg.SwitchWait) * time.Second,
+		status:        status,
+		pending:       make(map[string]*pendingSet),
+		cache:         cache,
+	}
+	m.loadBase()
+	if status == statusBuilding {
+		mlog.Info("store migrate restart during the build", "baseHeight", m.baseHeight, "copied", m.copied(),
+			"journal", m.journalSeq-m.journalFirst)
+		// 复制基准状态没有完成, 目标store中只有部分状态
+		if !m.copied() {
+			clearStoreDB(&toCfg)
+		}
+	}
+	m.fromModule, m.from = newSubStore(subcfg.From, cfg, subcfg.FromSub)
+	m.toModule, m.to = newSubStore(subcfg.To, &toCfg, subcfg.ToSub)
+	if status == statusSwitched {
+		m.switched = 1
+	}
+	mlog.Info("store migrate", "from", subcfg.From, "to", subcfg.To, "switchHeight", m.switchHeight, "status", status)
+	bs.SetChild(m)
+	return m
+}
+
+func newSubStore(name string, cfg *types.Store, sub json.RawMessage) (queue.Module, drivers.SubStore) {
+	create, err := drivers.Load(name)
+	if err != nil {
+		panic("store migrate: unsupported store " + name)
+	}
+	if len(sub) == 0 {
+		sub = nil
+	}
+	module := create(cfg, sub)
+	store, ok := module.(drivers.SubStore)
+	if !ok {
+		panic("store migrate: store " + name + " is not a sub store")
+	}
+	return module, store
+}
+
+// checkMVCCIter kvmvcc只有开启enableMVCCIter才能遍历状态
+func checkMVCCIter(name string, sub json.RawMessage) {
+	if name != "kvmvcc" && name != "kvmvccmavl" {
+		return
+	}
+	var cfg struct {
+		EnableMVCCIter bool `json:"enableMVCCIter"`
+	}
+	if len(sub) > 0 {
+		types.MustDecode(sub, &cfg)
+	}
+	if !cfg.EnableMVCCIter {
+		panic("store migrate: " + name + " must enable enableMVCCIter")
+	}
+}
+
+// Close close migrate store
+func (m *Store) Close() {
+	atomic.StoreInt32(&m.quit, 1)
+	m.wg.Wait()
+	m.BaseStore.Close()
+	m.fromModule.Close()
+	m.toModule.Close()
+	mlog.Info("store migrate closed")
+}
+
+func (m *Store) isQuit() bool {
+	return atomic.LoadInt32(&m.quit) == 1
+}
+
+func (m *Store) isSwitched() bool {
+	return atomic.LoadInt32(&m.switched) == 1
+}
+
+// fromHash 切换之后判断状态hash是否是切换之前源store的状态hash
+func (m *Store) fromHash(hash []byte) bool {
+	if !m.isSwitched() {
+		return true
+	}
+	if value, ok := m.cache.Get(string(hash)); ok {
+		return value.(bool)
+	}
+	from := m.getHash(hash) != nil
+	m.cache.Add(string(hash), from)
+	return from
+}
+
+// toTarget 把父状态hash换成目标store中的状态hash
+func (m *Store) toTarget(datas *types.StoreSet) *types.StoreSet {
+	prev := m.getHash(datas.StateHash)
+	if prev == nil {
+		prev = datas.StateHash
+	}
+	return &types.StoreSet{StateHash: prev, KV: datas.KV, Height: datas.Height}
+}
+
+// waitSwitch 到达切换高度时必须已经校验通过, 还在复制或者校验时最多等待switchWait
+// 迁移没有开始, 已经失败或者等待超时返回错误, 不切换store
+func (m *Store) waitSwitch() error {
+	deadline := time.Now().Add(m.switchWait)
+	for i := 0; ; i++ {
+		m.mtx.Lock()
+		status := m.status
+		m.mtx.Unlock()
+		switch status {
+		case statusVerified, statusSwitched:
+			return nil
+		case statusNone, statusFailed:
+			mlog.Error("store migrate: migration not finished before the switch height, can not switch store", "status", status)
+			return ErrMigrateNotReady
+		}
+		if m.isQuit() {
+			return types.ErrIsClosed
+		}
+		if time.Now().After(deadline) {
+			mlog.Error("store migrate: migration not verified in time before the switch height, can not switch store", "status", status)
+			return ErrMigrateNotReady
+		}
+		if i%60 == 0 {
+			mlog.Info("store migrate: waiting for migration before the switch height", "status", status)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (m *Store) setSwitched() {
+	if m.isSwitched() {
+		return
+	}
+	m.mtx.Lock()
+	m.setStatus(statusSwitched)
+	m.mtx.Unlock()
+	atomic.StoreInt32(&m.switched, 1)
+	mlog.Info("store migrate: switched to the target store")
+}
+
+// Set set kvs to the active store
+func (m *Store) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
+	if datas.Height >= m.switchHeight {
+		if err := m.waitSwitch(); err != nil {
+			return nil, err
+		}
+		m.setSwitched()
+		return m.to.Set(m.toTarget(datas), sync)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	hash, err := m.from.Set(datas, sync)
+	if err != nil {
+		return nil, err
+	}
+	m.committed(datas, hash, nil)
+	return hash, nil
+}
+
+// Get get values by keys
+func (m *Store) Get(datas *types.StoreGet) [][]byte {
+	if m.fromHash(datas.StateHash) {
+		return m.from.Get(datas)
+	}
+	return m.to.Get(datas)
+}
+
+// MemSet set kvs to the mem of the active store, write the target store too when synced
+func (m *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
+	if datas.Height >= m.switchHeight {
+		if err := m.waitSwitch(); err != nil {
+			return nil, err
+		}
+		hash, err := m.to.MemSet(m.toTarget(datas), sync)
+		if err != nil {
+			return nil, err
+		}
+		m.mtx.Lock()
+		m.pending[string(hash)] = &pendingSet{datas: datas, target: true}
+		m.mtx.Unlock()
+		return hash, nil
+	}
+	hash, err := m.from.MemSet(datas, sync)
+	if err != nil {
+		return nil, err
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	pend := &pendingSet{datas: datas}
+	if m.dualWrite() {
+		pend.hash, err = m.to.MemSet(m.toTarget(datas), sync)
+		if err != nil {
+			m.fail("target memset", err)
+		}
+	}
+	m.pending[string(hash)] = pend
+	return hash, nil
+}
+
+// Commit commit the mem kvs of the store that MemSet wrote
+func (m *Store) Commit(req *types.ReqHash) ([]byte, error) {
+	m.mtx.Lock()
+	pend := m.pending[string(req.Hash)]
+	delete(m.pending, string(req.Hash))
+	if pend != nil && pend.target {
+		m.mtx.Unlock()
+		hash, err := m.to.Commit(req)
+		if err == nil {
+			m.setSwitched()
+		}
+		return hash, err
+	}
+	defer m.mtx.Unlock()
+	hash, err := m.from.Commit(req)
+	if err != nil || pend == nil {
+		return hash, err
+	}
+	m.committed(pend.datas, hash, pend.hash)
+	return hash, nil
+}
+
+// Rollback rollback the mem kvs of the store that MemSet wrote
+func (m *Store) Rollback(req *types.ReqHash) ([]byte, error) {
+	m.mtx.Lock()
+	pend := m.pending[string(req.Hash)]
+	delete(m.pending, string(req.Hash))
+	m.mtx.Unlock()
+	if pend != nil && pend.target {
+		return m.to.Rollback(req)
+	}
+	if pend != nil && pend.hash != nil {
+		m.to.Rollback(&types.ReqHash{Hash: pend.hash})
+	}
+	return m.from.Rollback(req)
+}
+
+// Del 回滚区块, 切换之前的区块同时回滚两个store
+func (m *Store) Del(req *types.StoreDel) ([]byte, error) {
+	if req.Height >= m.switchHeight {
+		return m.to.Del(req)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	hash, err := m.from.Del(req)
+	if err != nil {
+		return nil, err
+	}
+	m.deleted(req)
+	return hash, nil
+}
+
+// MemSetUpgrade 数据升级只在当前使用的store中进行, 不参与迁移
+func (m *Store) MemSetUpgrade(datas *types.StoreSet, sync bool) ([]byte, error) {
+	if datas.Height >= m.switchHeight {
+		hash, err := m.to.MemSetUpgrade(m.toTarget(datas), sync)
+		if err == nil {
+			m.mtx.Lock()
+			m.pending[string(hash)] = &pendingSet{datas: datas, target: true}
+			m.mtx.Unlock()
+		}
+		return hash, err
+	}
+	return m.from.MemSetUpgrade(datas, sync)
+}
+
+// CommitUpgrade 数据升级只在当前使用的store中进行, 不参与迁移
+func (m *Store) CommitUpgrade(req *types.ReqHash) ([]byte, error) {
+	m.mtx.Lock()
+	pend := m.pending[string(req.Hash)]
+	delete(m.pending, string(req.Hash))
+	m.mtx.Unlock()
+	if pend != nil && pend.target {
+		return m.to.CommitUpgrade(req)
+	}
+	return m.from.CommitUpgrade(req)
+}
+
+// IterateRangeByStateHash 在状态hash所属的store中遍历
+func (m *Store) IterateRangeByStateHash(statehash []byte, start []byte, end []byte, ascending bool, fn func(key, value []byte) bool) {
+	if m.fromHash(statehash) {
+		m.from.IterateRangeByStateHash(statehash, start, end, ascending, fn)
+		return
+	}
+	m.to.IterateRangeByStateHash(statehash, start, end, ascending, fn)
+}
+
+// ProcEvent 其它消息交给当前使用的store处理
+func (m *Store) ProcEvent(msg *queue.Message) {
+	if msg == nil {
+		return
+	}
+	if m.isSwitched() {
+		m.to.ProcEvent(msg)
+		return
+	}
+	m.from.ProcEvent(msg)
+}
+
+func clearStoreDB(cfg *types.Store) {
+	db := dbm.NewDB("store", cfg.Driver, cfg.DbPath, cfg.DbCache)
+	clearDB(db)
+	db.Close()
+}
+
+// clearDB 删除数据库中所有的数据
+func clearDB(db dbm.DB) {
+	it := db.Iterator(nil, nil, false)
+	defer it.Close()
+	batch := db.NewBatch(true)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(it.Key())
+		if batch.ValueSize() > 1024*1024 {
+			dbm.MustWrite(batch)
+			batch.Reset()
+		}
+	}
+	dbm.MustWrite(batch)
+}
@@ -0,0 +1,215 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package migrate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	_ "github.com/33cn/plugin/plugin/store/kvmvcc"
+	"github.com/33cn/plugin/plugin/store/mpt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSub = `{"from":"kvmvcc","fromSub":{"enableMVCCIter":true},"to":"mpt","switchHeight":30,"confirmations":5}`
+
+func newTestStore(t *testing.T, dir string, sub string) *Store {
+	cfg := &types.Store{Name: "migrate_test", Driver: "leveldb", DbPath: dir, DbCache: 100}
+	return New(cfg, []byte(sub)).(*Store)
+}
+
+func genKVs(height int64) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for i := 0; i < 5; i++ {
+		kvs = append(kvs, &types.KeyValue{
+			Key:   []byte(fmt.Sprintf("mavl-coins-bty-%02d", (height*3+int64(i))%40)),
+			Value: []byte(fmt.Sprintf("v%d-%d", height, i)),
+		})
+	}
+	return kvs
+}
+
+func commit(t *testing.T, store drivers.SubStore, prev []byte, height int64, kvs []*types.KeyValue) []byte {
+	hash, err := store.MemSet(&types.StoreSet{StateHash: prev, KV: kvs, Height: height}, true)
+	require.Nil(t, err)
+	_, err = store.Commit(&types.ReqHash{Hash: hash})
+	require.Nil(t, err)
+	return hash
+}
+
+func waitStatus(t *testing.T, m *Store, status int32) {
+	for i := 0; i < 100; i++ {
+		m.mtx.Lock()
+		current := m.status
+		m.mtx.Unlock()
+		if current == status {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("wait status timeout", status)
+}
+
+func TestMigrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	refDir, err := ioutil.TempDir("", "migrate-ref")
+	require.Nil(t, err)
+	defer os.RemoveAll(refDir)
+
+	m := newTestStore(t, dir, testSub)
+	defer m.Close()
+	refModule := mpt.New(&types.Store{Name: "ref", Driver: "leveldb", DbPath: refDir, DbCache: 100}, nil)
+	defer refModule.Close()
+	ref := refModule.(drivers.SubStore)
+
+	hash, err := m.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: genKVs(0), Height: 0}, true)
+	require.Nil(t, err)
+	refHash, err := ref.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: genKVs(0), Height: 0}, true)
+	require.Nil(t, err)
+	hashes := [][]byte{hash}
+	height := int64(1)
+	for ; height < 10; height++ {
+		hash = commit(t, m, hash, height, genKVs(height))
+		refHash = commit(t, ref, refHash, height, genKVs(height))
+		hashes = append(hashes, hash)
+	}
+	waitStatus(t, m, statusVerified)
+
+	// 切换之前回滚区块, 两个store同时回滚
+	forked := commit(t, m, hash, height, []*types.KeyValue{{Key: []byte("mavl-coins-bty-fork"), Value: []byte("fork")}})
+	_, err = m.Del(&types.StoreDel{StateHash: forked, Height: height})
+	require.Nil(t, err)
+	assert.Nil(t, m.getHash(forked))
+
+	for ; height < 35; height++ {
+		hash = commit(t, m, hash, height, genKVs(height))
+		refHash = commit(t, ref, refHash, height, genKVs(height))
+		hashes = append(hashes, hash)
+		if height >= m.switchHeight {
+			// 切换之后的状态hash和直接使用目标store一样
+			assert.Equal(t, refHash, hash)
+		} else {
+			assert.NotEqual(t, refHash, hash)
+		}
+	}
+	assert.Equal(t, int32(statusSwitched), m.status)
+	assert.True(t, m.isSwitched())
+
+	// 切换之前和之后的状态都可以读取
+	key := genKVs(5)[0].Key
+	values := m.Get(&types.StoreGet{StateHash: hashes[5], Keys: [][]byte{key}})
+	assert.Equal(t, genKVs(5)[0].Value, values[0])
+	values = m.Get(&types.StoreGet{StateHash: hash, Keys: [][]byte{genKVs(34)[0].Key, []byte("mavl-coins-bty-fork")}})
+	assert.Equal(t, genKVs(34)[0].Value, values[0])
+	assert.Nil(t, values[1])
+	count := 0
+	m.IterateRangeByStateHash(hash, nil, nil, true, func(key, value []byte) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 40, count)
+}
+
+func TestMigrateRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	sub := `{"from":"kvmvcc","fromSub":{"enableMVCCIter":true},"to":"mpt","switchHeight":30,"confirmations":100}`
+	m := newTestStore(t, dir, sub)
+	hash, err := m.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: genKVs(0), Height: 0}, true)
+	require.Nil(t, err)
+	// 基准区块之后没有足够的区块, 不能在切换高度之前完成迁移
+	assert.Equal(t, int32(statusFailed), m.status)
+	m.Close()
+
+	m = newTestStore(t, dir, testSub)
+	assert.Equal(t, int32(statusFailed), m.status)
+	for height := int64(1); height < m.switchHeight; height++ {
+		hash = commit(t, m, hash, height, genKVs(height))
+	}
+	// 到达切换高度时返回错误, 继续使用源store
+	_, err = m.MemSet(&types.StoreSet{StateHash: hash, KV: genKVs(30), Height: 30}, true)
+	assert.Equal(t, ErrMigrateNotReady, err)
+	assert.False(t, m.isSwitched())
+	values := m.Get(&types.StoreGet{StateHash: hash, Keys: [][]byte{genKVs(29)[0].Key}})
+	assert.Equal(t, genKVs(29)[0].Value, values[0])
+	m.Close()
+}
+
+func TestMigrateResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// 复制没有开始时重启, 保留基准区块和日志
+	m := newTestStore(t, dir, testSub)
+	hash, err := m.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: genKVs(0), Height: 0}, true)
+	require.Nil(t, err)
+	hash = commit(t, m, hash, 1, genKVs(1))
+	assert.Equal(t, int32(statusBuilding), m.status)
+	m.Close()
+	m = newTestStore(t, dir, testSub)
+	assert.Equal(t, int32(statusBuilding), m.status)
+	assert.Equal(t, int64(0), m.baseHeight)
+	assert.Equal(t, int64(1), m.journalSeq)
+	hash = commit(t, m, hash, 2, genKVs(2))
+
+	// 复制完成之后重启, 接着重放日志
+	m.lastHash = hash
+	require.Nil(t, m.copyBase())
+	m.Close()
+	m = newTestStore(t, dir, testSub)
+	defer m.Close()
+	assert.Equal(t, int32(statusBuilding), m.status)
+	assert.True(t, m.copied())
+	assert.Equal(t, int64(0), m.journalFirst)
+	assert.Equal(t, int64(2), m.journalSeq)
+	for height := int64(3); height < 10; height++ {
+		hash = commit(t, m, hash, height, genKVs(height))
+	}
+	waitStatus(t, m, statusVerified)
+	assert.Equal(t, int64(0), m.baseHeight)
+}
+
+func TestMigrateSwitchWait(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	sub := `{"from":"kvmvcc","fromSub":{"enableMVCCIter":true},"to":"mpt","switchHeight":30,"confirmations":5,"switchWait":1}`
+	m := newTestStore(t, dir, sub)
+	defer m.Close()
+	hash, err := m.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: genKVs(0), Height: 0}, true)
+	require.Nil(t, err)
+	hash = commit(t, m, hash, 1, genKVs(1))
+	assert.Equal(t, int32(statusBuilding), m.status)
+	// 基准区块没有足够的确认数, 复制不会开始, 等待超时后返回错误
+	start := time.Now()
+	_, err = m.MemSet(&types.StoreSet{StateHash: hash, KV: genKVs(30), Height: 30}, true)
+	assert.Equal(t, ErrMigrateNotReady, err)
+	assert.True(t, time.Since(start) >= time.Second)
+	assert.False(t, m.isSwitched())
+	values := m.Get(&types.StoreGet{StateHash: hash, Keys: [][]byte{genKVs(1)[0].Key}})
+	assert.Equal(t, genKVs(1)[0].Value, values[0])
+}
+
+func TestMigrateConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Panics(t, func() { newTestStore(t, dir, `{"from":"kvdb","to":"mpt","switchHeight":30}`) })
+	assert.Panics(t, func() { newTestStore(t, dir, `{"from":"kvmvcc","to":"mpt","switchHeight":30}`) })
+	assert.Panics(t, func() { newTestStore(t, dir, `{"from":"mpt","to":"mpt","switchHeight":30}`) })
+	assert.Panics(t, func() { newTestStore(t, dir, `{"from":"kvmvcc","fromSub":{"enableMVCCIter":true},"to":"mpt"}`) })
+}
@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/spf13/cobra"
+)
+
+// Cmd mpt store 命令行
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mpt",
+		Short: "Mpt state store",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		ProofCmd(),
+	)
+	return cmd
+}
+
+// ProofCmd 获取并校验状态证明
+func ProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "Get the value of a state key with its merkle proof, verified against the state hash",
+		Run:   getStateProof,
+	}
+	cmd.Flags().StringP("state", "s", "", "state hash of the block, hex")
+	cmd.MarkFlagRequired("state")
+	cmd.Flags().StringP("key", "k", "", "state key")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func getStateProof(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	state, _ := cmd.Flags().GetString("state")
+	key, _ := cmd.Flags().GetString("key")
+	stateHash, err := common.FromHex(state)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	client, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res StateProofResult
+	err = client.Call("mpt.GetStateProof", &ReqGetStateProof{StateHash: state, Key: key}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	// 不信任节点, 用本地的状态hash校验
+	if _, err = VerifyStateProof(stateHash, &res); err != nil {
+		fmt.Fprintln(os.Stderr, "verify proof failed:", err)
+		return
+	}
+	data, err := json.MarshalIndent(res, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
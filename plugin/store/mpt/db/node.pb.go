@@ -410,33 +410,148 @@ func (m *ValueNode) GetValue() []byte {
 	return nil
 }
 
+// ReqStateProof 查询状态hash下key的证明
+type ReqStateProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStateProof) Reset()         { *m = ReqStateProof{} }
+func (m *ReqStateProof) String() string { return proto.CompactTextString(m) }
+func (*ReqStateProof) ProtoMessage()    {}
+func (*ReqStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_001a9bd628f8d256, []int{5}
+}
+func (m *ReqStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStateProof.Unmarshal(m, b)
+}
+func (m *ReqStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStateProof.Marshal(b, m, deterministic)
+}
+func (dst *ReqStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStateProof.Merge(dst, src)
+}
+func (m *ReqStateProof) XXX_Size() int {
+	return xxx_messageInfo_ReqStateProof.Size(m)
+}
+func (m *ReqStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStateProof proto.InternalMessageInfo
+
+func (m *ReqStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReqStateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// StateProof 状态证明, proof为从根节点到key路径上的节点编码, key不存在时value为空
+type StateProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_001a9bd628f8d256, []int{6}
+}
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (dst *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(dst, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StateProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Node)(nil), "mpt.Node")
 	proto.RegisterType((*FullNode)(nil), "mpt.FullNode")
 	proto.RegisterType((*ShortNode)(nil), "mpt.ShortNode")
 	proto.RegisterType((*HashNode)(nil), "mpt.HashNode")
 	proto.RegisterType((*ValueNode)(nil), "mpt.ValueNode")
+	proto.RegisterType((*ReqStateProof)(nil), "mpt.ReqStateProof")
+	proto.RegisterType((*StateProof)(nil), "mpt.StateProof")
 }
 
 func init() { proto.RegisterFile("node.proto", fileDescriptor_node_001a9bd628f8d256) }
 
 var fileDescriptor_node_001a9bd628f8d256 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x75, 0xb3, 0xd9, 0xda, 0x4c, 0xb5, 0xc8, 0xe0, 0x61, 0x41, 0xd0, 0xb8, 0x82, 0x04, 0x84,
-	0x1c, 0xea, 0xcd, 0xa3, 0x07, 0xe9, 0xc9, 0x43, 0x2c, 0xde, 0x23, 0x59, 0x89, 0xb8, 0xed, 0x86,
-	0x26, 0x29, 0xf6, 0x1b, 0xfd, 0xa9, 0x32, 0xbb, 0x9b, 0x90, 0xdb, 0xce, 0x7b, 0x6f, 0xde, 0xbc,
-	0x97, 0x00, 0xec, 0x6c, 0xa5, 0xf3, 0x66, 0x6f, 0x3b, 0x8b, 0x7c, 0xdb, 0x74, 0xea, 0x9f, 0x41,
-	0xfc, 0x6e, 0x2b, 0x8d, 0x0f, 0x10, 0x7f, 0xf7, 0xc6, 0xc8, 0x28, 0x65, 0xd9, 0x62, 0x75, 0x99,
-	0x6f, 0x9b, 0x2e, 0x7f, 0xeb, 0x8d, 0x21, 0x72, 0x7d, 0x56, 0x38, 0x12, 0x1f, 0x41, 0xb4, 0xb5,
-	0xdd, 0x77, 0x92, 0x3b, 0xd5, 0xd2, 0xa9, 0x3e, 0x08, 0x09, 0x32, 0x4f, 0x93, 0x59, 0x5d, 0xb6,
-	0xb5, 0x8c, 0x27, 0x66, 0xeb, 0xb2, 0xad, 0x07, 0x33, 0x22, 0x51, 0x01, 0x3f, 0x94, 0x46, 0x8a,
-	0x89, 0xd5, 0x67, 0x69, 0x7a, 0x1d, 0x44, 0x44, 0xe2, 0x12, 0xa2, 0xcd, 0x51, 0xb2, 0x94, 0x65,
-	0xa2, 0x88, 0x36, 0x47, 0xbc, 0x06, 0xf1, 0xb3, 0xab, 0xf4, 0x9f, 0x9c, 0x39, 0xc8, 0x0f, 0xaf,
-	0xe7, 0x20, 0x0e, 0xb4, 0xa9, 0x9e, 0x60, 0x3e, 0x64, 0xc6, 0x3b, 0x10, 0x54, 0xb6, 0x95, 0x2c,
-	0xe5, 0xd9, 0x62, 0x95, 0xb8, 0x03, 0xc4, 0x14, 0x1e, 0x57, 0x2f, 0x90, 0x8c, 0xd1, 0xf1, 0x0a,
-	0xf8, 0xaf, 0xf6, 0x97, 0x2e, 0x0a, 0x7a, 0xe2, 0x8d, 0x8f, 0xe7, 0xbf, 0xc7, 0x64, 0x9b, 0x50,
-	0x75, 0x0b, 0xf3, 0xa1, 0x0f, 0x62, 0x28, 0xeb, 0x77, 0xdd, 0x5b, 0xdd, 0x43, 0x32, 0x76, 0xa1,
-	0xd0, 0x2e, 0x5e, 0x50, 0xf8, 0xe1, 0x6b, 0xe6, 0xfe, 0xc2, 0xf3, 0x29, 0x00, 0x00, 0xff, 0xff,
-	0x42, 0x0c, 0x0a, 0xa4, 0x93, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4b, 0xc3, 0x30,
+	0x14, 0xc6, 0x6d, 0xd3, 0xcc, 0xf5, 0xcd, 0x0d, 0x79, 0xec, 0x10, 0x50, 0xb4, 0x46, 0x90, 0x81,
+	0xb0, 0xc3, 0xbc, 0x79, 0x11, 0x3c, 0xc8, 0x4e, 0x22, 0xdd, 0xf0, 0x5e, 0x59, 0x46, 0xc5, 0x6c,
+	0xa9, 0x6b, 0x36, 0xdc, 0xdf, 0xe8, 0x3f, 0x25, 0x2f, 0x49, 0xb7, 0x9e, 0xbd, 0x25, 0xdf, 0xf7,
+	0xbd, 0x2f, 0xbf, 0x57, 0x0a, 0xb0, 0x36, 0x0b, 0x35, 0xae, 0x36, 0xc6, 0x1a, 0x64, 0xab, 0xca,
+	0xca, 0xdf, 0x08, 0x92, 0x57, 0xb3, 0x50, 0x78, 0x0b, 0xc9, 0x72, 0xab, 0xb5, 0x88, 0xb3, 0x68,
+	0xd4, 0x9b, 0xf4, 0xc7, 0xab, 0xca, 0x8e, 0x5f, 0xb6, 0x5a, 0x93, 0x39, 0x3d, 0xc9, 0x9d, 0x89,
+	0x77, 0xc0, 0xeb, 0xd2, 0x6c, 0xac, 0x60, 0x2e, 0x35, 0x70, 0xa9, 0x19, 0x29, 0x21, 0xe6, 0x6d,
+	0x2a, 0x2b, 0x8b, 0xba, 0x14, 0x49, 0xab, 0x6c, 0x5a, 0xd4, 0x65, 0x53, 0x46, 0x26, 0x4a, 0x60,
+	0xbb, 0x42, 0x0b, 0xde, 0xaa, 0x7a, 0x2f, 0xf4, 0x56, 0x85, 0x10, 0x99, 0x38, 0x80, 0x78, 0xbe,
+	0x17, 0x51, 0x16, 0x8d, 0x78, 0x1e, 0xcf, 0xf7, 0x38, 0x04, 0xfe, 0xb9, 0x5e, 0xa8, 0x1f, 0xd1,
+	0x71, 0x92, 0xbf, 0x3c, 0x9f, 0x02, 0xdf, 0xd1, 0xa4, 0xbc, 0x87, 0x6e, 0xc3, 0x8c, 0xd7, 0xc0,
+	0x69, 0xd9, 0x5a, 0x44, 0x19, 0x1b, 0xf5, 0x26, 0xa9, 0x7b, 0x80, 0x9c, 0xdc, 0xeb, 0xf2, 0x11,
+	0xd2, 0x03, 0x3a, 0x9e, 0x03, 0xfb, 0x52, 0xfe, 0xa5, 0xb3, 0x9c, 0x8e, 0x78, 0xe1, 0xf1, 0xfc,
+	0xf7, 0x68, 0x4d, 0x93, 0x2a, 0xaf, 0xa0, 0xdb, 0xec, 0x83, 0x18, 0x96, 0xf5, 0xb3, 0xee, 0x2c,
+	0x6f, 0x20, 0x3d, 0xec, 0x82, 0xc3, 0x80, 0x17, 0x12, 0x81, 0xf5, 0x09, 0xfa, 0xb9, 0xfa, 0x9e,
+	0xd9, 0xc2, 0xaa, 0xb7, 0x8d, 0x31, 0x4b, 0xbc, 0x84, 0xb4, 0xa6, 0xdb, 0xf4, 0x58, 0x76, 0x14,
+	0x1a, 0xc0, 0xf8, 0x00, 0x28, 0x4b, 0x80, 0xff, 0x4f, 0x1f, 0xa1, 0x58, 0x0b, 0x8a, 0xd4, 0x8a,
+	0xea, 0x44, 0x92, 0x31, 0x52, 0xdd, 0xe5, 0xa3, 0xe3, 0x7e, 0x98, 0x87, 0xbf, 0x01, 0x00, 0x78,
+	0xfb, 0x07, 0xe2, 0x3e, 0x02, 0x00, 0x00,
 }
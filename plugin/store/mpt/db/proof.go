@@ -22,6 +22,7 @@ package mpt
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/33cn/chain33/common"
@@ -101,6 +102,64 @@ func (t *SecureTrie) Prove(key []byte, fromLevel uint, proofDb dbm.DB) error {
 	return t.trie.Prove(key, fromLevel, proofDb)
 }
 
+// ErrStateProofValue the value in the state proof doesn't match the proved one
+var ErrStateProofValue = errors.New("ErrStateProofValue")
+
+// Prove constructs a merkle proof for key, the key is hashed first when enableSecure
+func (t *TrieEx) Prove(key []byte, fromLevel uint, proofDb dbm.DB) error {
+	if enableSecure {
+		key = common.Sha3(key)
+	}
+	return t.Trie.Prove(key, fromLevel, proofDb)
+}
+
+// GetStateProof returns the value of key with the encoded nodes on its path,
+// which prove the value (or its absence) against the root hash of the trie.
+func (t *TrieEx) GetStateProof(key []byte) (*StateProof, error) {
+	value, err := t.TryGet(key)
+	if err != nil {
+		return nil, err
+	}
+	proofDb, err := dbm.NewGoMemDB("proof", "", 0)
+	if err != nil {
+		return nil, err
+	}
+	if err = t.Prove(key, 0, proofDb); err != nil {
+		return nil, err
+	}
+	proof := &StateProof{StateHash: t.Hash().Bytes(), Key: key, Value: value}
+	it := proofDb.Iterator(nil, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		proof.Proof = append(proof.Proof, it.ValueCopy())
+	}
+	return proof, nil
+}
+
+// VerifyStateProof checks the proof nodes lead from the state hash to the value of key.
+// It needs nothing but the proof, so a client can check a value against a trusted state hash.
+func VerifyStateProof(proof *StateProof) error {
+	proofDb, err := dbm.NewGoMemDB("proof", "", 0)
+	if err != nil {
+		return err
+	}
+	for _, n := range proof.GetProof() {
+		proofDb.Set(common.Sha3(n), n)
+	}
+	key := proof.GetKey()
+	if enableSecure {
+		key = common.Sha3(key)
+	}
+	value, _, err := VerifyProof(common.BytesToHash(proof.GetStateHash()), key, proofDb)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(value, proof.GetValue()) {
+		return ErrStateProofValue
+	}
+	return nil
+}
+
 // VerifyProof checks merkle proofs. The given proof must contain the value for
 // key in a trie with the given root hash. VerifyProof returns an error if the
 // proof contains invalid trie nodes or the wrong value.
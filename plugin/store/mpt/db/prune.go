@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+)
+
+// NodeMarker 记录裁剪时已经标记的节点
+type NodeMarker interface {
+	Marked(hash common.Hash) (bool, error)
+	Mark(hash common.Hash) error
+}
+
+// MarkedSet 在内存中记录标记的节点
+type MarkedSet map[common.Hash]struct{}
+
+// Marked 节点是否已经标记
+func (s MarkedSet) Marked(hash common.Hash) (bool, error) {
+	_, ok := s[hash]
+	return ok, nil
+}
+
+// Mark 标记节点
+func (s MarkedSet) Mark(hash common.Hash) error {
+	s[hash] = struct{}{}
+	return nil
+}
+
+// IsNode 节点在数据库中以编码的keccak256 hash为key保存, 其它32字节的key不是节点
+func IsNode(key, value []byte) bool {
+	return len(key) == HashLength && bytes.Equal(common.Sha3(value), key)
+}
+
+// MarkNodes 标记从root能到达的所有节点,已经标记过的节点的子树不再遍历
+func MarkNodes(db dbm.DB, root common.Hash, marker NodeMarker) error {
+	if root == (common.Hash{}) || root == emptyRoot {
+		return nil
+	}
+	stack := []common.Hash{root}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		marked, err := marker.Marked(hash)
+		if err != nil {
+			return err
+		}
+		if marked {
+			continue
+		}
+		enc, err := db.Get(hash[:])
+		if err != nil || enc == nil {
+			return &MissingNodeError{NodeHash: hash}
+		}
+		n, err := decodeNode(hash[:], enc, 0)
+		if err != nil {
+			return err
+		}
+		if err = marker.Mark(hash); err != nil {
+			return err
+		}
+		gatherChildren(n, &stack)
+	}
+	return nil
+}
@@ -8,10 +8,13 @@ import (
 	"github.com/33cn/chain33/common"
 	clog "github.com/33cn/chain33/common/log"
 	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/pluginmgr"
 	"github.com/33cn/chain33/queue"
 	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/rpcplugin"
 	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
+	stypes "github.com/33cn/plugin/plugin/store/types"
 	lru "github.com/hashicorp/golang-lru"
 )
 
@@ -30,18 +33,34 @@ func DisableLog() {
 // Store mpt store struct
 type Store struct {
 	*drivers.BaseStore
-	trees map[string]*mpt.TrieEx
-	cache *lru.Cache
+	trees   map[string]*mpt.TrieEx
+	heights map[string]int64
+	cache   *lru.Cache
+	pruner  *pruner
+}
+
+type subConfig struct {
+	// 是否开启裁剪
+	EnablePrune bool `json:"enablePrune"`
+	// 每隔多少高度裁剪一次, 保留最近这么多高度的状态
+	PruneHeight int64 `json:"pruneHeight"`
+	// 高度是其整数倍的状态作为检查点一直保留, 0表示不保留检查点
+	CheckpointInterval int64 `json:"checkpointInterval"`
 }
 
 func init() {
 	drivers.Reg("mpt", New)
+	pluginmgr.Register(&rpcplugin.Plugin{Name: "mpt", Cmd: Cmd, RPC: InitRPC})
 }
 
 // New new mpt store module
 func New(cfg *types.Store, sub []byte) queue.Module {
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
 	bs := drivers.NewBaseStore(cfg)
-	mpts := &Store{bs, make(map[string]*mpt.TrieEx), nil}
+	mpts := &Store{bs, make(map[string]*mpt.TrieEx), make(map[string]int64), nil, newPruner(bs.GetDB(), &subcfg)}
 	mpts.cache, _ = lru.New(10)
 	bs.SetChild(mpts)
 	return mpts
@@ -49,13 +68,16 @@ func New(cfg *types.Store, sub []byte) queue.Module {
 
 // Close close mpt store
 func (mpts *Store) Close() {
+	mpts.pruner.close()
 	mpts.BaseStore.Close()
 	mlog.Info("store mavl closed")
 }
 
 // Set set k v to mpt store db; sync is true represent write sync
 func (mpts *Store) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
-	hash, err := mpt.SetKVPair(mpts.GetDB(), datas, sync)
+	hash, err := mpts.pruner.commit(datas.Height, func() ([]byte, error) {
+		return mpt.SetKVPair(mpts.GetDB(), datas, sync)
+	})
 	if err != nil {
 		mlog.Error("mpt store error", "err", err)
 		return nil, err
@@ -65,24 +87,8 @@ func (mpts *Store) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
 
 // Get get values by keys
 func (mpts *Store) Get(datas *types.StoreGet) [][]byte {
-	var tree *mpt.TrieEx
-	var err error
 	values := make([][]byte, len(datas.Keys))
-	search := string(datas.StateHash)
-	if data, ok := mpts.cache.Get(search); ok {
-		tree = data.(*mpt.TrieEx)
-	} else if data, ok := mpts.trees[search]; ok {
-		tree = data
-	} else {
-		tree, err = mpt.NewEx(common.BytesToHash(datas.StateHash), mpt.NewDatabase(mpts.GetDB()))
-		if nil != err {
-			mlog.Error("Store get can not find a trie")
-		}
-		if nil == err {
-			mpts.cache.Add(search, tree)
-		}
-		mlog.Debug("store mpt get tree", "err", err, "StateHash", common.ToHex(datas.StateHash))
-	}
+	tree, err := mpts.getTree(datas.StateHash)
 	if err == nil {
 		for i := 0; i < len(datas.Keys); i++ {
 			value, err := tree.TryGet(datas.Keys[i])
@@ -94,6 +100,25 @@ func (mpts *Store) Get(datas *types.StoreGet) [][]byte {
 	return values
 }
 
+func (mpts *Store) getTree(stateHash []byte) (*mpt.TrieEx, error) {
+	search := string(stateHash)
+	if data, ok := mpts.cache.Get(search); ok {
+		return data.(*mpt.TrieEx), nil
+	}
+	if data, ok := mpts.trees[search]; ok {
+		return data, nil
+	}
+	tree, err := mpt.NewEx(common.BytesToHash(stateHash), mpt.NewDatabase(mpts.GetDB()))
+	if nil != err {
+		mlog.Error("Store get can not find a trie")
+	}
+	if nil == err {
+		mpts.cache.Add(search, tree)
+	}
+	mlog.Debug("store mpt get tree", "err", err, "StateHash", common.ToHex(stateHash))
+	return tree, err
+}
+
 // MemSet set keys values to memcory mpt, return root hash and error
 func (mpts *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 	var err error
@@ -113,6 +138,7 @@ func (mpts *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
 	}
 	hash := root[:]
 	mpts.trees[string(hash)] = tree
+	mpts.heights[string(hash)] = datas.Height
 	if len(mpts.trees) > 1000 {
 		mlog.Error("too many trees in cache")
 	}
@@ -126,25 +152,26 @@ func (mpts *Store) Commit(req *types.ReqHash) ([]byte, error) {
 		mlog.Error("store mpt commit", "err", types.ErrHashNotFound)
 		return nil, types.ErrHashNotFound
 	}
-	err := tree.Commit2Db(common.BytesToHash(req.Hash), true)
+	_, err := mpts.pruner.commit(mpts.heights[string(req.Hash)], func() ([]byte, error) {
+		return req.Hash, tree.Commit2Db(common.BytesToHash(req.Hash), true)
+	})
 	if nil != err {
 		mlog.Error("store mpt commit", "err", types.ErrHashNotFound)
 		return nil, types.ErrDataBaseDamage
 	}
 	delete(mpts.trees, string(req.Hash))
+	delete(mpts.heights, string(req.Hash))
 	return req.Hash, nil
 }
 
-// MemSetUpgrade set keys values to memcory mpt, return root hash and error
+// MemSetUpgrade 数据升级时计算状态hash, 内存树缓存起来等待CommitUpgrade写入
 func (mpts *Store) MemSetUpgrade(datas *types.StoreSet, sync bool) ([]byte, error) {
-	//not support
-	return nil, nil
+	return mpts.MemSet(datas, sync)
 }
 
-// CommitUpgrade convert memcory mpt to storage db
+// CommitUpgrade 数据升级时把内存树写入数据库
 func (mpts *Store) CommitUpgrade(req *types.ReqHash) ([]byte, error) {
-	//not support
-	return nil, nil
+	return mpts.Commit(req)
 }
 
 // Rollback 回退将缓存的mpt树删除掉
@@ -155,13 +182,25 @@ func (mpts *Store) Rollback(req *types.ReqHash) ([]byte, error) {
 		return nil, types.ErrHashNotFound
 	}
 	delete(mpts.trees, string(req.Hash))
+	delete(mpts.heights, string(req.Hash))
 	return req.Hash, nil
 }
 
-// Del ...
+// Del 回滚区块时删除状态: 只删除状态根索引和缓存, 节点可能被其它状态共享, 由裁剪回收
 func (mpts *Store) Del(req *types.StoreDel) ([]byte, error) {
-	//not support
-	return nil, nil
+	if _, err := mpts.getTree(req.StateHash); err != nil {
+		mlog.Error("store mpt del", "hash", common.ToHex(req.StateHash), "err", err)
+		return nil, types.ErrHashNotFound
+	}
+	if err := mpts.pruner.delRoot(req.Height, req.StateHash); err != nil {
+		mlog.Error("store mpt del root", "height", req.Height, "err", err)
+		return nil, err
+	}
+	mpts.cache.Remove(string(req.StateHash))
+	delete(mpts.trees, string(req.StateHash))
+	delete(mpts.heights, string(req.StateHash))
+	mlog.Info("store mpt del", "hash", common.ToHex(req.StateHash), "height", req.Height)
+	return req.StateHash, nil
 }
 
 // IterateRangeByStateHash 迭代实现功能； statehash：当前状态hash, start：开始查找的key, end: 结束的key, ascending：升序，降序, fn 迭代回调函数
@@ -169,10 +208,34 @@ func (mpts *Store) IterateRangeByStateHash(statehash []byte, start []byte, end [
 	mpt.IterateRangeByStateHash(mpts.GetDB(), statehash, start, end, ascending, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理状态证明查询,其它消息不支持
 func (mpts *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
+	if msg.Ty == stypes.EventStoreGetProof {
+		client := mpts.GetQueueClient()
+		req, ok := msg.GetData().(*mpt.ReqStateProof)
+		if !ok {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetProofReply, types.ErrInvalidParam))
+			return
+		}
+		proof, err := mpts.GetStateProof(req)
+		if err != nil {
+			msg.Reply(client.NewMessage("", stypes.EventStoreGetProofReply, err))
+			return
+		}
+		msg.Reply(client.NewMessage("", stypes.EventStoreGetProofReply, proof))
+		return
+	}
 	msg.ReplyErr("Store", types.ErrActionNotSupport)
 }
+
+// GetStateProof 获取状态hash下key的值和证明
+func (mpts *Store) GetStateProof(req *mpt.ReqStateProof) (*mpt.StateProof, error) {
+	tree, err := mpts.getTree(req.StateHash)
+	if err != nil {
+		return nil, types.ErrHashNotFound
+	}
+	return tree.GetStateProof(req.Key)
+}
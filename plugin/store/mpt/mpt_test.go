@@ -13,8 +13,10 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
 	drivers "github.com/33cn/chain33/system/store"
 	"github.com/33cn/chain33/types"
+	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -83,6 +85,162 @@ func TestKvddbSetGet(t *testing.T) {
 	assert.Equal(t, []byte(nil), values3[0])
 }
 
+func TestGetStateProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, nil).(*Store)
+	assert.NotNil(t, store)
+
+	var kv []*types.KeyValue
+	for i := 0; i < 100; i++ {
+		kv = append(kv, &types.KeyValue{Key: []byte(fmt.Sprintf("mavl-coins-bty-%d", i)), Value: []byte(fmt.Sprintf("v%d", i))})
+	}
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true)
+	assert.Nil(t, err)
+
+	proof, err := store.GetStateProof(&mpt.ReqStateProof{StateHash: hash, Key: []byte("mavl-coins-bty-10")})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v10"), proof.Value)
+	assert.Nil(t, mpt.VerifyStateProof(proof))
+
+	result := FormatStateProof(proof)
+	value, err := VerifyStateProof(hash, result)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v10"), value)
+
+	// 伪造的值和不匹配的状态hash都不能通过校验
+	result.Value = common.ToHex([]byte("v11"))
+	_, err = VerifyStateProof(hash, result)
+	assert.Equal(t, mpt.ErrStateProofValue, err)
+	result.Value = common.ToHex(proof.Value)
+	_, err = VerifyStateProof(drivers.EmptyRoot[:], result)
+	assert.NotNil(t, err)
+
+	// 不存在的key也可以证明
+	proof, err = store.GetStateProof(&mpt.ReqStateProof{StateHash: hash, Key: []byte("mavl-coins-bty-100")})
+	assert.Nil(t, err)
+	assert.Nil(t, proof.Value)
+	assert.Nil(t, mpt.VerifyStateProof(proof))
+	proof.Value = []byte("v100")
+	assert.Equal(t, mpt.ErrStateProofValue, mpt.VerifyStateProof(proof))
+}
+
+func countNodes(store *Store) int {
+	count := 0
+	it := store.GetDB().Iterator(nil, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		if mpt.IsNode(it.Key(), it.Value()) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, []byte(`{"enablePrune":true,"pruneHeight":5,"checkpointInterval":4}`)).(*Store)
+	defer store.Close()
+
+	// 每个高度只修改第一个key,其余节点在各个状态间共享
+	var keys [][]byte
+	var kv []*types.KeyValue
+	for i := 0; i < 20; i++ {
+		keys = append(keys, []byte(fmt.Sprintf("mavl-coins-bty-%d", i)))
+		kv = append(kv, &types.KeyValue{Key: keys[i], Value: []byte(GetRandomString(40))})
+	}
+	// key是32字节但不是节点的数据不能被裁剪
+	other := common.Sha256([]byte("other"))
+	assert.Nil(t, store.GetDB().Set(other, []byte("value")))
+	hash := drivers.EmptyRoot[:]
+	var hashes [][]byte
+	before := 0
+	for height := int64(0); height <= 10; height++ {
+		if height == 10 {
+			before = countNodes(store)
+		}
+		kv[0].Value = []byte(fmt.Sprintf("%040d", height))
+		hash, err = store.MemSet(&types.StoreSet{StateHash: hash, KV: kv, Height: height}, true)
+		assert.Nil(t, err)
+		_, err = store.Commit(&types.ReqHash{Hash: hash})
+		assert.Nil(t, err)
+		hashes = append(hashes, hash)
+	}
+	// 高度10提交后在后台裁剪
+	for store.pruner.isPruning() {
+		time.Sleep(time.Millisecond)
+	}
+	store.cache.Purge()
+
+	// 保留最近5个高度和检查点0,4,8的状态
+	marked := make(mpt.MarkedSet)
+	for height, hash := range hashes {
+		values := store.Get(&types.StoreGet{StateHash: hash, Keys: keys})
+		if height > 5 || height%4 == 0 {
+			assert.Nil(t, mpt.MarkNodes(store.GetDB(), common.BytesToHash(hash), marked))
+			assert.Equal(t, []byte(fmt.Sprintf("%040d", height)), values[0])
+			assert.Equal(t, kv[19].Value, values[19])
+		} else {
+			assert.Nil(t, values[0])
+		}
+	}
+	after := countNodes(store)
+	assert.True(t, after < before)
+	assert.Equal(t, len(marked), after)
+	value, err := store.GetDB().Get(other)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	// 裁剪结束后标记都被删除
+	it := store.GetDB().Iterator(markPrefix, nil, false)
+	defer it.Close()
+	assert.False(t, it.Rewind())
+}
+
+func TestPruneUnindexed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, []byte(`{"enablePrune":true,"pruneHeight":5,"checkpointInterval":4}`)).(*Store)
+	defer store.Close()
+
+	kv := []*types.KeyValue{{Key: []byte("mavl-coins-bty-0"), Value: []byte(GetRandomString(40))}}
+	hash := drivers.EmptyRoot[:]
+	commit := func(height int64) {
+		kv[0].Value = []byte(fmt.Sprintf("%040d", height))
+		hash, err = store.MemSet(&types.StoreSet{StateHash: hash, KV: kv, Height: height}, true)
+		assert.Nil(t, err)
+		_, err = store.Commit(&types.ReqHash{Hash: hash})
+		assert.Nil(t, err)
+		for store.pruner.isPruning() {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	// 从高度3开始才有状态根索引, 检查点0的状态根不知道, 不能裁剪
+	for height := int64(3); height <= 15; height++ {
+		commit(height)
+	}
+	assert.Equal(t, int64(3), store.pruner.first)
+	store.cache.Purge()
+	for height := int64(3); height <= 15; height++ {
+		_, err := store.GetDB().Get(genRootKey(height))
+		assert.Nil(t, err)
+	}
+
+	// 不开启检查点时, 最近的状态根都有索引就可以裁剪
+	store.pruner.cfg.CheckpointInterval = 0
+	commit(20)
+	_, err = store.GetDB().Get(genRootKey(15))
+	assert.Equal(t, dbm.ErrNotFoundInDb, err)
+	_, err = store.GetDB().Get(genRootKey(20))
+	assert.Nil(t, err)
+}
+
 func TestKvdbMemSet(t *testing.T) {
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
@@ -115,7 +273,6 @@ func TestKvdbMemSet(t *testing.T) {
 }
 
 func TestKvmvccdbMemSetUpgrade(t *testing.T) {
-	// not support
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
 	defer os.RemoveAll(dir) // clean up
@@ -123,11 +280,27 @@ func TestKvmvccdbMemSetUpgrade(t *testing.T) {
 	var storeCfg = newStoreCfg(dir)
 	store := New(storeCfg, nil).(*Store)
 	assert.NotNil(t, store)
-	store.MemSetUpgrade(nil, false)
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mk1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mk2"), Value: []byte("v2")})
+	datas := &types.StoreSet{
+		StateHash: drivers.EmptyRoot[:],
+		KV:        kv,
+		Height:    0}
+	hash, err := store.MemSetUpgrade(datas, true)
+	assert.Nil(t, err)
+	// 与MemSet计算的状态hash一致
+	hash1, err := store.MemSet(datas, true)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, hash1)
+	keys := [][]byte{[]byte("mk1"), []byte("mk2")}
+	values := store.Get(&types.StoreGet{StateHash: hash, Keys: keys})
+	assert.Len(t, values, 2)
+	assert.Equal(t, []byte("v1"), values[0])
 }
 
 func TestKvmvccdbCommitUpgrade(t *testing.T) {
-	// not support
 	dir, err := ioutil.TempDir("", "example")
 	assert.Nil(t, err)
 	defer os.RemoveAll(dir) // clean up
@@ -135,7 +308,86 @@ func TestKvmvccdbCommitUpgrade(t *testing.T) {
 	var storeCfg = newStoreCfg(dir)
 	store := New(storeCfg, nil).(*Store)
 	assert.NotNil(t, store)
-	store.CommitUpgrade(nil)
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mk1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mk2"), Value: []byte("v2")})
+	datas := &types.StoreSet{
+		StateHash: drivers.EmptyRoot[:],
+		KV:        kv,
+		Height:    0}
+	hash, err := store.MemSetUpgrade(datas, true)
+	assert.Nil(t, err)
+	actHash, err := store.CommitUpgrade(&types.ReqHash{Hash: hash, Upgrade: true})
+	assert.Nil(t, err)
+	assert.Equal(t, hash, actHash)
+	assert.Len(t, store.trees, 0)
+
+	// 写入数据库后重新加载也能读到
+	store.cache.Purge()
+	keys := [][]byte{[]byte("mk1"), []byte("mk2")}
+	values := store.Get(&types.StoreGet{StateHash: hash, Keys: keys})
+	assert.Equal(t, []byte("v1"), values[0])
+	assert.Equal(t, []byte("v2"), values[1])
+
+	// 没有kv时状态hash不变
+	hash2, err := store.MemSetUpgrade(&types.StoreSet{StateHash: hash, Height: 1}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, hash2)
+	_, err = store.CommitUpgrade(&types.ReqHash{Hash: hash2, Upgrade: true})
+	assert.Nil(t, err)
+
+	notExistHash, err := store.CommitUpgrade(&types.ReqHash{Hash: drivers.EmptyRoot[:], Upgrade: true})
+	assert.Equal(t, types.ErrHashNotFound, err)
+	assert.Nil(t, notExistHash)
+}
+
+func TestKvdbDel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir) // clean up
+	var storeCfg = newStoreCfg(dir)
+	store := New(storeCfg, []byte(`{"enablePrune":true,"pruneHeight":100}`)).(*Store)
+	defer store.Close()
+
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: []byte("mk1"), Value: []byte("v1")})
+	kv = append(kv, &types.KeyValue{Key: []byte("mk2"), Value: []byte("v2")})
+	hash1, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv, Height: 1}, true)
+	assert.Nil(t, err)
+	kv[0].Value = []byte("v11")
+	hash2, err := store.MemSet(&types.StoreSet{StateHash: hash1, KV: kv, Height: 2}, true)
+	assert.Nil(t, err)
+	_, err = store.Commit(&types.ReqHash{Hash: hash2})
+	assert.Nil(t, err)
+	root, err := store.GetDB().Get(genRootKey(2))
+	assert.Nil(t, err)
+	assert.Equal(t, hash2, root)
+
+	// 回滚高度2, 删除状态根索引, 父状态共享的节点不受影响
+	actHash, err := store.Del(&types.StoreDel{StateHash: hash2, Height: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, hash2, actHash)
+	_, err = store.GetDB().Get(genRootKey(2))
+	assert.Equal(t, types.ErrNotFound, err)
+	store.cache.Purge()
+	values := store.Get(&types.StoreGet{StateHash: hash1, Keys: [][]byte{[]byte("mk1"), []byte("mk2")}})
+	assert.Equal(t, []byte("v1"), values[0])
+	assert.Equal(t, []byte("v2"), values[1])
+
+	// 同一高度重新执行另一个区块, 删除旧状态不影响新的状态根索引
+	kv[0].Value = []byte("v12")
+	hash3, err := store.Set(&types.StoreSet{StateHash: hash1, KV: kv, Height: 2}, true)
+	assert.Nil(t, err)
+	_, err = store.Del(&types.StoreDel{StateHash: hash2, Height: 2})
+	assert.Nil(t, err)
+	root, err = store.GetDB().Get(genRootKey(2))
+	assert.Nil(t, err)
+	assert.Equal(t, hash3, root)
+
+	notExistHash, err := store.Del(&types.StoreDel{StateHash: common.Sha256([]byte("none")), Height: 2})
+	assert.Equal(t, types.ErrHashNotFound, err)
+	assert.Nil(t, notExistHash)
 }
 
 func TestKvdbRollback(t *testing.T) {
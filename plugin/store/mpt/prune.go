@@ -0,0 +1,318 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
+)
+
+const (
+	pruningStateStart = 1
+	pruningStateEnd   = 0
+	onceDeleteCount   = 10000                 // 单次删除的节点数
+	pruneSleep        = 10 * time.Millisecond // 每批删除后暂停,避免影响区块执行
+)
+
+var (
+	// 状态根索引: rootPrefix+高度 -> 状态hash, 不开启裁剪时也记录, 以后开启裁剪时才知道要保留哪些状态
+	rootPrefix = []byte("mpt-root-")
+	// 开始记录状态根索引的高度, 之前的状态根不知道, 裁剪会删除它们的节点
+	rootFirstKey = []byte("mpt-rootindex-first")
+	// 裁剪时标记的节点: markPrefix+节点hash, 不在内存中保存所有标记
+	markPrefix = []byte("mpt-mark-")
+)
+
+func genRootKey(height int64) []byte {
+	return append(append([]byte{}, rootPrefix...), []byte(fmt.Sprintf("%020d", height))...)
+}
+
+func genMarkKey(hash common.Hash) []byte {
+	return append(append([]byte{}, markPrefix...), hash[:]...)
+}
+
+// diskMarker 把标记写入数据库, 还没有写入的标记留在pending中
+type diskMarker struct {
+	db      dbm.DB
+	batch   dbm.Batch
+	pending map[common.Hash]struct{}
+	count   int
+}
+
+func newDiskMarker(db dbm.DB) *diskMarker {
+	return &diskMarker{db: db, batch: db.NewBatch(false), pending: make(map[common.Hash]struct{})}
+}
+
+func (m *diskMarker) Marked(hash common.Hash) (bool, error) {
+	if _, ok := m.pending[hash]; ok {
+		return true, nil
+	}
+	_, err := m.db.Get(genMarkKey(hash))
+	if err == dbm.ErrNotFoundInDb {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *diskMarker) Mark(hash common.Hash) error {
+	m.pending[hash] = struct{}{}
+	m.batch.Set(genMarkKey(hash), []byte{1})
+	m.count++
+	if len(m.pending) >= onceDeleteCount {
+		return m.flush()
+	}
+	return nil
+}
+
+func (m *diskMarker) flush() error {
+	if len(m.pending) == 0 {
+		return nil
+	}
+	if err := m.batch.Write(); err != nil {
+		return err
+	}
+	m.batch.Reset()
+	m.pending = make(map[common.Hash]struct{})
+	return nil
+}
+
+// pruner 标记清除方式的裁剪: 从保留的状态根标记所有能到达的节点, 删除其余的节点
+type pruner struct {
+	db  dbm.DB
+	cfg *subConfig
+	// 提交状态和删除节点互斥, 删除前先标记裁剪过程中新提交的状态
+	mtx        sync.Mutex
+	collecting bool
+	roots      []common.Hash
+	first      int64
+	state      int32
+	quit       int32
+	wg         sync.WaitGroup
+}
+
+func newPruner(db dbm.DB, cfg *subConfig) *pruner {
+	p := &pruner{db: db, cfg: cfg, first: -1}
+	if value, err := db.Get(rootFirstKey); err == nil {
+		p.first, _ = strconv.ParseInt(string(value), 10, 64)
+	}
+	return p
+}
+
+func (p *pruner) enabled() bool {
+	return p.cfg.EnablePrune && p.cfg.PruneHeight > 0
+}
+
+// commit 写入状态,记录状态根, 到了裁剪高度时在后台裁剪
+func (p *pruner) commit(height int64, write func() ([]byte, error)) ([]byte, error) {
+	p.mtx.Lock()
+	root, err := write()
+	if err == nil && p.first < 0 {
+		p.first = height
+		err = p.db.Set(rootFirstKey, []byte(strconv.FormatInt(height, 10)))
+	}
+	if err == nil {
+		err = p.db.Set(genRootKey(height), root)
+		if p.collecting {
+			p.roots = append(p.roots, common.BytesToHash(root))
+		}
+	}
+	p.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if p.enabled() && height%p.cfg.PruneHeight == 0 && height/p.cfg.PruneHeight > 1 &&
+		atomic.CompareAndSwapInt32(&p.state, pruningStateEnd, pruningStateStart) {
+		p.wg.Add(1)
+		go p.prune(height)
+	}
+	return root, nil
+}
+
+func (p *pruner) close() {
+	atomic.StoreInt32(&p.quit, 1)
+	p.wg.Wait()
+}
+
+func (p *pruner) isQuit() bool {
+	return atomic.LoadInt32(&p.quit) == 1
+}
+
+func (p *pruner) isPruning() bool {
+	return atomic.LoadInt32(&p.state) == pruningStateStart
+}
+
+// indexed 要保留的状态根是否都有索引: 最近PruneHeight个高度, 开启检查点时还有从0开始的检查点
+func (p *pruner) indexed(height int64) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.first < 0 || p.first > height-p.cfg.PruneHeight+1 {
+		return false
+	}
+	return p.cfg.CheckpointInterval <= 0 || p.first == 0
+}
+
+func (p *pruner) prune(height int64) {
+	defer p.wg.Done()
+	defer atomic.StoreInt32(&p.state, pruningStateEnd)
+	if !p.indexed(height) {
+		mlog.Error("mpt prune skipped, roots below the first indexed height are unknown", "height", height, "first", p.first)
+		return
+	}
+	start := time.Now()
+
+	p.mtx.Lock()
+	p.collecting = true
+	p.mtx.Unlock()
+	defer func() {
+		p.mtx.Lock()
+		p.collecting = false
+		p.roots = nil
+		p.mtx.Unlock()
+	}()
+
+	// 上次裁剪中断时留下的标记对应的子树可能没有标记完, 不能沿用
+	p.clearMarks()
+	defer p.clearMarks()
+	marker := newDiskMarker(p.db)
+	for _, root := range p.keepRoots(height) {
+		if err := mpt.MarkNodes(p.db, root, marker); err != nil {
+			mlog.Error("mpt prune mark", "root", common.ToHex(root[:]), "err", err)
+			return
+		}
+		if p.isQuit() {
+			return
+		}
+	}
+	if err := marker.flush(); err != nil {
+		mlog.Error("mpt prune mark", "err", err)
+		return
+	}
+	deleted := p.sweep(marker)
+	mlog.Info("mpt prune", "height", height, "live", marker.count, "deleted", deleted, "cost", time.Since(start))
+}
+
+// clearMarks 分批删除裁剪时写入的标记
+func (p *pruner) clearMarks() {
+	it := p.db.Iterator(markPrefix, nil, false)
+	defer it.Close()
+	batch := p.db.NewBatch(false)
+	count := 0
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(common.CopyBytes(it.Key()))
+		count++
+		if count%onceDeleteCount == 0 {
+			dbm.MustWrite(batch)
+			batch.Reset()
+		}
+	}
+	dbm.MustWrite(batch)
+}
+
+// keepRoots 最近PruneHeight个高度和检查点高度的状态根, 其余的状态根索引删除
+func (p *pruner) keepRoots(height int64) []common.Hash {
+	var roots []common.Hash
+	batch := p.db.NewBatch(true)
+	it := p.db.Iterator(rootPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := it.Key()
+		h, err := strconv.ParseInt(string(key[len(rootPrefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+		if h > height-p.cfg.PruneHeight || (p.cfg.CheckpointInterval > 0 && h%p.cfg.CheckpointInterval == 0) {
+			roots = append(roots, common.BytesToHash(it.Value()))
+			continue
+		}
+		batch.Delete(common.CopyBytes(key))
+	}
+	dbm.MustWrite(batch)
+	return roots
+}
+
+// sweep 删除没有标记的节点, 分批删除
+func (p *pruner) sweep(marker *diskMarker) int {
+	it := p.db.Iterator(nil, nil, false)
+	defer it.Close()
+	deleted := 0
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		if p.isQuit() {
+			return deleted
+		}
+		key := it.Key()
+		if !mpt.IsNode(key, it.Value()) {
+			continue
+		}
+		marked, err := marker.Marked(common.BytesToHash(key))
+		if err != nil {
+			mlog.Error("mpt prune sweep", "err", err)
+			return deleted
+		}
+		if marked {
+			continue
+		}
+		keys = append(keys, common.CopyBytes(key))
+		if len(keys) >= onceDeleteCount {
+			count, err := p.deleteNodes(keys, marker)
+			deleted += count
+			if err != nil {
+				return deleted
+			}
+			keys = nil
+			time.Sleep(pruneSleep)
+		}
+	}
+	count, _ := p.deleteNodes(keys, marker)
+	return deleted + count
+}
+
+func (p *pruner) deleteNodes(keys [][]byte, marker *diskMarker) (int, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	// 裁剪过程中新提交的状态可能重新写入了待删除的节点
+	for _, root := range p.roots {
+		if err := mpt.MarkNodes(p.db, root, marker); err != nil {
+			mlog.Error("mpt prune mark new root", "root", common.ToHex(root[:]), "err", err)
+			return 0, err
+		}
+	}
+	p.roots = nil
+	count := 0
+	batch := p.db.NewBatch(true)
+	for _, key := range keys {
+		marked, err := marker.Marked(common.BytesToHash(key))
+		if err != nil {
+			return count, err
+		}
+		if marked {
+			continue
+		}
+		batch.Delete(key)
+		count++
+	}
+	dbm.MustWrite(batch)
+	return count, nil
+}
+
+// delRoot 删除区块回滚的状态根索引, 索引已经指向其它状态时不删除
+func (p *pruner) delRoot(height int64, root []byte) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	key := genRootKey(height)
+	value, err := p.db.Get(key)
+	if err != nil || !bytes.Equal(value, root) {
+		return nil
+	}
+	return p.db.Delete(key)
+}
@@ -0,0 +1,131 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mpt
+
+import (
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	mpt "github.com/33cn/plugin/plugin/store/mpt/db"
+	stypes "github.com/33cn/plugin/plugin/store/types"
+)
+
+// ReqGetStateProof 查询状态证明的rpc参数, stateHash为hex编码
+type ReqGetStateProof struct {
+	StateHash string `json:"stateHash"`
+	Key       string `json:"key"`
+}
+
+// StateProofResult 状态证明, 除key外都是hex编码
+type StateProofResult struct {
+	StateHash string   `json:"stateHash"`
+	Key       string   `json:"key"`
+	Value     string   `json:"value"`
+	Proof     []string `json:"proof"`
+}
+
+// Jrpc 状态证明的rpc接口
+type Jrpc struct {
+	cli *channelClient
+}
+
+type channelClient struct {
+	rpctypes.ChannelClient
+	qclient queue.Client
+}
+
+// InitRPC 注册 rpc 接口
+func InitRPC(name string, s rpctypes.RPCServer) {
+	cli := &channelClient{qclient: s.GetQueueClient()}
+	cli.Init(name, s, &Jrpc{cli: cli}, nil)
+}
+
+// GetStateProof 获取状态hash下key的值和mpt证明
+func (c *Jrpc) GetStateProof(param *ReqGetStateProof, result *interface{}) error {
+	if param == nil || param.Key == "" {
+		return types.ErrInvalidParam
+	}
+	stateHash, err := common.FromHex(param.StateHash)
+	if err != nil || len(stateHash) != len(common.Hash{}) {
+		return types.ErrInvalidParam
+	}
+	proof, err := c.cli.GetStateProof(&mpt.ReqStateProof{StateHash: stateHash, Key: []byte(param.Key)})
+	if err != nil {
+		return err
+	}
+	*result = FormatStateProof(proof)
+	return nil
+}
+
+// GetStateProof 通过store模块获取证明, store不是mpt时返回不支持
+func (c *channelClient) GetStateProof(req *mpt.ReqStateProof) (*mpt.StateProof, error) {
+	msg := c.qclient.NewMessage("store", stypes.EventStoreGetProof, req)
+	err := c.qclient.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.qclient.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	switch reply := resp.GetData().(type) {
+	case *mpt.StateProof:
+		return reply, nil
+	case *types.Reply:
+		return nil, errors.New(string(reply.GetMsg()))
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// FormatStateProof 转换成rpc返回的格式
+func FormatStateProof(proof *mpt.StateProof) *StateProofResult {
+	result := &StateProofResult{
+		StateHash: common.ToHex(proof.StateHash),
+		Key:       string(proof.Key),
+		Value:     common.ToHex(proof.Value),
+	}
+	for _, n := range proof.Proof {
+		result.Proof = append(result.Proof, common.ToHex(n))
+	}
+	return result
+}
+
+// ParseStateProof 从rpc返回的格式解析证明
+func ParseStateProof(result *StateProofResult) (*mpt.StateProof, error) {
+	stateHash, err := common.FromHex(result.StateHash)
+	if err != nil {
+		return nil, err
+	}
+	value, err := common.FromHex(result.Value)
+	if err != nil {
+		return nil, err
+	}
+	proof := &mpt.StateProof{StateHash: stateHash, Key: []byte(result.Key), Value: value}
+	for _, n := range result.Proof {
+		data, err := common.FromHex(n)
+		if err != nil {
+			return nil, err
+		}
+		proof.Proof = append(proof.Proof, data)
+	}
+	return proof, nil
+}
+
+// VerifyStateProof 校验rpc返回的证明, 返回证明的值, key不存在时为nil
+func VerifyStateProof(stateHash []byte, result *StateProofResult) ([]byte, error) {
+	proof, err := ParseStateProof(result)
+	if err != nil {
+		return nil, err
+	}
+	// 只信任调用者给出的状态hash
+	proof.StateHash = stateHash
+	if err = mpt.VerifyStateProof(proof); err != nil {
+		return nil, err
+	}
+	return proof.Value, nil
+}
@@ -0,0 +1,177 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+)
+
+// loadBlocks 读取最新区块及之前的区块和总难度, 节点启动时需要加载最近的区块到index中
+func loadBlocks(bs *blockchain.BlockStore, count int64) ([]*types.BlockDetail, []*big.Int, error) {
+	height := bs.Height()
+	if height < 0 {
+		return nil, nil, types.ErrHeightNotExist
+	}
+	start := height - count + 1
+	if start < 0 {
+		start = 0
+	}
+	var blocks []*types.BlockDetail
+	var tds []*big.Int
+	for i := start; i <= height; i++ {
+		block, err := bs.LoadBlockByHeight(i)
+		if err != nil {
+			return nil, nil, err
+		}
+		td, err := bs.GetTdByBlockHash(block.Block.Hash())
+		if err != nil {
+			return nil, nil, err
+		}
+		blocks = append(blocks, block)
+		tds = append(tds, td)
+	}
+	return blocks, tds, nil
+}
+
+// Export 导出停止的节点height高度的状态和区块链数据库中的本地数据, 每chunkSize个kv一个数据块
+// 本地数据只有最新区块的, height不是最新区块时返回ErrNotLastBlock, 小于0表示最新区块;
+// 状态是mavl树时同时导出树的节点
+func Export(w io.Writer, store drivers.SubStore, blockdb dbm.DB, height int64, chunkSize int) (*Summary, error) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	blocks, tds, err := loadBlocks(blockchain.NewBlockStore(nil, blockdb, nil), blockchain.InitBlockNum+1)
+	if err != nil {
+		return nil, err
+	}
+	last := blocks[len(blocks)-1].Block
+	if height >= 0 && height != last.Height {
+		return nil, ErrNotLastBlock
+	}
+	if importer, ok := store.(StateImporter); ok && !isMavlState(store, last.Height) {
+		if err = blockKV(importer, blocks); err != nil {
+			return nil, err
+		}
+	}
+	sw, err := newWriter(w)
+	if err != nil {
+		return nil, err
+	}
+	if err = sw.writeBlocks(blocks, tds); err != nil {
+		return nil, err
+	}
+	if isMavlState(store, last.Height) {
+		db := storeDB(store)
+		if db == nil {
+			return nil, types.ErrNotSupport
+		}
+		if err = exportNodes(sw, db, last.StateHash, chunkSize); err != nil {
+			return nil, err
+		}
+	}
+	cw := &chunkWriter{sw: sw, ty: frameChunk, size: chunkSize}
+	store.IterateRangeByStateHash(last.StateHash, nil, nil, true, cw.add)
+	if err = cw.flush(); err != nil {
+		return nil, err
+	}
+	if err = exportLocal(sw, blockdb, chunkSize); err != nil {
+		return nil, err
+	}
+	if err = sw.writeEnd(); err != nil {
+		return nil, err
+	}
+	summary := sw.summary(last)
+	slog.Info("snapshot export", "height", last.Height, "stateHash", common.ToHex(last.StateHash), "count", summary.Count,
+		"nodes", summary.Nodes, "local", summary.Local, "chunks", summary.Chunks)
+	return summary, nil
+}
+
+// blockKV 区块中不保存状态变化, 从store中取得最后一个区块的状态变化并和区块头校验
+func blockKV(importer StateImporter, blocks []*types.BlockDetail) error {
+	detail := blocks[len(blocks)-1]
+	kvs, err := importer.BlockKV(detail.Block.Height)
+	if err != nil {
+		return err
+	}
+	var prevHash []byte
+	if len(blocks) > 1 {
+		prevHash = blocks[len(blocks)-2].Block.StateHash
+	}
+	hash := importer.BlockStateHash(&types.StoreSet{StateHash: prevHash, KV: kvs, Height: detail.Block.Height})
+	if !bytes.Equal(hash, detail.Block.StateHash) {
+		slog.Error("snapshot block state hash", "stateHash", common.ToHex(detail.Block.StateHash), "calc", common.ToHex(hash))
+		return ErrStateHashMismatch
+	}
+	detail.KV = kvs
+	return nil
+}
+
+// exportLocal 导出区块链数据库中除区块以外的数据
+func exportLocal(sw *writer, db dbm.DB, chunkSize int) error {
+	blockKeys := blockchain.GetLocalDBKeyList()
+	it := db.Iterator(nil, types.EmptyValue, false)
+	defer it.Close()
+	cw := &chunkWriter{sw: sw, ty: frameLocal, size: chunkSize}
+	for it.Rewind(); it.Valid(); it.Next() {
+		if isBlockKey(it.Key(), blockKeys) {
+			continue
+		}
+		if cw.add(it.Key(), it.Value()) {
+			break
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return cw.flush()
+}
+
+func isBlockKey(key []byte, blockKeys [][]byte) bool {
+	for _, prefix := range blockKeys {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// chunkWriter 把kv按chunkSize分块写入快照
+type chunkWriter struct {
+	sw   *writer
+	ty   byte
+	size int
+	kvs  []*types.KeyValue
+	err  error
+}
+
+// add 添加一个kv, 写入失败时返回true停止遍历
+func (c *chunkWriter) add(key, value []byte) bool {
+	if c.err != nil {
+		return true
+	}
+	c.kvs = append(c.kvs, &types.KeyValue{Key: common.CopyBytes(key), Value: common.CopyBytes(value)})
+	if len(c.kvs) >= c.size {
+		c.err = c.sw.writeChunk(c.ty, c.kvs)
+		c.kvs = nil
+	}
+	return c.err != nil
+}
+
+func (c *chunkWriter) flush() error {
+	if c.err != nil || len(c.kvs) == 0 {
+		return c.err
+	}
+	c.err = c.sw.writeChunk(c.ty, c.kvs)
+	c.kvs = nil
+	return c.err
+}
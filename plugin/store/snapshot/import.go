@@ -0,0 +1,319 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/store"
+	mavldb "github.com/33cn/chain33/system/store/mavl/db"
+	"github.com/33cn/chain33/types"
+)
+
+// StateImporter 状态hash不能由完整状态计算的store驱动(kvdb, kvmvcc)实现该接口
+// ImportState 把数据块写入height高度并且绑定到stateHash, 导入结束时最后写入区块的状态变化
+// BlockStateHash 按执行区块时的方式由父状态hash和区块的状态变化计算状态hash, 用来校验区块头
+// BlockKV 返回height高度区块的状态变化, 导出时放入快照中的区块
+type StateImporter interface {
+	ImportState(prevHash, stateHash []byte, height int64, kvs []*types.KeyValue) error
+	BlockStateHash(set *types.StoreSet) []byte
+	BlockKV(height int64) ([]*types.KeyValue, error)
+}
+
+// 数据块的先后顺序
+var chunkOrder = map[byte]int{frameNodes: 1, frameChunk: 2, frameLocal: 3}
+
+// Import 校验快照文件, 把状态导入store, 把区块和本地数据写入没有区块的区块链数据库
+// 导入的状态和区块头中的状态hash校验:
+// mavl树校验每个节点的hash, mpt由状态重新计算状态hash,
+// kvdb和kvmvcc由区块的状态变化计算状态hash, 并且导入的状态包含区块的状态变化
+// 导入失败时数据库中可能留下部分数据, 只能导入到新的数据目录
+func Import(r io.Reader, store drivers.SubStore, blockdb dbm.DB) (*Summary, error) {
+	if blockchain.NewBlockStore(nil, blockdb, nil).Height() != -1 {
+		return nil, ErrBlockChainNotEmpty
+	}
+	sr, err := newReader(r)
+	if err != nil {
+		return nil, err
+	}
+	blocks, tds, err := sr.readBlocks()
+	if err != nil {
+		return nil, err
+	}
+	if err = checkBlocks(blocks); err != nil {
+		return nil, err
+	}
+	state, err := newStateImport(store, blocks)
+	if err != nil {
+		return nil, err
+	}
+	order := 0
+	for {
+		ty, kvs, err := sr.readChunk()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if chunkOrder[ty] < order {
+			return nil, ErrSnapshotFormat
+		}
+		order = chunkOrder[ty]
+		switch ty {
+		case frameNodes:
+			err = state.writeNodes(kvs)
+		case frameChunk:
+			err = state.writeState(kvs)
+		case frameLocal:
+			err = writeKVs(blockdb, kvs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = state.finish(sr.counts[frameChunk]); err != nil {
+		return nil, err
+	}
+	if err = saveBlocks(blockdb, blocks, tds); err != nil {
+		return nil, err
+	}
+	last := blocks[len(blocks)-1].Block
+	slog.Info("snapshot import", "height", last.Height, "stateHash", common.ToHex(last.StateHash), "count", sr.counts[frameChunk],
+		"nodes", sr.counts[frameNodes], "local", sr.counts[frameLocal], "chunks", sr.chunks)
+	return &Summary{Height: last.Height, StateHash: last.StateHash, Count: sr.counts[frameChunk],
+		Nodes: sr.counts[frameNodes], Local: sr.counts[frameLocal], Chunks: sr.chunks}, nil
+}
+
+// stateImport 导入快照高度的状态并和区块头中的状态hash校验
+type stateImport struct {
+	store   drivers.SubStore
+	detail  *types.BlockDetail
+	builder *StateBuilder
+	// 状态是mavl树时导入的节点和由节点加载的树
+	db      dbm.DB
+	tree    *mavldb.Tree
+	leaves  int64
+	lastKey []byte
+}
+
+func newStateImport(store drivers.SubStore, blocks []*types.BlockDetail) (*stateImport, error) {
+	detail := blocks[len(blocks)-1]
+	last := detail.Block
+	var prevHash []byte
+	if len(blocks) > 1 {
+		prevHash = blocks[len(blocks)-2].Block.StateHash
+	}
+	s := &stateImport{store: store, detail: detail}
+	importer, isImporter := store.(StateImporter)
+	if isMavlState(store, last.Height) {
+		if s.db = storeDB(store); s.db == nil {
+			return nil, types.ErrNotSupport
+		}
+	} else if isImporter {
+		set := &types.StoreSet{StateHash: prevHash, KV: detail.KV, Height: last.Height}
+		if hash := importer.BlockStateHash(set); !bytes.Equal(hash, last.StateHash) {
+			slog.Error("snapshot block state hash", "stateHash", common.ToHex(last.StateHash), "calc", common.ToHex(hash))
+			return nil, ErrStateHashMismatch
+		}
+	}
+	// 只有mavl树的store由节点导入状态, 不需要再写入状态
+	if s.db == nil || isImporter {
+		s.builder = NewStateBuilder(store, prevHash, last.StateHash, last.Height)
+	}
+	return s, nil
+}
+
+func (s *stateImport) writeNodes(kvs []*types.KeyValue) error {
+	if s.db == nil {
+		return ErrSnapshotFormat
+	}
+	return writeKVs(s.db, kvs)
+}
+
+// loadTree 节点都导入之后校验整个树, 然后加载树
+func (s *stateImport) loadTree() error {
+	if s.tree != nil {
+		return nil
+	}
+	root := s.detail.Block.StateHash
+	leaves, err := verifyTree(s.db, root)
+	if err != nil {
+		return err
+	}
+	s.leaves = leaves
+	s.tree = mavldb.NewTree(s.db, true)
+	if isEmptyRoot(root) {
+		return nil
+	}
+	return s.tree.Load(root)
+}
+
+// writeState 状态是mavl树时, 每个kv必须是树的叶子节点, 并且key严格递增, 总数和叶子节点数相同
+func (s *stateImport) writeState(kvs []*types.KeyValue) error {
+	if s.db != nil {
+		if err := s.loadTree(); err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			if s.lastKey != nil && bytes.Compare(kv.Key, s.lastKey) <= 0 {
+				return ErrSnapshotFormat
+			}
+			s.lastKey = kv.Key
+			_, hash, exists := s.tree.GetHash(kv.Key)
+			if !exists || !bytes.HasSuffix(hash, leafHash(kv.Key, kv.Value)) {
+				slog.Error("snapshot state not in the mavl tree", "key", string(kv.Key))
+				return ErrStateHashMismatch
+			}
+		}
+	}
+	if s.builder == nil {
+		return nil
+	}
+	return s.builder.Write(kvs)
+}
+
+func (s *stateImport) finish(count int64) error {
+	last := s.detail.Block
+	if s.db != nil {
+		if err := s.loadTree(); err != nil {
+			return err
+		}
+		if s.leaves != count {
+			slog.Error("snapshot state count", "count", count, "leaves", s.leaves)
+			return ErrStateHashMismatch
+		}
+	}
+	if s.builder == nil {
+		return nil
+	}
+	if s.builder.importer == nil {
+		hash, err := s.builder.Finish()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, last.StateHash) {
+			slog.Error("snapshot import", "stateHash", common.ToHex(last.StateHash), "rebuilt", common.ToHex(hash))
+			return ErrStateHashMismatch
+		}
+		return nil
+	}
+	// 状态hash由区块的状态变化计算, 导入的状态必须包含这些变化
+	if len(s.detail.KV) > 0 {
+		keys := make([][]byte, len(s.detail.KV))
+		for i, kv := range s.detail.KV {
+			keys[i] = kv.Key
+		}
+		values := s.store.Get(&types.StoreGet{StateHash: last.StateHash, Keys: keys})
+		for i, kv := range s.detail.KV {
+			if !bytes.Equal(values[i], kv.Value) {
+				slog.Error("snapshot state does not match the block", "key", string(kv.Key))
+				return ErrStateHashMismatch
+			}
+		}
+	}
+	// 最后写入区块的状态变化, 导入的节点可以再导出快照
+	if err := s.builder.Write(s.detail.KV); err != nil {
+		return err
+	}
+	_, err := s.builder.Finish()
+	return err
+}
+
+func writeKVs(db dbm.DB, kvs []*types.KeyValue) error {
+	batch := db.NewBatch(true)
+	for _, kv := range kvs {
+		batch.Set(kv.Key, kv.Value)
+	}
+	return batch.Write()
+}
+
+// StateBuilder 逐块把完整状态写入store, 导入快照和迁移store共用
+type StateBuilder struct {
+	store     drivers.SubStore
+	importer  StateImporter
+	prevHash  []byte
+	stateHash []byte
+	height    int64
+	hash      []byte
+	written   bool
+}
+
+// NewStateBuilder 在height高度重建状态, prevHash是父状态hash, stateHash是原状态hash
+func NewStateBuilder(store drivers.SubStore, prevHash, stateHash []byte, height int64) *StateBuilder {
+	importer, _ := store.(StateImporter)
+	return &StateBuilder{store: store, importer: importer, prevHash: prevHash, stateHash: stateHash,
+		height: height, hash: drivers.EmptyRoot[:]}
+}
+
+// Write 写入一批状态
+func (b *StateBuilder) Write(kvs []*types.KeyValue) error {
+	var err error
+	if b.importer != nil {
+		err = b.importer.ImportState(b.prevHash, b.stateHash, b.height, kvs)
+		b.hash = b.stateHash
+	} else {
+		b.hash, err = b.store.Set(&types.StoreSet{StateHash: b.hash, KV: kvs, Height: b.height}, true)
+	}
+	b.written = true
+	return err
+}
+
+// Finish 返回重建后store中的状态hash, 实现了StateImporter的store绑定到原状态hash
+func (b *StateBuilder) Finish() ([]byte, error) {
+	if b.importer != nil && !b.written {
+		if err := b.Write(nil); err != nil {
+			return nil, err
+		}
+	}
+	return b.hash, nil
+}
+
+// checkBlocks 区块必须高度连续并且通过父hash相连
+func checkBlocks(blocks []*types.BlockDetail) error {
+	for i := 1; i < len(blocks); i++ {
+		prev, block := blocks[i-1].Block, blocks[i].Block
+		if block.Height != prev.Height+1 || !bytes.Equal(block.ParentHash, prev.Hash()) {
+			slog.Error("snapshot blocks not linked", "height", block.Height)
+			return types.ErrParentHash
+		}
+	}
+	first, last := blocks[0].Block.Height, blocks[len(blocks)-1].Block.Height
+	if first > 0 && first > last-blockchain.InitBlockNum {
+		slog.Error("snapshot blocks not enough", "first", first, "last", last, "need", blockchain.InitBlockNum+1)
+		return types.ErrBlockNotFound
+	}
+	return nil
+}
+
+// saveBlocks 把快照中的区块和总难度写入没有区块的区块链数据库, 节点启动后从快照高度开始同步
+// 节点启动时从数据库加载最近blockchain.InitBlockNum个区块, 快照中的区块必须覆盖这个范围
+func saveBlocks(db dbm.DB, blocks []*types.BlockDetail, tds []*big.Int) error {
+	bs := blockchain.NewBlockStore(nil, db, nil)
+	if bs.Height() != -1 {
+		return ErrBlockChainNotEmpty
+	}
+	batch := db.NewBatch(true)
+	for i, block := range blocks {
+		if _, err := bs.SaveBlock(batch, block, -1); err != nil {
+			return err
+		}
+		if err := bs.SaveTdByBlockHash(batch, block.Block.Hash(), tds[i]); err != nil {
+			return err
+		}
+	}
+	dbm.MustWrite(batch)
+	bs.UpdateHeight()
+	// 本地数据中没有数据库版本时和新建的数据库一样使用新的数据库版本
+	if bs.GetDbVersion() == 0 {
+		return bs.SetDbVersion(1)
+	}
+	return nil
+}
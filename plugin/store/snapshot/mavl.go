@@ -0,0 +1,122 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/system/store/mavl"
+	"github.com/33cn/chain33/types"
+)
+
+// MavlState 状态hash可能是mavl树根的store实现该接口, 比如分叉高度之前的kvmvccmavl
+// mavl树的形状和插入顺序有关, 不能由完整状态重建, 快照中导出树的节点
+type MavlState interface {
+	IsMavlState(height int64) bool
+}
+
+func isMavlState(store drivers.SubStore, height int64) bool {
+	switch s := store.(type) {
+	case *mavl.Store:
+		return true
+	case MavlState:
+		return s.IsMavlState(height)
+	}
+	return false
+}
+
+func storeDB(store drivers.SubStore) dbm.DB {
+	if s, ok := store.(interface{ GetDB() dbm.DB }); ok {
+		return s.GetDB()
+	}
+	return nil
+}
+
+func isEmptyRoot(root []byte) bool {
+	return len(root) == 0 || bytes.Equal(root, drivers.EmptyRoot[:])
+}
+
+// exportNodes 从根节点遍历mavl树, 按数据库中的key和value导出所有节点
+func exportNodes(sw *writer, db dbm.DB, root []byte, chunkSize int) error {
+	if isEmptyRoot(root) {
+		return nil
+	}
+	var kvs []*types.KeyValue
+	stack := [][]byte{root}
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		value, err := db.Get(key)
+		if err != nil {
+			return err
+		}
+		var node types.StoreNode
+		if err = types.Decode(value, &node); err != nil {
+			return err
+		}
+		if node.Height > 0 {
+			stack = append(stack, node.LeftHash, node.RightHash)
+		}
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: value})
+		if len(kvs) >= chunkSize {
+			if err = sw.writeChunk(frameNodes, kvs); err != nil {
+				return err
+			}
+			kvs = nil
+		}
+	}
+	if len(kvs) == 0 {
+		return nil
+	}
+	return sw.writeChunk(frameNodes, kvs)
+}
+
+func leafHash(key, value []byte) []byte {
+	leaf := &types.LeafNode{Height: 0, Key: key, Size: 1, Value: value}
+	return leaf.Hash()
+}
+
+// verifyTree 从根节点重新计算每个节点的hash, 返回叶子节点数
+// 开启前缀时节点的key是前缀加hash, 根节点没有前缀; 开启mvcc时叶子节点不保存值, 由状态数据校验
+func verifyTree(db dbm.DB, root []byte) (int64, error) {
+	if isEmptyRoot(root) {
+		return 0, nil
+	}
+	var leaves int64
+	stack := [][]byte{root}
+	for len(stack) > 0 {
+		key := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		value, err := db.Get(key)
+		if err != nil {
+			slog.Error("snapshot mavl node not found", "key", common.ToHex(key))
+			return 0, ErrStateHashMismatch
+		}
+		var node types.StoreNode
+		if err = types.Decode(value, &node); err != nil {
+			return 0, ErrSnapshotFormat
+		}
+		var hash []byte
+		if node.Height == 0 {
+			leaves++
+			if node.Value == nil {
+				continue
+			}
+			hash = leafHash(node.Key, node.Value)
+		} else {
+			inner := &types.InnerNode{Height: node.Height, Size: node.Size, LeftHash: node.LeftHash, RightHash: node.RightHash}
+			hash = inner.Hash()
+			stack = append(stack, node.LeftHash, node.RightHash)
+		}
+		if !bytes.HasSuffix(key, hash) {
+			slog.Error("snapshot mavl node hash", "key", common.ToHex(key), "hash", common.ToHex(hash))
+			return 0, ErrStateHashMismatch
+		}
+	}
+	return leaves, nil
+}
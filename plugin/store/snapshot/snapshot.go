@@ -0,0 +1,254 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package snapshot 离线导出和导入某个高度的完整状态, 新节点从快照高度开始同步
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+快照文件格式:
+
+magic(16字节) version(4字节)
+frame: type(1字节) length(4字节) payload sha256(payload)(32字节)
+
+第一个frame是区块信息(types.BlockDetails), 最后一个区块是快照高度的区块
+第二个frame是这些区块的总难度(types.LocalDBSet, key为区块hash)
+中间的frame是数据块(types.LocalDBSet), 依次是:
+  状态是mavl树时树的节点, 按原始的key和value保存
+  状态数据
+  区块链数据库中除区块以外的本地数据, 包括交易索引和执行器的本地数据
+最后一个frame是结束标记: 状态kv总数(8字节) 所有数据块校验和的sha256(32字节)
+*/
+
+var slog = log.New("module", "snapshot")
+
+var (
+	// ErrSnapshotFormat 不是快照文件或者版本不支持
+	ErrSnapshotFormat = errors.New("ErrSnapshotFormat")
+	// ErrSnapshotChecksum 数据块校验失败
+	ErrSnapshotChecksum = errors.New("ErrSnapshotChecksum")
+	// ErrSnapshotTruncated 快照文件不完整
+	ErrSnapshotTruncated = errors.New("ErrSnapshotTruncated")
+	// ErrStateHashMismatch 导入后重建的状态hash和快照的状态hash不一致
+	ErrStateHashMismatch = errors.New("ErrStateHashMismatch")
+	// ErrBlockChainNotEmpty 只能导入到没有区块的节点
+	ErrBlockChainNotEmpty = errors.New("ErrBlockChainNotEmpty")
+	// ErrNotLastBlock 本地数据只有最新区块的, 只能导出最新区块的快照
+	ErrNotLastBlock = errors.New("ErrNotLastBlock")
+)
+
+var magic = []byte("chain33-snapshot")
+
+const (
+	formatVersion = 2
+
+	frameBlocks = 1
+	frameChunk  = 2
+	frameEnd    = 3
+	frameTds    = 4
+	frameNodes  = 5
+	frameLocal  = 6
+
+	// 单个frame的最大长度, 防止损坏的文件申请过大的内存
+	maxFrameSize = 256 * 1024 * 1024
+	// DefaultChunkSize 默认每个数据块的kv个数
+	DefaultChunkSize = 10000
+)
+
+// Summary 快照的概要信息, Count是状态kv数, Nodes是mavl树的节点数, Local是本地数据的kv数
+type Summary struct {
+	Height    int64
+	StateHash []byte
+	Count     int64
+	Nodes     int64
+	Local     int64
+	Chunks    int64
+}
+
+// writer 按frame写快照文件, 同时累计数据块的校验和
+type writer struct {
+	w      io.Writer
+	digest hash.Hash
+	counts map[byte]int64
+	chunks int64
+}
+
+func newWriter(w io.Writer) (*writer, error) {
+	head := make([]byte, len(magic)+4)
+	copy(head, magic)
+	binary.BigEndian.PutUint32(head[len(magic):], formatVersion)
+	if _, err := w.Write(head); err != nil {
+		return nil, err
+	}
+	return &writer{w: w, digest: sha256.New(), counts: make(map[byte]int64)}, nil
+}
+
+func (w *writer) writeFrame(ty byte, payload []byte) ([]byte, error) {
+	head := make([]byte, 5)
+	head[0] = ty
+	binary.BigEndian.PutUint32(head[1:], uint32(len(payload)))
+	sum := sha256.Sum256(payload)
+	for _, data := range [][]byte{head, payload, sum[:]} {
+		if _, err := w.w.Write(data); err != nil {
+			return nil, err
+		}
+	}
+	return sum[:], nil
+}
+
+// writeBlocks 写入区块和区块的总难度, tds和blocks一一对应
+func (w *writer) writeBlocks(blocks []*types.BlockDetail, tds []*big.Int) error {
+	if _, err := w.writeFrame(frameBlocks, types.Encode(&types.BlockDetails{Items: blocks})); err != nil {
+		return err
+	}
+	set := &types.LocalDBSet{}
+	for i, block := range blocks {
+		set.KV = append(set.KV, &types.KeyValue{Key: block.Block.Hash(), Value: tds[i].Bytes()})
+	}
+	_, err := w.writeFrame(frameTds, types.Encode(set))
+	return err
+}
+
+// writeChunk 写入一个数据块, ty是frameNodes, frameChunk或者frameLocal
+func (w *writer) writeChunk(ty byte, kvs []*types.KeyValue) error {
+	sum, err := w.writeFrame(ty, types.Encode(&types.LocalDBSet{KV: kvs}))
+	if err != nil {
+		return err
+	}
+	w.digest.Write(sum)
+	w.counts[ty] += int64(len(kvs))
+	w.chunks++
+	return nil
+}
+
+func (w *writer) writeEnd() error {
+	payload := make([]byte, 8, 8+sha256.Size)
+	binary.BigEndian.PutUint64(payload, uint64(w.counts[frameChunk]))
+	_, err := w.writeFrame(frameEnd, w.digest.Sum(payload))
+	return err
+}
+
+func (w *writer) summary(block *types.Block) *Summary {
+	return &Summary{Height: block.Height, StateHash: block.StateHash, Count: w.counts[frameChunk],
+		Nodes: w.counts[frameNodes], Local: w.counts[frameLocal], Chunks: w.chunks}
+}
+
+// reader 按frame读快照文件并校验
+type reader struct {
+	r      io.Reader
+	digest hash.Hash
+	counts map[byte]int64
+	chunks int64
+}
+
+func newReader(r io.Reader) (*reader, error) {
+	head := make([]byte, len(magic)+4)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, ErrSnapshotFormat
+	}
+	if !bytes.Equal(head[:len(magic)], magic) || binary.BigEndian.Uint32(head[len(magic):]) != formatVersion {
+		return nil, ErrSnapshotFormat
+	}
+	return &reader{r: r, digest: sha256.New(), counts: make(map[byte]int64)}, nil
+}
+
+func (r *reader) readFrame() (byte, []byte, error) {
+	head := make([]byte, 5)
+	if _, err := io.ReadFull(r.r, head); err != nil {
+		return 0, nil, ErrSnapshotTruncated
+	}
+	size := binary.BigEndian.Uint32(head[1:])
+	if size > maxFrameSize {
+		return 0, nil, ErrSnapshotFormat
+	}
+	data := make([]byte, int(size)+sha256.Size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return 0, nil, ErrSnapshotTruncated
+	}
+	payload, sum := data[:size], data[size:]
+	expect := sha256.Sum256(payload)
+	if !bytes.Equal(expect[:], sum) {
+		return 0, nil, ErrSnapshotChecksum
+	}
+	if isChunk(head[0]) {
+		r.digest.Write(sum)
+	}
+	return head[0], payload, nil
+}
+
+func isChunk(ty byte) bool {
+	return ty == frameChunk || ty == frameNodes || ty == frameLocal
+}
+
+// readBlocks 读取区块和区块的总难度
+func (r *reader) readBlocks() ([]*types.BlockDetail, []*big.Int, error) {
+	ty, payload, err := r.readFrame()
+	if err != nil {
+		return nil, nil, err
+	}
+	var blocks types.BlockDetails
+	if ty != frameBlocks || types.Decode(payload, &blocks) != nil || len(blocks.Items) == 0 {
+		return nil, nil, ErrSnapshotFormat
+	}
+	for _, block := range blocks.Items {
+		if block.GetBlock() == nil {
+			return nil, nil, ErrSnapshotFormat
+		}
+	}
+	ty, payload, err = r.readFrame()
+	if err != nil {
+		return nil, nil, err
+	}
+	var set types.LocalDBSet
+	if ty != frameTds || types.Decode(payload, &set) != nil || len(set.KV) != len(blocks.Items) {
+		return nil, nil, ErrSnapshotFormat
+	}
+	tds := make([]*big.Int, len(set.KV))
+	for i, kv := range set.KV {
+		if !bytes.Equal(kv.Key, blocks.Items[i].Block.Hash()) {
+			return nil, nil, ErrSnapshotFormat
+		}
+		tds[i] = new(big.Int).SetBytes(kv.Value)
+	}
+	return blocks.Items, tds, nil
+}
+
+// readChunk 读取下一个数据块, 读到结束标记时校验kv总数和校验和, 返回io.EOF
+func (r *reader) readChunk() (byte, []*types.KeyValue, error) {
+	ty, payload, err := r.readFrame()
+	if err != nil {
+		return 0, nil, err
+	}
+	if isChunk(ty) {
+		var set types.LocalDBSet
+		if err := types.Decode(payload, &set); err != nil {
+			return 0, nil, ErrSnapshotFormat
+		}
+		r.counts[ty] += int64(len(set.KV))
+		r.chunks++
+		return ty, set.KV, nil
+	}
+	if ty == frameEnd {
+		if len(payload) != 8+sha256.Size || int64(binary.BigEndian.Uint64(payload)) != r.counts[frameChunk] {
+			return 0, nil, ErrSnapshotChecksum
+		}
+		if !bytes.Equal(r.digest.Sum(nil), payload[8:]) {
+			return 0, nil, ErrSnapshotChecksum
+		}
+		return ty, nil, io.EOF
+	}
+	return 0, nil, ErrSnapshotFormat
+}
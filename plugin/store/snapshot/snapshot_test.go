@@ -0,0 +1,390 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/blockchain"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/system/store/mavl"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/store/kvdb"
+	kvmvccdb "github.com/33cn/plugin/plugin/store/kvmvcc"
+	"github.com/33cn/plugin/plugin/store/kvmvccmavl"
+	"github.com/33cn/plugin/plugin/store/mpt"
+	"github.com/stretchr/testify/assert"
+)
+
+var localKey = []byte("LODB-snapshot-test")
+
+func newStore(t *testing.T, create drivers.Storecreate, sub []byte) (drivers.SubStore, func()) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	assert.Nil(t, err)
+	module := create(&types.Store{Name: "snapshot_test", Driver: "leveldb", DbPath: dir, DbCache: 100}, sub)
+	return module.(drivers.SubStore), func() {
+		module.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newBlockDB(t *testing.T) (dbm.DB, func()) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	assert.Nil(t, err)
+	db := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func genKVs(count int) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for i := 0; i < count; i++ {
+		kvs = append(kvs, &types.KeyValue{Key: []byte(fmt.Sprintf("mavl-coins-bty-%04d", i)), Value: []byte(fmt.Sprintf("v%d", i))})
+	}
+	return kvs
+}
+
+// execBlocks 每个区块执行一组状态变化, 区块头的状态hash由store计算, 区块和本地数据写入blockdb
+func execBlocks(t *testing.T, store drivers.SubStore, blockdb dbm.DB, sets [][]*types.KeyValue) []*types.BlockDetail {
+	var blocks []*types.BlockDetail
+	var tds []*big.Int
+	var parent []byte
+	prevHash := drivers.EmptyRoot[:]
+	for i, kvs := range sets {
+		hash, err := store.MemSet(&types.StoreSet{StateHash: prevHash, KV: kvs, Height: int64(i)}, true)
+		assert.Nil(t, err)
+		_, err = store.Commit(&types.ReqHash{Hash: hash})
+		assert.Nil(t, err)
+		block := &types.Block{Height: int64(i), ParentHash: parent, StateHash: hash, BlockTime: int64(i)}
+		blocks = append(blocks, &types.BlockDetail{Block: block, KV: kvs})
+		tds = append(tds, big.NewInt(int64(i+1)))
+		parent = block.Hash()
+		prevHash = hash
+	}
+	assert.Nil(t, saveBlocks(blockdb, blocks, tds))
+	assert.Nil(t, blockdb.Set(localKey, []byte("local")))
+	return blocks
+}
+
+// exportChain 在源store上执行区块并导出快照
+func exportChain(t *testing.T, create drivers.Storecreate, sub []byte, sets [][]*types.KeyValue) ([]byte, []*types.BlockDetail) {
+	store, closer := newStore(t, create, sub)
+	defer closer()
+	blockdb, dbCloser := newBlockDB(t)
+	defer dbCloser()
+	blocks := execBlocks(t, store, blockdb, sets)
+	var buf bytes.Buffer
+	summary, err := Export(&buf, store, blockdb, -1, 30)
+	assert.Nil(t, err)
+	last := blocks[len(blocks)-1].Block
+	assert.Equal(t, last.Height, summary.Height)
+	assert.Equal(t, last.StateHash, summary.StateHash)
+	assert.True(t, summary.Local > 0)
+	return buf.Bytes(), blocks
+}
+
+// importChain 导入快照, 检查区块, 总难度和本地数据
+func importChain(t *testing.T, store drivers.SubStore, data []byte, blocks []*types.BlockDetail) *Summary {
+	blockdb, closer := newBlockDB(t)
+	defer closer()
+	summary, err := Import(bytes.NewReader(data), store, blockdb)
+	assert.Nil(t, err)
+	if err != nil {
+		return nil
+	}
+	last := blocks[len(blocks)-1].Block
+	bs := blockchain.NewBlockStore(nil, blockdb, nil)
+	assert.Equal(t, last.Height, bs.Height())
+	td, err := bs.GetTdByBlockHash(last.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(blocks)), td.Int64())
+	value, err := blockdb.Get(localKey)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("local"), value)
+	// 只能导入到没有区块的节点
+	_, err = Import(bytes.NewReader(data), store, blockdb)
+	assert.Equal(t, ErrBlockChainNotEmpty, err)
+	return summary
+}
+
+func checkValues(t *testing.T, store drivers.SubStore, stateHash []byte, kvs []*types.KeyValue) {
+	keys := make([][]byte, len(kvs))
+	for i, kv := range kvs {
+		keys[i] = kv.Key
+	}
+	values := store.Get(&types.StoreGet{StateHash: stateHash, Keys: keys})
+	for i, kv := range kvs {
+		assert.Equal(t, kv.Value, values[i], string(kv.Key))
+	}
+}
+
+// rewrite 重新生成快照, modify可以修改区块和数据块
+func rewrite(t *testing.T, data []byte, modifyBlocks func([]*types.BlockDetail), modify func(ty byte, kvs []*types.KeyValue)) []byte {
+	sr, err := newReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	blocks, tds, err := sr.readBlocks()
+	assert.Nil(t, err)
+	if modifyBlocks != nil {
+		modifyBlocks(blocks)
+	}
+	var buf bytes.Buffer
+	sw, err := newWriter(&buf)
+	assert.Nil(t, err)
+	assert.Nil(t, sw.writeBlocks(blocks, tds))
+	for {
+		ty, kvs, err := sr.readChunk()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		if modify != nil {
+			modify(ty, kvs)
+		}
+		assert.Nil(t, sw.writeChunk(ty, kvs))
+	}
+	assert.Nil(t, sw.writeEnd())
+	return buf.Bytes()
+}
+
+func TestExportImportMpt(t *testing.T) {
+	kvs := genKVs(100)
+	data, blocks := exportChain(t, mpt.New, nil, [][]*types.KeyValue{kvs[:50], kvs[50:]})
+
+	store, closer := newStore(t, mpt.New, nil)
+	defer closer()
+	summary := importChain(t, store, data, blocks)
+	assert.Equal(t, int64(100), summary.Count)
+	assert.Equal(t, int64(0), summary.Nodes)
+	checkValues(t, store, summary.StateHash, kvs)
+}
+
+func TestExportImportKVMVCC(t *testing.T) {
+	kvs := genKVs(100)
+	sub := []byte(`{"enableMVCCIter":true}`)
+	data, blocks := exportChain(t, kvmvccdb.New, sub, [][]*types.KeyValue{kvs[:50], kvs[50:]})
+
+	store, closer := newStore(t, kvmvccdb.New, sub)
+	defer closer()
+	summary := importChain(t, store, data, blocks)
+	assert.Equal(t, int64(100), summary.Count)
+	checkValues(t, store, summary.StateHash, kvs)
+
+	// 下一个区块接着快照高度执行
+	next := []*types.KeyValue{{Key: kvs[0].Key, Value: []byte("v100")}}
+	hash, err := store.MemSet(&types.StoreSet{StateHash: summary.StateHash, KV: next, Height: 2}, true)
+	assert.Nil(t, err)
+	_, err = store.Commit(&types.ReqHash{Hash: hash})
+	assert.Nil(t, err)
+	checkValues(t, store, hash, append(next, kvs[99]))
+}
+
+func TestExportImportKVDB(t *testing.T) {
+	kvs := genKVs(100)
+	data, blocks := exportChain(t, kvdb.New, nil, [][]*types.KeyValue{kvs[:50], kvs[50:]})
+
+	store, closer := newStore(t, kvdb.New, nil)
+	defer closer()
+	summary := importChain(t, store, data, blocks)
+	checkValues(t, store, summary.StateHash, kvs[50:51])
+	count := 0
+	store.IterateRangeByStateHash(summary.StateHash, nil, nil, true, func(key, value []byte) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 100, count)
+}
+
+func TestExportNotLastBlock(t *testing.T) {
+	kvs := genKVs(100)
+	store, closer := newStore(t, kvdb.New, nil)
+	defer closer()
+	blockdb, dbCloser := newBlockDB(t)
+	defer dbCloser()
+	blocks := execBlocks(t, store, blockdb, [][]*types.KeyValue{kvs[:50], kvs[50:]})
+	last := blocks[len(blocks)-1].Block
+
+	var buf bytes.Buffer
+	_, err := Export(&buf, store, blockdb, last.Height-1, 30)
+	assert.Equal(t, ErrNotLastBlock, err)
+	summary, err := Export(&buf, store, blockdb, last.Height, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, last.StateHash, summary.StateHash)
+}
+
+// mavl树的形状和插入顺序有关, 按区块乱序插入后不能由排序的状态一次重建
+func mavlSets() ([]*types.KeyValue, [][]*types.KeyValue) {
+	kvs := genKVs(100)
+	var sets [][]*types.KeyValue
+	for i := 0; i < 5; i++ {
+		var set []*types.KeyValue
+		for j := len(kvs) - 1 - i; j >= 0; j -= 5 {
+			set = append(set, kvs[j])
+		}
+		sets = append(sets, set)
+	}
+	return kvs, sets
+}
+
+func TestExportImportMavl(t *testing.T) {
+	kvs, sets := mavlSets()
+	data, blocks := exportChain(t, mavl.New, nil, sets)
+
+	store, closer := newStore(t, mavl.New, nil)
+	defer closer()
+	// 排序的状态一次写入得到的树根和区块头不同
+	hash, err := store.Set(&types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kvs, Height: 0}, true)
+	assert.Nil(t, err)
+	assert.NotEqual(t, blocks[len(blocks)-1].Block.StateHash, hash)
+
+	store, closer = newStore(t, mavl.New, nil)
+	defer closer()
+	summary := importChain(t, store, data, blocks)
+	assert.Equal(t, int64(100), summary.Count)
+	assert.True(t, summary.Nodes >= 199)
+	checkValues(t, store, summary.StateHash, kvs)
+
+	// 下一个区块接着快照的树执行
+	next := []*types.KeyValue{{Key: kvs[0].Key, Value: []byte("v100")}}
+	hash, err = store.MemSet(&types.StoreSet{StateHash: summary.StateHash, KV: next, Height: 5}, true)
+	assert.Nil(t, err)
+	_, err = store.Commit(&types.ReqHash{Hash: hash})
+	assert.Nil(t, err)
+	checkValues(t, store, hash, append(next, kvs[99]))
+}
+
+func TestExportImportKVmMavl(t *testing.T) {
+	kvs, sets := mavlSets()
+	sub := []byte(`{"enableMVCCIter":true}`)
+	data, blocks := exportChain(t, kvmvccmavl.New, sub, sets)
+
+	store, closer := newStore(t, kvmvccmavl.New, sub)
+	defer closer()
+	summary := importChain(t, store, data, blocks)
+	assert.Equal(t, int64(100), summary.Count)
+	assert.True(t, summary.Nodes >= 199)
+	checkValues(t, store, summary.StateHash, kvs)
+}
+
+func TestImportMavlTampered(t *testing.T) {
+	_, sets := mavlSets()
+	data, _ := exportChain(t, mavl.New, nil, sets)
+
+	// 修改状态数据
+	damaged := rewrite(t, data, nil, func(ty byte, kvs []*types.KeyValue) {
+		if ty == frameChunk {
+			kvs[0].Value = []byte("bad")
+		}
+	})
+	store, closer := newStore(t, mavl.New, nil)
+	defer closer()
+	blockdb, dbCloser := newBlockDB(t)
+	defer dbCloser()
+	_, err := Import(bytes.NewReader(damaged), store, blockdb)
+	assert.Equal(t, ErrStateHashMismatch, err)
+
+	// 修改树的节点
+	damaged = rewrite(t, data, nil, func(ty byte, kvs []*types.KeyValue) {
+		if ty == frameNodes {
+			kvs[len(kvs)-1].Value = append([]byte{}, kvs[0].Value...)
+		}
+	})
+	store, closer = newStore(t, mavl.New, nil)
+	defer closer()
+	_, err = Import(bytes.NewReader(damaged), store, blockdb)
+	assert.Equal(t, ErrStateHashMismatch, err)
+}
+
+func TestImportHeaderMismatch(t *testing.T) {
+	kvs := genKVs(100)
+	sets := [][]*types.KeyValue{kvs[:50], kvs[50:]}
+	sub := []byte(`{"enableMVCCIter":true}`)
+	// 快照中的状态hash和区块头不一致
+	badHeader := func(blocks []*types.BlockDetail) {
+		blocks[len(blocks)-1].Block.StateHash = []byte("bad state hash")
+	}
+	for _, create := range []drivers.Storecreate{mpt.New, kvdb.New, kvmvccdb.New} {
+		data, _ := exportChain(t, create, sub, sets)
+		store, closer := newStore(t, create, sub)
+		blockdb, dbCloser := newBlockDB(t)
+		_, err := Import(bytes.NewReader(rewrite(t, data, badHeader, nil)), store, blockdb)
+		assert.Equal(t, ErrStateHashMismatch, err)
+		dbCloser()
+		closer()
+	}
+
+	// 区块的状态变化和导入的状态不一致
+	data, _ := exportChain(t, kvmvccdb.New, sub, sets)
+	damaged := rewrite(t, data, nil, func(ty byte, kvs []*types.KeyValue) {
+		if ty == frameChunk && bytes.Equal(kvs[0].Key, []byte("mavl-coins-bty-0090")) {
+			kvs[0].Value = []byte("bad")
+		}
+	})
+	store, closer := newStore(t, kvmvccdb.New, sub)
+	defer closer()
+	blockdb, dbCloser := newBlockDB(t)
+	defer dbCloser()
+	_, err := Import(bytes.NewReader(damaged), store, blockdb)
+	assert.Equal(t, ErrStateHashMismatch, err)
+}
+
+func TestImportCorrupted(t *testing.T) {
+	data, _ := exportChain(t, mpt.New, nil, [][]*types.KeyValue{genKVs(100)})
+
+	store, closer := newStore(t, mpt.New, nil)
+	defer closer()
+	blockdb, dbCloser := newBlockDB(t)
+	defer dbCloser()
+	// 修改数据块中的一个字节
+	damaged := append([]byte{}, data...)
+	damaged[len(damaged)/2] ^= 0xff
+	_, err := Import(bytes.NewReader(damaged), store, blockdb)
+	assert.Equal(t, ErrSnapshotChecksum, err)
+	// 缺少结束标记
+	_, err = Import(bytes.NewReader(data[:len(data)-10]), store, blockdb)
+	assert.Equal(t, ErrSnapshotTruncated, err)
+	_, err = Import(bytes.NewReader([]byte("not a snapshot file")), store, blockdb)
+	assert.Equal(t, ErrSnapshotFormat, err)
+	// 本地数据在状态数据之前
+	damaged = rewrite(t, data, nil, nil)
+	sr, _ := newReader(bytes.NewReader(damaged))
+	blocks, tds, _ := sr.readBlocks()
+	var buf bytes.Buffer
+	sw, _ := newWriter(&buf)
+	assert.Nil(t, sw.writeBlocks(blocks, tds))
+	assert.Nil(t, sw.writeChunk(frameLocal, []*types.KeyValue{{Key: localKey, Value: []byte("local")}}))
+	assert.Nil(t, sw.writeChunk(frameChunk, genKVs(1)))
+	assert.Nil(t, sw.writeEnd())
+	_, err = Import(bytes.NewReader(buf.Bytes()), store, blockdb)
+	assert.Equal(t, ErrSnapshotFormat, err)
+}
+
+func TestCheckBlocks(t *testing.T) {
+	db, closer := newBlockDB(t)
+	defer closer()
+	store, storeCloser := newStore(t, mpt.New, nil)
+	defer storeCloser()
+	blocks := execBlocks(t, store, db, [][]*types.KeyValue{genKVs(1), genKVs(2), genKVs(3)})
+
+	// 区块不相连
+	assert.Equal(t, types.ErrParentHash, checkBlocks([]*types.BlockDetail{blocks[0], blocks[2]}))
+	// 区块数不够节点启动时加载
+	assert.Equal(t, types.ErrBlockNotFound, checkBlocks([]*types.BlockDetail{{Block: &types.Block{Height: blockchain.InitBlockNum + 1}}}))
+	assert.Nil(t, checkBlocks(blocks))
+	loaded, tds, err := loadBlocks(blockchain.NewBlockStore(nil, db, nil), 2)
+	assert.Nil(t, err)
+	assert.Len(t, loaded, 2)
+	assert.Equal(t, blocks[2].Block.Hash(), loaded[1].Block.Hash())
+	assert.Equal(t, int64(3), tds[1].Int64())
+	assert.Equal(t, ErrBlockChainNotEmpty, saveBlocks(db, blocks, tds))
+}
@@ -0,0 +1,142 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// chain33-snapshot 节点停止时导出某个高度的完整状态, 或者把状态快照导入新节点的数据目录
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/store"
+	_ "github.com/33cn/chain33/system"
+	drivers "github.com/33cn/chain33/system/store"
+	"github.com/33cn/chain33/types"
+	_ "github.com/33cn/plugin/plugin"
+	"github.com/33cn/plugin/plugin/store/snapshot"
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "chain33-snapshot",
+		Short: "Export or import the full state of a stopped node",
+	}
+	// 错误由main输出并以非零状态退出
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
+	rootCmd.PersistentFlags().StringP("conf", "f", "chain33.toml", "node config file")
+	rootCmd.AddCommand(exportCmd(), importCmd())
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the state at a height of a stopped node to a snapshot file, only the last block is available",
+		RunE:  exportSnapshot,
+	}
+	cmd.Flags().StringP("state", "s", "", "state hash of the last block, hex, checked against the block when set")
+	cmd.Flags().Int64P("height", "t", -1, "height to export, the last block when negative")
+	cmd.Flags().StringP("output", "o", "", "snapshot file")
+	cmd.MarkFlagRequired("output")
+	cmd.Flags().IntP("chunk", "c", snapshot.DefaultChunkSize, "kv count of a chunk")
+	return cmd
+}
+
+func importCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a snapshot file into an empty data dir, the node starts syncing after the snapshot height",
+		RunE:  importSnapshot,
+	}
+	cmd.Flags().StringP("input", "i", "", "snapshot file")
+	cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+func initNode(cmd *cobra.Command) (dbm.DB, queue.Module) {
+	conf, _ := cmd.Flags().GetString("conf")
+	cfg, sub := types.InitCfg(conf)
+	types.Init(cfg.Title, cfg)
+	blockdb := dbm.NewDB("blockchain", cfg.BlockChain.Driver, cfg.BlockChain.DbPath, cfg.BlockChain.DbCache)
+	return blockdb, store.New(cfg.Store, sub.Store)
+}
+
+func exportSnapshot(cmd *cobra.Command, args []string) error {
+	state, _ := cmd.Flags().GetString("state")
+	height, _ := cmd.Flags().GetInt64("height")
+	output, _ := cmd.Flags().GetString("output")
+	chunk, _ := cmd.Flags().GetInt("chunk")
+
+	blockdb, module := initNode(cmd)
+	defer blockdb.Close()
+	defer module.Close()
+	bs := blockchain.NewBlockStore(nil, blockdb, nil)
+	if height >= 0 && height != bs.Height() {
+		return fmt.Errorf("height %d: %v, the last block is at height %d", height, snapshot.ErrNotLastBlock, bs.Height())
+	}
+	if state != "" {
+		last, err := bs.LoadBlockByHeight(bs.Height())
+		if err != nil {
+			return err
+		}
+		stateHash, err := common.FromHex(state)
+		if err != nil || !bytes.Equal(stateHash, last.Block.StateHash) {
+			return fmt.Errorf("state hash does not match the last block at height %d", last.Block.Height)
+		}
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	summary, err := snapshot.Export(w, module.(drivers.SubStore), blockdb, height, chunk)
+	if err != nil {
+		return fmt.Errorf("export: %v", err)
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	printSummary(summary)
+	return nil
+}
+
+func importSnapshot(cmd *cobra.Command, args []string) error {
+	input, _ := cmd.Flags().GetString("input")
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	blockdb, module := initNode(cmd)
+	defer blockdb.Close()
+	defer module.Close()
+	summary, err := snapshot.Import(bufio.NewReader(file), module.(drivers.SubStore), blockdb)
+	if err != nil {
+		return fmt.Errorf("import: %v", err)
+	}
+	printSummary(summary)
+	return nil
+}
+
+func printSummary(summary *snapshot.Summary) {
+	fmt.Println("height:", summary.Height)
+	fmt.Println("stateHash:", common.ToHex(summary.StateHash))
+	fmt.Println("kvs:", summary.Count, "nodes:", summary.Nodes, "local:", summary.Local, "chunks:", summary.Chunks)
+}
@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package types store插件共用的定义
+package types
+
+// EventStorePluginBase store插件自定义事件的起点, chain33定义的事件都小于这个值
+// store模块不处理的事件交给具体存储的ProcEvent
+const EventStorePluginBase = 1000
+
+// store插件的查询事件, 新的事件只能加在最后
+const (
+	// mpt的状态证明
+	EventStoreGetProof = EventStorePluginBase + 1 + iota
+	EventStoreGetProofReply
+	// kvmvcc和kvmvccmavl按高度查询历史状态
+	EventStoreGetAtHeight
+	EventStoreGetAtHeightReply
+	EventStoreGetKeyVersions
+	EventStoreGetKeyVersionsReply
+)